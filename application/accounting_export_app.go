@@ -0,0 +1,37 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type accountingExportApp struct {
+	ar repository.AccountingExportRepository
+}
+
+// accountingExportApp implement the AccountingExportAppInterface.
+var _ AccountingExportAppInterface = &accountingExportApp{}
+
+// AccountingExportAppInterface is an interface.
+type AccountingExportAppInterface interface {
+	SaveCommerceMLExport(
+		request *entity.AccountingExportRequest,
+		source string,
+		createdBy string,
+	) (*entity.AccountingExport, error)
+	GetAccountingExports(p *repository.Parameters) ([]*entity.AccountingExport, *repository.Meta, error)
+}
+
+func (a accountingExportApp) SaveCommerceMLExport(
+	request *entity.AccountingExportRequest,
+	source string,
+	createdBy string,
+) (*entity.AccountingExport, error) {
+	return a.ar.SaveCommerceMLExport(request, source, createdBy)
+}
+
+func (a accountingExportApp) GetAccountingExports(
+	p *repository.Parameters,
+) ([]*entity.AccountingExport, *repository.Meta, error) {
+	return a.ar.GetAccountingExports(p)
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type auditLogApp struct {
+	ar repository.AuditLogRepository
+}
+
+// auditLogApp implement the AuditLogAppInterface.
+var _ AuditLogAppInterface = &auditLogApp{}
+
+// AuditLogAppInterface is an interface.
+type AuditLogAppInterface interface {
+	GetAuditLogs(p *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error)
+	GetEntityAuditLogs(
+		entityType string,
+		entityUUID string,
+		p *repository.Parameters,
+	) ([]*entity.AuditLog, *repository.Meta, error)
+	GetUserAuditLogs(userUUID string, p *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error)
+}
+
+func (a auditLogApp) GetAuditLogs(p *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error) {
+	return a.ar.GetAuditLogs(p)
+}
+
+func (a auditLogApp) GetEntityAuditLogs(
+	entityType string,
+	entityUUID string,
+	p *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return a.ar.GetEntityAuditLogs(entityType, entityUUID, p)
+}
+
+func (a auditLogApp) GetUserAuditLogs(
+	userUUID string,
+	p *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return a.ar.GetUserAuditLogs(userUUID, p)
+}
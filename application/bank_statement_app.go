@@ -0,0 +1,47 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type bankStatementApp struct {
+	br repository.BankStatementRepository
+}
+
+// bankStatementApp implement the BankStatementAppInterface.
+var _ BankStatementAppInterface = &bankStatementApp{}
+
+// BankStatementAppInterface is an interface.
+type BankStatementAppInterface interface {
+	SaveBankStatement(statement *entity.BankStatement) (*entity.BankStatement, error)
+	GetBankStatements(p *repository.Parameters) ([]*entity.BankStatement, *repository.Meta, error)
+	GetBankStatement(UUID string) (*entity.BankStatement, error)
+	ResolveBankStatementLine(
+		UUID string,
+		request *entity.BankStatementLineResolveRequest,
+		resolvedBy string,
+	) (*entity.BankStatementLine, map[string]string, error)
+}
+
+func (b bankStatementApp) SaveBankStatement(statement *entity.BankStatement) (*entity.BankStatement, error) {
+	return b.br.SaveBankStatement(statement)
+}
+
+func (b bankStatementApp) GetBankStatements(
+	p *repository.Parameters,
+) ([]*entity.BankStatement, *repository.Meta, error) {
+	return b.br.GetBankStatements(p)
+}
+
+func (b bankStatementApp) GetBankStatement(UUID string) (*entity.BankStatement, error) {
+	return b.br.GetBankStatement(UUID)
+}
+
+func (b bankStatementApp) ResolveBankStatementLine(
+	UUID string,
+	request *entity.BankStatementLineResolveRequest,
+	resolvedBy string,
+) (*entity.BankStatementLine, map[string]string, error) {
+	return b.br.ResolveBankStatementLine(UUID, request, resolvedBy)
+}
@@ -0,0 +1,32 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type calendarApp struct {
+	cr repository.CalendarRepository
+}
+
+// calendarApp implement the CalendarAppInterface.
+var _ CalendarAppInterface = &calendarApp{}
+
+// CalendarAppInterface is an interface.
+type CalendarAppInterface interface {
+	GetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error)
+	ResetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error)
+	GetCalendarFeed(token string) (*entity.CalendarFeed, error)
+}
+
+func (c calendarApp) GetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error) {
+	return c.cr.GetCalendarTokens(userUUID)
+}
+
+func (c calendarApp) ResetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error) {
+	return c.cr.ResetCalendarTokens(userUUID)
+}
+
+func (c calendarApp) GetCalendarFeed(token string) (*entity.CalendarFeed, error) {
+	return c.cr.GetCalendarFeed(token)
+}
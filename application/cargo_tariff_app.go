@@ -0,0 +1,52 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type cargoTariffApp struct {
+	tr repository.CargoTariffRepository
+}
+
+// cargoTariffApp implement the CargoTariffAppInterface.
+var _ CargoTariffAppInterface = &cargoTariffApp{}
+
+// CargoTariffAppInterface is an interface.
+type CargoTariffAppInterface interface {
+	SaveCargoTariff(*entity.CargoTariff) (*entity.CargoTariff, map[string]string, error)
+	UpdateCargoTariff(UUID string, tariff *entity.CargoTariff) (*entity.CargoTariff, map[string]string, error)
+	DeleteCargoTariff(UUID string) error
+	GetCargoTariffs(p *repository.Parameters) ([]*entity.CargoTariff, *repository.Meta, error)
+	GetCargoTariff(UUID string) (*entity.CargoTariff, error)
+	QuoteShipment(shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error)
+}
+
+func (t cargoTariffApp) SaveCargoTariff(tariff *entity.CargoTariff) (*entity.CargoTariff, map[string]string, error) {
+	return t.tr.SaveCargoTariff(tariff)
+}
+
+func (t cargoTariffApp) UpdateCargoTariff(
+	UUID string,
+	tariff *entity.CargoTariff,
+) (*entity.CargoTariff, map[string]string, error) {
+	return t.tr.UpdateCargoTariff(UUID, tariff)
+}
+
+func (t cargoTariffApp) DeleteCargoTariff(UUID string) error {
+	return t.tr.DeleteCargoTariff(UUID)
+}
+
+func (t cargoTariffApp) GetCargoTariffs(
+	p *repository.Parameters,
+) ([]*entity.CargoTariff, *repository.Meta, error) {
+	return t.tr.GetCargoTariffs(p)
+}
+
+func (t cargoTariffApp) GetCargoTariff(UUID string) (*entity.CargoTariff, error) {
+	return t.tr.GetCargoTariff(UUID)
+}
+
+func (t cargoTariffApp) QuoteShipment(shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error) {
+	return t.tr.QuoteShipment(shipment)
+}
@@ -0,0 +1,37 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type codApp struct {
+	tr repository.CODRepository
+}
+
+// codApp implement the CODAppInterface.
+var _ CODAppInterface = &codApp{}
+
+// CODAppInterface is an interface.
+type CODAppInterface interface {
+	GetCODSettlements(request *entity.CODSettlementRequest) ([]*entity.CODSettlement, error)
+	SaveCODPayouts(request *entity.CODSettlementRequest, createdBy string) ([]*entity.CODPayout, error)
+	GetCODPayouts(p *repository.Parameters) ([]*entity.CODPayout, *repository.Meta, error)
+	GetCODPayout(UUID string) (*entity.CODPayout, error)
+}
+
+func (t codApp) GetCODSettlements(request *entity.CODSettlementRequest) ([]*entity.CODSettlement, error) {
+	return t.tr.GetCODSettlements(request)
+}
+
+func (t codApp) SaveCODPayouts(request *entity.CODSettlementRequest, createdBy string) ([]*entity.CODPayout, error) {
+	return t.tr.SaveCODPayouts(request, createdBy)
+}
+
+func (t codApp) GetCODPayouts(p *repository.Parameters) ([]*entity.CODPayout, *repository.Meta, error) {
+	return t.tr.GetCODPayouts(p)
+}
+
+func (t codApp) GetCODPayout(UUID string) (*entity.CODPayout, error) {
+	return t.tr.GetCODPayout(UUID)
+}
@@ -0,0 +1,22 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type dataImportApp struct {
+	dr repository.DataImportRepository
+}
+
+// dataImportApp implement the DataImportAppInterface.
+var _ DataImportAppInterface = &dataImportApp{}
+
+// DataImportAppInterface is an interface.
+type DataImportAppInterface interface {
+	SaveDataImport(data *entity.DataImport) (*entity.DataImport, error)
+}
+
+func (d dataImportApp) SaveDataImport(data *entity.DataImport) (*entity.DataImport, error) {
+	return d.dr.SaveDataImport(data)
+}
@@ -23,6 +23,13 @@ type DriverAppInterface interface {
 
 	AddDriverVehicle(driver *entity.Driver) (*entity.Driver, map[string]string, error)
 	DeleteDriverVehicle(dirive *entity.Driver) (*entity.Driver, map[string]string, error)
+
+	GetDriverWorkRule() (*entity.DriverWorkRule, error)
+	UpdateDriverWorkRule(rule *entity.DriverWorkRule) (*entity.DriverWorkRule, error)
+	GetDriverTimesheet(
+		UUID string,
+		request *entity.DriverTimesheetRequest,
+	) (*entity.DriverTimesheet, map[string]string, error)
 }
 
 func (t driverApp) SaveDriver(driver *entity.Driver) (*entity.Driver, map[string]string, error) {
@@ -52,3 +59,18 @@ func (t driverApp) AddDriverVehicle(driver *entity.Driver) (*entity.Driver, map[
 func (t driverApp) DeleteDriverVehicle(driver *entity.Driver) (*entity.Driver, map[string]string, error) {
 	return t.tr.DeleteDriverVehicle(driver)
 }
+
+func (t driverApp) GetDriverWorkRule() (*entity.DriverWorkRule, error) {
+	return t.tr.GetDriverWorkRule()
+}
+
+func (t driverApp) UpdateDriverWorkRule(rule *entity.DriverWorkRule) (*entity.DriverWorkRule, error) {
+	return t.tr.UpdateDriverWorkRule(rule)
+}
+
+func (t driverApp) GetDriverTimesheet(
+	UUID string,
+	request *entity.DriverTimesheetRequest,
+) (*entity.DriverTimesheet, map[string]string, error) {
+	return t.tr.GetDriverTimesheet(UUID, request)
+}
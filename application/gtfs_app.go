@@ -0,0 +1,27 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type gtfsApp struct {
+	gr repository.GTFSRepository
+}
+
+// gtfsApp implement the GTFSAppInterface.
+var _ GTFSAppInterface = &gtfsApp{}
+
+// GTFSAppInterface is an interface.
+type GTFSAppInterface interface {
+	GetGTFSExport(export *entity.GTFSExport) (*entity.GTFSExport, error)
+	SaveGTFSImport(data *entity.GTFSImport) (*entity.GTFSImport, error)
+}
+
+func (g gtfsApp) GetGTFSExport(export *entity.GTFSExport) (*entity.GTFSExport, error) {
+	return g.gr.GetGTFSExport(export)
+}
+
+func (g gtfsApp) SaveGTFSImport(data *entity.GTFSImport) (*entity.GTFSImport, error) {
+	return g.gr.SaveGTFSImport(data)
+}
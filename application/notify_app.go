@@ -16,6 +16,7 @@ type NotifyAppInterface interface {
 	ToEmail() *notify.Notification
 	ToSMS() *notify.Notification
 	Notify(receiver []string, template string, templateData interface{}, language string) *notify.Notification
+	SMSEnabled() bool
 }
 
 // ToEmail is an implementation of method ToEmail.
@@ -36,3 +37,8 @@ func (n notifyApp) Notify(
 	language string) *notify.Notification {
 	return n.ni.Notify(receiver, template, templateData, language)
 }
+
+// SMSEnabled is an implementation of method SMSEnabled.
+func (n notifyApp) SMSEnabled() bool {
+	return n.ni.SMSEnabled()
+}
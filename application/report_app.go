@@ -0,0 +1,37 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type reportApp struct {
+	tr repository.ReportRepository
+}
+
+// reportApp implement the ReportAppInterface.
+var _ ReportAppInterface = &reportApp{}
+
+// ReportAppInterface is an interface.
+type ReportAppInterface interface {
+	GetRevenueReport(request *entity.ReportRequest) (entity.ReportRevenues, error)
+	GetLoadFactorReport(request *entity.ReportRequest) (entity.ReportLoadFactors, error)
+	GetAverageFareReport(request *entity.ReportRequest) (entity.ReportAverageFares, error)
+	GetCancellationReport(request *entity.ReportRequest) (entity.ReportCancellations, error)
+}
+
+func (t reportApp) GetRevenueReport(request *entity.ReportRequest) (entity.ReportRevenues, error) {
+	return t.tr.GetRevenueReport(request)
+}
+
+func (t reportApp) GetLoadFactorReport(request *entity.ReportRequest) (entity.ReportLoadFactors, error) {
+	return t.tr.GetLoadFactorReport(request)
+}
+
+func (t reportApp) GetAverageFareReport(request *entity.ReportRequest) (entity.ReportAverageFares, error) {
+	return t.tr.GetAverageFareReport(request)
+}
+
+func (t reportApp) GetCancellationReport(request *entity.ReportRequest) (entity.ReportCancellations, error) {
+	return t.tr.GetCancellationReport(request)
+}
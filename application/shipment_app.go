@@ -0,0 +1,107 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type shipmentApp struct {
+	tr repository.ShipmentRepository
+}
+
+// shipmentApp implement the ShipmentAppInterface.
+var _ ShipmentAppInterface = &shipmentApp{}
+
+// ShipmentAppInterface is an interface.
+type ShipmentAppInterface interface {
+	SaveShipment(*entity.Shipment) (*entity.Shipment, map[string]string, error)
+	UpdateShipment(
+		UUID string,
+		shipment *entity.Shipment,
+	) (*entity.Shipment, map[string]string, error)
+	DeleteShipment(UUID string) error
+	GetShipments(p *repository.Parameters) ([]*entity.Shipment, *repository.Meta, error)
+	GetShipment(UUID string) (*entity.Shipment, error)
+	GetShipmentByTrackingNumber(trackingNumber string) (*entity.Shipment, error)
+	GetTripShipments(tripUUID string) ([]*entity.Shipment, error)
+	SaveShipmentEvent(
+		UUID string,
+		event *entity.ShipmentEvent,
+	) (*entity.ShipmentEvent, map[string]string, error)
+	IssueShipmentDeliveryCode(UUID string) (*entity.Shipment, map[string]string, error)
+	VerifyShipmentDelivery(UUID string, delivery *entity.ShipmentDelivery) (map[string]string, error)
+	SaveShipmentDelivery(
+		UUID string,
+		delivery *entity.ShipmentDelivery,
+	) (*entity.ShipmentDelivery, map[string]string, error)
+	GetShipmentDelivery(UUID string) (*entity.ShipmentDelivery, error)
+	GetShipmentEvents(UUID string) ([]*entity.ShipmentEvent, error)
+}
+
+func (t shipmentApp) SaveShipment(
+	shipment *entity.Shipment,
+) (*entity.Shipment, map[string]string, error) {
+	return t.tr.SaveShipment(shipment)
+}
+
+func (t shipmentApp) UpdateShipment(
+	UUID string,
+	shipment *entity.Shipment,
+) (*entity.Shipment, map[string]string, error) {
+	return t.tr.UpdateShipment(UUID, shipment)
+}
+
+func (t shipmentApp) DeleteShipment(UUID string) error {
+	return t.tr.DeleteShipment(UUID)
+}
+
+func (t shipmentApp) GetShipments(
+	p *repository.Parameters,
+) ([]*entity.Shipment, *repository.Meta, error) {
+	return t.tr.GetShipments(p)
+}
+
+func (t shipmentApp) GetShipment(UUID string) (*entity.Shipment, error) {
+	return t.tr.GetShipment(UUID)
+}
+
+func (t shipmentApp) GetShipmentByTrackingNumber(trackingNumber string) (*entity.Shipment, error) {
+	return t.tr.GetShipmentByTrackingNumber(trackingNumber)
+}
+
+func (t shipmentApp) GetTripShipments(tripUUID string) ([]*entity.Shipment, error) {
+	return t.tr.GetTripShipments(tripUUID)
+}
+
+func (t shipmentApp) SaveShipmentEvent(
+	UUID string,
+	event *entity.ShipmentEvent,
+) (*entity.ShipmentEvent, map[string]string, error) {
+	return t.tr.SaveShipmentEvent(UUID, event)
+}
+
+func (t shipmentApp) GetShipmentEvents(UUID string) ([]*entity.ShipmentEvent, error) {
+	return t.tr.GetShipmentEvents(UUID)
+}
+
+func (t shipmentApp) IssueShipmentDeliveryCode(UUID string) (*entity.Shipment, map[string]string, error) {
+	return t.tr.IssueShipmentDeliveryCode(UUID)
+}
+
+func (t shipmentApp) VerifyShipmentDelivery(
+	UUID string,
+	delivery *entity.ShipmentDelivery,
+) (map[string]string, error) {
+	return t.tr.VerifyShipmentDelivery(UUID, delivery)
+}
+
+func (t shipmentApp) SaveShipmentDelivery(
+	UUID string,
+	delivery *entity.ShipmentDelivery,
+) (*entity.ShipmentDelivery, map[string]string, error) {
+	return t.tr.SaveShipmentDelivery(UUID, delivery)
+}
+
+func (t shipmentApp) GetShipmentDelivery(UUID string) (*entity.ShipmentDelivery, error) {
+	return t.tr.GetShipmentDelivery(UUID)
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type trashApp struct {
+	tr repository.TrashRepository
+}
+
+// trashApp implement the TrashAppInterface.
+var _ TrashAppInterface = &trashApp{}
+
+// TrashAppInterface is an interface.
+type TrashAppInterface interface {
+	GetTrash(resource *entity.TrashResource, p *repository.Parameters) ([]interface{}, *repository.Meta, error)
+	RestoreTrash(resource *entity.TrashResource, UUID string) (interface{}, map[string]string, error)
+	PurgeTrash(resource *entity.TrashResource, UUID string) error
+}
+
+func (t trashApp) GetTrash(
+	resource *entity.TrashResource,
+	p *repository.Parameters,
+) ([]interface{}, *repository.Meta, error) {
+	return t.tr.GetTrash(resource, p)
+}
+
+func (t trashApp) RestoreTrash(resource *entity.TrashResource, UUID string) (interface{}, map[string]string, error) {
+	return t.tr.RestoreTrash(resource, UUID)
+}
+
+func (t trashApp) PurgeTrash(resource *entity.TrashResource, UUID string) error {
+	return t.tr.PurgeTrash(resource, UUID)
+}
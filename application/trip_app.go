@@ -22,6 +22,10 @@ type TripAppInterface interface {
 	DeleteTrip(UUID string) error
 	GetTrips(p *repository.Parameters) ([]*entity.Trip, *repository.Meta, error)
 	GetTrip(UUID string) (*entity.Trip, error)
+	GetTripLoadPlan(UUID string, weight float64, volume float64) (*entity.TripLoadPlan, error)
+	SaveTripRoute(UUID string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error)
+	GetTripRoute(UUID string) (*entity.TripRoute, error)
+	GetDriverTripRoutes(userUUID string) ([]*entity.TripRoute, error)
 }
 
 func (t tripApp) SaveTrip(
@@ -50,3 +54,19 @@ func (t tripApp) GetTrips(
 func (t tripApp) GetTrip(UUID string) (*entity.Trip, error) {
 	return t.tr.GetTrip(UUID)
 }
+
+func (t tripApp) GetTripLoadPlan(UUID string, weight float64, volume float64) (*entity.TripLoadPlan, error) {
+	return t.tr.GetTripLoadPlan(UUID, weight, volume)
+}
+
+func (t tripApp) SaveTripRoute(UUID string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error) {
+	return t.tr.SaveTripRoute(UUID, route)
+}
+
+func (t tripApp) GetTripRoute(UUID string) (*entity.TripRoute, error) {
+	return t.tr.GetTripRoute(UUID)
+}
+
+func (t tripApp) GetDriverTripRoutes(userUUID string) ([]*entity.TripRoute, error) {
+	return t.tr.GetDriverTripRoutes(userUUID)
+}
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type vehicleComplianceApp struct {
+	vr repository.VehicleComplianceRepository
+}
+
+// vehicleComplianceApp implement the VehicleComplianceAppInterface.
+var _ VehicleComplianceAppInterface = &vehicleComplianceApp{}
+
+// VehicleComplianceAppInterface is an interface.
+type VehicleComplianceAppInterface interface {
+	SaveVehicleCompliance(*entity.VehicleCompliance) (*entity.VehicleCompliance, map[string]string, error)
+	UpdateVehicleCompliance(
+		UUID string,
+		record *entity.VehicleCompliance,
+	) (*entity.VehicleCompliance, map[string]string, error)
+	DeleteVehicleCompliance(UUID string) error
+	GetVehicleCompliances(p *repository.Parameters) ([]*entity.VehicleCompliance, *repository.Meta, error)
+	GetVehicleCompliance(UUID string) (*entity.VehicleCompliance, error)
+	GetExpiringVehicleCompliances(days int) ([]*entity.VehicleCompliance, error)
+}
+
+func (v vehicleComplianceApp) SaveVehicleCompliance(
+	record *entity.VehicleCompliance,
+) (*entity.VehicleCompliance, map[string]string, error) {
+	return v.vr.SaveVehicleCompliance(record)
+}
+
+func (v vehicleComplianceApp) UpdateVehicleCompliance(
+	UUID string,
+	record *entity.VehicleCompliance,
+) (*entity.VehicleCompliance, map[string]string, error) {
+	return v.vr.UpdateVehicleCompliance(UUID, record)
+}
+
+func (v vehicleComplianceApp) DeleteVehicleCompliance(UUID string) error {
+	return v.vr.DeleteVehicleCompliance(UUID)
+}
+
+func (v vehicleComplianceApp) GetVehicleCompliances(
+	p *repository.Parameters,
+) ([]*entity.VehicleCompliance, *repository.Meta, error) {
+	return v.vr.GetVehicleCompliances(p)
+}
+
+func (v vehicleComplianceApp) GetVehicleCompliance(UUID string) (*entity.VehicleCompliance, error) {
+	return v.vr.GetVehicleCompliance(UUID)
+}
+
+func (v vehicleComplianceApp) GetExpiringVehicleCompliances(days int) ([]*entity.VehicleCompliance, error) {
+	return v.vr.GetExpiringVehicleCompliances(days)
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+type webhookApp struct {
+	wr repository.WebhookRepository
+}
+
+// webhookApp implement the WebhookAppInterface.
+var _ WebhookAppInterface = &webhookApp{}
+
+// WebhookAppInterface is an interface.
+type WebhookAppInterface interface {
+	SaveWebhookSubscription(*entity.WebhookSubscription) (*entity.WebhookSubscription, map[string]string, error)
+	UpdateWebhookSubscription(
+		UUID string,
+		subscription *entity.WebhookSubscription,
+	) (*entity.WebhookSubscription, map[string]string, error)
+	DeleteWebhookSubscription(UUID string) error
+	GetWebhookSubscription(UUID string) (*entity.WebhookSubscription, error)
+	GetWebhookSubscriptions(p *repository.Parameters) ([]*entity.WebhookSubscription, *repository.Meta, error)
+	GetWebhookDeliveries(
+		subscriptionUUID string,
+		p *repository.Parameters,
+	) ([]*entity.WebhookDelivery, *repository.Meta, error)
+	ReplayWebhookDelivery(UUID string) (*entity.WebhookDelivery, error)
+}
+
+func (w webhookApp) SaveWebhookSubscription(
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, map[string]string, error) {
+	return w.wr.SaveWebhookSubscription(subscription)
+}
+
+func (w webhookApp) UpdateWebhookSubscription(
+	UUID string,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, map[string]string, error) {
+	return w.wr.UpdateWebhookSubscription(UUID, subscription)
+}
+
+func (w webhookApp) DeleteWebhookSubscription(UUID string) error {
+	return w.wr.DeleteWebhookSubscription(UUID)
+}
+
+func (w webhookApp) GetWebhookSubscription(UUID string) (*entity.WebhookSubscription, error) {
+	return w.wr.GetWebhookSubscription(UUID)
+}
+
+func (w webhookApp) GetWebhookSubscriptions(
+	p *repository.Parameters,
+) ([]*entity.WebhookSubscription, *repository.Meta, error) {
+	return w.wr.GetWebhookSubscriptions(p)
+}
+
+func (w webhookApp) GetWebhookDeliveries(
+	subscriptionUUID string,
+	p *repository.Parameters,
+) ([]*entity.WebhookDelivery, *repository.Meta, error) {
+	return w.wr.GetWebhookDeliveries(subscriptionUUID, p)
+}
+
+func (w webhookApp) ReplayWebhookDelivery(UUID string) (*entity.WebhookDelivery, error) {
+	return w.wr.ReplayWebhookDelivery(UUID)
+}
@@ -63,6 +63,14 @@ type SMTPConfig struct {
 	SMTPPassword string
 }
 
+// SMSConfig represent sms gateway config keys, sms is not sent when provider is not set.
+type SMSConfig struct {
+	SMSProvider string
+	SMSURL      string
+	SMSAPIID    string
+	SMSSender   string
+}
+
 // RollbarConfig represent rollbar config keys.
 type RollbarConfig struct {
 	Token       string
@@ -86,6 +94,66 @@ type KeyConfig struct {
 	AppPublicKey  string
 }
 
+// RateLimitConfig represent rate limit config keys.
+type RateLimitConfig struct {
+	TrackingLimit  int
+	TrackingWindow int
+	GTFSLimit      int
+	GTFSWindow     int
+}
+
+// FiscalConfig represent fiscal provider config keys.
+type FiscalConfig struct {
+	Provider       string
+	URL            string
+	Login          string
+	Password       string
+	GroupCode      string
+	INN            string
+	Email          string
+	SNO            string
+	PaymentAddress string
+	VAT            string
+	MaxAttempts    int
+	Interval       int
+}
+
+// WebhookConfig represent webhook delivery config keys, timeout and interval are in seconds.
+type WebhookConfig struct {
+	Timeout     int
+	MaxAttempts int
+	Interval    int
+}
+
+// OutboxConfig represent relay of outbox events config keys, interval is in seconds.
+// Events are appended to Redis Stream when stream is set.
+type OutboxConfig struct {
+	MaxAttempts       int
+	Interval          int
+	RedisStream       string
+	RedisStreamMaxLen int
+}
+
+// AuditConfig represent audit log config keys, logs older than retention days are purged.
+type AuditConfig struct {
+	RetentionDays int
+}
+
+// IdempotencyConfig represent idempotency keys config keys, lifetimes are in seconds.
+// Response is stored for TTL, request which was interrupted keeps its key locked for LockTTL.
+type IdempotencyConfig struct {
+	TTL     int
+	LockTTL int
+}
+
+// GTFSConfig represent agency of GTFS feed config keys.
+type GTFSConfig struct {
+	AgencyName  string
+	AgencyURL   string
+	AgencyLang  string
+	AgencyPhone string
+}
+
 // Config represent config keys.
 type Config struct {
 	DBConfig
@@ -94,9 +162,17 @@ type Config struct {
 	RedisTestConfig
 	MinioConfig
 	SMTPConfig
+	SMSConfig
 	RollbarConfig
 	Oauth2Config
 	KeyConfig
+	RateLimitConfig
+	FiscalConfig
+	WebhookConfig
+	OutboxConfig
+	AuditConfig
+	IdempotencyConfig
+	GTFSConfig
 	AppEnvironment  string
 	AppLanguage     string
 	AppTimezone     string
@@ -153,6 +229,12 @@ func New() *Config {
 			SMTPUsername: getEnv("SMTP_USERNAME", ""),
 			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 		},
+		SMSConfig: SMSConfig{
+			SMSProvider: getEnv("SMS_PROVIDER", ""),
+			SMSURL:      getEnv("SMS_URL", ""),
+			SMSAPIID:    getEnv("SMS_API_ID", ""),
+			SMSSender:   getEnv("SMS_SENDER", ""),
+		},
 		RollbarConfig: RollbarConfig{
 			Token:       getEnv("ROLLBAR_TOKEN", ""),
 			Environment: getEnv("APP_ENV", "local"),
@@ -171,6 +253,50 @@ func New() *Config {
 			AppPrivateKey: getEnv("APP_PRIVATE_KEY", "default-private-key"),
 			AppPublicKey:  getEnv("APP_PUBLIC_KEY", "default-public-key"),
 		},
+		RateLimitConfig: RateLimitConfig{
+			TrackingLimit:  getEnvAsInt("RATE_LIMIT_TRACKING", 30),
+			TrackingWindow: getEnvAsInt("RATE_LIMIT_TRACKING_WINDOW", 60),
+			GTFSLimit:      getEnvAsInt("RATE_LIMIT_GTFS", 10),
+			GTFSWindow:     getEnvAsInt("RATE_LIMIT_GTFS_WINDOW", 60),
+		},
+		FiscalConfig: FiscalConfig{
+			Provider:       getEnv("FISCAL_PROVIDER", ""),
+			URL:            getEnv("FISCAL_URL", ""),
+			Login:          getEnv("FISCAL_LOGIN", ""),
+			Password:       getEnv("FISCAL_PASSWORD", ""),
+			GroupCode:      getEnv("FISCAL_GROUP_CODE", ""),
+			INN:            getEnv("FISCAL_INN", ""),
+			Email:          getEnv("FISCAL_EMAIL", ""),
+			SNO:            getEnv("FISCAL_SNO", "osn"),
+			PaymentAddress: getEnv("FISCAL_PAYMENT_ADDRESS", ""),
+			VAT:            getEnv("FISCAL_VAT", "vat20"),
+			MaxAttempts:    getEnvAsInt("FISCAL_MAX_ATTEMPTS", 10),
+			Interval:       getEnvAsInt("FISCAL_INTERVAL", 60),
+		},
+		WebhookConfig: WebhookConfig{
+			Timeout:     getEnvAsInt("WEBHOOK_TIMEOUT", 10),
+			MaxAttempts: getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			Interval:    getEnvAsInt("WEBHOOK_INTERVAL", 30),
+		},
+		OutboxConfig: OutboxConfig{
+			MaxAttempts:       getEnvAsInt("OUTBOX_MAX_ATTEMPTS", 20),
+			Interval:          getEnvAsInt("OUTBOX_INTERVAL", 5),
+			RedisStream:       getEnv("OUTBOX_REDIS_STREAM", ""),
+			RedisStreamMaxLen: getEnvAsInt("OUTBOX_REDIS_STREAM_MAX_LEN", 100000),
+		},
+		AuditConfig: AuditConfig{
+			RetentionDays: getEnvAsInt("AUDIT_RETENTION_DAYS", 365),
+		},
+		IdempotencyConfig: IdempotencyConfig{
+			TTL:     getEnvAsInt("IDEMPOTENCY_TTL", 86400),
+			LockTTL: getEnvAsInt("IDEMPOTENCY_LOCK_TTL", 60),
+		},
+		GTFSConfig: GTFSConfig{
+			AgencyName:  getEnv("GTFS_AGENCY_NAME", "Cargo"),
+			AgencyURL:   getEnv("GTFS_AGENCY_URL", "http://localhost"),
+			AgencyLang:  getEnv("GTFS_AGENCY_LANG", "ru"),
+			AgencyPhone: getEnv("GTFS_AGENCY_PHONE", ""),
+		},
 		AppEnvironment:  getEnv("APP_ENV", "local"),
 		AppLanguage:     getEnv("APP_LANG", "en"),
 		AppTimezone:     getEnv("APP_TIMEZONE", "Europe/Moscow"),
@@ -118,8 +118,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/documentType": {
-            "post": {
+        "/api/v1/external/accountingExports": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -128,17 +128,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new documentType.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get list of accounting exports with exported period and number of records, the latest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "document types"
+                    "accounting exports"
                 ],
-                "summary": "Create a new documentType",
+                "summary": "Get accounting exports",
                 "parameters": [
                     {
                         "enum": [
@@ -156,20 +153,11 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "DocumentType documentType",
-                        "name": "documentType",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailDocumentType"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -192,8 +180,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -207,8 +195,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/documentType/uuid": {
-            "put": {
+        "/api/v1/external/accountingExports/commerceml": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -217,17 +205,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing documentType.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Export cities, routes, passenger types, orders, payments and refunds changed since the latest export\nas CommerceML 2 file for 1C, end of exported period becomes watermark of the next export.",
                 "produces": [
-                    "application/json"
+                    "text/xml"
                 ],
                 "tags": [
-                    "document types"
+                    "accounting exports"
                 ],
-                "summary": "Update documentType",
+                "summary": "Export sales and reference data in CommerceML",
                 "parameters": [
                     {
                         "enum": [
@@ -247,46 +232,17 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "DocumentType UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "DocumentType name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "DocumentType region",
-                        "name": "region",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "DocumentType latitude",
-                        "name": "latitude",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "DocumentType longitude",
-                        "name": "longitude",
-                        "in": "formData",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Export all records ignoring watermark",
+                        "name": "full",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.successOutput"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -307,12 +263,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -322,7 +272,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/documentType/{uuid}": {
+        "/api/v1/external/auditLogs": {
             "get": {
                 "security": [
                     {
@@ -332,14 +282,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing documentType.",
+                "description": "Get changes of data, the latest first. Every create, update and delete of record is logged with\nuser, application of API key and request ID of request which made it and changed fields with values\nbefore and after change. Logs are append-only and are kept for retention period.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "document types"
+                    "audit logs"
                 ],
-                "summary": "Get documentType",
+                "summary": "Get audit logs",
                 "parameters": [
                     {
                         "enum": [
@@ -357,13 +307,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "DocumentType UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -391,8 +334,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -404,8 +347,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/auditLogs/{entity}/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -414,14 +359,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing documentType.",
+                "description": "Get changes of record, entity is a name of table of record, e.g. trips.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "document types"
+                    "audit logs"
                 ],
-                "summary": "Delete documentType",
+                "summary": "Get audit logs of record",
                 "parameters": [
                     {
                         "enum": [
@@ -442,7 +387,14 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "DocumentType UUID",
+                        "description": "Entity type",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -473,8 +425,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -488,7 +440,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/documentTypes": {
+        "/api/v1/external/bankStatements": {
             "get": {
                 "security": [
                     {
@@ -498,14 +450,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing documentTypes.",
+                "description": "Get list of imported bank statements with number of matched, unmatched and discrepancy lines.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "document types"
+                    "bank statements"
                 ],
-                "summary": "Get documentTypes",
+                "summary": "Get bank statements",
                 "parameters": [
                     {
                         "enum": [
@@ -550,8 +502,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -563,9 +515,7 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/driver": {
+            },
             "post": {
                 "security": [
                     {
@@ -575,17 +525,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new driver.",
+                "description": "Import CSV or 1CClientBankExchange statement and match its lines to payments by external ID, amount and date.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "bank statements"
                 ],
-                "summary": "Create a new driver",
+                "summary": "Import bank statement",
                 "parameters": [
                     {
                         "enum": [
@@ -605,13 +555,21 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Driver driver",
-                        "name": "driver",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailDriver"
-                        }
+                        "type": "file",
+                        "description": "Statement file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "1c"
+                        ],
+                        "type": "string",
+                        "description": "Statement format, detected when empty",
+                        "name": "format",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -639,8 +597,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -654,7 +612,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/driver/uuid": {
+        "/api/v1/external/bankStatements/lines/{uuid}": {
             "put": {
                 "security": [
                     {
@@ -664,7 +622,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing driver.",
+                "description": "Match unmatched or discrepancy line to payment by hand or ignore it, e.g. bank fee.",
                 "consumes": [
                     "application/json"
                 ],
@@ -672,9 +630,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "bank statements"
                 ],
-                "summary": "Update driver",
+                "summary": "Resolve bank statement line",
                 "parameters": [
                     {
                         "enum": [
@@ -694,12 +652,19 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Driver driver",
-                        "name": "driver",
+                        "type": "string",
+                        "description": "Bank statement line UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resolution of line",
+                        "name": "resolution",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.DetailDriver"
+                            "$ref": "#/definitions/entity.BankStatementLineResolveRequest"
                         }
                     }
                 ],
@@ -734,6 +699,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -743,8 +714,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/driver/vehicle_add": {
-            "post": {
+        "/api/v1/external/bankStatements/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -753,17 +724,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Add vehicle to a driver.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get bank statement with matched, unmatched, discrepancy and resolved lines.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "bank statements"
                 ],
-                "summary": "Add vehicle to a driver",
+                "summary": "Get bank statement",
                 "parameters": [
                     {
                         "enum": [
@@ -783,20 +751,24 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Driver driver",
-                        "name": "driver",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailDriver"
-                        }
+                        "type": "string",
+                        "description": "Bank statement UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -832,8 +804,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/driver/vehicle_del": {
-            "post": {
+        "/api/v1/external/calendars": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -842,17 +814,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete vehicle to a driver.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get subscription URLs of iCalendar feeds of current user: feed of paid orders\nand feed of assigned trips when user is a driver. URLs are issued on the first request.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "calendars"
                 ],
-                "summary": "Delete vehicle to a driver",
+                "summary": "Get calendar subscriptions",
                 "parameters": [
                     {
                         "enum": [
@@ -870,20 +839,11 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "Driver driver",
-                        "name": "driver",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailDriver"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -900,18 +860,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -921,8 +869,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/driver/{uuid}": {
-            "get": {
+        "/api/v1/external/calendars/reset": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -931,14 +879,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing driver.",
+                "description": "Revoke subscription URLs of iCalendar feeds of current user and issue new ones.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "calendars"
                 ],
-                "summary": "Get driver",
+                "summary": "Reset calendar subscriptions",
                 "parameters": [
                     {
                         "enum": [
@@ -956,13 +904,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Driver UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -984,18 +925,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1003,8 +932,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/cargoTariffs": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1013,14 +944,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing driver.",
+                "description": "Get list of existing cargo tariffs.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "cargo tariffs"
                 ],
-                "summary": "Delete driver",
+                "summary": "Get cargo tariffs",
                 "parameters": [
                     {
                         "enum": [
@@ -1038,13 +969,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Driver UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -1072,8 +996,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -1085,10 +1009,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/drivers": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1097,14 +1019,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing drivers.",
+                "description": "Create a new cargo tariff, tariff without route applies to all routes without own tariff.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "drivers"
+                    "cargo tariffs"
                 ],
-                "summary": "Get drivers",
+                "summary": "Create a new cargo tariff",
                 "parameters": [
                     {
                         "enum": [
@@ -1122,11 +1047,20 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "description": "Cargo tariff data",
+                        "name": "cargo_tariff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailCargoTariff"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -1149,8 +1083,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -1164,19 +1098,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/login": {
+        "/api/v1/external/cargoTariffs/quote": {
             "post": {
-                "description": "Login by email and password.",
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Calculate price of shipment by weight, volume, route distance and declared value.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "cargo tariffs"
                 ],
-                "summary": "Authentication login",
+                "summary": "Quote shipment",
                 "parameters": [
                     {
                         "enum": [
@@ -1196,18 +1138,13 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "User email",
-                        "name": "email",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
+                        "description": "Shipment data",
+                        "name": "shipment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailShipment"
+                        }
                     }
                 ],
                 "responses": {
@@ -1229,8 +1166,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -1250,21 +1187,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/logout": {
-            "post": {
+        "/api/v1/external/cargoTariffs/{uuid}": {
+            "get": {
                 "security": [
+                    {
+                        "BasicAuth": []
+                    },
                     {
                         "JWTAuth": []
                     }
                 ],
-                "description": "Logout using Authorization Header.",
+                "description": "Get detail of existing cargo tariff with weight brackets.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "cargo tariffs"
                 ],
-                "summary": "Authentication logout",
+                "summary": "Get cargo tariff",
                 "parameters": [
                     {
                         "enum": [
@@ -1282,6 +1222,13 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cargo tariff UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1289,6 +1236,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -1303,6 +1256,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1316,10 +1275,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/order": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1328,7 +1285,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new order.",
+                "description": "Update an existing cargo tariff, weight brackets are replaced.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1336,9 +1293,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cargo tariffs"
                 ],
-                "summary": "Create a new order",
+                "summary": "Update cargo tariff",
                 "parameters": [
                     {
                         "enum": [
@@ -1358,18 +1315,31 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Order order",
-                        "name": "order",
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cargo tariff UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cargo tariff data",
+                        "name": "cargo_tariff",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.DetailOrder"
+                            "$ref": "#/definitions/entity.DetailCargoTariff"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -1398,6 +1368,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1405,10 +1387,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/order/uuid": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1417,17 +1397,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing order.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete an existing cargo tariff.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cargo tariffs"
                 ],
-                "summary": "Update order",
+                "summary": "Delete cargo tariff",
                 "parameters": [
                     {
                         "enum": [
@@ -1447,13 +1424,17 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Order order",
-                        "name": "order",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailOrder"
-                        }
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cargo tariff UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1487,6 +1468,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1496,7 +1483,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/order/{uuid}": {
+        "/api/v1/external/cod/payouts": {
             "get": {
                 "security": [
                     {
@@ -1506,14 +1493,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing order.",
+                "description": "Get list of cash on delivery payouts.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cash on delivery"
                 ],
-                "summary": "Get order",
+                "summary": "Get cash on delivery payouts",
                 "parameters": [
                     {
                         "enum": [
@@ -1534,10 +1521,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Order UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Sender phone",
+                        "name": "sender_phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "per_page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1565,8 +1563,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -1579,7 +1577,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1588,14 +1586,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing order.",
+                "description": "Create payout batch for each sender from collected cash on delivery which is not paid out yet.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cash on delivery"
                 ],
-                "summary": "Delete order",
+                "summary": "Create cash on delivery payouts",
                 "parameters": [
                     {
                         "enum": [
@@ -1615,16 +1616,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "Order UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Period of collection and sender",
+                        "name": "payout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.CODSettlementRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -1647,8 +1650,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -1662,8 +1671,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/orderStatusType": {
-            "post": {
+        "/api/v1/external/cod/payouts/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1672,17 +1681,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new orderStatusType.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get detail of cash on delivery payout with its payments.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ordes status types"
+                    "cash on delivery"
                 ],
-                "summary": "Create a new orderStatusType",
+                "summary": "Get cash on delivery payout",
                 "parameters": [
                     {
                         "enum": [
@@ -1702,18 +1708,16 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "OrderStatusType orderStatusType",
-                        "name": "orderStatusType",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailOrderStatusType"
-                        }
+                        "type": "string",
+                        "description": "Payout UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -1751,8 +1755,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/orderStatusType/uuid": {
-            "put": {
+        "/api/v1/external/cod/settlement": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1761,17 +1765,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing orderStatusType.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get cash on delivery collected for each sender, our fees, paid out and outstanding amounts.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ordes status types"
+                    "cash on delivery"
                 ],
-                "summary": "Update orderStatusType",
+                "summary": "Get cash on delivery settlement report",
                 "parameters": [
                     {
                         "enum": [
@@ -1792,46 +1793,29 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "OrderStatusType UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Collected from date (yyyy-mm-dd)",
+                        "name": "date_from",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "OrderStatusType name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
+                        "description": "Collected to date inclusive (yyyy-mm-dd)",
+                        "name": "date_to",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "OrderStatusType region",
-                        "name": "region",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "OrderStatusType latitude",
-                        "name": "latitude",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "OrderStatusType longitude",
-                        "name": "longitude",
-                        "in": "formData",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.successOutput"
-                        }
+                        "description": "Sender phone",
+                        "name": "sender_phone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1851,8 +1835,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -1866,8 +1850,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/orderStatusType/{uuid}": {
-            "get": {
+        "/api/v1/external/documentType": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1876,14 +1860,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing orderStatusType.",
+                "description": "Create a new documentType.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ordes status types"
+                    "document types"
                 ],
-                "summary": "Get orderStatusType",
+                "summary": "Create a new documentType",
                 "parameters": [
                     {
                         "enum": [
@@ -1903,16 +1890,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "OrderStatusType UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "DocumentType documentType",
+                        "name": "documentType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailDocumentType"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -1948,8 +1937,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/documentType/uuid": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -1958,14 +1949,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing orderStatusType.",
+                "description": "Update an existing documentType.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ordes status types"
+                    "document types"
                 ],
-                "summary": "Delete orderStatusType",
+                "summary": "Update documentType",
                 "parameters": [
                     {
                         "enum": [
@@ -1986,10 +1980,44 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "OrderStatusType UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType region",
+                        "name": "region",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType latitude",
+                        "name": "latitude",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType longitude",
+                        "name": "longitude",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2023,6 +2051,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2032,7 +2066,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/orderStatusTypes": {
+        "/api/v1/external/documentType/{uuid}": {
             "get": {
                 "security": [
                     {
@@ -2042,14 +2076,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing orderStatusTypes.",
+                "description": "Get detail of existing documentType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ordes status types"
+                    "document types"
                 ],
-                "summary": "Get orderStatusTypes",
+                "summary": "Get documentType",
                 "parameters": [
                     {
                         "enum": [
@@ -2067,6 +2101,13 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2074,6 +2115,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -2107,10 +2154,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/orders": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -2119,14 +2164,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing orders.",
+                "description": "Delete an existing documentType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "document types"
                 ],
-                "summary": "Get orders",
+                "summary": "Delete documentType",
                 "parameters": [
                     {
                         "enum": [
@@ -2144,6 +2189,19 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "DocumentType UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2177,6 +2235,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2186,8 +2250,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passenger": {
-            "post": {
+        "/api/v1/external/documentTypes": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -2196,17 +2260,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new passenger.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get list of existing documentTypes.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger"
+                    "document types"
                 ],
-                "summary": "Create a new passenger",
+                "summary": "Get documentTypes",
                 "parameters": [
                     {
                         "enum": [
@@ -2224,20 +2285,11 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "Passenger passenger",
-                        "name": "passenger",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailPassenger"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -2275,8 +2327,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passenger/uuid": {
-            "put": {
+        "/api/v1/external/driver": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -2285,7 +2337,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing passenger.",
+                "description": "Create a new driver.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2293,9 +2345,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "passenger"
+                    "drivers"
                 ],
-                "summary": "Update passenger",
+                "summary": "Create a new driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2315,44 +2367,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "Passenger UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Passenger name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Passenger region",
-                        "name": "region",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Passenger latitude",
-                        "name": "latitude",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Passenger longitude",
-                        "name": "longitude",
-                        "in": "formData",
-                        "required": true
+                        "description": "Driver driver",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailDriver"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -2390,7 +2416,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passenger/{uuid}": {
+        "/api/v1/external/driver/routes": {
             "get": {
                 "security": [
                     {
@@ -2400,14 +2426,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing passenger.",
+                "description": "Get routes of not finished trips assigned to driver of authenticated user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger"
+                    "trips"
                 ],
-                "summary": "Get passenger",
+                "summary": "Get routes of current driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2425,13 +2451,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Passenger UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2472,8 +2491,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/driver/uuid": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -2482,14 +2503,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing passenger.",
+                "description": "Update an existing driver.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger"
+                    "drivers"
                 ],
-                "summary": "Delete passenger",
+                "summary": "Update driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2510,10 +2534,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Passenger UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Driver driver",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailDriver"
+                        }
                     }
                 ],
                 "responses": {
@@ -2547,6 +2579,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2556,8 +2594,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passengerTypes": {
-            "get": {
+        "/api/v1/external/driver/vehicle_add": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -2566,14 +2604,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing passengerTypes.",
+                "description": "Add vehicle to a driver.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger types"
+                    "drivers"
                 ],
-                "summary": "Get passengerTypes",
+                "summary": "Add vehicle to a driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2591,11 +2632,20 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "description": "Driver driver",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailDriver"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -2631,7 +2681,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/external/driver/vehicle_del": {
             "post": {
                 "security": [
                     {
@@ -2641,7 +2693,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new passengerType.",
+                "description": "Delete vehicle to a driver.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2649,9 +2701,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "passenger types"
+                    "drivers"
                 ],
-                "summary": "Create a new passengerType",
+                "summary": "Delete vehicle to a driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2671,12 +2723,12 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "PassengerType passengerType",
-                        "name": "passengerType",
+                        "description": "Driver driver",
+                        "name": "driver",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.DetailPassengerType"
+                            "$ref": "#/definitions/entity.DetailDriver"
                         }
                     }
                 ],
@@ -2720,8 +2772,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passengerTypes/uuid": {
-            "put": {
+        "/api/v1/external/driver/workRules": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -2730,17 +2782,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing passengerType.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get driving and rest limits checked when trip is assigned to driver, durations are in minutes.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger types"
+                    "drivers"
                 ],
-                "summary": "Update passengerType",
+                "summary": "Get driver working time rules",
                 "parameters": [
                     {
                         "enum": [
@@ -2758,41 +2807,87 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "PassengerType UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "PassengerType name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
+                        "BasicAuth": []
                     },
                     {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update driving and rest limits, durations are in minutes and zero disables the rule.\nEnforcement \"reject\" rejects violating trip assignment, \"warn\" saves it and returns warnings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drivers"
+                ],
+                "summary": "Update driver working time rules",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
                         "type": "string",
-                        "description": "PassengerType region",
-                        "name": "region",
-                        "in": "formData",
-                        "required": true
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
                     },
                     {
                         "type": "string",
-                        "description": "PassengerType latitude",
-                        "name": "latitude",
-                        "in": "formData",
-                        "required": true
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "PassengerType longitude",
-                        "name": "longitude",
-                        "in": "formData",
-                        "required": true
+                        "description": "Working time rules",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailDriverWorkRule"
+                        }
                     }
                 ],
                 "responses": {
@@ -2820,8 +2915,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -2835,7 +2930,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passengerTypes/{uuid}": {
+        "/api/v1/external/driver/{uuid}": {
             "get": {
                 "security": [
                     {
@@ -2845,14 +2940,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing passengerType.",
+                "description": "Get detail of existing driver.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger types"
+                    "drivers"
                 ],
-                "summary": "Get passengerType",
+                "summary": "Get driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2873,7 +2968,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "PassengerType UUID",
+                        "description": "Driver UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -2884,6 +2979,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -2927,14 +3028,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing passengerType.",
+                "description": "Delete an existing driver.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger types"
+                    "drivers"
                 ],
-                "summary": "Delete passengerType",
+                "summary": "Delete driver",
                 "parameters": [
                     {
                         "enum": [
@@ -2955,7 +3056,13 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "PassengerType UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Driver UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -2992,6 +3099,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3001,7 +3114,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/passengers": {
+        "/api/v1/external/driver/{uuid}/timesheet": {
             "get": {
                 "security": [
                     {
@@ -3011,14 +3124,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing passengers.",
+                "description": "Get driving of driver by days with violations of working time rules, current week by default.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "passenger"
+                    "drivers"
                 ],
-                "summary": "Get passengers",
+                "summary": "Get driver timesheet",
                 "parameters": [
                     {
                         "enum": [
@@ -3036,6 +3149,25 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Driver UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date to (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3069,6 +3201,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3078,19 +3216,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/password/forgot": {
-            "post": {
-                "description": "Retrieve an email contain link to reset password.",
-                "consumes": [
-                    "multipart/form-data"
+        "/api/v1/external/drivers": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
                 ],
+                "description": "Get list of existing drivers.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "drivers"
                 ],
-                "summary": "Authentication forgot password",
+                "summary": "Get drivers",
                 "parameters": [
                     {
                         "enum": [
@@ -3105,10 +3248,9 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Email address",
-                        "name": "email",
-                        "in": "formData",
-                        "required": true
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -3124,14 +3266,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -3145,19 +3293,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/password/reset/{token}": {
+        "/api/v1/external/gtfsImports": {
             "post": {
-                "description": "Set a new password.",
-                "consumes": [
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Import routes and trips of partner GTFS feed. Stops are matched to sities by name or by the nearest\nsity within radius, unmatched stops are created as sities when create_sities is set. Trips are created\nfor every date of service from the first date up to the last date in timezone of agency of feed,\ntrips of unmatched stops are not imported and stops are listed in unmatched report.",
+                "consumes": [
                     "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "gtfs"
                 ],
-                "summary": "Authentication reset password",
+                "summary": "Import GTFS feed",
                 "parameters": [
                     {
                         "enum": [
@@ -3172,24 +3328,47 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Token from forgot password request",
-                        "name": "token",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "file",
+                        "description": "GTFS archive",
+                        "name": "file",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "New password",
-                        "name": "new_password",
-                        "in": "formData",
-                        "required": true
+                        "description": "The first date of imported trips (2006-01-02), today by default",
+                        "name": "from",
+                        "in": "formData"
                     },
                     {
                         "type": "string",
-                        "description": "Confirm password",
-                        "name": "confirm_password",
-                        "in": "formData",
-                        "required": true
+                        "description": "The last date of imported trips (2006-01-02), 30 days by default",
+                        "name": "to",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "default": 10,
+                        "description": "Radius of match of stop to the nearest sity in kilometers",
+                        "name": "radius",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Create sities of unmatched stops",
+                        "name": "create_sities",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Report matches without import",
+                        "name": "dry_run",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
@@ -3199,14 +3378,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.successOutput"
                         }
                     },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -3226,7 +3417,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/payment": {
+        "/api/v1/external/imports/{entity}": {
             "post": {
                 "security": [
                     {
@@ -3236,17 +3427,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new payment.",
+                "description": "Import sities, vehicles, drivers, routes or trips from CSV with header row or JSON array of objects.\nEvery row is validated and creates or updates record found by natural key, all rows are imported\nin one transaction, nothing is imported when any row is invalid or in dry run.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "data imports"
                 ],
-                "summary": "Create a new payment",
+                "summary": "Import reference data and schedules",
                 "parameters": [
                     {
                         "enum": [
@@ -3266,16 +3457,50 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Payment payment",
-                        "name": "payment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailPayment"
-                        }
+                        "enum": [
+                            "sities",
+                            "vehicles",
+                            "drivers",
+                            "routes",
+                            "trips"
+                        ],
+                        "type": "string",
+                        "description": "Imported entity",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Import file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "Import format, detected when empty",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate rows without import",
+                        "name": "dry_run",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
                     "201": {
                         "description": "Created",
                         "schema": {
@@ -3300,8 +3525,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -3315,27 +3540,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/payment/order_add": {
+        "/api/v1/external/login": {
             "post": {
-                "security": [
-                    {
-                        "BasicAuth": []
-                    },
-                    {
-                        "JWTAuth": []
-                    }
-                ],
-                "description": "Add order to a payment.",
+                "description": "Login by email and password.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "authentication"
                 ],
-                "summary": "Add order to a payment",
+                "summary": "Authentication login",
                 "parameters": [
                     {
                         "enum": [
@@ -3355,18 +3572,23 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Payment payment",
-                        "name": "payment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailPayment"
-                        }
+                        "type": "string",
+                        "description": "User email",
+                        "name": "email",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -3383,14 +3605,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -3404,27 +3626,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/payment/order_del": {
+        "/api/v1/external/logout": {
             "post": {
                 "security": [
-                    {
-                        "BasicAuth": []
-                    },
                     {
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete order to a payment.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Logout using Authorization Header.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "authentication"
                 ],
-                "summary": "Delete order to a payment",
+                "summary": "Authentication logout",
                 "parameters": [
                     {
                         "enum": [
@@ -3442,20 +3658,11 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "Payment payment",
-                        "name": "payment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailPayment"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -3472,12 +3679,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3493,8 +3694,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/payment/uuid": {
-            "put": {
+        "/api/v1/external/order": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -3503,7 +3704,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing payment.",
+                "description": "Create a new order.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3511,9 +3712,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "orders"
                 ],
-                "summary": "Update payment",
+                "summary": "Create a new order",
                 "parameters": [
                     {
                         "enum": [
@@ -3533,18 +3734,24 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Payment payment",
-                        "name": "payment",
+                        "type": "string",
+                        "description": "Key of request, repeated request returns stored response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Order order",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.DetailPayment"
+                            "$ref": "#/definitions/entity.DetailOrder"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -3573,6 +3780,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3582,8 +3801,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/payment/{uuid}": {
-            "get": {
+        "/api/v1/external/order/uuid": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -3592,14 +3811,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing payment.",
+                "description": "Update an existing order.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "orders"
                 ],
-                "summary": "Get payment",
+                "summary": "Update order",
                 "parameters": [
                     {
                         "enum": [
@@ -3620,10 +3842,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Payment UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Order order",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailOrder"
+                        }
                     }
                 ],
                 "responses": {
@@ -3657,6 +3887,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3664,8 +3900,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/order/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -3674,14 +3912,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing payment.",
+                "description": "Get detail of existing order.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "orders"
                 ],
-                "summary": "Delete payment",
+                "summary": "Get order",
                 "parameters": [
                     {
                         "enum": [
@@ -3702,7 +3940,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Payment UUID",
+                        "description": "Order UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -3713,6 +3951,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -3746,10 +3990,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/payments": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -3758,14 +4000,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing payments.",
+                "description": "Delete an existing order.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "orders"
                 ],
-                "summary": "Get payments",
+                "summary": "Delete order",
                 "parameters": [
                     {
                         "enum": [
@@ -3783,6 +4025,19 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3816,6 +4071,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3825,8 +4086,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/preference": {
-            "get": {
+        "/api/v1/external/orderStatusType": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -3835,14 +4096,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get current user preference using Authorization Header.",
+                "description": "Create a new orderStatusType.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "preference"
+                    "ordes status types"
                 ],
-                "summary": "User preference",
+                "summary": "Create a new orderStatusType",
                 "parameters": [
                     {
                         "enum": [
@@ -3860,11 +4124,20 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "description": "OrderStatusType orderStatusType",
+                        "name": "orderStatusType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailOrderStatusType"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -3881,6 +4154,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3894,7 +4173,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/external/orderStatusType/uuid": {
             "put": {
                 "security": [
                     {
@@ -3904,7 +4185,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update current user preference using Authorization Header.",
+                "description": "Update an existing orderStatusType.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3912,9 +4193,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "preference"
+                    "ordes status types"
                 ],
-                "summary": "Update user preference",
+                "summary": "Update orderStatusType",
                 "parameters": [
                     {
                         "enum": [
@@ -3934,13 +4215,45 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "User preference",
-                        "name": "preference",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailUserPreference"
-                        }
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType region",
+                        "name": "region",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType latitude",
+                        "name": "latitude",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType longitude",
+                        "name": "longitude",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3962,14 +4275,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -3983,8 +4302,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/preference/reset": {
-            "post": {
+        "/api/v1/external/orderStatusType/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -3993,14 +4312,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Reset current user preference to default using Authorization Header.",
+                "description": "Get detail of existing orderStatusType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "preference"
+                    "ordes status types"
                 ],
-                "summary": "Reset user preference",
+                "summary": "Get orderStatusType",
                 "parameters": [
                     {
                         "enum": [
@@ -4018,6 +4337,13 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4025,6 +4351,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -4039,6 +4371,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -4052,10 +4390,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/price": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4064,17 +4400,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new price.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete an existing orderStatusType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prices"
+                    "ordes status types"
                 ],
-                "summary": "Create a new price",
+                "summary": "Delete orderStatusType",
                 "parameters": [
                     {
                         "enum": [
@@ -4094,18 +4427,22 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Price price",
-                        "name": "price",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailPrice"
-                        }
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderStatusType UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -4134,6 +4471,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4143,8 +4486,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/price/uuid": {
-            "put": {
+        "/api/v1/external/orderStatusTypes": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4153,17 +4496,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing price.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get list of existing orderStatusTypes.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prices"
+                    "ordes status types"
                 ],
-                "summary": "Update price",
+                "summary": "Get orderStatusTypes",
                 "parameters": [
                     {
                         "enum": [
@@ -4181,41 +4521,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Price UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Price name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Price region",
-                        "name": "region",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Price latitude",
-                        "name": "latitude",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Price longitude",
-                        "name": "longitude",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4258,7 +4563,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/price/{uuid}": {
+        "/api/v1/external/orders": {
             "get": {
                 "security": [
                     {
@@ -4268,14 +4573,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing price.",
+                "description": "Get list of existing orders.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prices"
+                    "orders"
                 ],
-                "summary": "Get price",
+                "summary": "Get orders",
                 "parameters": [
                     {
                         "enum": [
@@ -4293,13 +4598,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Price UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4340,8 +4638,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/passenger": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4350,14 +4650,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing price.",
+                "description": "Create a new passenger.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prices"
+                    "passenger"
                 ],
-                "summary": "Delete price",
+                "summary": "Create a new passenger",
                 "parameters": [
                     {
                         "enum": [
@@ -4377,16 +4680,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "Price UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Passenger passenger",
+                        "name": "passenger",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailPassenger"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -4424,8 +4729,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/prices": {
-            "get": {
+        "/api/v1/external/passenger/uuid": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4434,14 +4739,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing prices.",
+                "description": "Update an existing passenger.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prices"
+                    "passenger"
                 ],
-                "summary": "Get prices",
+                "summary": "Update passenger",
                 "parameters": [
                     {
                         "enum": [
@@ -4459,6 +4767,47 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger region",
+                        "name": "region",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger latitude",
+                        "name": "latitude",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger longitude",
+                        "name": "longitude",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4492,6 +4841,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4501,7 +4856,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/profile": {
+        "/api/v1/external/passenger/{uuid}": {
             "get": {
                 "security": [
                     {
@@ -4509,19 +4864,16 @@ const docTemplate = `{
                     },
                     {
                         "JWTAuth": []
-                    },
-                    {
-                        "OauthGrantTypePassword": []
                     }
                 ],
-                "description": "Get current user profile using Authorization Header.",
+                "description": "Get detail of existing passenger.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "passenger"
                 ],
-                "summary": "Authentication profile",
+                "summary": "Get passenger",
                 "parameters": [
                     {
                         "enum": [
@@ -4539,6 +4891,13 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4546,6 +4905,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -4560,6 +4925,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -4573,21 +4944,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/refresh": {
-            "post": {
-                "description": "Retrieve a new access_token using refresh token.",
-                "consumes": [
-                    "multipart/form-data"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
                 ],
+                "description": "Delete an existing passenger.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "passenger"
                 ],
-                "summary": "Authentication refresh",
+                "summary": "Delete passenger",
                 "parameters": [
                     {
                         "enum": [
@@ -4602,9 +4976,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Refresh token from /login",
-                        "name": "refresh_token",
-                        "in": "formData",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passenger UUID",
+                        "name": "uuid",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -4621,14 +5007,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -4642,8 +5040,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/regularityType": {
-            "post": {
+        "/api/v1/external/passengerTypes": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4652,17 +5050,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new regularityType.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get list of existing passengerTypes.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "regularity types"
+                    "passenger types"
                 ],
-                "summary": "Create a new regularityType",
+                "summary": "Get passengerTypes",
                 "parameters": [
                     {
                         "enum": [
@@ -4680,20 +5075,11 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "RegularityType regularityType",
-                        "name": "regularityType",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailRegularityType"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -4729,10 +5115,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/regularityType/uuid": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4741,7 +5125,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing regularityType.",
+                "description": "Create a new passengerType.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4749,9 +5133,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "regularity types"
+                    "passenger types"
                 ],
-                "summary": "Update regularityType",
+                "summary": "Create a new passengerType",
                 "parameters": [
                     {
                         "enum": [
@@ -4771,44 +5155,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "RegularityType UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "RegularityType name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "RegularityType region",
-                        "name": "region",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "RegularityType latitude",
-                        "name": "latitude",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "RegularityType longitude",
-                        "name": "longitude",
-                        "in": "formData",
-                        "required": true
+                        "description": "PassengerType passengerType",
+                        "name": "passengerType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailPassengerType"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -4846,8 +5204,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/regularityType/{uuid}": {
-            "get": {
+        "/api/v1/external/passengerTypes/uuid": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4856,14 +5214,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing regularityType.",
+                "description": "Update an existing passengerType.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "regularity types"
+                    "passenger types"
                 ],
-                "summary": "Get regularityType",
+                "summary": "Update passengerType",
                 "parameters": [
                     {
                         "enum": [
@@ -4884,20 +5245,54 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "RegularityType UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "PassengerType UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.successOutput"
-                        }
                     },
-                    "400": {
+                    {
+                        "type": "string",
+                        "description": "PassengerType name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PassengerType region",
+                        "name": "region",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PassengerType latitude",
+                        "name": "latitude",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PassengerType longitude",
+                        "name": "longitude",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
@@ -4921,6 +5316,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4928,8 +5329,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/passengerTypes/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -4938,14 +5341,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing regularityType.",
+                "description": "Get detail of existing passengerType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "regularity types"
+                    "passenger types"
                 ],
-                "summary": "Delete regularityType",
+                "summary": "Get passengerType",
                 "parameters": [
                     {
                         "enum": [
@@ -4966,7 +5369,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "RegularityType UUID",
+                        "description": "PassengerType UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -4977,6 +5380,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -5010,10 +5419,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/regularityTypes": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5022,14 +5429,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing regularityTypes.",
+                "description": "Delete an existing passengerType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "regularity types"
+                    "passenger types"
                 ],
-                "summary": "Get regularityTypes",
+                "summary": "Delete passengerType",
                 "parameters": [
                     {
                         "enum": [
@@ -5047,6 +5454,19 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "PassengerType UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5080,6 +5500,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5089,8 +5515,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/route": {
-            "post": {
+        "/api/v1/external/passengers": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5099,17 +5525,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new route.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get list of existing passengers.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "passenger"
                 ],
-                "summary": "Create a new route",
+                "summary": "Get passengers",
                 "parameters": [
                     {
                         "enum": [
@@ -5127,20 +5550,11 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "Route route",
-                        "name": "route",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailRoute"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5178,27 +5592,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/route/price_add": {
+        "/api/v1/external/password/forgot": {
             "post": {
-                "security": [
-                    {
-                        "BasicAuth": []
-                    },
-                    {
-                        "JWTAuth": []
-                    }
-                ],
-                "description": "Add price to a route.",
+                "description": "Retrieve an email contain link to reset password.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "authentication"
                 ],
-                "summary": "Add price to a route",
+                "summary": "Authentication forgot password",
                 "parameters": [
                     {
                         "enum": [
@@ -5213,23 +5619,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Request id",
-                        "name": "Set-Request-Id",
-                        "in": "header"
-                    },
-                    {
-                        "description": "Route route",
-                        "name": "route",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailRoute"
-                        }
+                        "description": "Email address",
+                        "name": "email",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5240,20 +5638,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -5267,27 +5659,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/route/price_del": {
+        "/api/v1/external/password/reset/{token}": {
             "post": {
-                "security": [
-                    {
-                        "BasicAuth": []
-                    },
-                    {
-                        "JWTAuth": []
-                    }
-                ],
-                "description": "Delete price to a route.",
+                "description": "Set a new password.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "authentication"
                 ],
-                "summary": "Delete price to a route",
+                "summary": "Authentication reset password",
                 "parameters": [
                     {
                         "enum": [
@@ -5302,23 +5686,29 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Request id",
-                        "name": "Set-Request-Id",
-                        "in": "header"
+                        "description": "Token from forgot password request",
+                        "name": "token",
+                        "in": "formData",
+                        "required": true
                     },
                     {
-                        "description": "Route route",
-                        "name": "route",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailRoute"
-                        }
+                        "type": "string",
+                        "description": "New password",
+                        "name": "new_password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Confirm password",
+                        "name": "confirm_password",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5329,20 +5719,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -5356,8 +5740,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/route/uuid": {
-            "put": {
+        "/api/v1/external/payment": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5366,7 +5750,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing route.",
+                "description": "Create a new payment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5374,9 +5758,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "payments"
                 ],
-                "summary": "Update route",
+                "summary": "Create a new payment",
                 "parameters": [
                     {
                         "enum": [
@@ -5396,18 +5780,24 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Route route",
-                        "name": "route",
+                        "type": "string",
+                        "description": "Key of request, repeated request returns stored response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Payment payment",
+                        "name": "payment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.DetailRoute"
+                            "$ref": "#/definitions/entity.DetailPayment"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5436,6 +5826,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5445,8 +5847,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/route/{uuid}": {
-            "get": {
+        "/api/v1/external/payment/order_add": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5455,14 +5857,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing route.",
+                "description": "Add order to a payment.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "payments"
                 ],
-                "summary": "Get route",
+                "summary": "Add order to a payment",
                 "parameters": [
                     {
                         "enum": [
@@ -5482,16 +5887,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "Route UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Payment payment",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailPayment"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5527,8 +5934,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/external/payment/order_del": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5537,14 +5946,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing route.",
+                "description": "Delete order to a payment.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "payments"
                 ],
-                "summary": "Delete route",
+                "summary": "Delete order to a payment",
                 "parameters": [
                     {
                         "enum": [
@@ -5564,16 +5976,18 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "Route UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Payment payment",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailPayment"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5611,8 +6025,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/routes": {
-            "get": {
+        "/api/v1/external/payment/uuid": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5621,14 +6035,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get list of existing routes.",
+                "description": "Update an existing payment.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "payments"
                 ],
-                "summary": "Get routes",
+                "summary": "Update payment",
                 "parameters": [
                     {
                         "enum": [
@@ -5646,6 +6063,21 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Payment payment",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailPayment"
+                        }
                     }
                 ],
                 "responses": {
@@ -5679,6 +6111,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5688,16 +6126,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/sities": {
+        "/api/v1/external/payment/{uuid}": {
             "get": {
-                "description": "Get list of existing sities.",
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of existing payment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sity"
+                    "payments"
                 ],
-                "summary": "Get sities",
+                "summary": "Get payment",
                 "parameters": [
                     {
                         "enum": [
@@ -5715,6 +6161,13 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5722,6 +6175,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -5730,6 +6189,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5737,10 +6214,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/external/sity": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5749,17 +6224,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new sity.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete an existing payment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sity"
+                    "payments"
                 ],
-                "summary": "Create a new sity",
+                "summary": "Delete payment",
                 "parameters": [
                     {
                         "enum": [
@@ -5779,18 +6251,22 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Sity sity",
-                        "name": "passenger",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailSity"
-                        }
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
                         }
@@ -5819,6 +6295,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5828,8 +6310,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/sity/uuid": {
-            "put": {
+        "/api/v1/external/payments": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5838,17 +6320,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing sity.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get list of existing payments.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sity"
+                    "payments"
                 ],
-                "summary": "Update sity",
+                "summary": "Get payments",
                 "parameters": [
                     {
                         "enum": [
@@ -5866,15 +6345,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "description": "Sity sity",
-                        "name": "passenger",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entity.DetailSity"
-                        }
                     }
                 ],
                 "responses": {
@@ -5917,16 +6387,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/sity/{uuid}": {
+        "/api/v1/external/preference": {
             "get": {
-                "description": "Get detail of existing sity.",
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get current user preference using Authorization Header.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sity"
+                    "preference"
                 ],
-                "summary": "Get sity",
+                "summary": "User preference",
                 "parameters": [
                     {
                         "enum": [
@@ -5944,13 +6422,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sity UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -5966,6 +6437,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5974,7 +6457,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -5983,14 +6466,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing sity.",
+                "description": "Update current user preference using Authorization Header.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sity"
+                    "preference"
                 ],
-                "summary": "Delete sity",
+                "summary": "Update user preference",
                 "parameters": [
                     {
                         "enum": [
@@ -6010,11 +6496,13 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "Sity UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "User preference",
+                        "name": "preference",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailUserPreference"
+                        }
                     }
                 ],
                 "responses": {
@@ -6036,18 +6524,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6057,27 +6545,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/users": {
-            "get": {
+        "/api/v1/external/preference/reset": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     },
                     {
                         "JWTAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get list of existing users.",
+                "description": "Reset current user preference to default using Authorization Header.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "preference"
                 ],
-                "summary": "Get users",
+                "summary": "Reset user preference",
                 "parameters": [
                     {
                         "enum": [
@@ -6116,12 +6601,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorOutput"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -6135,7 +6614,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/external/price": {
             "post": {
                 "security": [
                     {
@@ -6145,17 +6626,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new user.",
+                "description": "Create a new price.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "prices"
                 ],
-                "summary": "Create a new user",
+                "summary": "Create a new price",
                 "parameters": [
                     {
                         "enum": [
@@ -6175,32 +6656,13 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "User name",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User email",
-                        "name": "email",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User phone",
-                        "name": "phone",
-                        "in": "formData",
-                        "required": true
+                        "description": "Price price",
+                        "name": "price",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailPrice"
+                        }
                     }
                 ],
                 "responses": {
@@ -6243,7 +6705,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/users/uuid": {
+        "/api/v1/external/price/uuid": {
             "put": {
                 "security": [
                     {
@@ -6253,17 +6715,17 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing user.",
+                "description": "Update an existing price.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "prices"
                 ],
-                "summary": "Update user",
+                "summary": "Update price",
                 "parameters": [
                     {
                         "enum": [
@@ -6284,36 +6746,42 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Price UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User name",
+                        "description": "Price name",
                         "name": "name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User password",
-                        "name": "password",
+                        "description": "Price region",
+                        "name": "region",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User email",
-                        "name": "email",
+                        "description": "Price latitude",
+                        "name": "latitude",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User phone",
-                        "name": "phone",
+                        "description": "Price longitude",
+                        "name": "longitude",
                         "in": "formData",
                         "required": true
                     }
@@ -6349,6 +6817,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6358,7 +6832,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/users/{uuid}": {
+        "/api/v1/external/price/{uuid}": {
             "get": {
                 "security": [
                     {
@@ -6368,14 +6842,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing user.",
+                "description": "Get detail of existing price.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "prices"
                 ],
-                "summary": "Get user",
+                "summary": "Get price",
                 "parameters": [
                     {
                         "enum": [
@@ -6396,7 +6870,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User UUID",
+                        "description": "Price UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -6407,6 +6881,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -6450,14 +6930,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing user.",
+                "description": "Delete an existing price.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "prices"
                 ],
-                "summary": "Delete user",
+                "summary": "Delete price",
                 "parameters": [
                     {
                         "enum": [
@@ -6478,7 +6958,13 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Price UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -6515,6 +7001,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6524,8 +7016,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/users/{uuid}/avatar": {
-            "put": {
+        "/api/v1/external/prices": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
@@ -6534,17 +7026,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update user avatar of existing user.",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Get list of existing prices.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "prices"
                 ],
-                "summary": "Update user avatar",
+                "summary": "Get prices",
                 "parameters": [
                     {
                         "enum": [
@@ -6562,20 +7051,6 @@ const docTemplate = `{
                         "description": "Request id",
                         "name": "Set-Request-Id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "User UUID",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "User Avatar",
-                        "name": "avatar",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -6618,7 +7093,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/vehicles": {
+        "/api/v1/external/profile": {
             "get": {
                 "security": [
                     {
@@ -6626,16 +7101,19 @@ const docTemplate = `{
                     },
                     {
                         "JWTAuth": []
+                    },
+                    {
+                        "OauthGrantTypePassword": []
                     }
                 ],
-                "description": "Get list of existing vehicles.",
+                "description": "Get current user profile using Authorization Header.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "vehicles"
+                    "authentication"
                 ],
-                "summary": "Get vehicles",
+                "summary": "Authentication profile",
                 "parameters": [
                     {
                         "enum": [
@@ -6674,8 +7152,63 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/refresh": {
+            "post": {
+                "description": "Retrieve a new access_token using refresh token.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "authentication"
+                ],
+                "summary": "Authentication refresh",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refresh token from /login",
+                        "name": "refresh_token",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.errorOutput"
                         }
@@ -6686,6 +7219,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6693,7 +7232,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/external/regularityType": {
             "post": {
                 "security": [
                     {
@@ -6703,7 +7244,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Create a new vehicle.",
+                "description": "Create a new regularityType.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6711,9 +7252,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vehicles"
+                    "regularity types"
                 ],
-                "summary": "Create a new vehicle",
+                "summary": "Create a new regularityType",
                 "parameters": [
                     {
                         "enum": [
@@ -6733,12 +7274,12 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Vehicle vehicle",
-                        "name": "vehicle",
+                        "description": "RegularityType regularityType",
+                        "name": "regularityType",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.DetailVehicle"
+                            "$ref": "#/definitions/entity.DetailRegularityType"
                         }
                     }
                 ],
@@ -6782,7 +7323,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/vehicles/uuid": {
+        "/api/v1/external/regularityType/uuid": {
             "put": {
                 "security": [
                     {
@@ -6792,7 +7333,7 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Update an existing vehicle.",
+                "description": "Update an existing regularityType.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6800,9 +7341,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vehicles"
+                    "regularity types"
                 ],
-                "summary": "Update vehicle",
+                "summary": "Update regularityType",
                 "parameters": [
                     {
                         "enum": [
@@ -6823,35 +7364,41 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RegularityType UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle name",
+                        "description": "RegularityType name",
                         "name": "name",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle region",
+                        "description": "RegularityType region",
                         "name": "region",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle latitude",
+                        "description": "RegularityType latitude",
                         "name": "latitude",
                         "in": "formData",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle longitude",
+                        "description": "RegularityType longitude",
                         "name": "longitude",
                         "in": "formData",
                         "required": true
@@ -6888,6 +7435,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6897,7 +7450,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/external/vehicles/{uuid}": {
+        "/api/v1/external/regularityType/{uuid}": {
             "get": {
                 "security": [
                     {
@@ -6907,14 +7460,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Get detail of existing vehicle.",
+                "description": "Get detail of existing regularityType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "vehicles"
+                    "regularity types"
                 ],
-                "summary": "Get vehicle",
+                "summary": "Get regularityType",
                 "parameters": [
                     {
                         "enum": [
@@ -6935,7 +7488,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle UUID",
+                        "description": "RegularityType UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -6946,6 +7499,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
                         }
                     },
                     "400": {
@@ -6989,14 +7548,14 @@ const docTemplate = `{
                         "JWTAuth": []
                     }
                 ],
-                "description": "Delete an existing vehicle.",
+                "description": "Delete an existing regularityType.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "vehicles"
+                    "regularity types"
                 ],
-                "summary": "Delete vehicle",
+                "summary": "Delete regularityType",
                 "parameters": [
                     {
                         "enum": [
@@ -7017,7 +7576,13 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Vehicle UUID",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RegularityType UUID",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -7054,6 +7619,196 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.errorOutput"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/regularityTypes": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get list of existing regularityTypes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regularity types"
+                ],
+                "summary": "Get regularityTypes",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/reports/averageFare": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get number of tickets and average fare by passenger type, fare is share of ticket in order payments\nby route price of passenger type less refunds.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get average fare report",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Departure from date (yyyy-mm-dd)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Departure to date inclusive (yyyy-mm-dd)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route UUID",
+                        "name": "route_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7062,9 +7817,5762 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "/api/v1/external/reports/cancellations": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get canceled orders, cancellation rate and refunds by route, day, week or month.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get cancellation report",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordered or refunded from date (yyyy-mm-dd)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordered or refunded to date inclusive (yyyy-mm-dd)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route UUID",
+                        "name": "route_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "route",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "route",
+                        "description": "Grouping",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/reports/loadFactor": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get seats sold to passengers against seats of vehicle for every trip or route.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get load factor report",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Departure from date (yyyy-mm-dd)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Departure to date inclusive (yyyy-mm-dd)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route UUID",
+                        "name": "route_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "trip",
+                            "route"
+                        ],
+                        "type": "string",
+                        "default": "trip",
+                        "description": "Grouping",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/reports/revenue": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get order payments, refunds and net revenue by route, day, week or month.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get revenue report",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Paid from date (yyyy-mm-dd)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Paid to date inclusive (yyyy-mm-dd)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route UUID",
+                        "name": "route_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "route",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "route",
+                        "description": "Grouping",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/route": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Create a new route.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Create a new route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Route route",
+                        "name": "route",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailRoute"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/route/price_add": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Add price to a route.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Add price to a route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Route route",
+                        "name": "route",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailRoute"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/route/price_del": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete price to a route.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Delete price to a route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Route route",
+                        "name": "route",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailRoute"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/route/uuid": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing route.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Update route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Route route",
+                        "name": "route",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailRoute"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/route/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of existing route.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Get route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing route.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Delete route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/routes": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get list of existing routes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "Get routes",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Create a new shipment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Create a new shipment",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Shipment data",
+                        "name": "shipment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailShipment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of existing shipment.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Get shipment",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing shipment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Update shipment",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Shipment data",
+                        "name": "shipment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailShipment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing shipment.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Delete shipment",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment/{uuid}/delivery": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get proof of shipment delivery with links to download photo and signature.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Get proof of delivery",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Confirm hand over of shipment to recipient by confirmation code with photo and/or signature.\nProof of delivery is stored and shipment is closed.\nCash on delivery must be collected in full, it is recorded as payment of type cod.\nCode is invalidated after 5 wrong attempts, a new code must be issued then.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Confirm shipment delivery",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of person who received shipment",
+                        "name": "recipient_name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delivery confirmation code",
+                        "name": "code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Collected cash on delivery",
+                        "name": "cod_amount",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photo of handed over shipment",
+                        "name": "photo",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Signature image of recipient",
+                        "name": "signature",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment/{uuid}/deliveryCode": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Generate a new delivery confirmation code and send it to recipient, previous code becomes invalid.\nCode is sent by email and by sms when sms gateway is configured, 422 is returned when recipient can not be reached.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Issue delivery confirmation code",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment/{uuid}/event": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Add a new event to shipment timeline and update shipment status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Add shipment event",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shipment event data",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailShipmentEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment/{uuid}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get timeline of existing shipment.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Get shipment events",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipment/{uuid}/label": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Print shipping label of shipment with tracking number as Code128 barcode and QR code.",
+                "produces": [
+                    "application/pdf",
+                    "application/zpl",
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Print shipping label",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pdf",
+                            "zpl"
+                        ],
+                        "type": "string",
+                        "default": "pdf",
+                        "description": "Label format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/shipments": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get list of existing shipments.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Get shipments",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/sities": {
+            "get": {
+                "description": "Get list of existing sities.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sity"
+                ],
+                "summary": "Get sities",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/sity": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Create a new sity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sity"
+                ],
+                "summary": "Create a new sity",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Sity sity",
+                        "name": "passenger",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailSity"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/sity/uuid": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing sity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sity"
+                ],
+                "summary": "Update sity",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Sity sity",
+                        "name": "passenger",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailSity"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/sity/{uuid}": {
+            "get": {
+                "description": "Get detail of existing sity.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sity"
+                ],
+                "summary": "Get sity",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sity UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing sity.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sity"
+                ],
+                "summary": "Delete sity",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sity UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/track/{tracking_number}": {
+            "get": {
+                "description": "Get public timeline of shipment by tracking number. Personal data of sender and recipient is hidden.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Track shipment",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shipment tracking number",
+                        "name": "tracking_number",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/trash/{resource}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get soft-deleted records of resource, the latest deleted first. Resource is a name of table:\nsities, vehicles, drivers, routes, trips, orders, payments, passengers, passenger_types, prices,\ndocument_types, regularity_types, order_status_types, shipments or cargo_tariffs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Get deleted records",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/trash/{resource}/{uuid}": {
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Permanently delete soft-deleted record of resource, purged record can not be restored.\nPayment with fiscal receipt, payout or bank statement line is not purged.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Purge deleted record",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/trash/{resource}/{uuid}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Restore soft-deleted record of resource. Record is not restored when record it references,\ne.g. route of trip, is deleted or does not exist.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Restore deleted record",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/trip/{uuid}/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Print shipping labels of all shipments assigned to trip in one document.",
+                "produces": [
+                    "application/pdf",
+                    "application/zpl",
+                    "application/json"
+                ],
+                "tags": [
+                    "shipments"
+                ],
+                "summary": "Print shipping labels of trip",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trip UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pdf",
+                            "zpl"
+                        ],
+                        "type": "string",
+                        "default": "pdf",
+                        "description": "Label format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/trip/{uuid}/loadPlan": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get luggage load of trip by shipments and passenger baggage.\nAlternative trips of the same route are suggested when requested load does not fit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Get trip load plan",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trip UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Requested additional weight, kg",
+                        "name": "weight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Requested additional volume, m³",
+                        "name": "volume",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/trip/{uuid}/route": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get optimized sequence of drop-off stops of trip with ETAs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Get trip route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trip UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Order drop-off stops of trip shipments starting from depot to minimize distance.\nETAs are derived from departure time of trip and average speed, previous route is replaced.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Optimize trip route",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trip UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Depot coordinates, average speed (km/h) and stop duration (minutes)",
+                        "name": "route",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailTripRoute"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/users": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get list of existing users.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get users",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Create a new user.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User email",
+                        "name": "email",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User phone",
+                        "name": "phone",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/users/uuid": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing user.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User email",
+                        "name": "email",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User phone",
+                        "name": "phone",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/users/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of existing user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/users/{uuid}/auditLogs": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get changes of data made by user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit logs"
+                ],
+                "summary": "Get audit logs of user",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/users/{uuid}/avatar": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update user avatar of existing user.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user avatar",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "User Avatar",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/vehicleCompliances": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get list of vehicle compliance records ordered by expiry date.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle compliances"
+                ],
+                "summary": "Get vehicle compliance records",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Create insurance, inspection, tachograph calibration or maintenance window of vehicle with optional scan.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle compliances"
+                ],
+                "summary": "Create a new vehicle compliance record",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle UUID",
+                        "name": "vehicle_uuid",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "insurance",
+                            "inspection",
+                            "tachograph",
+                            "maintenance"
+                        ],
+                        "type": "string",
+                        "description": "Record type",
+                        "name": "type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Document number",
+                        "name": "number",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valid from date (YYYY-MM-DD), required for maintenance",
+                        "name": "valid_from",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valid until date (YYYY-MM-DD)",
+                        "name": "valid_until",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Note",
+                        "name": "note",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Scan of document",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/vehicleCompliances/expiring": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get vehicle documents which expire within given number of days and are not renewed yet.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle compliances"
+                ],
+                "summary": "Get expiring vehicle documents",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Number of days",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/vehicleCompliances/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of vehicle compliance record with link to download scan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle compliances"
+                ],
+                "summary": "Get vehicle compliance record",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle compliance UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing vehicle compliance record, scan is replaced only when a new file is uploaded.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle compliances"
+                ],
+                "summary": "Update vehicle compliance record",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle compliance UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle UUID",
+                        "name": "vehicle_uuid",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "insurance",
+                            "inspection",
+                            "tachograph",
+                            "maintenance"
+                        ],
+                        "type": "string",
+                        "description": "Record type",
+                        "name": "type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Document number",
+                        "name": "number",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valid from date (YYYY-MM-DD), required for maintenance",
+                        "name": "valid_from",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valid until date (YYYY-MM-DD)",
+                        "name": "valid_until",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Note",
+                        "name": "note",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Scan of document",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing vehicle compliance record.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle compliances"
+                ],
+                "summary": "Delete vehicle compliance record",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle compliance UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/vehicles": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get list of existing vehicles.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Get vehicles",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Create a new vehicle.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Create a new vehicle",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Vehicle vehicle",
+                        "name": "vehicle",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.DetailVehicle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/vehicles/uuid": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing vehicle.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Update vehicle",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle region",
+                        "name": "region",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle latitude",
+                        "name": "latitude",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle longitude",
+                        "name": "longitude",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/vehicles/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of existing vehicle.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Get vehicle",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing vehicle.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Delete vehicle",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vehicle UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/webhookDeliveries/{uuid}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Queue a new delivery of event of delivery, subscriber recognizes repeated event by X-Webhook-Id.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Replay webhook delivery",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook delivery UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get list of existing webhook subscriptions.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook subscriptions",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Subscribe URL of application to events: order.created, order.status_changed, payment.succeeded,\ntrip.delayed or * for every event. Every delivery is a POST request signed by HMAC-SHA256 of\n\"timestamp.body\" with secret of subscription in X-Webhook-Signature header \"t=\u003cunix\u003e,v1=\u003chex\u003e\".\nX-Webhook-Id is an ID of event, it is the same in retries and replays of event.\nSecret is returned only in response of this request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a new webhook subscription",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Webhook subscription data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookSubscriptionFieldsForDetail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/webhooks/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get detail of existing webhook subscription.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook subscription",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of record, sent in If-Match header of update and delete"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Update an existing webhook subscription, secret is kept. Disabled subscription receives no events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update webhook subscription",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook subscription data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookSubscriptionFieldsForDetail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Delete an existing webhook subscription, pending deliveries are not sent.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete webhook subscription",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of record, change is rejected when record was changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/external/webhooks/{uuid}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    },
+                    {
+                        "JWTAuth": []
+                    }
+                ],
+                "description": "Get delivery log of webhook subscription, the latest first. Failed delivery has error and response\nof the last attempt, pending delivery has time of the next attempt.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.successOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/calendars/{token}": {
+            "get": {
+                "description": "Get iCalendar feed of subscription URL. Driver feed contains assigned trips, passenger feed contains\npaid orders with seats. Trips departed more than 30 days ago are not included, deleted trips\nand refunded orders are cancelled events.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "calendars"
+                ],
+                "summary": "Get calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token of feed with .ics extension",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/gtfs.zip": {
+            "get": {
+                "description": "Get static GTFS feed of trips for trip planners and map applications. Sities are stops,\ntrips of route departing at the same time of day are one trip running on dates of trips.\nTrips departing from today are exported by default, period is limited to 366 days.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "gtfs"
+                ],
+                "summary": "Get GTFS feed",
+                "parameters": [
+                    {
+                        "enum": [
+                            "en",
+                            "ru"
+                        ],
+                        "type": "string",
+                        "default": "en",
+                        "description": "Language code",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request id",
+                        "name": "Set-Request-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The first date of departure (2006-01-02)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The last date of departure (2006-01-02)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorOutput"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.BankStatementLineResolveRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "payment_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CODSettlementRequest": {
+            "type": "object",
+            "properties": {
+                "date_from": {
+                    "type": "string"
+                },
+                "date_to": {
+                    "type": "string"
+                },
+                "sender_phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DetailCargoTariff": {
+            "type": "object",
+            "properties": {
+                "brackets": {
+                    "type": "array",
+                    "items": {}
+                },
+                "cod_fee_percent": {
+                    "type": "number"
+                },
+                "insurance_percent": {
+                    "type": "number"
+                },
+                "minimum_charge": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price_per_km": {
+                    "type": "number"
+                },
+                "route_uuid": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                },
+                "volumetric_divisor": {
+                    "type": "number"
+                }
+            }
+        },
         "entity.DetailDocumentType": {
             "type": "object",
             "properties": {
@@ -7094,9 +13602,50 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.DetailDriverWorkRule": {
+            "type": "object",
+            "properties": {
+                "enforcement": {
+                    "type": "string"
+                },
+                "extended_daily_driving": {
+                    "type": "integer"
+                },
+                "extended_days_per_week": {
+                    "type": "integer"
+                },
+                "max_continuous_driving": {
+                    "type": "integer"
+                },
+                "max_daily_driving": {
+                    "type": "integer"
+                },
+                "max_weekly_driving": {
+                    "type": "integer"
+                },
+                "min_break": {
+                    "type": "integer"
+                },
+                "min_daily_rest": {
+                    "type": "integer"
+                },
+                "min_weekly_rest": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "entity.DetailOrder": {
             "type": "object",
             "properties": {
+                "baggage_volume": {
+                    "type": "number"
+                },
+                "baggage_weight": {
+                    "type": "number"
+                },
                 "external_uuid": {
                     "type": "string"
                 },
@@ -7130,6 +13679,9 @@ const docTemplate = `{
                 "Type": {
                     "type": "string"
                 },
+                "is_canceled": {
+                    "type": "boolean"
+                },
                 "uuid": {
                     "type": "string"
                 }
@@ -7174,6 +13726,12 @@ const docTemplate = `{
                 "Type": {
                     "type": "string"
                 },
+                "max_age": {
+                    "type": "integer"
+                },
+                "min_age": {
+                    "type": "integer"
+                },
                 "uuid": {
                     "type": "string"
                 }
@@ -7188,6 +13746,9 @@ const docTemplate = `{
                 "external_uuid": {
                     "type": "string"
                 },
+                "fiscal_status": {
+                    "type": "string"
+                },
                 "orders": {
                     "type": "array",
                     "items": {}
@@ -7195,10 +13756,20 @@ const docTemplate = `{
                 "payment_date": {
                     "type": "string"
                 },
+                "payout_uuid": {
+                    "type": "string"
+                },
+                "receipt": {},
+                "shipment_uuid": {
+                    "type": "string"
+                },
                 "trip": {},
                 "trip_uuid": {
                     "type": "string"
                 },
+                "type": {
+                    "type": "string"
+                },
                 "user": {},
                 "user_uuid": {
                     "type": "string"
@@ -7258,6 +13829,123 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.DetailShipment": {
+            "type": "object",
+            "properties": {
+                "cargo_tariff_uuid": {
+                    "type": "string"
+                },
+                "cod_amount": {
+                    "type": "number"
+                },
+                "cod_fee": {
+                    "type": "number"
+                },
+                "declared_value": {
+                    "type": "number"
+                },
+                "destination_address": {
+                    "type": "string"
+                },
+                "destination_latitude": {
+                    "type": "number"
+                },
+                "destination_longitude": {
+                    "type": "number"
+                },
+                "events": {},
+                "from_uuid": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "number"
+                },
+                "length": {
+                    "type": "number"
+                },
+                "notify_email": {
+                    "type": "boolean"
+                },
+                "notify_sms": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "recipient_email": {
+                    "type": "string"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "recipient_phone": {
+                    "type": "string"
+                },
+                "sender_email": {
+                    "type": "string"
+                },
+                "sender_name": {
+                    "type": "string"
+                },
+                "sender_phone": {
+                    "type": "string"
+                },
+                "sity_from": {},
+                "sity_to": {},
+                "status": {
+                    "type": "string"
+                },
+                "to_uuid": {
+                    "type": "string"
+                },
+                "tracking_number": {
+                    "type": "string"
+                },
+                "trip": {},
+                "trip_uuid": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                },
+                "volume": {
+                    "type": "number"
+                },
+                "weight": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "entity.DetailShipmentEvent": {
+            "type": "object",
+            "properties": {
+                "event_time": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "shipment_uuid": {
+                    "type": "string"
+                },
+                "sity": {},
+                "sity_uuid": {
+                    "type": "string"
+                },
+                "trip_uuid": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
         "entity.DetailSity": {
             "type": "object",
             "properties": {
@@ -7301,6 +13989,103 @@ const docTemplate = `{
                 },
                 "vehicle_uuid": {
                     "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DriverWorkViolation"
+                    }
+                }
+            }
+        },
+        "entity.DetailTripRoute": {
+            "type": "object",
+            "properties": {
+                "average_speed": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "depot_latitude": {
+                    "type": "number"
+                },
+                "depot_longitude": {
+                    "type": "number"
+                },
+                "finish_time": {
+                    "type": "string"
+                },
+                "skipped_shipments": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "stop_duration": {
+                    "type": "integer"
+                },
+                "stops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DetailTripRouteStop"
+                    }
+                },
+                "total_distance": {
+                    "type": "number"
+                },
+                "trip_uuid": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DetailTripRouteStop": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "cod_amount": {
+                    "type": "number"
+                },
+                "cumulative_distance": {
+                    "type": "number"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "eta": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "recipient_phone": {
+                    "type": "string"
+                },
+                "sequence": {
+                    "type": "integer"
+                },
+                "shipment_uuid": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tracking_number": {
+                    "type": "string"
                 }
             }
         },
@@ -7324,6 +14109,12 @@ const docTemplate = `{
                 "class": {
                     "type": "string"
                 },
+                "luggage_volume": {
+                    "type": "number"
+                },
+                "luggage_weight": {
+                    "type": "number"
+                },
                 "model": {
                     "type": "string"
                 },
@@ -7338,6 +14129,58 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.DriverWorkViolation": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "integer"
+                },
+                "end": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "rule": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                },
+                "trip_uuids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entity.WebhookSubscriptionFieldsForDetail": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
         "response.errorOutput": {
             "type": "object",
             "properties": {
@@ -0,0 +1,336 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/commerceml"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// AccountingExportCommerceML is a format of accounting export for 1C.
+	AccountingExportCommerceML = "commerceml"
+
+	// AccountingExportSourceAPI is a source of export requested through API.
+	AccountingExportSourceAPI = "api"
+
+	// AccountingExportSourceCLI is a source of export run by command, e.g. scheduled by cron.
+	AccountingExportSourceCLI = "cli"
+
+	// AccountingExportCurrency is a currency of amounts of accounting export.
+	AccountingExportCurrency = "RUB"
+
+	accountingClassifierID       = "cargo-rest-api-classifier"
+	accountingCatalogID          = "cargo-rest-api-catalog"
+	accountingPassengerTypeID    = "cargo-rest-api-passenger-type"
+	accountingRouteFromID        = "cargo-rest-api-route-from"
+	accountingRouteToID          = "cargo-rest-api-route-to"
+	accountingServiceRequisite   = "Услуга"
+	accountingDictionaryProperty = "Справочник"
+)
+
+// AccountingExport represent schema of table accounting_exports.
+// Export contains records changed after ChangedFrom up to ChangedTo, ChangedTo of the latest export is a watermark
+// of the next one. Zero ChangedFrom means full export.
+type AccountingExport struct {
+	UUID               string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	Format             string         `gorm:"size:20;not null;index;"                   json:"format"`
+	Source             string         `gorm:"size:10;"                                  json:"source"`
+	ChangedFrom        time.Time      `                                                 json:"changed_from"`
+	ChangedTo          time.Time      `gorm:"index;"                                    json:"changed_to"`
+	SityCount          int            `gorm:"default:0"                                 json:"sity_count"`
+	RouteCount         int            `gorm:"default:0"                                 json:"route_count"`
+	PassengerTypeCount int            `gorm:"default:0"                                 json:"passenger_type_count"`
+	OrderCount         int            `gorm:"default:0"                                 json:"order_count"`
+	PaymentCount       int            `gorm:"default:0"                                 json:"payment_count"`
+	CreatedBy          string         `gorm:"size:36;"                                  json:"created_by"`
+	Content            []byte         `gorm:"-"                                         json:"-"`
+	CreatedAt          time.Time      `                                                 json:"created_at,omitempty"`
+	UpdatedAt          time.Time      `                                                 json:"updated_at,omitempty"`
+	DeletedAt          gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
+}
+
+// AccountingExports represent multiple AccountingExport.
+type AccountingExports []*AccountingExport
+
+// AccountingExportRequest represent request of accounting export, full export ignores watermark.
+type AccountingExportRequest struct {
+	Full bool `json:"full" form:"full"`
+}
+
+// AccountingExportData represent records changed in period of export, deleted records are included.
+type AccountingExportData struct {
+	Sities         []*Sity
+	Routes         []*Route
+	PassengerTypes []*PassengerType
+	Orders         []*Order
+	Payments       []*Payment
+}
+
+// DetailAccountingExport represent format of detail AccountingExport.
+type DetailAccountingExport struct {
+	UUID               string    `json:"uuid"`
+	Format             string    `json:"format"`
+	Source             string    `json:"source"`
+	ChangedFrom        time.Time `json:"changed_from"`
+	ChangedTo          time.Time `json:"changed_to"`
+	SityCount          int       `json:"sity_count"`
+	RouteCount         int       `json:"route_count"`
+	PassengerTypeCount int       `json:"passenger_type_count"`
+	OrderCount         int       `json:"order_count"`
+	PaymentCount       int       `json:"payment_count"`
+	CreatedBy          string    `json:"created_by"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// TableName return name of table.
+func (u *AccountingExport) TableName() string {
+	return "accounting_exports"
+}
+
+// FilterableFields return fields.
+func (u *AccountingExport) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "format", "source", "changed_from", "changed_to", "created_by"}
+}
+
+// BeforeCreate handle uuid generation.
+func (u *AccountingExport) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// NewAccountingExport return export of format changed after watermark up to now,
+// end of period is truncated to seconds so records are not lost by precision of timestamps of database.
+func NewAccountingExport(format string, source string, watermark time.Time, createdBy string) *AccountingExport {
+	return &AccountingExport{
+		Format:      format,
+		Source:      source,
+		ChangedFrom: watermark,
+		ChangedTo:   time.Now().Truncate(time.Second),
+		CreatedBy:   createdBy,
+	}
+}
+
+// FileName return name of exported file.
+func (u *AccountingExport) FileName() string {
+	return "commerceml-" + u.ChangedTo.Format("20060102-150405") + ".xml"
+}
+
+// CommerceML will write records as CommerceML file: cities and passenger types are dictionaries of classifier,
+// routes are services of catalog, orders, payments and refunds are documents.
+func (u *AccountingExport) CommerceML(data *AccountingExportData) error {
+	u.SityCount = len(data.Sities)
+	u.RouteCount = len(data.Routes)
+	u.PassengerTypeCount = len(data.PassengerTypes)
+	u.OrderCount = len(data.Orders)
+	u.PaymentCount = len(data.Payments)
+
+	information := commerceml.New(u.ChangedTo)
+	information.Classifier = &commerceml.Classifier{
+		ID:   accountingClassifierID,
+		Name: "Классификатор (пассажирские перевозки)",
+		Properties: []commerceml.Property{
+			{ID: accountingRouteFromID, Name: "Пункт отправления", ValueType: accountingDictionaryProperty},
+			{ID: accountingRouteToID, Name: "Пункт назначения", ValueType: accountingDictionaryProperty},
+			{ID: accountingPassengerTypeID, Name: "Тип пассажира", ValueType: accountingDictionaryProperty},
+		},
+	}
+	// Cities are values of both departure and destination of route.
+	for _, sity := range data.Sities {
+		value := commerceml.DictionaryValue{ID: sity.UUID, Value: sity.Name}
+		information.Classifier.Properties[0].Values = append(information.Classifier.Properties[0].Values, value)
+		information.Classifier.Properties[1].Values = append(information.Classifier.Properties[1].Values, value)
+	}
+	for _, passengerType := range data.PassengerTypes {
+		information.Classifier.Properties[2].Values = append(
+			information.Classifier.Properties[2].Values,
+			commerceml.DictionaryValue{ID: passengerType.UUID, Value: passengerType.Type},
+		)
+	}
+
+	information.Catalog = &commerceml.Catalog{
+		ID:           accountingCatalogID,
+		ClassifierID: accountingClassifierID,
+		Name:         "Каталог услуг (пассажирские перевозки)",
+		OnlyChanges:  !u.ChangedFrom.IsZero(),
+	}
+	for _, route := range data.Routes {
+		information.Catalog.Products = append(information.Catalog.Products, commerceml.Product{
+			ID:       route.UUID,
+			Deleted:  route.DeletedAt.Valid,
+			Name:     accountingRouteName(route),
+			BaseUnit: commerceml.Piece(),
+			Properties: []commerceml.PropertyValue{
+				{ID: accountingRouteFromID, Value: route.FromUUID},
+				{ID: accountingRouteToID, Value: route.ToUUID},
+			},
+			Requisites: []commerceml.Requisite{
+				{Name: "ВидНоменклатуры", Value: accountingServiceRequisite},
+				{Name: "ТипНоменклатуры", Value: accountingServiceRequisite},
+				{Name: "Расстояние", Value: commerceml.Quantity(float64(route.Distance))},
+			},
+		})
+	}
+
+	for _, order := range data.Orders {
+		information.Documents = append(information.Documents, accountingOrderDocument(order))
+	}
+	for _, payment := range data.Payments {
+		information.Documents = append(information.Documents, accountingPaymentDocument(payment))
+	}
+
+	content, err := commerceml.Marshal(information)
+	if err != nil {
+		return err
+	}
+	u.Content = content
+	return nil
+}
+
+// accountingOrderDocument will return order as document with line of tickets for every passenger type,
+// fare is price of route for passenger type.
+func accountingOrderDocument(order *Order) commerceml.Document {
+	route := &order.Trip.Route
+	prices := map[string]*Price{}
+	for _, price := range route.Prices {
+		prices[price.PassengerTypeUUID] = price
+	}
+	items := map[string]*commerceml.LineItem{}
+	quantities := map[string]float64{}
+	var keys []string
+	var total float64
+	for _, passenger := range order.Passengers {
+		key := passenger.PassengerTypeUUID
+		var fare float64
+		name := accountingRouteName(route)
+		if price, ok := prices[key]; ok {
+			fare = price.Price
+			if price.PassengerType.Type != "" {
+				name += ", " + price.PassengerType.Type
+			}
+		}
+		if _, ok := items[key]; !ok {
+			keys = append(keys, key)
+			items[key] = &commerceml.LineItem{
+				ID:        route.UUID + "#" + key,
+				Name:      name,
+				BaseUnit:  commerceml.Piece(),
+				UnitPrice: commerceml.Money(fare),
+				Requisites: []commerceml.Requisite{
+					{Name: "ВидНоменклатуры", Value: accountingServiceRequisite},
+					{Name: "ТипНоменклатуры", Value: accountingServiceRequisite},
+				},
+			}
+		}
+		quantities[key]++
+		items[key].Quantity = commerceml.Quantity(quantities[key])
+		items[key].Amount = commerceml.Money(roundMoney(quantities[key] * fare))
+		total += fare
+	}
+	sort.Strings(keys)
+
+	document := commerceml.Document{
+		ID:        order.UUID,
+		Deleted:   order.DeletedAt.Valid,
+		Number:    accountingNumber(order.ExternalUUID, order.UUID),
+		Date:      commerceml.Date(order.OrderDate),
+		Time:      commerceml.Time(order.OrderDate),
+		Operation: commerceml.OperationOrder,
+		Role:      commerceml.RoleSeller,
+		Currency:  AccountingExportCurrency,
+		Rate:      "1",
+		Amount:    commerceml.Money(roundMoney(total)),
+		Requisites: []commerceml.Requisite{
+			{Name: "Статус заказа", Value: order.Status.Type},
+			{Name: "Отменен", Value: accountingBool(order.Status.IsCanceled)},
+			{Name: "Рейс", Value: order.TripUUID},
+			{Name: "Дата отправления", Value: commerceml.DateTime(order.Trip.DepartureTime)},
+		},
+	}
+	for _, key := range keys {
+		document.Products = append(document.Products, *items[key])
+	}
+	return document
+}
+
+// accountingPaymentDocument will return payment or refund as document with orders of payment as requisites.
+func accountingPaymentDocument(payment *Payment) commerceml.Document {
+	operation := commerceml.OperationPayment
+	if payment.Type == PaymentTypeRefund {
+		operation = commerceml.OperationRefund
+	}
+	document := commerceml.Document{
+		ID:        payment.UUID,
+		Deleted:   payment.DeletedAt.Valid,
+		Number:    accountingNumber(payment.ExternalUUID, payment.UUID),
+		Date:      commerceml.Date(payment.PaymentDate),
+		Time:      commerceml.Time(payment.PaymentDate),
+		Operation: operation,
+		Role:      commerceml.RoleSeller,
+		Currency:  AccountingExportCurrency,
+		Rate:      "1",
+		Amount:    commerceml.Money(payment.Amount),
+		Requisites: []commerceml.Requisite{
+			{Name: "Внешний идентификатор", Value: payment.ExternalUUID},
+			{Name: "Рейс", Value: payment.TripUUID},
+		},
+	}
+	for _, order := range payment.Orders {
+		document.Requisites = append(document.Requisites, commerceml.Requisite{Name: "Заказ", Value: order.UUID})
+	}
+	return document
+}
+
+// accountingRouteName return name of service of route.
+func accountingRouteName(route *Route) string {
+	return "Проезд " + route.SityFrom.Name + " - " + route.SityTo.Name
+}
+
+// accountingNumber return external number of document, UUID is used when external number is empty.
+func accountingNumber(externalUUID string, UUID string) string {
+	if externalUUID != "" {
+		return externalUUID
+	}
+	return UUID
+}
+
+// accountingBool return boolean value of requisite.
+func accountingBool(value bool) string {
+	if value {
+		return "true"
+	}
+	return "false"
+}
+
+// DetailAccountingExports will return formatted export detail of multiple export.
+func (exports AccountingExports) DetailAccountingExports() []interface{} {
+	result := make([]interface{}, len(exports))
+	for index, export := range exports {
+		result[index] = export.DetailAccountingExport()
+	}
+	return result
+}
+
+// DetailAccountingExport will return formatted export detail.
+func (u *AccountingExport) DetailAccountingExport() interface{} {
+	return &DetailAccountingExport{
+		UUID:               u.UUID,
+		Format:             u.Format,
+		Source:             u.Source,
+		ChangedFrom:        u.ChangedFrom,
+		ChangedTo:          u.ChangedTo,
+		SityCount:          u.SityCount,
+		RouteCount:         u.RouteCount,
+		PassengerTypeCount: u.PassengerTypeCount,
+		OrderCount:         u.OrderCount,
+		PaymentCount:       u.PaymentCount,
+		CreatedBy:          u.CreatedBy,
+		CreatedAt:          u.CreatedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/audit"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DefaultAuditLogRetentionDays is a default number of days audit log is kept.
+const DefaultAuditLogRetentionDays = 365
+
+// ErrAuditLogAppendOnly is returned when stored audit log is changed.
+var ErrAuditLogAppendOnly = errors.New("audit log is append-only")
+
+// AuditLog represent schema of table audit_logs.
+// Audit log is a change of record made by actor, changes are field-level values before and after change.
+// Logs are never changed, logs older than retention period are purged.
+type AuditLog struct {
+	UUID            string    `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid"`
+	EntityType      string    `gorm:"size:100;not null;index:idx_audit_logs_entity;" json:"entity_type"`
+	EntityUUID      string    `gorm:"size:36;not null;index:idx_audit_logs_entity;" json:"entity_uuid"`
+	Action          string    `gorm:"size:20;not null;" json:"action"`
+	UserUUID        string    `gorm:"size:36;index;" json:"user_uuid"`
+	ApplicationUUID string    `gorm:"size:36;index;" json:"application_uuid"`
+	APIKeyUUID      string    `gorm:"size:36;" json:"api_key_uuid"`
+	RequestID       string    `gorm:"size:100;index;" json:"request_id"`
+	Changes         string    `gorm:"type:text;" json:"-"`
+	CreatedAt       time.Time `gorm:"index;" json:"created_at"`
+}
+
+// AuditLogs represent multiple AuditLog.
+type AuditLogs []*AuditLog
+
+// DetailAuditLog represent format of detail AuditLog.
+type DetailAuditLog struct {
+	UUID            string                  `json:"uuid"`
+	EntityType      string                  `json:"entity_type"`
+	EntityUUID      string                  `json:"entity_uuid"`
+	Action          string                  `json:"action"`
+	UserUUID        string                  `json:"user_uuid"`
+	ApplicationUUID string                  `json:"application_uuid"`
+	APIKeyUUID      string                  `json:"api_key_uuid"`
+	RequestID       string                  `json:"request_id"`
+	Changes         map[string]audit.Change `json:"changes"`
+	CreatedAt       time.Time               `json:"created_at"`
+}
+
+// TableName return name of table.
+func (u *AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// FilterableFields return fields.
+func (u *AuditLog) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "entity_type", "entity_uuid", "action", "user_uuid", "application_uuid", "request_id"}
+}
+
+// NewAuditLog will construct audit log of change of record by actor, actor is nil when change is not made
+// in request.
+func NewAuditLog(
+	entityType string,
+	entityUUID string,
+	action string,
+	changes map[string]audit.Change,
+	actor *audit.Actor,
+	now time.Time,
+) (*AuditLog, error) {
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	log := &AuditLog{
+		EntityType: entityType,
+		EntityUUID: entityUUID,
+		Action:     action,
+		Changes:    string(encoded),
+		CreatedAt:  now,
+	}
+	if actor != nil {
+		log.UserUUID = actor.UserUUID
+		log.ApplicationUUID = actor.ApplicationUUID
+		log.APIKeyUUID = actor.APIKeyUUID
+		log.RequestID = actor.RequestID
+	}
+	return log, nil
+}
+
+// BeforeCreate handle uuid generation.
+func (u *AuditLog) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// BeforeUpdate rejects change of stored audit log.
+func (u *AuditLog) BeforeUpdate(tx *gorm.DB) error {
+	return ErrAuditLogAppendOnly
+}
+
+// DetailAuditLogs will return formatted audit log detail of multiple audit log.
+func (logs AuditLogs) DetailAuditLogs() []interface{} {
+	result := make([]interface{}, len(logs))
+	for index, log := range logs {
+		result[index] = log.DetailAuditLog()
+	}
+	return result
+}
+
+// DetailAuditLog will return formatted audit log detail of audit log.
+func (u *AuditLog) DetailAuditLog() interface{} {
+	changes := map[string]audit.Change{}
+	_ = json.Unmarshal([]byte(u.Changes), &changes)
+	return &DetailAuditLog{
+		UUID:            u.UUID,
+		EntityType:      u.EntityType,
+		EntityUUID:      u.EntityUUID,
+		Action:          u.Action,
+		UserUUID:        u.UserUUID,
+		ApplicationUUID: u.ApplicationUUID,
+		APIKeyUUID:      u.APIKeyUUID,
+		RequestID:       u.RequestID,
+		Changes:         changes,
+		CreatedAt:       u.CreatedAt,
+	}
+}
@@ -0,0 +1,408 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/bankstatement"
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"html"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// BankStatementLineMatched is a status of line matched to payment automatically.
+	BankStatementLineMatched = bankstatement.StatusMatched
+
+	// BankStatementLineUnmatched is a status of line without payment.
+	BankStatementLineUnmatched = bankstatement.StatusUnmatched
+
+	// BankStatementLineDiscrepancy is a status of line with payment which differs from line.
+	BankStatementLineDiscrepancy = bankstatement.StatusDiscrepancy
+
+	// BankStatementLineResolved is a status of line matched to payment by accountant.
+	BankStatementLineResolved = "resolved"
+
+	// BankStatementLineIgnored is a status of line which has no payment, e.g. bank fee.
+	BankStatementLineIgnored = "ignored"
+
+	// BankStatementResolveMatch is an action to match line to payment.
+	BankStatementResolveMatch = "match"
+
+	// BankStatementResolveIgnore is an action to ignore line.
+	BankStatementResolveIgnore = "ignore"
+
+	// BankStatementMatchWindow is a maximum difference between date of line and date of payment.
+	BankStatementMatchWindow = 3 * 24 * time.Hour
+
+	// BankStatementMaxFileSize is a maximum size of statement file in megabytes.
+	BankStatementMaxFileSize = 10
+)
+
+// BankStatement represent schema of table bank_statements.
+// Statement is a file imported from bank with money transfers of acquirer payouts and refunds.
+type BankStatement struct {
+	UUID             string             `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	Format           string             `gorm:"size:10;not null;"                         json:"format"`
+	FileName         string             `gorm:"size:255;"                                 json:"file_name"`
+	Account          string             `gorm:"size:34;index;"                            json:"account"`
+	DateFrom         time.Time          `                                                 json:"date_from"`
+	DateTo           time.Time          `                                                 json:"date_to"`
+	LineCount        int                `gorm:"default:0"                                 json:"line_count"`
+	MatchedCount     int                `gorm:"default:0"                                 json:"matched_count"`
+	UnmatchedCount   int                `gorm:"default:0"                                 json:"unmatched_count"`
+	DiscrepancyCount int                `gorm:"default:0"                                 json:"discrepancy_count"`
+	ResolvedCount    int                `gorm:"default:0"                                 json:"resolved_count"`
+	TotalAmount      float64            `gorm:"default:0"                                 json:"total_amount"`
+	CreatedBy        string             `gorm:"size:36;"                                  json:"created_by"`
+	Lines            BankStatementLines `gorm:"foreignKey:StatementUUID"                  json:"lines"`
+	CreatedAt        time.Time          `                                                 json:"created_at,omitempty"`
+	UpdatedAt        time.Time          `                                                 json:"updated_at,omitempty"`
+	DeletedAt        gorm.DeletedAt     `                                                 json:"deleted_at,omitempty"`
+}
+
+// BankStatements represent multiple BankStatement.
+type BankStatements []*BankStatement
+
+// BankStatementLine represent schema of table bank_statement_lines.
+// Incoming line has positive amount, outgoing line (refund) has negative amount.
+type BankStatementLine struct {
+	UUID           string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	StatementUUID  string         `gorm:"size:36;not null;index;"                   json:"statement_uuid"`
+	LineNumber     int            `                                                 json:"line_number"`
+	Date           time.Time      `gorm:"index;"                                    json:"date"`
+	Amount         float64        `                                                 json:"amount"`
+	Reference      string         `gorm:"size:100;index;"                           json:"reference"`
+	DocumentNumber string         `gorm:"size:50;"                                  json:"document_number"`
+	Counterparty   string         `gorm:"size:255;"                                 json:"counterparty"`
+	Purpose        string         `gorm:"size:500;"                                 json:"purpose"`
+	Status         string         `gorm:"size:20;not null;index;"                   json:"status"`
+	Discrepancy    string         `gorm:"size:20;"                                  json:"discrepancy"`
+	PaymentUUID    string         `gorm:"size:36;index;"                            json:"payment_uuid"`
+	ResolvedBy     string         `gorm:"size:36;"                                  json:"resolved_by"`
+	ResolvedAt     *time.Time     `                                                 json:"resolved_at"`
+	Comment        string         `gorm:"size:255;"                                 json:"comment"`
+	CreatedAt      time.Time      `                                                 json:"created_at,omitempty"`
+	UpdatedAt      time.Time      `                                                 json:"updated_at,omitempty"`
+	DeletedAt      gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
+}
+
+// BankStatementLines represent multiple BankStatementLine.
+type BankStatementLines []*BankStatementLine
+
+// BankStatementLineResolveRequest represent resolution of line left after automatic matching.
+type BankStatementLineResolveRequest struct {
+	Action      string `json:"action"       form:"action"`
+	PaymentUUID string `json:"payment_uuid" form:"payment_uuid"`
+	Comment     string `json:"comment"      form:"comment"`
+}
+
+// DetailBankStatement represent format of detail BankStatement with lines split by status.
+type DetailBankStatement struct {
+	BankStatementFieldsForDetail
+	Matched       []interface{} `json:"matched"`
+	Unmatched     []interface{} `json:"unmatched"`
+	Discrepancies []interface{} `json:"discrepancies"`
+	Resolved      []interface{} `json:"resolved"`
+}
+
+// DetailBankStatementList represent format of DetailBankStatement for BankStatement list.
+type DetailBankStatementList struct {
+	BankStatementFieldsForDetail
+}
+
+// BankStatementFieldsForDetail represent fields of detail BankStatement.
+type BankStatementFieldsForDetail struct {
+	UUID             string    `json:"uuid"`
+	Format           string    `json:"format"`
+	FileName         string    `json:"file_name"`
+	Account          string    `json:"account"`
+	DateFrom         time.Time `json:"date_from"`
+	DateTo           time.Time `json:"date_to"`
+	LineCount        int       `json:"line_count"`
+	MatchedCount     int       `json:"matched_count"`
+	UnmatchedCount   int       `json:"unmatched_count"`
+	DiscrepancyCount int       `json:"discrepancy_count"`
+	ResolvedCount    int       `json:"resolved_count"`
+	TotalAmount      float64   `json:"total_amount"`
+	CreatedBy        string    `json:"created_by"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// DetailBankStatementLine represent format of detail BankStatementLine.
+type DetailBankStatementLine struct {
+	UUID           string     `json:"uuid"`
+	StatementUUID  string     `json:"statement_uuid"`
+	LineNumber     int        `json:"line_number"`
+	Date           time.Time  `json:"date"`
+	Amount         float64    `json:"amount"`
+	Reference      string     `json:"reference"`
+	DocumentNumber string     `json:"document_number"`
+	Counterparty   string     `json:"counterparty"`
+	Purpose        string     `json:"purpose"`
+	Status         string     `json:"status"`
+	Discrepancy    string     `json:"discrepancy,omitempty"`
+	PaymentUUID    string     `json:"payment_uuid,omitempty"`
+	ResolvedBy     string     `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	Comment        string     `json:"comment,omitempty"`
+}
+
+// TableName return name of table.
+func (u *BankStatement) TableName() string {
+	return "bank_statements"
+}
+
+// TableName return name of table.
+func (u *BankStatementLine) TableName() string {
+	return "bank_statement_lines"
+}
+
+// FilterableFields return fields.
+func (u *BankStatement) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "format", "file_name", "account", "date_from", "date_to", "created_by"}
+}
+
+// BeforeCreate handle uuid generation.
+func (u *BankStatement) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// BeforeCreate handle uuid generation.
+func (u *BankStatementLine) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// NewBankStatement will read statement file of given format, format is detected when it is empty.
+// Lines of statement are unmatched until statement is reconciled.
+func NewBankStatement(fileName string, format string, content []byte) (*BankStatement, error) {
+	statement, err := bankstatement.Parse(content, format, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	result := &BankStatement{
+		Format:   statement.Format,
+		FileName: html.EscapeString(strings.TrimSpace(fileName)),
+		Account:  statement.Account,
+		DateFrom: statement.DateFrom,
+		DateTo:   statement.DateTo,
+	}
+	for _, line := range statement.Lines {
+		result.Lines = append(result.Lines, &BankStatementLine{
+			LineNumber:     line.Number,
+			Date:           line.Date,
+			Amount:         line.Amount,
+			Reference:      line.Reference,
+			DocumentNumber: line.DocumentNumber,
+			Counterparty:   line.Counterparty,
+			Purpose:        line.Purpose,
+			Status:         BankStatementLineUnmatched,
+		})
+	}
+	result.Count()
+	return result, nil
+}
+
+// References return references of lines.
+func (u *BankStatement) References() []string {
+	var references []string
+	for _, line := range u.Lines {
+		if line.Reference != "" {
+			references = append(references, line.Reference)
+		}
+	}
+	return references
+}
+
+// Period return window of payment dates which can be matched to lines.
+func (u *BankStatement) Period() (time.Time, time.Time) {
+	from, to := u.DateFrom, u.DateTo
+	for _, line := range u.Lines {
+		if from.IsZero() || line.Date.Before(from) {
+			from = line.Date
+		}
+		if to.IsZero() || line.Date.After(to) {
+			to = line.Date
+		}
+	}
+	return from.Add(-BankStatementMatchWindow), to.AddDate(0, 0, 1).Add(BankStatementMatchWindow)
+}
+
+// Reconcile will match lines to payments, reconciled are UUID of payments already matched by other statements.
+// Order payments are incoming money and refunds are outgoing money of statement.
+func (u *BankStatement) Reconcile(payments Payments, reconciled map[string]bool) {
+	candidates := make([]bankstatement.Payment, len(payments))
+	for i, payment := range payments {
+		amount := payment.Amount
+		if payment.Type == PaymentTypeRefund {
+			amount = -amount
+		}
+		candidates[i] = bankstatement.Payment{
+			ID:         payment.UUID,
+			Reference:  payment.ExternalUUID,
+			Amount:     amount,
+			Date:       payment.PaymentDate,
+			Reconciled: reconciled[payment.UUID],
+		}
+	}
+	lines := make([]bankstatement.Line, len(u.Lines))
+	for i, line := range u.Lines {
+		lines[i] = bankstatement.Line{Number: line.LineNumber, Date: line.Date, Amount: line.Amount, Reference: line.Reference}
+	}
+	for i, match := range bankstatement.Reconcile(lines, candidates, BankStatementMatchWindow) {
+		u.Lines[i].Status = match.Status
+		u.Lines[i].Discrepancy = match.Discrepancy
+		u.Lines[i].PaymentUUID = match.PaymentID
+	}
+	u.Count()
+}
+
+// Count will recalculate number of lines by status and total amount of statement.
+func (u *BankStatement) Count() {
+	u.LineCount, u.MatchedCount, u.UnmatchedCount, u.DiscrepancyCount, u.ResolvedCount = len(u.Lines), 0, 0, 0, 0
+	u.TotalAmount = 0
+	for _, line := range u.Lines {
+		u.TotalAmount += line.Amount
+		switch line.Status {
+		case BankStatementLineMatched:
+			u.MatchedCount++
+		case BankStatementLineUnmatched:
+			u.UnmatchedCount++
+		case BankStatementLineDiscrepancy:
+			u.DiscrepancyCount++
+		default:
+			u.ResolvedCount++
+		}
+	}
+	u.TotalAmount = roundMoney(u.TotalAmount)
+}
+
+// Resolve will apply accountant resolution to line.
+func (u *BankStatementLine) Resolve(request *BankStatementLineResolveRequest, resolvedBy string) {
+	now := time.Now()
+	u.Status = BankStatementLineIgnored
+	u.PaymentUUID = ""
+	if request.Action == BankStatementResolveMatch {
+		u.Status = BankStatementLineResolved
+		u.PaymentUUID = request.PaymentUUID
+	}
+	u.Comment = request.Comment
+	u.ResolvedBy = resolvedBy
+	u.ResolvedAt = &now
+	u.UpdatedAt = now
+}
+
+// DetailBankStatements will return formatted statement detail of multiple statement.
+func (statements BankStatements) DetailBankStatements() []interface{} {
+	result := make([]interface{}, len(statements))
+	for index, statement := range statements {
+		result[index] = statement.DetailBankStatementList()
+	}
+	return result
+}
+
+// bankStatementFieldsForDetail will return shared fields of statement detail.
+func (u *BankStatement) bankStatementFieldsForDetail() BankStatementFieldsForDetail {
+	return BankStatementFieldsForDetail{
+		UUID:             u.UUID,
+		Format:           u.Format,
+		FileName:         u.FileName,
+		Account:          u.Account,
+		DateFrom:         u.DateFrom,
+		DateTo:           u.DateTo,
+		LineCount:        u.LineCount,
+		MatchedCount:     u.MatchedCount,
+		UnmatchedCount:   u.UnmatchedCount,
+		DiscrepancyCount: u.DiscrepancyCount,
+		ResolvedCount:    u.ResolvedCount,
+		TotalAmount:      u.TotalAmount,
+		CreatedBy:        u.CreatedBy,
+		CreatedAt:        u.CreatedAt,
+	}
+}
+
+// DetailBankStatement will return formatted statement detail with matched, unmatched, discrepancy and resolved lines.
+func (u *BankStatement) DetailBankStatement() interface{} {
+	detail := &DetailBankStatement{
+		BankStatementFieldsForDetail: u.bankStatementFieldsForDetail(),
+		Matched:                      []interface{}{},
+		Unmatched:                    []interface{}{},
+		Discrepancies:                []interface{}{},
+		Resolved:                     []interface{}{},
+	}
+	for _, line := range u.Lines {
+		switch line.Status {
+		case BankStatementLineMatched:
+			detail.Matched = append(detail.Matched, line.DetailBankStatementLine())
+		case BankStatementLineUnmatched:
+			detail.Unmatched = append(detail.Unmatched, line.DetailBankStatementLine())
+		case BankStatementLineDiscrepancy:
+			detail.Discrepancies = append(detail.Discrepancies, line.DetailBankStatementLine())
+		default:
+			detail.Resolved = append(detail.Resolved, line.DetailBankStatementLine())
+		}
+	}
+	return detail
+}
+
+// DetailBankStatementList will return formatted statement detail for statement list.
+func (u *BankStatement) DetailBankStatementList() interface{} {
+	return &DetailBankStatementList{
+		BankStatementFieldsForDetail: u.bankStatementFieldsForDetail(),
+	}
+}
+
+// DetailBankStatementLine will return formatted line detail.
+func (u *BankStatementLine) DetailBankStatementLine() interface{} {
+	return &DetailBankStatementLine{
+		UUID:           u.UUID,
+		StatementUUID:  u.StatementUUID,
+		LineNumber:     u.LineNumber,
+		Date:           u.Date,
+		Amount:         u.Amount,
+		Reference:      u.Reference,
+		DocumentNumber: u.DocumentNumber,
+		Counterparty:   u.Counterparty,
+		Purpose:        u.Purpose,
+		Status:         u.Status,
+		Discrepancy:    u.Discrepancy,
+		PaymentUUID:    u.PaymentUUID,
+		ResolvedBy:     u.ResolvedBy,
+		ResolvedAt:     u.ResolvedAt,
+		Comment:        u.Comment,
+	}
+}
+
+// Prepare will prepare submitted data of line resolution.
+func (u *BankStatementLineResolveRequest) Prepare() {
+	u.Action = strings.ToLower(strings.TrimSpace(u.Action))
+	u.PaymentUUID = html.EscapeString(strings.TrimSpace(u.PaymentUUID))
+	u.Comment = html.EscapeString(strings.TrimSpace(u.Comment))
+}
+
+// ValidateBankStatementLineResolveRequest will validate line resolution request.
+func (u *BankStatementLineResolveRequest) ValidateBankStatementLineResolveRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("action", u.Action, validation.AddRule().Required().In(
+			BankStatementResolveMatch,
+			BankStatementResolveIgnore,
+		).Apply()).
+		Set("payment_uuid", u.PaymentUUID, validation.AddRule().
+			When(u.Action == BankStatementResolveMatch, validation.AddRule().Required()).
+			IsUUID().
+			Apply()).
+		Set("comment", u.Comment, validation.AddRule().Length(0, 255).Apply())
+	return validation.Validate()
+}
@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/ical"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// CalendarFeedDriver is a feed of trips of driver of user.
+	CalendarFeedDriver = "driver"
+
+	// CalendarFeedPassenger is a feed of orders paid by user.
+	CalendarFeedPassenger = "passenger"
+
+	// CalendarFeedPastDays is a number of days of past trips kept in feed.
+	CalendarFeedPastDays = 30
+
+	// CalendarFeedRefreshInterval is a suggested interval of polling of feed by calendar applications.
+	CalendarFeedRefreshInterval = time.Hour
+
+	// CalendarFeedExtension is an extension of feed file in subscription URL.
+	CalendarFeedExtension = ".ics"
+
+	calendarProductID    = "-//cargo-rest-api//calendar//RU"
+	calendarUIDDomain    = "cargo-rest-api"
+	calendarTimeLayout   = "02.01.2006 15:04"
+	calendarTokenByteLen = 32
+)
+
+// CalendarToken represent schema of table calendar_tokens.
+// Token is a secret of subscription URL of feed, it is the only credential of calendar applications.
+type CalendarToken struct {
+	UUID      string    `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid"`
+	UserUUID  string    `gorm:"size:36;not null;index;"                   json:"user_uuid"`
+	Kind      string    `gorm:"size:20;not null;"                         json:"kind"`
+	Token     string    `gorm:"size:64;not null;uniqueIndex;"             json:"-"`
+	CreatedAt time.Time `                                                 json:"created_at"`
+	UpdatedAt time.Time `                                                 json:"updated_at"`
+}
+
+// CalendarTokens represent multiple CalendarToken.
+type CalendarTokens []*CalendarToken
+
+// DetailCalendarToken represent format of detail CalendarToken.
+type DetailCalendarToken struct {
+	Kind      string    `json:"kind"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CalendarFeed represent trips of driver or orders of passenger of feed, deleted trips and orders
+// and refunded orders are cancelled events.
+type CalendarFeed struct {
+	Kind     string
+	Trips    []*Trip
+	Orders   []*Order
+	Refunded map[string]bool
+}
+
+// TableName return name of table.
+func (u *CalendarToken) TableName() string {
+	return "calendar_tokens"
+}
+
+// BeforeCreate handle uuid and token generation.
+func (u *CalendarToken) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	if u.Token == "" {
+		token := make([]byte, calendarTokenByteLen)
+		if _, err := rand.Read(token); err != nil {
+			return err
+		}
+		u.Token = hex.EncodeToString(token)
+	}
+	return nil
+}
+
+// DetailCalendarToken will return subscription URL of feed, baseURL is scheme and host of API.
+func (u *CalendarToken) DetailCalendarToken(baseURL string) interface{} {
+	return &DetailCalendarToken{
+		Kind:      u.Kind,
+		URL:       strings.TrimSuffix(baseURL, "/") + "/calendars/" + u.Token + CalendarFeedExtension,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+// DetailCalendarTokens will return subscription URLs of feeds.
+func (u CalendarTokens) DetailCalendarTokens(baseURL string) []interface{} {
+	result := make([]interface{}, len(u))
+	for index, token := range u {
+		result[index] = token.DetailCalendarToken(baseURL)
+	}
+	return result
+}
+
+// ICS will return feed as iCalendar, times of description are in location.
+func (f *CalendarFeed) ICS(location *time.Location, now time.Time) []byte {
+	calendar := &ical.Calendar{
+		ProductID:       calendarProductID,
+		Timezone:        location.String(),
+		RefreshInterval: CalendarFeedRefreshInterval,
+	}
+	switch f.Kind {
+	case CalendarFeedDriver:
+		calendar.Name = "Trips"
+		for _, trip := range f.Trips {
+			event := calendarTripEvent(trip, location)
+			event.UID = "trip-" + trip.UUID + "@" + calendarUIDDomain
+			event.Description = strings.Join(calendarTripDescription(trip, location), "\n")
+			calendar.Events = append(calendar.Events, event)
+		}
+	case CalendarFeedPassenger:
+		calendar.Name = "Bookings"
+		for _, order := range f.Orders {
+			event := calendarTripEvent(&order.Trip, location)
+			event.UID = "order-" + order.UUID + "@" + calendarUIDDomain
+			lines := calendarTripDescription(&order.Trip, location)
+			if order.Seat != "" {
+				lines = append(lines, "Seat: "+order.Seat)
+			}
+			event.Description = strings.Join(lines, "\n")
+			event.Created = order.CreatedAt
+			calendarModified(event, order.UpdatedAt, order.DeletedAt)
+			if order.DeletedAt.Valid || f.Refunded[order.UUID] {
+				event.Status = ical.StatusCancelled
+			}
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+	return ical.Marshal(calendar, now)
+}
+
+// calendarTripEvent return event of trip from departure to arrival, sequence is a time of the last change of trip
+// so calendar applications update changed trip.
+func calendarTripEvent(trip *Trip, location *time.Location) *ical.Event {
+	event := &ical.Event{
+		Status:   ical.StatusConfirmed,
+		Summary:  fmt.Sprintf("%s — %s", trip.Route.SityFrom.Name, trip.Route.SityTo.Name),
+		Location: calendarSityLocation(&trip.Route.SityFrom),
+		Start:    trip.DepartureTime,
+		End:      trip.ArravialTive,
+		Created:  trip.CreatedAt,
+	}
+	calendarModified(event, trip.UpdatedAt, trip.DeletedAt)
+	if event.End.Before(event.Start) {
+		event.End = event.Start
+	}
+	if trip.DeletedAt.Valid {
+		event.Status = ical.StatusCancelled
+	}
+	return event
+}
+
+// calendarModified will move time of the last change of event to time of update or deletion of record
+// when it is later, sequence of event is a time of the last change.
+func calendarModified(event *ical.Event, updatedAt time.Time, deletedAt gorm.DeletedAt) {
+	if updatedAt.After(event.LastModified) {
+		event.LastModified = updatedAt
+	}
+	if deletedAt.Valid && deletedAt.Time.After(event.LastModified) {
+		event.LastModified = deletedAt.Time
+	}
+	if !event.LastModified.IsZero() {
+		event.Sequence = int(event.LastModified.Unix())
+	}
+}
+
+// calendarTripDescription return lines of description of trip with local times and vehicle.
+func calendarTripDescription(trip *Trip, location *time.Location) []string {
+	lines := []string{
+		fmt.Sprintf("From: %s", calendarSityLocation(&trip.Route.SityFrom)),
+		fmt.Sprintf("To: %s", calendarSityLocation(&trip.Route.SityTo)),
+		fmt.Sprintf("Departure: %s (%s)", trip.DepartureTime.In(location).Format(calendarTimeLayout), location),
+		fmt.Sprintf("Arrival: %s (%s)", trip.ArravialTive.In(location).Format(calendarTimeLayout), location),
+	}
+	if trip.Vehicle.RegCode != "" {
+		lines = append(lines, strings.TrimSpace("Vehicle: "+trip.Vehicle.RegCode+" "+trip.Vehicle.Model))
+	}
+	return lines
+}
+
+// calendarSityLocation return name of sity with region.
+func calendarSityLocation(sity *Sity) string {
+	if sity.Region == "" {
+		return sity.Name
+	}
+	return sity.Name + ", " + sity.Region
+}
@@ -0,0 +1,311 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+// DefaultVolumetricDivisor is a divisor of volumetric weight in cm³ per kg.
+const DefaultVolumetricDivisor = 5000
+
+// CargoTariff represent schema of table cargo_tariffs.
+// Tariff without route is a default tariff which applies to routes without own tariff.
+type CargoTariff struct {
+	UUID              string              `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	Name              string              `gorm:"size:100;not null;"                        json:"name"                form:"name"`
+	RouteUUID         string              `gorm:"size:36;index;"                            json:"route_uuid"          form:"route_uuid"`
+	VolumetricDivisor float64             `gorm:"default:5000"                              json:"volumetric_divisor"  form:"volumetric_divisor"`
+	PricePerKm        float64             `gorm:"default:0"                                 json:"price_per_km"        form:"price_per_km"`
+	InsurancePercent  float64             `gorm:"default:0"                                 json:"insurance_percent"   form:"insurance_percent"`
+	MinimumCharge     float64             `gorm:"default:0"                                 json:"minimum_charge"      form:"minimum_charge"`
+	CODFeePercent     float64             `gorm:"default:0"                                 json:"cod_fee_percent"     form:"cod_fee_percent"`
+	Brackets          CargoTariffBrackets `gorm:"foreignKey:CargoTariffUUID"                json:"brackets"            form:"brackets"`
+	CreatedAt         time.Time           `                                                 json:"created_at,omitempty"`
+	UpdatedAt         time.Time           `                                                 json:"updated_at,omitempty"`
+	DeletedAt         gorm.DeletedAt      `                                                 json:"deleted_at,omitempty"`
+}
+
+// CargoTariffBracket represent schema of table cargo_tariff_brackets.
+// Bracket price applies to chargeable weight up to MaxWeight, zero MaxWeight means no upper limit.
+type CargoTariffBracket struct {
+	UUID            string  `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	CargoTariffUUID string  `gorm:"size:36;not null;index;"                   json:"cargo_tariff_uuid,omitempty"`
+	MaxWeight       float64 `gorm:"default:0"                                 json:"max_weight" form:"max_weight"`
+	Price           float64 `gorm:"default:0"                                 json:"price"      form:"price"`
+}
+
+// CargoTariffFaker represent content when generate fake data of cargo tariff.
+type CargoTariffFaker struct {
+	UUID             string  `faker:"uuid_hyphenated"`
+	Name             string  `faker:"name"`
+	PricePerKm       float64 `faker:"price_per_km"`
+	InsurancePercent float64 `faker:"insurance_percent"`
+	MinimumCharge    float64 `faker:"minimum_charge"`
+}
+
+// CargoTariffs represent multiple CargoTariff.
+type CargoTariffs []*CargoTariff
+
+// CargoTariffBrackets represent multiple CargoTariffBracket.
+type CargoTariffBrackets []*CargoTariffBracket
+
+// CargoQuote represent calculated price of shipment.
+type CargoQuote struct {
+	CargoTariffUUID  string  `json:"cargo_tariff_uuid"`
+	RouteUUID        string  `json:"route_uuid"`
+	Distance         int     `json:"distance"`
+	ActualWeight     float64 `json:"actual_weight"`
+	VolumetricWeight float64 `json:"volumetric_weight"`
+	ChargeableWeight float64 `json:"chargeable_weight"`
+	WeightCharge     float64 `json:"weight_charge"`
+	DistanceCharge   float64 `json:"distance_charge"`
+	InsuranceCharge  float64 `json:"insurance_charge"`
+	MinimumCharge    float64 `json:"minimum_charge"`
+	Total            float64 `json:"total"`
+	CODAmount        float64 `json:"cod_amount"`
+	CODFee           float64 `json:"cod_fee"`
+}
+
+// DetailCargoTariff represent format of detail CargoTariff.
+type DetailCargoTariff struct {
+	CargoTariffFieldsForDetail
+	Brackets []interface{} `json:"brackets"`
+}
+
+// DetailCargoTariffList represent format of DetailCargoTariff for CargoTariff list.
+type DetailCargoTariffList struct {
+	CargoTariffFieldsForDetail
+	CargoTariffFieldsForList
+}
+
+// CargoTariffFieldsForDetail represent fields of detail CargoTariff.
+type CargoTariffFieldsForDetail struct {
+	UUID              string  `json:"uuid"`
+	Name              string  `json:"name"`
+	RouteUUID         string  `json:"route_uuid"`
+	VolumetricDivisor float64 `json:"volumetric_divisor"`
+	PricePerKm        float64 `json:"price_per_km"`
+	InsurancePercent  float64 `json:"insurance_percent"`
+	MinimumCharge     float64 `json:"minimum_charge"`
+	CODFeePercent     float64 `json:"cod_fee_percent"`
+}
+
+// CargoTariffFieldsForList represent fields of detail CargoTariff for CargoTariff list.
+type CargoTariffFieldsForList struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DetailCargoTariffBracket represent format of detail CargoTariffBracket.
+type DetailCargoTariffBracket struct {
+	UUID      string  `json:"uuid"`
+	MaxWeight float64 `json:"max_weight"`
+	Price     float64 `json:"price"`
+}
+
+// TableName return name of table.
+func (u *CargoTariff) TableName() string {
+	return "cargo_tariffs"
+}
+
+// TableName return name of table.
+func (u *CargoTariffBracket) TableName() string {
+	return "cargo_tariff_brackets"
+}
+
+// FilterableFields return fields.
+func (u *CargoTariff) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "name", "route_uuid", "price_per_km", "insurance_percent", "minimum_charge"}
+}
+
+// Prepare will prepare submitted data of cargo tariff.
+func (u *CargoTariff) Prepare() {
+	u.Name = html.EscapeString(strings.TrimSpace(u.Name))
+	u.RouteUUID = html.EscapeString(strings.TrimSpace(u.RouteUUID))
+	if u.VolumetricDivisor == 0 {
+		u.VolumetricDivisor = DefaultVolumetricDivisor
+	}
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// BeforeCreate handle uuid generation.
+func (u *CargoTariff) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// BeforeCreate handle uuid generation.
+func (u *CargoTariffBracket) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// VolumetricWeight return volumetric weight in kg of dimensions measured in centimeters.
+func (u *CargoTariff) VolumetricWeight(length float64, width float64, height float64) float64 {
+	divisor := u.VolumetricDivisor
+	if divisor <= 0 {
+		divisor = DefaultVolumetricDivisor
+	}
+	return length * width * height / divisor
+}
+
+// Bracket return bracket which covers chargeable weight or nil when weight is not covered by tariff.
+func (u *CargoTariff) Bracket(weight float64) *CargoTariffBracket {
+	brackets := make(CargoTariffBrackets, len(u.Brackets))
+	copy(brackets, u.Brackets)
+	sort.SliceStable(brackets, func(i, j int) bool {
+		if brackets[i].MaxWeight == 0 || brackets[j].MaxWeight == 0 {
+			return brackets[j].MaxWeight == 0 && brackets[i].MaxWeight != 0
+		}
+		return brackets[i].MaxWeight < brackets[j].MaxWeight
+	})
+	for _, bracket := range brackets {
+		if bracket.MaxWeight == 0 || weight <= bracket.MaxWeight {
+			return bracket
+		}
+	}
+	return nil
+}
+
+// Quote will calculate price of shipment transported over distance in km.
+// Fee of cash on delivery is not included into total, it is withheld from COD payout to sender.
+// The second return value is false when shipment weight is not covered by tariff brackets.
+func (u *CargoTariff) Quote(shipment *Shipment, distance int) (*CargoQuote, bool) {
+	quote := &CargoQuote{
+		CargoTariffUUID:  u.UUID,
+		RouteUUID:        u.RouteUUID,
+		Distance:         distance,
+		ActualWeight:     shipment.Weight,
+		VolumetricWeight: roundWeight(u.VolumetricWeight(shipment.Length, shipment.Width, shipment.Height)),
+		MinimumCharge:    u.MinimumCharge,
+	}
+	quote.ChargeableWeight = math.Max(quote.ActualWeight, quote.VolumetricWeight)
+
+	bracket := u.Bracket(quote.ChargeableWeight)
+	if bracket == nil {
+		return quote, false
+	}
+
+	quote.WeightCharge = roundMoney(bracket.Price)
+	quote.DistanceCharge = roundMoney(float64(distance) * u.PricePerKm)
+	quote.InsuranceCharge = roundMoney(shipment.DeclaredValue * u.InsurancePercent / 100)
+	quote.Total = roundMoney(math.Max(quote.WeightCharge+quote.DistanceCharge+quote.InsuranceCharge, u.MinimumCharge))
+	quote.CODAmount = shipment.CODAmount
+	quote.CODFee = roundMoney(shipment.CODAmount * u.CODFeePercent / 100)
+	return quote, true
+}
+
+// roundMoney will round amount to hundredths.
+func roundMoney(amount float64) float64 {
+	return roundTo(amount, 2)
+}
+
+// roundTo will round value to number of decimal places.
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
+
+// DetailCargoTariffs will return formatted cargo tariff detail of multiple cargo tariff.
+func (tariffs CargoTariffs) DetailCargoTariffs() []interface{} {
+	result := make([]interface{}, len(tariffs))
+	for index, tariff := range tariffs {
+		result[index] = tariff.DetailCargoTariffList()
+	}
+	return result
+}
+
+// cargoTariffFieldsForDetail will return shared fields of cargo tariff detail.
+func (u *CargoTariff) cargoTariffFieldsForDetail() CargoTariffFieldsForDetail {
+	return CargoTariffFieldsForDetail{
+		UUID:              u.UUID,
+		Name:              u.Name,
+		RouteUUID:         u.RouteUUID,
+		VolumetricDivisor: u.VolumetricDivisor,
+		PricePerKm:        u.PricePerKm,
+		InsurancePercent:  u.InsurancePercent,
+		MinimumCharge:     u.MinimumCharge,
+		CODFeePercent:     u.CODFeePercent,
+	}
+}
+
+// DetailCargoTariff will return formatted cargo tariff detail of cargo tariff.
+func (u *CargoTariff) DetailCargoTariff() interface{} {
+	brackets := make([]interface{}, len(u.Brackets))
+	for index, bracket := range u.Brackets {
+		brackets[index] = &DetailCargoTariffBracket{
+			UUID:      bracket.UUID,
+			MaxWeight: bracket.MaxWeight,
+			Price:     bracket.Price,
+		}
+	}
+	return &DetailCargoTariff{
+		CargoTariffFieldsForDetail: u.cargoTariffFieldsForDetail(),
+		Brackets:                   brackets,
+	}
+}
+
+// DetailCargoTariffList will return formatted cargo tariff detail of cargo tariff for cargo tariff list.
+func (u *CargoTariff) DetailCargoTariffList() interface{} {
+	return &DetailCargoTariffList{
+		CargoTariffFieldsForDetail: u.cargoTariffFieldsForDetail(),
+		CargoTariffFieldsForList: CargoTariffFieldsForList{
+			CreatedAt: u.CreatedAt,
+		},
+	}
+}
+
+// ValidateSaveCargoTariff will validate create a new cargo tariff request.
+func (u *CargoTariff) ValidateSaveCargoTariff() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("name", u.Name, validation.AddRule().Required().Length(3, 100).Apply()).
+		Set("route_uuid", u.RouteUUID, validation.AddRule().IsUUID().Apply()).
+		Set("volumetric_divisor", u.VolumetricDivisor, validation.AddRule().MinValue(0.0).Apply()).
+		Set("price_per_km", u.PricePerKm, validation.AddRule().MinValue(0.0).Apply()).
+		Set("insurance_percent", u.InsurancePercent, validation.AddRule().MinValue(0.0).MaxValue(100.0).Apply()).
+		Set("minimum_charge", u.MinimumCharge, validation.AddRule().MinValue(0.0).Apply()).
+		Set("cod_fee_percent", u.CODFeePercent, validation.AddRule().MinValue(0.0).MaxValue(100.0).Apply()).
+		Set("brackets", u.Brackets, validation.AddRule().Required().Apply())
+	for _, bracket := range u.Brackets {
+		validation.
+			Set("max_weight", bracket.MaxWeight, validation.AddRule().MinValue(0.0).Apply()).
+			Set("price", bracket.Price, validation.AddRule().MinValue(0.0).Apply())
+	}
+	return validation.Validate()
+}
+
+// ValidateUpdateCargoTariff will validate update a new cargo tariff request.
+func (u *CargoTariff) ValidateUpdateCargoTariff() []response.ErrorForm {
+	return u.ValidateSaveCargoTariff()
+}
+
+// ValidateQuoteShipment will validate shipment data of quote request.
+func (u *Shipment) ValidateQuoteShipment() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("from_uuid", u.FromUUID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("to_uuid", u.ToUUID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("weight", u.Weight, validation.AddRule().Required().MinValue(0.0).Apply()).
+		Set("length", u.Length, validation.AddRule().MinValue(0.0).Apply()).
+		Set("width", u.Width, validation.AddRule().MinValue(0.0).Apply()).
+		Set("height", u.Height, validation.AddRule().MinValue(0.0).Apply()).
+		Set("declared_value", u.DeclaredValue, validation.AddRule().MinValue(0.0).Apply()).
+		Set("cod_amount", u.CODAmount, validation.AddRule().MinValue(0.0).Apply())
+	return validation.Validate()
+}
@@ -0,0 +1,238 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"html"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+// CODDateLayout is a layout of dates of cash on delivery settlement period.
+const CODDateLayout = "2006-01-02"
+
+// CODPayout represent schema of table cod_payouts.
+// Payout is a batch of cash on delivery collected for sender, minus our fees.
+type CODPayout struct {
+	UUID            string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	SenderPhone     string         `gorm:"size:20;not null;index;"                   json:"sender_phone"`
+	SenderName      string         `gorm:"size:100;"                                 json:"sender_name"`
+	ShipmentCount   int            `gorm:"default:0"                                 json:"shipment_count"`
+	CollectedAmount float64        `gorm:"default:0"                                 json:"collected_amount"`
+	FeeAmount       float64        `gorm:"default:0"                                 json:"fee_amount"`
+	PayoutAmount    float64        `gorm:"default:0"                                 json:"payout_amount"`
+	CreatedBy       string         `gorm:"size:36;"                                  json:"created_by"`
+	Payments        Payments       `gorm:"foreignKey:PayoutUUID"                     json:"payments"`
+	CreatedAt       time.Time      `                                                 json:"created_at,omitempty"`
+	UpdatedAt       time.Time      `                                                 json:"updated_at,omitempty"`
+	DeletedAt       gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
+}
+
+// CODPayouts represent multiple CODPayout.
+type CODPayouts []*CODPayout
+
+// CODSettlement represent cash on delivery collected for sender and its settlement.
+type CODSettlement struct {
+	SenderPhone       string  `json:"sender_phone"`
+	SenderName        string  `json:"sender_name"`
+	ShipmentCount     int     `json:"shipment_count"`
+	CollectedAmount   float64 `json:"collected_amount"`
+	FeeAmount         float64 `json:"fee_amount"`
+	PayoutAmount      float64 `json:"payout_amount"`
+	PaidOutAmount     float64 `json:"paid_out_amount"`
+	OutstandingAmount float64 `json:"outstanding_amount"`
+}
+
+// CODSettlements represent multiple CODSettlement.
+type CODSettlements []*CODSettlement
+
+// CODSettlementRequest represent filter of cash on delivery settlement report and payout.
+// Dates are inclusive and filter date of collection.
+type CODSettlementRequest struct {
+	DateFrom    string `json:"date_from"    form:"date_from"`
+	DateTo      string `json:"date_to"      form:"date_to"`
+	SenderPhone string `json:"sender_phone" form:"sender_phone"`
+}
+
+// CODCollection represent cash on delivery payment collected for sender with fee of its shipment.
+type CODCollection struct {
+	PaymentUUID string
+	SenderPhone string
+	SenderName  string
+	Amount      float64
+	Fee         float64
+}
+
+// CODCollections represent multiple CODCollection.
+type CODCollections []*CODCollection
+
+// DetailCODPayout represent format of detail CODPayout.
+type DetailCODPayout struct {
+	CODPayoutFieldsForDetail
+	Payments []interface{} `json:"payments"`
+}
+
+// DetailCODPayoutList represent format of DetailCODPayout for CODPayout list.
+type DetailCODPayoutList struct {
+	CODPayoutFieldsForDetail
+}
+
+// CODPayoutFieldsForDetail represent fields of detail CODPayout.
+type CODPayoutFieldsForDetail struct {
+	UUID            string    `json:"uuid"`
+	SenderPhone     string    `json:"sender_phone"`
+	SenderName      string    `json:"sender_name"`
+	ShipmentCount   int       `json:"shipment_count"`
+	CollectedAmount float64   `json:"collected_amount"`
+	FeeAmount       float64   `json:"fee_amount"`
+	PayoutAmount    float64   `json:"payout_amount"`
+	CreatedBy       string    `json:"created_by"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// TableName return name of table.
+func (u *CODPayout) TableName() string {
+	return "cod_payouts"
+}
+
+// FilterableFields return fields.
+func (u *CODPayout) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "sender_phone", "sender_name", "payout_amount", "created_by"}
+}
+
+// BeforeCreate handle uuid generation.
+func (u *CODPayout) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// Calculate will round collected amounts and calculate amount to pay out to sender.
+func (u *CODSettlement) Calculate() {
+	u.CollectedAmount = roundMoney(u.CollectedAmount)
+	u.FeeAmount = roundMoney(u.FeeAmount)
+	u.PaidOutAmount = roundMoney(u.PaidOutAmount)
+	u.PayoutAmount = roundMoney(u.CollectedAmount - u.FeeAmount)
+	u.OutstandingAmount = roundMoney(u.PayoutAmount - u.PaidOutAmount)
+}
+
+// Settlement will summarize collections of one sender which are not paid out yet.
+func (collections CODCollections) Settlement() *CODSettlement {
+	settlement := &CODSettlement{ShipmentCount: len(collections)}
+	for _, collection := range collections {
+		settlement.SenderPhone = collection.SenderPhone
+		if settlement.SenderName < collection.SenderName {
+			settlement.SenderName = collection.SenderName
+		}
+		settlement.CollectedAmount += collection.Amount
+		settlement.FeeAmount += collection.Fee
+	}
+	settlement.Calculate()
+	return settlement
+}
+
+// PaymentUUIDs return UUIDs of payments of collections.
+func (collections CODCollections) PaymentUUIDs() []string {
+	paymentUUIDs := make([]string, len(collections))
+	for i, collection := range collections {
+		paymentUUIDs[i] = collection.PaymentUUID
+	}
+	return paymentUUIDs
+}
+
+// NewCODPayout will return payout batch of cash on delivery payments collected for sender.
+func NewCODPayout(settlement *CODSettlement, payments Payments) *CODPayout {
+	return &CODPayout{
+		SenderPhone:     settlement.SenderPhone,
+		SenderName:      settlement.SenderName,
+		ShipmentCount:   settlement.ShipmentCount,
+		CollectedAmount: settlement.CollectedAmount,
+		FeeAmount:       settlement.FeeAmount,
+		PayoutAmount:    settlement.PayoutAmount,
+		Payments:        payments,
+	}
+}
+
+// Prepare will prepare submitted data of cash on delivery settlement request.
+func (u *CODSettlementRequest) Prepare() {
+	u.DateFrom = strings.TrimSpace(u.DateFrom)
+	u.DateTo = strings.TrimSpace(u.DateTo)
+	u.SenderPhone = html.EscapeString(strings.TrimSpace(u.SenderPhone))
+}
+
+// Period return inclusive period of collection, zero time means the period is not limited.
+func (u *CODSettlementRequest) Period() (time.Time, time.Time) {
+	var from, to time.Time
+	if u.DateFrom != "" {
+		from, _ = time.ParseInLocation(CODDateLayout, u.DateFrom, time.Local)
+	}
+	if u.DateTo != "" {
+		to, _ = time.ParseInLocation(CODDateLayout, u.DateTo, time.Local)
+		to = to.AddDate(0, 0, 1)
+	}
+	return from, to
+}
+
+// ValidateCODSettlementRequest will validate cash on delivery settlement request.
+func (u *CODSettlementRequest) ValidateCODSettlementRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("date_from", u.DateFrom, validation.AddRule().IsDate(CODDateLayout).Apply()).
+		Set("date_to", u.DateTo, validation.AddRule().IsDate(CODDateLayout).Apply()).
+		Set("sender_phone", u.SenderPhone, validation.AddRule().IsPhone().Apply())
+	return validation.Validate()
+}
+
+// DetailCODSettlements will return settlements of senders.
+func (settlements CODSettlements) DetailCODSettlements() []interface{} {
+	result := make([]interface{}, len(settlements))
+	for index, settlement := range settlements {
+		result[index] = settlement
+	}
+	return result
+}
+
+// DetailCODPayouts will return formatted payout detail of multiple payout.
+func (payouts CODPayouts) DetailCODPayouts() []interface{} {
+	result := make([]interface{}, len(payouts))
+	for index, payout := range payouts {
+		result[index] = payout.DetailCODPayoutList()
+	}
+	return result
+}
+
+// codPayoutFieldsForDetail will return shared fields of payout detail.
+func (u *CODPayout) codPayoutFieldsForDetail() CODPayoutFieldsForDetail {
+	return CODPayoutFieldsForDetail{
+		UUID:            u.UUID,
+		SenderPhone:     u.SenderPhone,
+		SenderName:      u.SenderName,
+		ShipmentCount:   u.ShipmentCount,
+		CollectedAmount: u.CollectedAmount,
+		FeeAmount:       u.FeeAmount,
+		PayoutAmount:    u.PayoutAmount,
+		CreatedBy:       u.CreatedBy,
+		CreatedAt:       u.CreatedAt,
+	}
+}
+
+// DetailCODPayout will return formatted payout detail with its payments.
+func (u *CODPayout) DetailCODPayout() interface{} {
+	return &DetailCODPayout{
+		CODPayoutFieldsForDetail: u.codPayoutFieldsForDetail(),
+		Payments:                 u.Payments.DetailPayments(),
+	}
+}
+
+// DetailCODPayoutList will return formatted payout detail for payout list.
+func (u *CODPayout) DetailCODPayoutList() interface{} {
+	return &DetailCODPayoutList{
+		CODPayoutFieldsForDetail: u.codPayoutFieldsForDetail(),
+	}
+}
@@ -0,0 +1,26 @@
+package entity_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCODCollections_Settlement(t *testing.T) {
+	collections := entity.CODCollections{
+		{PaymentUUID: "payment-1", SenderPhone: "+79001234567", SenderName: "Ivan", Amount: 1000.005, Fee: 20},
+		{PaymentUUID: "payment-2", SenderPhone: "+79001234567", SenderName: "Ivan Petrov", Amount: 500, Fee: 10.5},
+	}
+
+	settlement := collections.Settlement()
+
+	assert.Equal(t, "+79001234567", settlement.SenderPhone)
+	assert.Equal(t, "Ivan Petrov", settlement.SenderName)
+	assert.Equal(t, 2, settlement.ShipmentCount)
+	assert.Equal(t, 1500.01, settlement.CollectedAmount)
+	assert.Equal(t, 30.5, settlement.FeeAmount)
+	assert.Equal(t, 1469.51, settlement.PayoutAmount)
+	assert.Equal(t, 1469.51, settlement.OutstandingAmount)
+	assert.Equal(t, []string{"payment-1", "payment-2"}, collections.PaymentUUIDs())
+}
@@ -0,0 +1,340 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/dataimport"
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DataImportSities is an import of sities, sity is found by name and region.
+	DataImportSities = "sities"
+
+	// DataImportVehicles is an import of vehicles, vehicle is found by registration code.
+	DataImportVehicles = "vehicles"
+
+	// DataImportDrivers is an import of drivers, driver is found by name.
+	DataImportDrivers = "drivers"
+
+	// DataImportRoutes is an import of routes, route is found by sities of departure and destination.
+	DataImportRoutes = "routes"
+
+	// DataImportTrips is an import of trips, trip is found by vehicle and departure time.
+	DataImportTrips = "trips"
+
+	// DataImportActionCreate is an action of row creating a new record.
+	DataImportActionCreate = "create"
+
+	// DataImportActionUpdate is an action of row updating existing record.
+	DataImportActionUpdate = "update"
+
+	// DataImportActionFailed is an action of row which is not imported.
+	DataImportActionFailed = "failed"
+
+	// DataImportMaxFileSize is a max size of imported file in megabytes.
+	DataImportMaxFileSize = 10
+
+	// DataImportTimeLayout is a layout of date and time in imported rows, time is local.
+	DataImportTimeLayout = "2006-01-02 15:04:05"
+
+	// DataImportListSeparator separates values of list in CSV rows.
+	DataImportListSeparator = ","
+)
+
+// DataImportEntities are entities which can be imported, in order of their dependencies.
+var DataImportEntities = []string{
+	DataImportSities,
+	DataImportVehicles,
+	DataImportDrivers,
+	DataImportRoutes,
+	DataImportTrips,
+}
+
+// ErrDataImportEntityUnsupported is returned when entity can not be imported.
+var ErrDataImportEntityUnsupported = errors.New("import entity is not supported")
+
+// DataImport represent import of rows of one entity, import is committed when no row failed and it is not dry run.
+type DataImport struct {
+	Entity    string
+	Format    string
+	DryRun    bool
+	Committed bool
+	Rows      []*DataImportRow
+}
+
+// DataImportRow represent imported row and result of its import.
+type DataImportRow struct {
+	dataimport.Row
+	Key        string
+	Action     string
+	UUID       string
+	Errors     map[string]string
+	Validation []response.ErrorForm
+}
+
+// DetailDataImport represent format of detail DataImport.
+type DetailDataImport struct {
+	Entity    string                 `json:"entity"`
+	Format    string                 `json:"format"`
+	DryRun    bool                   `json:"dry_run"`
+	Committed bool                   `json:"committed"`
+	Total     int                    `json:"total"`
+	Created   int                    `json:"created"`
+	Updated   int                    `json:"updated"`
+	Failed    int                    `json:"failed"`
+	Rows      []*DetailDataImportRow `json:"rows"`
+}
+
+// DetailDataImportRow represent format of detail DataImportRow.
+type DetailDataImportRow struct {
+	Row    int               `json:"row"`
+	Key    string            `json:"key"`
+	Action string            `json:"action"`
+	UUID   string            `json:"uuid,omitempty"`
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// NewDataImport will read rows of file, format is detected by file name or content when empty.
+func NewDataImport(entityName string, fileName string, format string, dryRun bool, content []byte) (*DataImport, error) {
+	if !IsDataImportEntity(entityName) {
+		return nil, ErrDataImportEntityUnsupported
+	}
+	if format == "" {
+		format = dataimport.Detect(fileName, content)
+	}
+	rows, err := dataimport.Read(content, format)
+	if err != nil {
+		return nil, err
+	}
+	result := &DataImport{Entity: entityName, Format: format, DryRun: dryRun}
+	for _, row := range rows {
+		result.Rows = append(result.Rows, &DataImportRow{Row: row, Errors: map[string]string{}})
+	}
+	return result, nil
+}
+
+// IsDataImportEntity return true when entity can be imported.
+func IsDataImportEntity(entityName string) bool {
+	for _, name := range DataImportEntities {
+		if name == entityName {
+			return true
+		}
+	}
+	return false
+}
+
+// Failed return true when any row is not imported.
+func (u *DataImport) Failed() bool {
+	for _, row := range u.Rows {
+		if row.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+// Count return number of created, updated and failed rows.
+func (u *DataImport) Count() (created int, updated int, failed int) {
+	for _, row := range u.Rows {
+		switch {
+		case row.Failed():
+			failed++
+		case row.Action == DataImportActionCreate:
+			created++
+		case row.Action == DataImportActionUpdate:
+			updated++
+		}
+	}
+	return created, updated, failed
+}
+
+// Failed return true when row has errors.
+func (r *DataImportRow) Failed() bool {
+	return len(r.Errors) > 0 || len(r.Validation) > 0
+}
+
+// Fail will add error of field, message is a key of translation.
+func (r *DataImportRow) Fail(field string, err error) {
+	r.Errors[field] = err.Error()
+}
+
+// FailAll will add errors of fields, messages are keys of translation.
+func (r *DataImportRow) FailAll(errDesc map[string]string) {
+	for field, message := range errDesc {
+		r.Errors[field] = message
+	}
+}
+
+// Validate will add validation errors.
+func (r *DataImportRow) Validate(validation []response.ErrorForm) {
+	r.Validation = append(r.Validation, validation...)
+}
+
+// List return values of field separated by comma, empty values are skipped.
+func (r *DataImportRow) List(name string) []string {
+	var values []string
+	for _, value := range strings.Split(r.Value(name), DataImportListSeparator) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Sity will return sity of row.
+func (r *DataImportRow) Sity() *Sity {
+	sity := &Sity{
+		Name:      r.Value("name"),
+		Region:    r.Value("region"),
+		Latitude:  r.Value("latitude"),
+		Longitude: r.Value("longitude"),
+	}
+	sity.Prepare()
+	r.Key = sity.Name
+	if sity.Region != "" {
+		r.Key += ", " + sity.Region
+	}
+	return sity
+}
+
+// Vehicle will return vehicle of row, numbers are validated before they are read.
+func (r *DataImportRow) Vehicle() *Vehicle {
+	validation := validator.New()
+	validation.
+		Set("number_of_seats", r.Value("number_of_seats"), validation.AddRule().IsInt().Apply()).
+		Set("luggage_weight", r.Value("luggage_weight"), validation.AddRule().IsFloat().Apply()).
+		Set("luggage_volume", r.Value("luggage_volume"), validation.AddRule().IsFloat().Apply())
+	r.Validate(validation.Validate())
+
+	vehicle := &Vehicle{
+		RegCode:       r.Value("reg_code"),
+		Model:         r.Value("model"),
+		NumberOfSeats: r.int("number_of_seats"),
+		Class:         r.Value("class"),
+		LuggageWeight: r.float("luggage_weight"),
+		LuggageVolume: r.float("luggage_volume"),
+	}
+	vehicle.Prepare()
+	r.Key = vehicle.RegCode
+	return vehicle
+}
+
+// Driver will return driver of row, user and vehicles of driver are referenced by email and registration codes.
+func (r *DataImportRow) Driver() *Driver {
+	validation := validator.New()
+	validation.Set("user_email", r.Value("user_email"), validation.AddRule().IsEmail().Apply())
+	r.Validate(validation.Validate())
+
+	driver := &Driver{Name: r.Value("name")}
+	driver.Prepare()
+	r.Key = driver.Name
+	return driver
+}
+
+// Route will return route of row, sities are referenced by name and region.
+func (r *DataImportRow) Route() *Route {
+	validation := validator.New()
+	validation.
+		Set("from", r.Value("from"), validation.AddRule().Required().Apply()).
+		Set("to", r.Value("to"), validation.AddRule().Required().Apply()).
+		Set("distance", r.Value("distance"), validation.AddRule().IsInt().Apply()).
+		Set("distance_time", r.Value("distance_time"), validation.AddRule().IsInt().Apply())
+	r.Validate(validation.Validate())
+
+	route := &Route{
+		Distance:     r.int("distance"),
+		DistanceTime: r.int("distance_time"),
+	}
+	r.Key = r.Value("from") + " - " + r.Value("to")
+	return route
+}
+
+// Trip will return trip of row, route is referenced by sities, vehicle by registration code, driver by name
+// and regularity by type.
+func (r *DataImportRow) Trip() *Trip {
+	validation := validator.New()
+	validation.
+		Set("from", r.Value("from"), validation.AddRule().Required().Apply()).
+		Set("to", r.Value("to"), validation.AddRule().Required().Apply()).
+		Set("vehicle", r.Value("vehicle"), validation.AddRule().Required().Apply()).
+		Set(
+			"departure_time",
+			r.Value("departure_time"),
+			validation.AddRule().Required().IsTime(DataImportTimeLayout).Apply(),
+		).
+		Set(
+			"arrival_time",
+			r.Value("arrival_time"),
+			validation.AddRule().Required().IsTime(DataImportTimeLayout).Apply(),
+		)
+	r.Validate(validation.Validate())
+
+	trip := &Trip{
+		DepartureTime: r.time("departure_time"),
+		ArravialTive:  r.time("arrival_time"),
+	}
+	r.Key = r.Value("vehicle") + " " + r.Value("departure_time")
+	return trip
+}
+
+// int return value of field as integer, invalid value is zero.
+func (r *DataImportRow) int(name string) int {
+	value, _ := strconv.Atoi(r.Value(name))
+	return value
+}
+
+// float return value of field as float, invalid value is zero.
+func (r *DataImportRow) float(name string) float64 {
+	value, _ := strconv.ParseFloat(r.Value(name), 64)
+	return value
+}
+
+// time return value of field as local time, invalid value is zero.
+func (r *DataImportRow) time(name string) time.Time {
+	value, _ := time.ParseInLocation(DataImportTimeLayout, r.Value(name), time.Local)
+	return value
+}
+
+// DetailDataImport will return formatted import with results of rows,
+// validation errors are translated by translate and merged with errors of references.
+func (u *DataImport) DetailDataImport(translate func([]response.ErrorForm) map[string]string) interface{} {
+	created, updated, failed := u.Count()
+	detail := &DetailDataImport{
+		Entity:    u.Entity,
+		Format:    u.Format,
+		DryRun:    u.DryRun,
+		Committed: u.Committed,
+		Total:     len(u.Rows),
+		Created:   created,
+		Updated:   updated,
+		Failed:    failed,
+		Rows:      make([]*DetailDataImportRow, len(u.Rows)),
+	}
+	for index, row := range u.Rows {
+		errDesc := map[string]string{}
+		for field, message := range translate(row.Validation) {
+			errDesc[field] = message
+		}
+		for field, message := range row.Errors {
+			errDesc[field] = message
+		}
+		action := row.Action
+		if row.Failed() {
+			action = DataImportActionFailed
+		} else {
+			errDesc = nil
+		}
+		detail.Rows[index] = &DetailDataImportRow{
+			Row:    row.Number,
+			Key:    row.Key,
+			Action: action,
+			UUID:   row.UUID,
+			Errors: errDesc,
+		}
+	}
+	return detail
+}
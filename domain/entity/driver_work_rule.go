@@ -0,0 +1,298 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"cargo-rest-api/pkg/worktime"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// DriverWorkRuleReject is an enforcement which rejects trip assignment violating working-time rules.
+	DriverWorkRuleReject = "reject"
+
+	// DriverWorkRuleWarn is an enforcement which saves trip assignment and returns violations as warnings.
+	DriverWorkRuleWarn = "warn"
+
+	// DriverTimesheetDateLayout is a layout of dates of driver timesheet.
+	DriverTimesheetDateLayout = "2006-01-02"
+
+	// DriverTimesheetMaxDays is a maximal length of driver timesheet period.
+	DriverTimesheetMaxDays = 92
+)
+
+// DriverWorkRule represent schema of table driver_work_rules.
+// Table keeps the only row with working-time rules of all drivers, durations are in minutes.
+// Zero limit disables the rule.
+type DriverWorkRule struct {
+	UUID                 string `json:"uuid,omitempty"          gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	Enforcement          string `json:"enforcement"             gorm:"size:10;not null;"`
+	MaxContinuousDriving int    `json:"max_continuous_driving"`
+	MinBreak             int    `json:"min_break"`
+	MaxDailyDriving      int    `json:"max_daily_driving"`
+	ExtendedDailyDriving int    `json:"extended_daily_driving"`
+	ExtendedDaysPerWeek  int    `json:"extended_days_per_week"`
+	MinDailyRest         int    `json:"min_daily_rest"`
+	MaxWeeklyDriving     int    `json:"max_weekly_driving"`
+	MinWeeklyRest        int    `json:"min_weekly_rest"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// DetailDriverWorkRule represent format of detail DriverWorkRule.
+type DetailDriverWorkRule struct {
+	Enforcement          string    `json:"enforcement"`
+	MaxContinuousDriving int       `json:"max_continuous_driving"`
+	MinBreak             int       `json:"min_break"`
+	MaxDailyDriving      int       `json:"max_daily_driving"`
+	ExtendedDailyDriving int       `json:"extended_daily_driving"`
+	ExtendedDaysPerWeek  int       `json:"extended_days_per_week"`
+	MinDailyRest         int       `json:"min_daily_rest"`
+	MaxWeeklyDriving     int       `json:"max_weekly_driving"`
+	MinWeeklyRest        int       `json:"min_weekly_rest"`
+	UpdatedAt            time.Time `json:"updated_at,omitempty"`
+}
+
+// DriverWorkViolation represent breach of working-time rule by trips of driver, durations are in minutes.
+type DriverWorkViolation struct {
+	Rule      string    `json:"rule"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Actual    int       `json:"actual"`
+	Limit     int       `json:"limit"`
+	TripUUIDs []string  `json:"trip_uuids"`
+}
+
+// DriverTimesheetRequest represent period of driver timesheet, dates are inclusive.
+// Current week is used when period is not given.
+type DriverTimesheetRequest struct {
+	DateFrom string `json:"date_from" form:"date_from"`
+	DateTo   string `json:"date_to"   form:"date_to"`
+}
+
+// DriverTimesheet represent driving of driver by days with violations of working-time rules.
+type DriverTimesheet struct {
+	DriverUUID     string                `json:"driver_uuid"`
+	DriverName     string                `json:"driver_name"`
+	DateFrom       string                `json:"date_from"`
+	DateTo         string                `json:"date_to"`
+	TripCount      int                   `json:"trip_count"`
+	DrivingMinutes int                   `json:"driving_minutes"`
+	Days           []DriverTimesheetDay  `json:"days"`
+	Violations     []DriverWorkViolation `json:"violations"`
+}
+
+// DriverTimesheetDay represent driving of driver within a day.
+type DriverTimesheetDay struct {
+	Date           string    `json:"date"`
+	TripCount      int       `json:"trip_count"`
+	DrivingMinutes int       `json:"driving_minutes"`
+	FirstDeparture time.Time `json:"first_departure"`
+	LastArrival    time.Time `json:"last_arrival"`
+	TripUUIDs      []string  `json:"trip_uuids"`
+}
+
+// TableName return name of table.
+func (u *DriverWorkRule) TableName() string {
+	return "driver_work_rules"
+}
+
+// BeforeCreate handle uuid generation.
+func (u *DriverWorkRule) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// DefaultDriverWorkRule return working-time rules of Russian regulation of drivers, they apply until rules are saved.
+func DefaultDriverWorkRule() *DriverWorkRule {
+	return &DriverWorkRule{
+		Enforcement:          DriverWorkRuleReject,
+		MaxContinuousDriving: 270,
+		MinBreak:             45,
+		MaxDailyDriving:      540,
+		ExtendedDailyDriving: 600,
+		ExtendedDaysPerWeek:  2,
+		MinDailyRest:         660,
+		MaxWeeklyDriving:     3360,
+		MinWeeklyRest:        2700,
+	}
+}
+
+// Prepare will prepare submitted data of working-time rules.
+func (u *DriverWorkRule) Prepare() {
+	u.Enforcement = strings.ToLower(strings.TrimSpace(u.Enforcement))
+	u.UpdatedAt = time.Now()
+}
+
+// IsWarning will check whether violations are returned as warnings instead of rejecting trip.
+func (u *DriverWorkRule) IsWarning() bool {
+	return u.Enforcement == DriverWorkRuleWarn
+}
+
+// Rules return limits of working time.
+func (u *DriverWorkRule) Rules() worktime.Rules {
+	return worktime.Rules{
+		MaxContinuousDriving: time.Duration(u.MaxContinuousDriving) * time.Minute,
+		MinBreak:             time.Duration(u.MinBreak) * time.Minute,
+		MaxDailyDriving:      time.Duration(u.MaxDailyDriving) * time.Minute,
+		ExtendedDailyDriving: time.Duration(u.ExtendedDailyDriving) * time.Minute,
+		ExtendedDaysPerWeek:  u.ExtendedDaysPerWeek,
+		MinDailyRest:         time.Duration(u.MinDailyRest) * time.Minute,
+		MaxWeeklyDriving:     time.Duration(u.MaxWeeklyDriving) * time.Minute,
+		MinWeeklyRest:        time.Duration(u.MinWeeklyRest) * time.Minute,
+	}
+}
+
+// Check return violations of working-time rules by trips of driver.
+func (u *DriverWorkRule) Check(trips Trips) []DriverWorkViolation {
+	violations := worktime.Check(u.Rules(), driverShifts(trips), time.Local)
+	result := make([]DriverWorkViolation, len(violations))
+	for i, v := range violations {
+		result[i] = DriverWorkViolation{
+			Rule:      v.Rule,
+			Start:     v.Start,
+			End:       v.End,
+			Actual:    int(v.Actual / time.Minute),
+			Limit:     int(v.Limit / time.Minute),
+			TripUUIDs: v.Shifts,
+		}
+	}
+	return result
+}
+
+// DetailDriverWorkRule will return formatted working-time rules.
+func (u *DriverWorkRule) DetailDriverWorkRule() interface{} {
+	return &DetailDriverWorkRule{
+		Enforcement:          u.Enforcement,
+		MaxContinuousDriving: u.MaxContinuousDriving,
+		MinBreak:             u.MinBreak,
+		MaxDailyDriving:      u.MaxDailyDriving,
+		ExtendedDailyDriving: u.ExtendedDailyDriving,
+		ExtendedDaysPerWeek:  u.ExtendedDaysPerWeek,
+		MinDailyRest:         u.MinDailyRest,
+		MaxWeeklyDriving:     u.MaxWeeklyDriving,
+		MinWeeklyRest:        u.MinWeeklyRest,
+		UpdatedAt:            u.UpdatedAt,
+	}
+}
+
+// ValidateUpdateDriverWorkRule will validate update working-time rules request.
+func (u *DriverWorkRule) ValidateUpdateDriverWorkRule() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("enforcement", u.Enforcement, validation.AddRule().Required().In(
+			DriverWorkRuleReject,
+			DriverWorkRuleWarn,
+		).Apply()).
+		Set("max_continuous_driving", u.MaxContinuousDriving, validation.AddRule().MinValue(0).MaxValue(1440).Apply()).
+		Set("min_break", u.MinBreak, validation.AddRule().MinValue(0).MaxValue(1440).Apply()).
+		Set("max_daily_driving", u.MaxDailyDriving, validation.AddRule().MinValue(0).MaxValue(1440).Apply()).
+		Set("extended_daily_driving", u.ExtendedDailyDriving, validation.AddRule().MinValue(0).MaxValue(1440).Apply()).
+		Set("extended_days_per_week", u.ExtendedDaysPerWeek, validation.AddRule().MinValue(0).MaxValue(7).Apply()).
+		Set("min_daily_rest", u.MinDailyRest, validation.AddRule().MinValue(0).MaxValue(1440).Apply()).
+		Set("max_weekly_driving", u.MaxWeeklyDriving, validation.AddRule().MinValue(0).MaxValue(10080).Apply()).
+		Set("min_weekly_rest", u.MinWeeklyRest, validation.AddRule().MinValue(0).MaxValue(10080).Apply())
+	return validation.Validate()
+}
+
+// Prepare will prepare submitted data of driver timesheet request.
+func (u *DriverTimesheetRequest) Prepare() {
+	u.DateFrom = strings.TrimSpace(u.DateFrom)
+	u.DateTo = strings.TrimSpace(u.DateTo)
+}
+
+// Period return period of timesheet, end of period is exclusive.
+func (u *DriverTimesheetRequest) Period() (time.Time, time.Time) {
+	from := worktime.StartOfWeek(time.Now(), time.Local)
+	if u.DateFrom != "" {
+		from, _ = time.ParseInLocation(DriverTimesheetDateLayout, u.DateFrom, time.Local)
+	}
+	to := from.AddDate(0, 0, 7)
+	if u.DateTo != "" {
+		to, _ = time.ParseInLocation(DriverTimesheetDateLayout, u.DateTo, time.Local)
+		to = to.AddDate(0, 0, 1)
+	}
+	return from, to
+}
+
+// ValidateDriverTimesheetRequest will validate driver timesheet request.
+func (u *DriverTimesheetRequest) ValidateDriverTimesheetRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("date_from", u.DateFrom, validation.AddRule().IsDate(DriverTimesheetDateLayout).Apply()).
+		Set("date_to", u.DateTo, validation.AddRule().IsDate(DriverTimesheetDateLayout).Apply())
+	return validation.Validate()
+}
+
+// NewDriverTimesheet will return timesheet of driver for period, trips around the period are used to find violations.
+func NewDriverTimesheet(
+	driver *Driver,
+	rule *DriverWorkRule,
+	trips Trips,
+	from time.Time,
+	to time.Time,
+) *DriverTimesheet {
+	tripsByUUID := map[string]*Trip{}
+	for _, trip := range trips {
+		tripsByUUID[trip.UUID] = trip
+	}
+
+	timesheet := &DriverTimesheet{
+		DriverUUID: driver.UUID,
+		DriverName: driver.Name,
+		DateFrom:   from.Format(DriverTimesheetDateLayout),
+		DateTo:     to.AddDate(0, 0, -1).Format(DriverTimesheetDateLayout),
+		Days:       []DriverTimesheetDay{},
+		Violations: []DriverWorkViolation{},
+	}
+	counted := map[string]bool{}
+	for _, d := range worktime.Days(driverShifts(trips), time.Local) {
+		if d.Date.Before(from) || !d.Date.Before(to) {
+			continue
+		}
+		timesheetDay := DriverTimesheetDay{
+			Date:           d.Date.Format(DriverTimesheetDateLayout),
+			TripCount:      len(d.Shifts),
+			DrivingMinutes: int(d.Driving / time.Minute),
+			TripUUIDs:      d.Shifts,
+		}
+		for i, tripUUID := range d.Shifts {
+			trip := tripsByUUID[tripUUID]
+			if i == 0 || trip.DepartureTime.Before(timesheetDay.FirstDeparture) {
+				timesheetDay.FirstDeparture = trip.DepartureTime
+			}
+			if trip.ArravialTive.After(timesheetDay.LastArrival) {
+				timesheetDay.LastArrival = trip.ArravialTive
+			}
+			if !counted[tripUUID] {
+				counted[tripUUID] = true
+				timesheet.TripCount++
+			}
+		}
+		timesheet.DrivingMinutes += timesheetDay.DrivingMinutes
+		timesheet.Days = append(timesheet.Days, timesheetDay)
+	}
+	for _, violation := range rule.Check(trips) {
+		if violation.End.After(from) && violation.Start.Before(to) {
+			timesheet.Violations = append(timesheet.Violations, violation)
+		}
+	}
+	return timesheet
+}
+
+// driverShifts return trips as periods of driving.
+func driverShifts(trips Trips) []worktime.Shift {
+	shifts := make([]worktime.Shift, 0, len(trips))
+	for _, trip := range trips {
+		shifts = append(shifts, worktime.Shift{ID: trip.UUID, Start: trip.DepartureTime, End: trip.ArravialTive})
+	}
+	return shifts
+}
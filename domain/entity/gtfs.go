@@ -0,0 +1,323 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/gtfs"
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// GTFSAgencyID is an id of agency of exported routes.
+	GTFSAgencyID = "cargo-rest-api"
+
+	// GTFSDateLayout is a layout of dates of GTFS export request.
+	GTFSDateLayout = "2006-01-02"
+
+	// GTFSFileName is a name of exported GTFS feed.
+	GTFSFileName = "gtfs.zip"
+
+	// GTFSExportMaxPeriod is a max number of days of exported trips.
+	GTFSExportMaxPeriod = 366
+)
+
+var (
+	// ErrGTFSEmpty is returned when there are no trips which can be exported.
+	ErrGTFSEmpty = errors.New("there are no trips to export")
+
+	// ErrGTFSInvalidPeriod is returned when the last date is before the first one or period is too long.
+	ErrGTFSInvalidPeriod = errors.New("invalid period of GTFS export")
+)
+
+// GTFSAgency represent agency operating exported trips, timezone is a timezone of departure and arrival times.
+type GTFSAgency struct {
+	Name     string
+	URL      string
+	Timezone string
+	Lang     string
+	Phone    string
+}
+
+// GTFSRequest represent request of GTFS export, trips departing from the first date up to the last date are exported.
+type GTFSRequest struct {
+	From string `json:"from" form:"from"`
+	To   string `json:"to"   form:"to"`
+}
+
+// GTFSExport represent static GTFS feed of trips departing in period, To is an exclusive end of period.
+// Trips of sities without coordinates are skipped.
+type GTFSExport struct {
+	Agency       GTFSAgency
+	From         time.Time
+	To           time.Time
+	GeneratedAt  time.Time
+	StopCount    int
+	RouteCount   int
+	TripCount    int
+	ServiceCount int
+	SkippedCount int
+	Content      []byte
+}
+
+// gtfsPattern represent trips of route departing at the same time of day with the same duration,
+// pattern is exported as one GTFS trip running on dates of trips.
+type gtfsPattern struct {
+	route     *Route
+	departure int
+	arrival   int
+	dates     map[string]time.Time
+}
+
+// ValidateGTFSRequest will validate dates of request.
+func (u *GTFSRequest) ValidateGTFSRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("from", u.From, validation.AddRule().IsTime(GTFSDateLayout).Apply()).
+		Set("to", u.To, validation.AddRule().IsTime(GTFSDateLayout).Apply())
+	return validation.Validate()
+}
+
+// NewGTFSExport return export of trips requested in timezone of agency, export starts today when from is empty
+// and lasts GTFSExportMaxPeriod days when to is empty.
+func NewGTFSExport(agency GTFSAgency, request *GTFSRequest, now time.Time) (*GTFSExport, error) {
+	location, err := time.LoadLocation(agency.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	now = now.In(location)
+	export := &GTFSExport{
+		Agency:      agency,
+		From:        time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location),
+		GeneratedAt: now.Truncate(time.Second),
+	}
+	if request.From != "" {
+		if export.From, err = time.ParseInLocation(GTFSDateLayout, request.From, location); err != nil {
+			return nil, err
+		}
+	}
+	if request.To != "" {
+		to, err := time.ParseInLocation(GTFSDateLayout, request.To, location)
+		if err != nil {
+			return nil, err
+		}
+		export.To = to.AddDate(0, 0, 1)
+	} else {
+		export.To = export.From.AddDate(0, 0, GTFSExportMaxPeriod)
+	}
+	if !export.To.After(export.From) || export.To.After(export.From.AddDate(0, 0, GTFSExportMaxPeriod)) {
+		return nil, ErrGTFSInvalidPeriod
+	}
+	return export, nil
+}
+
+// GTFS will write trips as GTFS feed: sities are stops, routes are routes, trips of route departing at the same time
+// of day are one trip, dates of trips are calendar of weekdays with removed dates or list of added dates,
+// whichever is shorter. Trips need route with sities preloaded.
+func (u *GTFSExport) GTFS(trips []*Trip) error {
+	location, err := time.LoadLocation(u.Agency.Timezone)
+	if err != nil {
+		return err
+	}
+	patterns := map[string]*gtfsPattern{}
+	for _, trip := range trips {
+		_, okFrom := gtfsStop(&trip.Route.SityFrom)
+		_, okTo := gtfsStop(&trip.Route.SityTo)
+		if !okFrom || !okTo || !trip.ArravialTive.After(trip.DepartureTime) {
+			u.SkippedCount++
+			continue
+		}
+		departure := trip.DepartureTime.In(location)
+		date := time.Date(departure.Year(), departure.Month(), departure.Day(), 0, 0, 0, 0, time.UTC)
+		seconds := departure.Hour()*3600 + departure.Minute()*60 + departure.Second()
+		duration := int(trip.ArravialTive.Sub(trip.DepartureTime).Seconds())
+		key := fmt.Sprintf("%s-%02d%02d%02d-%d", trip.RouteUUID, seconds/3600, seconds/60%60, seconds%60, duration)
+		pattern, ok := patterns[key]
+		if !ok {
+			pattern = &gtfsPattern{
+				route:     &trip.Route,
+				departure: seconds,
+				arrival:   seconds + duration,
+				dates:     map[string]time.Time{},
+			}
+			patterns[key] = pattern
+		}
+		pattern.dates[date.Format(gtfs.DateLayout)] = date
+	}
+	if len(patterns) == 0 {
+		return ErrGTFSEmpty
+	}
+
+	feed := &gtfs.Feed{
+		Agencies: []gtfs.Agency{{
+			ID:       GTFSAgencyID,
+			Name:     u.Agency.Name,
+			URL:      u.Agency.URL,
+			Timezone: u.Agency.Timezone,
+			Lang:     u.Agency.Lang,
+			Phone:    u.Agency.Phone,
+		}},
+		FeedInfo: &gtfs.FeedInfo{
+			PublisherName: u.Agency.Name,
+			PublisherURL:  u.Agency.URL,
+			Lang:          u.Agency.Lang,
+			Version:       u.GeneratedAt.Format(time.RFC3339),
+		},
+	}
+	stops := map[string]bool{}
+	routes := map[string]bool{}
+	services := map[string]bool{}
+	keys := make([]string, 0, len(patterns))
+	for key := range patterns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		pattern := patterns[key]
+		route := pattern.route
+		for _, sity := range []*Sity{&route.SityFrom, &route.SityTo} {
+			if stop, _ := gtfsStop(sity); !stops[stop.ID] {
+				stops[stop.ID] = true
+				feed.Stops = append(feed.Stops, stop)
+			}
+		}
+		if !routes[route.UUID] {
+			routes[route.UUID] = true
+			feed.Routes = append(feed.Routes, gtfs.Route{
+				ID:       route.UUID,
+				AgencyID: GTFSAgencyID,
+				LongName: route.SityFrom.Name + " - " + route.SityTo.Name,
+				Type:     gtfs.RouteTypeBus,
+			})
+		}
+		serviceID := pattern.serviceID()
+		if !services[serviceID] {
+			services[serviceID] = true
+			pattern.calendar(feed, serviceID)
+		}
+		feed.Trips = append(feed.Trips, gtfs.Trip{
+			ID:        key,
+			RouteID:   route.UUID,
+			ServiceID: serviceID,
+			Headsign:  route.SityTo.Name,
+		})
+		feed.StopTimes = append(feed.StopTimes,
+			gtfs.StopTime{
+				TripID:        key,
+				StopID:        route.SityFrom.UUID,
+				Sequence:      1,
+				ArrivalTime:   pattern.departure,
+				DepartureTime: pattern.departure,
+				Timepoint:     gtfs.TimepointExact,
+			},
+			gtfs.StopTime{
+				TripID:        key,
+				StopID:        route.SityTo.UUID,
+				Sequence:      2,
+				ArrivalTime:   pattern.arrival,
+				DepartureTime: pattern.arrival,
+				Timepoint:     gtfs.TimepointExact,
+			},
+		)
+		for _, date := range pattern.dates {
+			if feed.FeedInfo.StartDate.IsZero() || date.Before(feed.FeedInfo.StartDate) {
+				feed.FeedInfo.StartDate = date
+			}
+			if date.After(feed.FeedInfo.EndDate) {
+				feed.FeedInfo.EndDate = date
+			}
+		}
+	}
+
+	content, err := gtfs.Marshal(feed)
+	if err != nil {
+		return err
+	}
+	u.StopCount = len(feed.Stops)
+	u.RouteCount = len(feed.Routes)
+	u.TripCount = len(feed.Trips)
+	u.ServiceCount = len(services)
+	u.Content = content
+	return nil
+}
+
+// sortedDates return dates of pattern in ascending order.
+func (p *gtfsPattern) sortedDates() []time.Time {
+	dates := make([]time.Time, 0, len(p.dates))
+	for _, date := range p.dates {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	return dates
+}
+
+// serviceID return id of service running on dates of pattern, patterns of the same dates share service.
+func (p *gtfsPattern) serviceID() string {
+	var keys []string
+	for _, date := range p.sortedDates() {
+		keys = append(keys, date.Format(gtfs.DateLayout))
+	}
+	return fmt.Sprintf("%x", sha1.Sum([]byte(strings.Join(keys, ","))))[:12]
+}
+
+// calendar will add service of pattern to feed: weekdays of dates between the first and the last date
+// with removed missing dates, or added dates when there are fewer of them than removed ones.
+func (p *gtfsPattern) calendar(feed *gtfs.Feed, serviceID string) {
+	dates := p.sortedDates()
+	var days [7]bool
+	for _, date := range dates {
+		days[date.Weekday()] = true
+	}
+	start, end := dates[0], dates[len(dates)-1]
+	var removed []time.Time
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		if _, ok := p.dates[date.Format(gtfs.DateLayout)]; days[date.Weekday()] && !ok {
+			removed = append(removed, date)
+		}
+	}
+
+	if len(dates) > 1 && len(removed) < len(dates) {
+		feed.Calendars = append(feed.Calendars, gtfs.Calendar{
+			ServiceID: serviceID,
+			Days:      days,
+			StartDate: start,
+			EndDate:   end,
+		})
+		for _, date := range removed {
+			feed.CalendarDates = append(feed.CalendarDates, gtfs.CalendarDate{
+				ServiceID:     serviceID,
+				Date:          date,
+				ExceptionType: gtfs.ExceptionRemoved,
+			})
+		}
+		return
+	}
+	for _, date := range dates {
+		feed.CalendarDates = append(feed.CalendarDates, gtfs.CalendarDate{
+			ServiceID:     serviceID,
+			Date:          date,
+			ExceptionType: gtfs.ExceptionAdded,
+		})
+	}
+}
+
+// gtfsStop return sity as stop, false is returned when sity has no valid coordinates.
+func gtfsStop(sity *Sity) (gtfs.Stop, bool) {
+	latitude, errLatitude := strconv.ParseFloat(strings.TrimSpace(sity.Latitude), 64)
+	longitude, errLongitude := strconv.ParseFloat(strings.TrimSpace(sity.Longitude), 64)
+	stop := gtfs.Stop{
+		ID:          sity.UUID,
+		Name:        sity.Name,
+		Description: sity.Region,
+		Latitude:    latitude,
+		Longitude:   longitude,
+	}
+	stop.Located = errLatitude == nil && errLongitude == nil && sity.UUID != "" && sity.Name != "" &&
+		latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+	return stop, stop.Located
+}
@@ -0,0 +1,377 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/gtfs"
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/routing"
+	"cargo-rest-api/pkg/validator"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// GTFSImportMaxFileSize is a max size of imported GTFS archive in megabytes.
+	GTFSImportMaxFileSize = 50
+
+	// GTFSImportDefaultHorizon is a number of days of trips imported when the last date is not set.
+	GTFSImportDefaultHorizon = 30
+
+	// GTFSImportMaxHorizon is a max number of days of imported trips.
+	GTFSImportMaxHorizon = 366
+
+	// GTFSImportDefaultRadius is a default distance in kilometers within which stop matches the nearest sity.
+	GTFSImportDefaultRadius = 10.0
+
+	// GTFSImportMatchName is a match of stop to sity of the same name.
+	GTFSImportMatchName = "name"
+
+	// GTFSImportMatchCoordinates is a match of stop to the nearest sity within radius.
+	GTFSImportMatchCoordinates = "coordinates"
+
+	// GTFSImportMatchCreated is a match of stop to sity created by import.
+	GTFSImportMatchCreated = "created"
+
+	// GTFSImportMatchNone is a stop which is not matched, trips of stop are not imported.
+	GTFSImportMatchNone = "unmatched"
+)
+
+// ErrGTFSImportInvalidHorizon is returned when the last date is before the first one or period is too long.
+var ErrGTFSImportInvalidHorizon = errors.New("invalid horizon of GTFS import")
+
+// GTFSImportRequest represent request of GTFS import, trips running from the first date up to the last date
+// are imported.
+type GTFSImportRequest struct {
+	From         string `json:"from"          form:"from"`
+	To           string `json:"to"            form:"to"`
+	Radius       string `json:"radius"        form:"radius"`
+	CreateSities bool   `json:"create_sities" form:"create_sities"`
+	DryRun       bool   `json:"dry_run"       form:"dry_run"`
+}
+
+// GTFSImport represent import of GTFS feed: stops are matched to sities, pairs of the first and the last stop of trip
+// are routes and trips are created for every date of service in horizon. Import is committed when it is not dry run.
+type GTFSImport struct {
+	Location     *time.Location
+	From         time.Time
+	To           time.Time
+	Radius       float64
+	CreateSities bool
+	DryRun       bool
+	Committed    bool
+	Stops        []*GTFSImportStop
+	Trips        []*GTFSImportTrip
+
+	// SkippedCount is a number of trips of feed without times of the first and the last stop.
+	SkippedCount  int
+	RouteCreated  int
+	RouteExisting int
+	TripCreated   int
+	TripExisting  int
+	TripUnmatched int
+}
+
+// GTFSImportStop represent stop of imported trips and its match to sity, nearest sity is kept for unmatched stop.
+type GTFSImportStop struct {
+	gtfs.Stop
+	TripCount int
+	Match     string
+	SityUUID  string
+	SityName  string
+	Distance  float64
+}
+
+// GTFSImportTrip represent trip of feed running on date of service.
+type GTFSImportTrip struct {
+	TripID        string
+	From          *GTFSImportStop
+	To            *GTFSImportStop
+	DepartureTime time.Time
+	ArrivalTime   time.Time
+}
+
+// DetailGTFSImport represent format of detail GTFSImport.
+type DetailGTFSImport struct {
+	DryRun          bool                    `json:"dry_run"`
+	Committed       bool                    `json:"committed"`
+	From            string                  `json:"from"`
+	To              string                  `json:"to"`
+	StopCount       int                     `json:"stop_count"`
+	MatchedStops    int                     `json:"matched_stops"`
+	CreatedSities   int                     `json:"created_sities"`
+	UnmatchedStops  int                     `json:"unmatched_stops"`
+	RouteCreated    int                     `json:"route_created"`
+	RouteExisting   int                     `json:"route_existing"`
+	TripCreated     int                     `json:"trip_created"`
+	TripExisting    int                     `json:"trip_existing"`
+	TripUnmatched   int                     `json:"trip_unmatched"`
+	TripSkipped     int                     `json:"trip_skipped"`
+	Stops           []*DetailGTFSImportStop `json:"stops"`
+	UnmatchedReport []*DetailGTFSImportStop `json:"unmatched_report"`
+}
+
+// DetailGTFSImportStop represent format of detail GTFSImportStop, sity of unmatched stop is the nearest sity.
+type DetailGTFSImportStop struct {
+	StopID    string  `json:"stop_id"`
+	StopName  string  `json:"stop_name"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	TripCount int     `json:"trip_count"`
+	Match     string  `json:"match"`
+	SityUUID  string  `json:"sity_uuid,omitempty"`
+	SityName  string  `json:"sity_name,omitempty"`
+	Distance  float64 `json:"distance,omitempty"`
+}
+
+// ValidateGTFSImportRequest will validate dates and radius of request.
+func (u *GTFSImportRequest) ValidateGTFSImportRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("from", u.From, validation.AddRule().IsTime(GTFSDateLayout).Apply()).
+		Set("to", u.To, validation.AddRule().IsTime(GTFSDateLayout).Apply()).
+		Set("radius", u.Radius, validation.AddRule().IsFloat().Apply())
+	return validation.Validate()
+}
+
+// NewGTFSImport will read feed and plan trips running in horizon, dates are in timezone of agency of feed.
+// Horizon starts today when the first date is not set and lasts GTFSImportDefaultHorizon days when the last is not set.
+func NewGTFSImport(request *GTFSImportRequest, content []byte, now time.Time) (*GTFSImport, error) {
+	feed, err := gtfs.Read(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(feed.Agencies) == 0 {
+		return nil, &gtfs.Error{File: "agency.txt", Message: "file has no rows"}
+	}
+	location, err := time.LoadLocation(feed.Agencies[0].Timezone)
+	if err != nil {
+		return nil, &gtfs.Error{File: "agency.txt", Row: 1, Field: "agency_timezone", Message: "is invalid"}
+	}
+
+	now = now.In(location)
+	result := &GTFSImport{
+		Location:     location,
+		From:         time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location),
+		Radius:       GTFSImportDefaultRadius,
+		CreateSities: request.CreateSities,
+		DryRun:       request.DryRun,
+	}
+	if request.From != "" {
+		if result.From, err = time.ParseInLocation(GTFSDateLayout, request.From, location); err != nil {
+			return nil, err
+		}
+	}
+	result.To = result.From.AddDate(0, 0, GTFSImportDefaultHorizon-1)
+	if request.To != "" {
+		if result.To, err = time.ParseInLocation(GTFSDateLayout, request.To, location); err != nil {
+			return nil, err
+		}
+	}
+	if result.To.Before(result.From) || !result.To.Before(result.From.AddDate(0, 0, GTFSImportMaxHorizon)) {
+		return nil, ErrGTFSImportInvalidHorizon
+	}
+	if request.Radius != "" {
+		if result.Radius, err = strconv.ParseFloat(request.Radius, 64); err != nil {
+			return nil, err
+		}
+	}
+	result.plan(feed)
+	return result, nil
+}
+
+// plan will add trips of feed for every date of their service, trip departs from the first stop
+// and arrives to the last stop. Platforms without coordinates are replaced by their stations.
+func (u *GTFSImport) plan(feed *gtfs.Feed) {
+	stops := map[string]gtfs.Stop{}
+	for _, stop := range feed.Stops {
+		stops[stop.ID] = stop
+	}
+	resolved := map[string]*GTFSImportStop{}
+	resolve := func(stopID string) *GTFSImportStop {
+		stop, ok := stops[stopID]
+		if !ok {
+			return nil
+		}
+		if parent, ok := stops[stop.ParentStation]; ok && !stop.Located {
+			stop = parent
+		}
+		if resolved[stop.ID] == nil {
+			resolved[stop.ID] = &GTFSImportStop{Stop: stop, Match: GTFSImportMatchNone}
+		}
+		return resolved[stop.ID]
+	}
+
+	stopTimes := map[string][]gtfs.StopTime{}
+	for _, stopTime := range feed.StopTimes {
+		stopTimes[stopTime.TripID] = append(stopTimes[stopTime.TripID], stopTime)
+	}
+	dates := feed.ServiceDates(u.From, u.To)
+	for _, trip := range feed.Trips {
+		times := stopTimes[trip.ID]
+		if len(times) < 2 {
+			u.SkippedCount++
+			continue
+		}
+		first, last := times[0], times[len(times)-1]
+		departure, arrival := first.DepartureTime, last.ArrivalTime
+		if departure == gtfs.NoTime {
+			departure = first.ArrivalTime
+		}
+		if arrival == gtfs.NoTime {
+			arrival = last.DepartureTime
+		}
+		from, to := resolve(first.StopID), resolve(last.StopID)
+		if departure == gtfs.NoTime || arrival <= departure || from == nil || to == nil {
+			u.SkippedCount++
+			continue
+		}
+		for _, date := range dates[trip.ServiceID] {
+			// Times of service day are measured from noon minus 12 hours, which differs from midnight on days of
+			// daylight saving time change.
+			noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, u.Location).Add(-12 * time.Hour)
+			u.Trips = append(u.Trips, &GTFSImportTrip{
+				TripID:        trip.ID,
+				From:          from,
+				To:            to,
+				DepartureTime: noon.Add(time.Duration(departure) * time.Second),
+				ArrivalTime:   noon.Add(time.Duration(arrival) * time.Second),
+			})
+			from.TripCount++
+			to.TripCount++
+		}
+	}
+
+	for _, stop := range resolved {
+		if stop.TripCount > 0 {
+			u.Stops = append(u.Stops, stop)
+		}
+	}
+	sort.Slice(u.Stops, func(i, j int) bool { return u.Stops[i].ID < u.Stops[j].ID })
+	sort.SliceStable(u.Trips, func(i, j int) bool { return u.Trips[i].DepartureTime.Before(u.Trips[j].DepartureTime) })
+}
+
+// MatchSity will match stop to sity of the same name, the nearest one when there are several of them,
+// or to the nearest sity within radius. The nearest sity is kept for unmatched stop to reconcile it.
+func (s *GTFSImportStop) MatchSity(sities []*Sity, radius float64) {
+	var named, nearest *Sity
+	namedDistance, nearestDistance := math.MaxFloat64, math.MaxFloat64
+	for _, sity := range sities {
+		distance := math.MaxFloat64
+		if point, ok := sityPoint(sity); ok && s.Located {
+			distance = routing.Distance(routing.Point{Latitude: s.Latitude, Longitude: s.Longitude}, point)
+		}
+		if strings.EqualFold(strings.TrimSpace(sity.Name), s.Name) && (named == nil || distance < namedDistance) {
+			named, namedDistance = sity, distance
+		}
+		if distance < nearestDistance {
+			nearest, nearestDistance = sity, distance
+		}
+	}
+	switch {
+	case named != nil:
+		s.matched(GTFSImportMatchName, named, namedDistance)
+	case nearest != nil && nearestDistance <= radius:
+		s.matched(GTFSImportMatchCoordinates, nearest, nearestDistance)
+	case nearest != nil:
+		s.Match = GTFSImportMatchNone
+		s.SityUUID, s.SityName, s.Distance = nearest.UUID, nearest.Name, roundDistance(nearestDistance)
+	}
+}
+
+// Sity return new sity of stop.
+func (s *GTFSImportStop) Sity() *Sity {
+	sity := &Sity{Name: s.Name}
+	if s.Located {
+		sity.Latitude = strconv.FormatFloat(s.Latitude, 'f', 6, 64)
+		sity.Longitude = strconv.FormatFloat(s.Longitude, 'f', 6, 64)
+	}
+	sity.Prepare()
+	return sity
+}
+
+// Created will match stop to sity created by import.
+func (s *GTFSImportStop) Created(sity *Sity) {
+	s.matched(GTFSImportMatchCreated, sity, 0)
+}
+
+// Matched return true when stop is matched to sity.
+func (s *GTFSImportStop) Matched() bool {
+	return s.Match != GTFSImportMatchNone
+}
+
+// matched will set sity of stop.
+func (s *GTFSImportStop) matched(match string, sity *Sity, distance float64) {
+	s.Match, s.SityUUID, s.SityName = match, sity.UUID, sity.Name
+	s.Distance = 0
+	if distance != math.MaxFloat64 {
+		s.Distance = roundDistance(distance)
+	}
+}
+
+// Route return route of trip between sities of stops, distance is great-circle distance in meters
+// and distance time is duration of trip in minutes.
+func (t *GTFSImportTrip) Route() *Route {
+	route := &Route{
+		FromUUID:     t.From.SityUUID,
+		ToUUID:       t.To.SityUUID,
+		DistanceTime: int(t.ArrivalTime.Sub(t.DepartureTime).Minutes()),
+	}
+	if t.From.Located && t.To.Located {
+		route.Distance = int(math.Round(1000 * routing.Distance(
+			routing.Point{Latitude: t.From.Latitude, Longitude: t.From.Longitude},
+			routing.Point{Latitude: t.To.Latitude, Longitude: t.To.Longitude},
+		)))
+	}
+	return route
+}
+
+// DetailGTFSImport will return report of import, unmatched stops are reported with the nearest sity.
+func (u *GTFSImport) DetailGTFSImport() interface{} {
+	detail := &DetailGTFSImport{
+		DryRun:        u.DryRun,
+		Committed:     u.Committed,
+		From:          u.From.Format(GTFSDateLayout),
+		To:            u.To.Format(GTFSDateLayout),
+		StopCount:     len(u.Stops),
+		RouteCreated:  u.RouteCreated,
+		RouteExisting: u.RouteExisting,
+		TripCreated:   u.TripCreated,
+		TripExisting:  u.TripExisting,
+		TripUnmatched: u.TripUnmatched,
+		TripSkipped:   u.SkippedCount,
+		Stops:         make([]*DetailGTFSImportStop, 0, len(u.Stops)),
+	}
+	for _, stop := range u.Stops {
+		row := &DetailGTFSImportStop{
+			StopID:    stop.ID,
+			StopName:  stop.Name,
+			Latitude:  stop.Latitude,
+			Longitude: stop.Longitude,
+			TripCount: stop.TripCount,
+			Match:     stop.Match,
+			SityUUID:  stop.SityUUID,
+			SityName:  stop.SityName,
+			Distance:  stop.Distance,
+		}
+		detail.Stops = append(detail.Stops, row)
+		switch stop.Match {
+		case GTFSImportMatchNone:
+			detail.UnmatchedStops++
+			detail.UnmatchedReport = append(detail.UnmatchedReport, row)
+		case GTFSImportMatchCreated:
+			detail.CreatedSities++
+		default:
+			detail.MatchedStops++
+		}
+	}
+	return detail
+}
+
+// sityPoint return coordinates of sity, false is returned when sity has no valid coordinates.
+func sityPoint(sity *Sity) (routing.Point, bool) {
+	stop, ok := gtfsStop(sity)
+	return routing.Point{Latitude: stop.Latitude, Longitude: stop.Longitude}, ok
+}
@@ -23,6 +23,9 @@ type Order struct {
 	StatusUUID string          `json:"status_uuid"`
 	Status     OrderStatusType `json:"status"         gorm:"foreignKey:StatusUUID"`
 
+	BaggageWeight float64 `json:"baggage_weight" gorm:"default:0"`
+	BaggageVolume float64 `json:"baggage_volume" gorm:"default:0"`
+
 	ExternalUUID string `json:"external_uuid"`
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
@@ -68,6 +71,9 @@ type OrderFieldsForDetail struct {
 	Seat       string    `json:"seat"`
 	StatusUUID string    `json:"status_uuid,omitempty"`
 
+	BaggageWeight float64 `json:"baggage_weight"`
+	BaggageVolume float64 `json:"baggage_volume"`
+
 	ExternalUUID string `json:"external_uuid,omitempty"`
 }
 
@@ -113,6 +119,24 @@ func (u *Order) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// TotalBaggageWeight will return summary baggage weight (kg) of multiple order.
+func (order Orders) TotalBaggageWeight() float64 {
+	var weight float64
+	for _, order := range order {
+		weight += order.BaggageWeight
+	}
+	return weight
+}
+
+// TotalBaggageVolume will return summary baggage volume (m³) of multiple order.
+func (order Orders) TotalBaggageVolume() float64 {
+	var volume float64
+	for _, order := range order {
+		volume += order.BaggageVolume
+	}
+	return volume
+}
+
 // DetailOrders will return formatted order detail of multiple order.
 func (order Orders) DetailOrders() []interface{} {
 	result := make([]interface{}, len(order))
@@ -130,6 +154,9 @@ func (u *Order) DetailOrder() interface{} {
 			OrderDate:    u.OrderDate,
 			Seat:         u.Seat,
 			ExternalUUID: u.ExternalUUID,
+
+			BaggageWeight: u.BaggageWeight,
+			BaggageVolume: u.BaggageVolume,
 		},
 		Passengers: Passengers.DetailPassengers(u.Passengers),
 		Status:     u.Status.DetailOrderStatusType(),
@@ -147,6 +174,9 @@ func (u *Order) DetailOrderList() interface{} {
 			Seat:         u.Seat,
 			StatusUUID:   u.StatusUUID,
 			ExternalUUID: u.ExternalUUID,
+
+			BaggageWeight: u.BaggageWeight,
+			BaggageVolume: u.BaggageVolume,
 		},
 		OrderFieldsForList: OrderFieldsForList{
 			CreatedAt: u.CreatedAt,
@@ -157,6 +187,9 @@ func (u *Order) DetailOrderList() interface{} {
 // ValidateSaveOrder will validate create a new order request.
 func (u *Order) ValidateSaveOrder() []response.ErrorForm {
 	validation := validator.New()
+	validation.
+		Set("baggage_weight", u.BaggageWeight, validation.AddRule().MinValue(0.0).Apply()).
+		Set("baggage_volume", u.BaggageVolume, validation.AddRule().MinValue(0.0).Apply())
 	// validation.
 	// 	Set(
 	// 		"from",
@@ -169,6 +202,9 @@ func (u *Order) ValidateSaveOrder() []response.ErrorForm {
 // ValidateUpdateOrder will validate update a new order request.
 func (u *Order) ValidateUpdateOrder() []response.ErrorForm {
 	validation := validator.New()
+	validation.
+		Set("baggage_weight", u.BaggageWeight, validation.AddRule().MinValue(0.0).Apply()).
+		Set("baggage_volume", u.BaggageVolume, validation.AddRule().MinValue(0.0).Apply())
 	// validation.
 	// 	Set(
 	// 		"from",
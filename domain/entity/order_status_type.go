@@ -13,12 +13,14 @@ import (
 )
 
 // OrderStatusType represent schema of table order_status_type.
+// Orders of status marked as canceled take no seats and no luggage room and are reported as canceled.
 type OrderStatusType struct {
-	UUID      string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
-	Type      string         `gorm:"size:100;not null;"                        json:"type,omitempty"       form:"type"`
-	CreatedAt time.Time      `                                                 json:"created_at,omitempty"`
-	UpdatedAt time.Time      `                                                 json:"updated_at,omitempty"`
-	DeletedAt gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
+	UUID       string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	Type       string         `gorm:"size:100;not null;"                        json:"type,omitempty"       form:"type"`
+	IsCanceled bool           `gorm:"not null;default:false;index;"             json:"is_canceled"          form:"is_canceled"`
+	CreatedAt  time.Time      `                                                 json:"created_at,omitempty"`
+	UpdatedAt  time.Time      `                                                 json:"updated_at,omitempty"`
+	DeletedAt  gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
 }
 
 // OrderStatusTypeFaker represent content when generate fake data of document_type.
@@ -43,8 +45,9 @@ type DetailOrderStatusTypeList struct {
 
 // OrderStatusTypeFieldsForDetail represent fields of detail OrderStatusType.
 type OrderStatusTypeFieldsForDetail struct {
-	UUID string `json:"uuid"`
-	Type string `json:"Type"`
+	UUID       string `json:"uuid"`
+	Type       string `json:"Type"`
+	IsCanceled bool   `json:"is_canceled"`
 }
 
 // OrderStatusTypeFieldsForList represent fields of detail OrderStatusType for OrderStatusType list.
@@ -91,8 +94,9 @@ func (orderStatusType OrderStatusTypes) DetailOrderStatusTypes() []interface{} {
 func (u *OrderStatusType) DetailOrderStatusType() interface{} {
 	return &DetailOrderStatusType{
 		OrderStatusTypeFieldsForDetail: OrderStatusTypeFieldsForDetail{
-			UUID: u.UUID,
-			Type: u.Type,
+			UUID:       u.UUID,
+			Type:       u.Type,
+			IsCanceled: u.IsCanceled,
 		},
 	}
 }
@@ -101,8 +105,9 @@ func (u *OrderStatusType) DetailOrderStatusType() interface{} {
 func (u *OrderStatusType) DetailOrderStatusTypeList() interface{} {
 	return &DetailOrderStatusTypeList{
 		OrderStatusTypeFieldsForDetail: OrderStatusTypeFieldsForDetail{
-			UUID: u.UUID,
-			Type: u.Type,
+			UUID:       u.UUID,
+			Type:       u.Type,
+			IsCanceled: u.IsCanceled,
 		},
 		OrderStatusTypeFieldsForList: OrderStatusTypeFieldsForList{
 			CreatedAt: u.CreatedAt,
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/outbox"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// OutboxStatusPending is a status of event waiting to be published.
+	OutboxStatusPending = "pending"
+
+	// OutboxStatusPublished is a status of event accepted by every sink.
+	OutboxStatusPublished = "published"
+
+	// OutboxStatusFailed is a status of event not published after all attempts.
+	OutboxStatusFailed = "failed"
+
+	// OutboxAggregateOrder is an aggregate type of order events.
+	OutboxAggregateOrder = "order"
+
+	// OutboxAggregatePayment is an aggregate type of payment events.
+	OutboxAggregatePayment = "payment"
+
+	// OutboxAggregateTrip is an aggregate type of trip events.
+	OutboxAggregateTrip = "trip"
+
+	// DefaultOutboxMaxAttempts is a default number of attempts to publish event.
+	DefaultOutboxMaxAttempts = 20
+
+	// OutboxBatchSize is a number of events published at once.
+	OutboxBatchSize = 100
+
+	// OutboxRetryDelay is a delay before the second attempt, delay grows with square of attempts.
+	OutboxRetryDelay = 10 * time.Second
+
+	// OutboxLockDuration is a time event claimed by relay is hidden from other relays,
+	// event of relay stopped while publishing is claimed again after it.
+	OutboxLockDuration = 5 * time.Minute
+
+	outboxErrorLength = 255
+)
+
+// OutboxEvent represent schema of table outbox_events.
+// Event is stored in transaction of change of aggregate and is published by relay after commit,
+// UUID of event is idempotency key of consumers.
+type OutboxEvent struct {
+	UUID          string     `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid"`
+	Event         string     `gorm:"size:50;not null;index;"                   json:"event"`
+	AggregateType string     `gorm:"size:50;not null;"                         json:"aggregate_type"`
+	AggregateUUID string     `gorm:"size:36;not null;index;"                   json:"aggregate_uuid"`
+	Payload       string     `gorm:"type:text;"                                json:"payload"`
+	Status        string     `gorm:"size:20;not null;index;"                   json:"status"`
+	Attempts      int        `gorm:"default:0"                                 json:"attempts"`
+	Error         string     `gorm:"size:255;"                                 json:"error"`
+	NextAttemptAt *time.Time `gorm:"index;"                                    json:"next_attempt_at"`
+	LockedUntil   *time.Time `gorm:"index;"                                    json:"-"`
+	PublishedAt   *time.Time `                                                 json:"published_at"`
+	CreatedAt     time.Time  `                                                 json:"created_at"`
+}
+
+// OutboxEvents represent multiple OutboxEvent.
+type OutboxEvents []*OutboxEvent
+
+// TableName return name of table.
+func (u *OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// NewOutboxEvent will construct pending event of aggregate with data encoded as JSON payload.
+func NewOutboxEvent(name string, aggregateType string, aggregateUUID string, data interface{}, now time.Time) (*OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxEvent{
+		UUID:          uuid.New().String(),
+		Event:         name,
+		AggregateType: aggregateType,
+		AggregateUUID: aggregateUUID,
+		Payload:       string(payload),
+		Status:        OutboxStatusPending,
+		CreatedAt:     now,
+	}, nil
+}
+
+// Message will return event published to sinks.
+func (u *OutboxEvent) Message() *outbox.Message {
+	return &outbox.Message{
+		ID:          u.UUID,
+		Event:       u.Event,
+		Aggregate:   u.AggregateType,
+		AggregateID: u.AggregateUUID,
+		Payload:     []byte(u.Payload),
+		CreatedAt:   u.CreatedAt,
+	}
+}
+
+// Published will mark event accepted by every sink.
+func (u *OutboxEvent) Published(now time.Time) {
+	u.Attempts++
+	u.Status = OutboxStatusPublished
+	u.Error = ""
+	u.NextAttemptAt = nil
+	u.PublishedAt = &now
+}
+
+// PublishFailed will schedule next attempt with growing delay, event is failed when attempts are exhausted.
+func (u *OutboxEvent) PublishFailed(err error, maxAttempts int, now time.Time) {
+	u.Attempts++
+	u.Error = err.Error()
+	if len(u.Error) > outboxErrorLength {
+		u.Error = u.Error[:outboxErrorLength]
+	}
+	if u.Attempts >= maxAttempts {
+		u.Status = OutboxStatusFailed
+		u.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(time.Duration(u.Attempts*u.Attempts) * OutboxRetryDelay)
+	u.NextAttemptAt = &next
+}
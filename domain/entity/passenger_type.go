@@ -2,6 +2,7 @@ package entity
 
 import (
 	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/util"
 	"cargo-rest-api/pkg/validator"
 	"html"
 	"strings"
@@ -16,6 +17,8 @@ import (
 type PassengerType struct {
 	UUID      string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
 	Type      string         `gorm:"size:100;not null;"                        json:"type,omitempty"       form:"type"`
+	MinAge    int            `gorm:"default:0"                                 json:"min_age"              form:"min_age"`
+	MaxAge    int            `gorm:"default:0"                                 json:"max_age"              form:"max_age"`
 	CreatedAt time.Time      `                                                 json:"created_at,omitempty"`
 	UpdatedAt time.Time      `                                                 json:"updated_at,omitempty"`
 	DeletedAt gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
@@ -23,8 +26,10 @@ type PassengerType struct {
 
 // PassengerTypeFaker represent content when generate fake data of passenger_type.
 type PassengerTypeFaker struct {
-	UUID string `faker:"uuid_hyphenated"`
-	Type string `faker:"type"`
+	UUID   string `faker:"uuid_hyphenated"`
+	Type   string `faker:"type"`
+	MinAge int    `faker:"min_age"`
+	MaxAge int    `faker:"max_age"`
 }
 
 // PassengerTypes represent multiple PassengerType.
@@ -43,8 +48,10 @@ type DetailPassengerTypeList struct {
 
 // PassengerTypeFieldsForDetail represent fields of detail PassengerType.
 type PassengerTypeFieldsForDetail struct {
-	UUID string `json:"uuid"`
-	Type string `json:"Type"`
+	UUID   string `json:"uuid"`
+	Type   string `json:"Type"`
+	MinAge int    `json:"min_age"`
+	MaxAge int    `json:"max_age"`
 }
 
 // PassengerTypeFieldsForList represent fields of detail PassengerType for PassengerType list.
@@ -59,7 +66,7 @@ func (u *PassengerType) TableName() string {
 
 // FilterableFields return fields.
 func (u *PassengerType) FilterableFields() []interface{} {
-	return []interface{}{"uuid", "type"}
+	return []interface{}{"uuid", "type", "min_age", "max_age"}
 }
 
 // Prepare will prepare submitted data of passenger_type.
@@ -78,6 +85,48 @@ func (u *PassengerType) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// CoversAge return true when age fits into the age band of passenger_type.
+// MaxAge equal to zero means the band has no upper limit.
+func (u *PassengerType) CoversAge(age int) bool {
+	return age >= u.MinAge && (u.MaxAge == 0 || age <= u.MaxAge)
+}
+
+// CoversBirthDay return true when passenger born at birthDay fits into the age band at the given date.
+func (u *PassengerType) CoversBirthDay(birthDay time.Time, at time.Time) bool {
+	return u.CoversAge(util.FullYears(birthDay, at))
+}
+
+// NarrowerThan return true when age band of passenger_type is narrower than age band of other.
+// Bands without upper limit are the widest, among them the one with higher lower limit is narrower.
+// Bands of equal width are ordered by UUID, so the choice does not depend on order of records.
+func (u *PassengerType) NarrowerThan(other *PassengerType) bool {
+	if (u.MaxAge == 0) != (other.MaxAge == 0) {
+		return u.MaxAge != 0
+	}
+	if u.MaxAge == 0 && u.MinAge != other.MinAge {
+		return u.MinAge > other.MinAge
+	}
+	width, otherWidth := u.MaxAge-u.MinAge, other.MaxAge-other.MinAge
+	if width != otherWidth {
+		return width < otherWidth
+	}
+	return u.UUID < other.UUID
+}
+
+// ByBirthDay will return passenger_type which age band covers passenger born at birthDay on the given date.
+// The narrowest covering band is returned, so types without upper limit apply only when no bounded band
+// covers the age.
+func (passengerType PassengerTypes) ByBirthDay(birthDay time.Time, at time.Time) *PassengerType {
+	age := util.FullYears(birthDay, at)
+	var found *PassengerType
+	for _, pt := range passengerType {
+		if pt.CoversAge(age) && (found == nil || pt.NarrowerThan(found)) {
+			found = pt
+		}
+	}
+	return found
+}
+
 // DetailPassengerTypes will return formatted passenger_type detail of multiple passenger_type.
 func (passengerType PassengerTypes) DetailPassengerTypes() []interface{} {
 	result := make([]interface{}, len(passengerType))
@@ -91,8 +140,10 @@ func (passengerType PassengerTypes) DetailPassengerTypes() []interface{} {
 func (u *PassengerType) DetailPassengerType() interface{} {
 	return &DetailPassengerType{
 		PassengerTypeFieldsForDetail: PassengerTypeFieldsForDetail{
-			UUID: u.UUID,
-			Type: u.Type,
+			UUID:   u.UUID,
+			Type:   u.Type,
+			MinAge: u.MinAge,
+			MaxAge: u.MaxAge,
 		},
 	}
 }
@@ -101,8 +152,10 @@ func (u *PassengerType) DetailPassengerType() interface{} {
 func (u *PassengerType) DetailPassengerTypeList() interface{} {
 	return &DetailPassengerTypeList{
 		PassengerTypeFieldsForDetail: PassengerTypeFieldsForDetail{
-			UUID: u.UUID,
-			Type: u.Type,
+			UUID:   u.UUID,
+			Type:   u.Type,
+			MinAge: u.MinAge,
+			MaxAge: u.MaxAge,
 		},
 		PassengerTypeFieldsForList: PassengerTypeFieldsForList{
 			CreatedAt: u.CreatedAt,
@@ -118,7 +171,9 @@ func (u *PassengerType) ValidateSavePassengerType() []response.ErrorForm {
 			"type",
 			u.Type,
 			validation.AddRule().Required().IsAlphaNumericSpaceAndSpecialCharacter().Apply(),
-		)
+		).
+		Set("min_age", u.MinAge, validation.AddRule().MinValue(0).Apply()).
+		Set("max_age", u.MaxAge, validation.AddRule().MinValue(0).When(u.MaxAge > 0, validation.AddRule().MinValue(u.MinAge)).Apply())
 	return validation.Validate()
 }
 
@@ -130,6 +185,8 @@ func (u *PassengerType) ValidateUpdatePassengerType() []response.ErrorForm {
 			"type",
 			u.Type,
 			validation.AddRule().Required().IsAlphaNumericSpaceAndSpecialCharacter().Apply(),
-		)
+		).
+		Set("min_age", u.MinAge, validation.AddRule().MinValue(0).Apply()).
+		Set("max_age", u.MaxAge, validation.AddRule().MinValue(0).When(u.MaxAge > 0, validation.AddRule().MinValue(u.MinAge)).Apply())
 	return validation.Validate()
 }
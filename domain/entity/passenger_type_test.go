@@ -0,0 +1,69 @@
+package entity_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassengerType_CoversAge(t *testing.T) {
+	child := &entity.PassengerType{MinAge: 0, MaxAge: 11}
+	adult := &entity.PassengerType{MinAge: 12, MaxAge: 0}
+
+	cases := []struct {
+		age   int
+		child bool
+		adult bool
+	}{
+		{age: 0, child: true, adult: false},
+		{age: 11, child: true, adult: false},
+		{age: 12, child: false, adult: true},
+		{age: 90, child: false, adult: true},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.child, child.CoversAge(tc.age), "child at %d", tc.age)
+		assert.Equal(t, tc.adult, adult.CoversAge(tc.age), "adult at %d", tc.age)
+	}
+}
+
+func TestPassengerTypes_ByBirthDay(t *testing.T) {
+	anyAge := &entity.PassengerType{UUID: "anyAge", Type: "Any"}
+	adult := &entity.PassengerType{UUID: "adult", Type: "Adult", MinAge: 12}
+	child := &entity.PassengerType{UUID: "child", Type: "Child", MinAge: 2, MaxAge: 11}
+	infant := &entity.PassengerType{UUID: "infant", Type: "Infant", MinAge: 0, MaxAge: 1}
+	types := entity.PassengerTypes{anyAge, adult, child, infant}
+	departure := time.Date(2022, time.April, 22, 11, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		birthDay time.Time
+		expected *entity.PassengerType
+	}{
+		{name: "newborn", birthDay: departure.AddDate(0, -1, 0), expected: infant},
+		{name: "one day before 2nd birthday", birthDay: departure.AddDate(-2, 0, 1), expected: infant},
+		{name: "2nd birthday", birthDay: departure.AddDate(-2, 0, 0), expected: child},
+		{name: "one day before 12th birthday", birthDay: departure.AddDate(-12, 0, 1), expected: child},
+		{name: "12th birthday", birthDay: departure.AddDate(-12, 0, 0), expected: adult},
+		{name: "senior", birthDay: departure.AddDate(-80, 0, 0), expected: adult},
+	}
+	for _, tc := range cases {
+		for _, ordered := range []entity.PassengerTypes{types, {infant, child, adult, anyAge}} {
+			assert.Equal(t, tc.expected, ordered.ByBirthDay(tc.birthDay, departure), tc.name)
+		}
+	}
+
+	assert.Equal(t, anyAge, entity.PassengerTypes{anyAge}.ByBirthDay(departure.AddDate(-30, 0, 0), departure))
+	assert.Nil(t, entity.PassengerTypes{child}.ByBirthDay(departure.AddDate(-30, 0, 0), departure))
+}
+
+func TestPassengerType_NarrowerThan(t *testing.T) {
+	first := &entity.PassengerType{UUID: "a", MinAge: 2, MaxAge: 11}
+	second := &entity.PassengerType{UUID: "b", MinAge: 5, MaxAge: 14}
+
+	assert.True(t, first.NarrowerThan(second))
+	assert.False(t, second.NarrowerThan(first))
+	assert.True(t, (&entity.PassengerType{MinAge: 18}).NarrowerThan(&entity.PassengerType{}))
+	assert.True(t, first.NarrowerThan(&entity.PassengerType{MinAge: 12}))
+}
@@ -12,10 +12,22 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// PaymentTypeOrder is a type of payment for passenger orders.
+	PaymentTypeOrder = "order"
+
+	// PaymentTypeCOD is a type of payment of cash on delivery collected from shipment recipient.
+	PaymentTypeCOD = "cod"
+
+	// PaymentTypeRefund is a type of payment returned to customer for passenger orders, amount is positive.
+	PaymentTypeRefund = "refund"
+)
+
 // Payment represent schema of table payment.
 type Payment struct {
 	UUID string `json:"uuid,omitempty" gorm:"size:36;not null;uniqueIndex;primary_key;"`
 
+	Type        string    `json:"type"         gorm:"size:20;default:order;index;"`
 	PaymentDate time.Time `json:"payment_date"`
 
 	Amount   float64  `json:"amount"`
@@ -28,6 +40,20 @@ type Payment struct {
 
 	ExternalUUID string `json:"external_uuid"`
 
+	ShipmentUUID string `json:"shipment_uuid,omitempty" gorm:"size:36;index;"`
+	PayoutUUID   string `json:"payout_uuid,omitempty"   gorm:"size:36;index;"`
+
+	// Fiscal receipt is kept by fiscal provider only and is not bound from request.
+	FiscalStatus         string     `json:"-" gorm:"size:20;index;"`
+	FiscalUUID           string     `json:"-" gorm:"size:64;"`
+	FiscalDocumentNumber string     `json:"-" gorm:"size:20;"`
+	FiscalSign           string     `json:"-" gorm:"size:20;"`
+	FiscalStorageNumber  string     `json:"-" gorm:"size:20;"`
+	FiscalAttempts       int        `json:"-"`
+	FiscalError          string     `json:"-" gorm:"size:255;"`
+	FiscalNextAttemptAt  *time.Time `json:"-" gorm:"index;"`
+	FiscalizedAt         *time.Time `json:"-"`
+
 	CreatedAt time.Time      `json:"created_at,omitempty"`
 	UpdatedAt time.Time      `json:"updated_at,omitempty"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at"`
@@ -47,9 +73,10 @@ type Payments []*Payment
 // DetailPayment represent format of detail Payment.
 type DetailPayment struct {
 	PaymentFieldsForDetail
-	Orders []interface{} `json:"orders"`
-	User   interface{}   `json:"user"`
-	Trip   interface{}   `json:"trip"`
+	Orders  []interface{} `json:"orders"`
+	User    interface{}   `json:"user"`
+	Trip    interface{}   `json:"trip"`
+	Receipt interface{}   `json:"receipt"`
 }
 
 // DetailPaymentList represent format of DetailPayment for Payment list.
@@ -61,12 +88,18 @@ type DetailPaymentList struct {
 // PaymentFieldsForDetail represent fields of detail Payment.
 type PaymentFieldsForDetail struct {
 	UUID        string    `json:"uuid"`
+	Type        string    `json:"type"`
 	PaymentDate time.Time `json:"payment_date"`
 	Amount      float64   `json:"amount"`
 	UserUUID    string    `json:"user_uuid,omitempty"`
 	TripUUID    string    `json:"trip_uuid,omitempty"`
 
 	ExternalUUID string `json:"external_uuid"`
+
+	ShipmentUUID string `json:"shipment_uuid,omitempty"`
+	PayoutUUID   string `json:"payout_uuid,omitempty"`
+
+	FiscalStatus string `json:"fiscal_status,omitempty"`
 }
 
 // PaymentFieldsForList represent fields of detail Payment for Payment list.
@@ -83,16 +116,24 @@ func (u *Payment) TableName() string {
 func (u *Payment) FilterableFields() []interface{} {
 	return []interface{}{
 		"uuid",
+		"type",
 		"payment_date",
 		"amount",
 		"user_uuid",
 		"trip_uuid",
 		"external_uuid",
+		"shipment_uuid",
+		"payout_uuid",
+		"fiscal_status",
 	}
 }
 
 // Prepare will prepare submitted data of payment.
+// Submitted payments are order payments or refunds, cash on delivery is recorded at shipment delivery only.
 func (u *Payment) Prepare() {
+	if u.Type != PaymentTypeRefund {
+		u.Type = PaymentTypeOrder
+	}
 	u.UUID = html.EscapeString(strings.TrimSpace(u.UUID))
 	u.TripUUID = html.EscapeString(strings.TrimSpace(u.TripUUID))
 	u.ExternalUUID = html.EscapeString(strings.TrimSpace(u.ExternalUUID))
@@ -100,12 +141,15 @@ func (u *Payment) Prepare() {
 	u.UpdatedAt = time.Now()
 }
 
-// BeforeCreate handle uuid generation and password hashing.
+// BeforeCreate handle uuid generation and queue of fiscal receipt.
 func (u *Payment) BeforeCreate(tx *gorm.DB) error {
 	generateUUID := uuid.New()
 	if u.UUID == "" {
 		u.UUID = generateUUID.String()
 	}
+	if u.FiscalStatus == "" && u.RequiresReceipt() {
+		u.FiscalStatus = PaymentFiscalStatusPending
+	}
 	return nil
 }
 
@@ -123,13 +167,17 @@ func (u *Payment) DetailPayment() interface{} {
 	return &DetailPayment{
 		PaymentFieldsForDetail: PaymentFieldsForDetail{
 			UUID:         u.UUID,
+			Type:         u.Type,
 			PaymentDate:  u.PaymentDate,
 			Amount:       u.Amount,
 			ExternalUUID: u.ExternalUUID,
+			ShipmentUUID: u.ShipmentUUID,
+			PayoutUUID:   u.PayoutUUID,
 		},
-		Orders: Orders.DetailOrders(u.Orders),
-		User:   u.User.DetailUser(),
-		Trip:   u.Trip.DetailTrip(),
+		Orders:  Orders.DetailOrders(u.Orders),
+		User:    u.User.DetailUser(),
+		Trip:    u.Trip.DetailTrip(),
+		Receipt: u.DetailReceipt(),
 	}
 }
 
@@ -138,11 +186,15 @@ func (u *Payment) DetailPaymentList() interface{} {
 	return &DetailPaymentList{
 		PaymentFieldsForDetail: PaymentFieldsForDetail{
 			UUID:         u.UUID,
+			Type:         u.Type,
 			PaymentDate:  u.PaymentDate,
 			Amount:       u.Amount,
 			UserUUID:     u.UserUUID,
 			TripUUID:     u.TripUUID,
 			ExternalUUID: u.ExternalUUID,
+			ShipmentUUID: u.ShipmentUUID,
+			PayoutUUID:   u.PayoutUUID,
+			FiscalStatus: u.FiscalStatus,
 		},
 		PaymentFieldsForList: PaymentFieldsForList{
 			CreatedAt: u.CreatedAt,
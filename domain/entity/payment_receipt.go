@@ -0,0 +1,192 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/fiscal"
+	"sort"
+	"time"
+)
+
+const (
+	// PaymentFiscalStatusPending is a status of receipt waiting to be sent to fiscal provider.
+	PaymentFiscalStatusPending = "pending"
+
+	// PaymentFiscalStatusWait is a status of receipt registered at fiscal provider and waiting for cash register.
+	PaymentFiscalStatusWait = "wait"
+
+	// PaymentFiscalStatusDone is a status of receipt printed with fiscal sign.
+	PaymentFiscalStatusDone = "done"
+
+	// PaymentFiscalStatusFailed is a status of receipt rejected by provider or not sent after all attempts.
+	PaymentFiscalStatusFailed = "failed"
+
+	// DefaultPaymentFiscalMaxAttempts is a default number of attempts to send receipt.
+	DefaultPaymentFiscalMaxAttempts = 10
+
+	// PaymentFiscalBatchSize is a number of receipts processed at once.
+	PaymentFiscalBatchSize = 50
+
+	// PaymentFiscalReportInterval is an interval of checking receipt registered at fiscal provider.
+	PaymentFiscalReportInterval = time.Minute
+
+	// PaymentFiscalLockDuration is a time receipt claimed by worker is hidden from other workers,
+	// receipt of worker stopped while sending is claimed again after it.
+	PaymentFiscalLockDuration = 5 * time.Minute
+
+	paymentFiscalItemName = "Проезд"
+)
+
+// DetailPaymentReceipt represent format of fiscal receipt of Payment.
+type DetailPaymentReceipt struct {
+	Status         string     `json:"status"`
+	UUID           string     `json:"uuid,omitempty"`
+	DocumentNumber string     `json:"document_number,omitempty"`
+	Sign           string     `json:"sign,omitempty"`
+	StorageNumber  string     `json:"storage_number,omitempty"`
+	Attempts       int        `json:"attempts"`
+	Error          string     `json:"error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	FiscalizedAt   *time.Time `json:"fiscalized_at,omitempty"`
+}
+
+// RequiresReceipt return true when fiscal receipt is printed for payment,
+// receipts are printed for order payments and refunds, cash on delivery is collected for shipment sender.
+func (u *Payment) RequiresReceipt() bool {
+	return u.Type == PaymentTypeOrder || u.Type == PaymentTypeRefund
+}
+
+// Receipt will return fiscal receipt of payment with tickets of paid orders as items,
+// sum of items is fitted to amount of payment, payment without tickets is printed as one item.
+// Electronic receipt is sent to email or phone of payer.
+func (u *Payment) Receipt(vat string, now time.Time) *fiscal.Receipt {
+	operation := fiscal.OperationSell
+	if u.Type == PaymentTypeRefund {
+		operation = fiscal.OperationSellRefund
+	}
+	receipt := &fiscal.Receipt{
+		ExternalID: u.UUID,
+		Operation:  operation,
+		Timestamp:  now,
+		Client:     fiscal.Client{Email: u.User.Email, Phone: u.User.Phone},
+		Total:      roundMoney(u.Amount),
+	}
+	for _, order := range u.Orders {
+		receipt.Items = append(receipt.Items, paymentReceiptItems(order, vat)...)
+	}
+	if len(receipt.Items) == 0 {
+		receipt.Items = []fiscal.Item{
+			{Name: paymentFiscalItemName, Price: receipt.Total, Quantity: 1, Sum: receipt.Total, VAT: vat},
+		}
+	}
+	receipt.Fit()
+	return receipt
+}
+
+// paymentReceiptItems return tickets of order for every passenger type, passengers without fare are skipped.
+func paymentReceiptItems(order *Order, vat string) []fiscal.Item {
+	route := &order.Trip.Route
+	prices := map[string]*Price{}
+	for _, price := range route.Prices {
+		prices[price.PassengerTypeUUID] = price
+	}
+	items := map[string]*fiscal.Item{}
+	var keys []string
+	for _, passenger := range order.Passengers {
+		price, ok := prices[passenger.PassengerTypeUUID]
+		if !ok || price.Price <= 0 {
+			continue
+		}
+		key := passenger.PassengerTypeUUID
+		if _, ok := items[key]; !ok {
+			name := accountingRouteName(route)
+			if price.PassengerType.Type != "" {
+				name += ", " + price.PassengerType.Type
+			}
+			keys = append(keys, key)
+			items[key] = &fiscal.Item{Name: name, Price: roundMoney(price.Price), VAT: vat}
+		}
+		items[key].Quantity++
+		items[key].Sum = roundMoney(items[key].Quantity * items[key].Price)
+	}
+	sort.Strings(keys)
+
+	result := make([]fiscal.Item, len(keys))
+	for index, key := range keys {
+		result[index] = *items[key]
+	}
+	return result
+}
+
+// Fiscalize will store state of receipt returned by fiscal provider.
+// Attempts are counted when receipt is sent, checking of registered receipt is not counted.
+func (u *Payment) Fiscalize(result *fiscal.Result, now time.Time) {
+	if u.FiscalStatus != PaymentFiscalStatusWait {
+		u.FiscalAttempts++
+	}
+	if result.UUID != "" {
+		u.FiscalUUID = result.UUID
+	}
+	u.FiscalError = result.Error
+	switch result.Status {
+	case fiscal.StatusDone:
+		u.FiscalStatus = PaymentFiscalStatusDone
+		u.FiscalDocumentNumber = result.DocumentNumber
+		u.FiscalSign = result.Sign
+		u.FiscalStorageNumber = result.StorageNumber
+		fiscalizedAt := result.RegisteredAt
+		if fiscalizedAt.IsZero() {
+			fiscalizedAt = now
+		}
+		u.FiscalizedAt = &fiscalizedAt
+		u.FiscalNextAttemptAt = nil
+	case fiscal.StatusFail:
+		u.FiscalStatus = PaymentFiscalStatusFailed
+		u.FiscalNextAttemptAt = nil
+	default:
+		u.FiscalStatus = PaymentFiscalStatusWait
+		next := now.Add(PaymentFiscalReportInterval)
+		u.FiscalNextAttemptAt = &next
+	}
+}
+
+// RequeueReceipt will queue failed receipt to be sent to fiscal provider again as a new registration.
+func (u *Payment) RequeueReceipt() {
+	u.FiscalStatus = PaymentFiscalStatusPending
+	u.FiscalUUID = ""
+	u.FiscalAttempts = 0
+	u.FiscalNextAttemptAt = nil
+}
+
+// FiscalizeFailed will schedule next attempt after error of fiscal provider with growing delay,
+// receipt not sent is failed when attempts are exhausted, registered receipt is checked until provider reports it.
+func (u *Payment) FiscalizeFailed(err error, maxAttempts int, now time.Time) {
+	u.FiscalAttempts++
+	u.FiscalError = err.Error()
+	if len(u.FiscalError) > 255 {
+		u.FiscalError = u.FiscalError[:255]
+	}
+	if u.FiscalStatus != PaymentFiscalStatusWait && u.FiscalAttempts >= maxAttempts {
+		u.FiscalStatus = PaymentFiscalStatusFailed
+		u.FiscalNextAttemptAt = nil
+		return
+	}
+	next := now.Add(time.Duration(u.FiscalAttempts*u.FiscalAttempts) * time.Minute)
+	u.FiscalNextAttemptAt = &next
+}
+
+// DetailReceipt will return formatted fiscal receipt of payment, payment without receipt return nil.
+func (u *Payment) DetailReceipt() interface{} {
+	if u.FiscalStatus == "" {
+		return nil
+	}
+	return &DetailPaymentReceipt{
+		Status:         u.FiscalStatus,
+		UUID:           u.FiscalUUID,
+		DocumentNumber: u.FiscalDocumentNumber,
+		Sign:           u.FiscalSign,
+		StorageNumber:  u.FiscalStorageNumber,
+		Attempts:       u.FiscalAttempts,
+		Error:          u.FiscalError,
+		NextAttemptAt:  u.FiscalNextAttemptAt,
+		FiscalizedAt:   u.FiscalizedAt,
+	}
+}
@@ -0,0 +1,574 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"cargo-rest-api/pkg/worktime"
+	"html"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ReportDateLayout is a layout of dates of report period.
+	ReportDateLayout = "2006-01-02"
+
+	// ReportGroupRoute is a grouping of report rows by route.
+	ReportGroupRoute = "route"
+
+	// ReportGroupTrip is a grouping of report rows by trip.
+	ReportGroupTrip = "trip"
+
+	// ReportGroupDay is a grouping of report rows by calendar day.
+	ReportGroupDay = "day"
+
+	// ReportGroupWeek is a grouping of report rows by week from Monday.
+	ReportGroupWeek = "week"
+
+	// ReportGroupMonth is a grouping of report rows by calendar month.
+	ReportGroupMonth = "month"
+
+	// ReportFormatJSON is a format of report returned as response data.
+	ReportFormatJSON = "json"
+
+	// ReportFormatCSV is a format of report returned as csv attachment.
+	ReportFormatCSV = "csv"
+
+	reportMonthLayout = "2006-01"
+	reportTimeLayout  = "2006-01-02 15:04"
+)
+
+// ReportRequest represent filter of report, dates are inclusive.
+// Revenue and refunds are filtered by payment date, orders by order date and seats by departure of trip.
+type ReportRequest struct {
+	DateFrom  string `json:"date_from"  form:"date_from"`
+	DateTo    string `json:"date_to"    form:"date_to"`
+	RouteUUID string `json:"route_uuid" form:"route_uuid"`
+	GroupBy   string `json:"group_by"   form:"group_by"`
+	Format    string `json:"format"     form:"format"`
+}
+
+// ReportGroup represent route or period summarized by report row.
+type ReportGroup struct {
+	Period    string `json:"period,omitempty"`
+	RouteUUID string `json:"route_uuid,omitempty"`
+	RouteName string `json:"route_name,omitempty"`
+}
+
+// ReportPayment represent order payment or refund with route of its trip.
+type ReportPayment struct {
+	PaymentDate time.Time
+	Type        string
+	Amount      float64
+	RouteUUID   string
+	FromName    string
+	ToName      string
+}
+
+// ReportOrder represent order with number of its passengers and route of its trip.
+type ReportOrder struct {
+	OrderDate time.Time
+	Canceled  bool
+	Seats     int
+	RouteUUID string
+	FromName  string
+	ToName    string
+}
+
+// ReportTripSeats represent trip with seats of its vehicle and seats sold to passengers.
+type ReportTripSeats struct {
+	TripUUID      string
+	DepartureTime time.Time
+	Seats         int
+	SoldSeats     int
+	RouteUUID     string
+	FromName      string
+	ToName        string
+}
+
+// ReportTicketPayment represent order payment or refund with ticket of passenger of order it is made for.
+// TicketCount is a number of tickets of all orders of payment, payment is shared equally between them.
+type ReportTicketPayment struct {
+	PaymentUUID       string
+	Type              string
+	Amount            float64
+	TicketCount       int
+	Price             float64
+	PriceTotal        float64
+	OrderUUID         string
+	PassengerUUID     string
+	PassengerTypeUUID string
+	PassengerType     string
+}
+
+// ReportRevenue represent revenue and refunds of route or period.
+type ReportRevenue struct {
+	ReportGroup
+	PaymentCount int     `json:"payment_count"`
+	Revenue      float64 `json:"revenue"`
+	RefundCount  int     `json:"refund_count"`
+	RefundAmount float64 `json:"refund_amount"`
+	NetRevenue   float64 `json:"net_revenue"`
+}
+
+// ReportRevenues represent multiple ReportRevenue.
+type ReportRevenues []*ReportRevenue
+
+// ReportLoadFactor represent sold seats of trip or route against seats of vehicles.
+type ReportLoadFactor struct {
+	TripUUID      string     `json:"trip_uuid,omitempty"`
+	DepartureTime *time.Time `json:"departure_time,omitempty"`
+	RouteUUID     string     `json:"route_uuid"`
+	RouteName     string     `json:"route_name"`
+	TripCount     int        `json:"trip_count"`
+	Seats         int        `json:"seats"`
+	SoldSeats     int        `json:"sold_seats"`
+	LoadFactor    float64    `json:"load_factor"`
+}
+
+// ReportLoadFactors represent multiple ReportLoadFactor.
+type ReportLoadFactors []*ReportLoadFactor
+
+// ReportAverageFare represent fares of tickets sold to passengers of passenger type.
+// Fare of ticket is its share of order payments less refunds, tickets without payment are left out.
+type ReportAverageFare struct {
+	PassengerTypeUUID string  `json:"passenger_type_uuid"`
+	PassengerType     string  `json:"passenger_type"`
+	TicketCount       int     `json:"ticket_count"`
+	TotalFare         float64 `json:"total_fare"`
+	AverageFare       float64 `json:"average_fare"`
+}
+
+// ReportAverageFares represent multiple ReportAverageFare.
+type ReportAverageFares []*ReportAverageFare
+
+// ReportCancellation represent canceled orders and refunds of route or period.
+type ReportCancellation struct {
+	ReportGroup
+	OrderCount       int     `json:"order_count"`
+	CanceledCount    int     `json:"canceled_count"`
+	CanceledSeats    int     `json:"canceled_seats"`
+	CancellationRate float64 `json:"cancellation_rate"`
+	RefundCount      int     `json:"refund_count"`
+	RefundAmount     float64 `json:"refund_amount"`
+}
+
+// ReportCancellations represent multiple ReportCancellation.
+type ReportCancellations []*ReportCancellation
+
+// Prepare will prepare submitted data of report request, JSON and given grouping are used by default.
+func (u *ReportRequest) Prepare(groupBy string) {
+	u.DateFrom = strings.TrimSpace(u.DateFrom)
+	u.DateTo = strings.TrimSpace(u.DateTo)
+	u.RouteUUID = html.EscapeString(strings.TrimSpace(u.RouteUUID))
+	u.GroupBy = strings.ToLower(strings.TrimSpace(u.GroupBy))
+	if u.GroupBy == "" {
+		u.GroupBy = groupBy
+	}
+	u.Format = strings.ToLower(strings.TrimSpace(u.Format))
+	if u.Format == "" {
+		u.Format = ReportFormatJSON
+	}
+}
+
+// Period return inclusive period of report, zero time means the period is not limited.
+func (u *ReportRequest) Period() (time.Time, time.Time) {
+	var from, to time.Time
+	if u.DateFrom != "" {
+		from, _ = time.ParseInLocation(ReportDateLayout, u.DateFrom, time.Local)
+	}
+	if u.DateTo != "" {
+		to, _ = time.ParseInLocation(ReportDateLayout, u.DateTo, time.Local)
+		to = to.AddDate(0, 0, 1)
+	}
+	return from, to
+}
+
+// IsCSV will check whether report is requested as csv attachment.
+func (u *ReportRequest) IsCSV() bool {
+	return u.Format == ReportFormatCSV
+}
+
+// ValidateReportRequest will validate report request, grouping is ignored when report has no groups.
+func (u *ReportRequest) ValidateReportRequest(groups ...string) []response.ErrorForm {
+	allowed := make([]interface{}, len(groups))
+	for i, group := range groups {
+		allowed[i] = group
+	}
+	validation := validator.New()
+	validation.
+		Set("date_from", u.DateFrom, validation.AddRule().IsDate(ReportDateLayout).Apply()).
+		Set("date_to", u.DateTo, validation.AddRule().IsDate(ReportDateLayout).Apply()).
+		Set("route_uuid", u.RouteUUID, validation.AddRule().IsUUID().Apply()).
+		Set(
+			"group_by",
+			u.GroupBy,
+			validation.AddRule().When(len(groups) > 0, validation.AddRule().In(allowed...)).Apply(),
+		).
+		Set("format", u.Format, validation.AddRule().In(ReportFormatJSON, ReportFormatCSV).Apply())
+	return validation.Validate()
+}
+
+// newReportGroup will return route or period of row, period is a day, Monday of week or month.
+func newReportGroup(groupBy string, date time.Time, routeUUID string, fromName string, toName string) ReportGroup {
+	date = date.In(time.Local)
+	switch groupBy {
+	case ReportGroupRoute:
+		return ReportGroup{RouteUUID: routeUUID, RouteName: reportRouteName(fromName, toName)}
+	case ReportGroupWeek:
+		return ReportGroup{Period: worktime.StartOfWeek(date, time.Local).Format(ReportDateLayout)}
+	case ReportGroupMonth:
+		return ReportGroup{Period: date.Format(reportMonthLayout)}
+	default:
+		return ReportGroup{Period: date.Format(ReportDateLayout)}
+	}
+}
+
+// key return identifier of group.
+func (g ReportGroup) key() string {
+	return g.Period + g.RouteUUID
+}
+
+// less will order periods chronologically and routes by name.
+func (g ReportGroup) less(other ReportGroup) bool {
+	if g.Period != other.Period {
+		return g.Period < other.Period
+	}
+	if g.RouteName != other.RouteName {
+		return g.RouteName < other.RouteName
+	}
+	return g.RouteUUID < other.RouteUUID
+}
+
+// csvHeader return csv columns of group.
+func (g ReportGroup) csvHeader(groupBy string) []string {
+	if groupBy == ReportGroupRoute {
+		return []string{"route_uuid", "route_name"}
+	}
+	return []string{"period"}
+}
+
+// csv return csv values of group.
+func (g ReportGroup) csv(groupBy string) []string {
+	if groupBy == ReportGroupRoute {
+		return []string{g.RouteUUID, g.RouteName}
+	}
+	return []string{g.Period}
+}
+
+// NewReportRevenues will summarize order payments and refunds by route or period.
+func NewReportRevenues(payments []*ReportPayment, groupBy string) ReportRevenues {
+	var revenues ReportRevenues
+	index := map[string]*ReportRevenue{}
+	for _, payment := range payments {
+		group := newReportGroup(groupBy, payment.PaymentDate, payment.RouteUUID, payment.FromName, payment.ToName)
+		revenue, ok := index[group.key()]
+		if !ok {
+			revenue = &ReportRevenue{ReportGroup: group}
+			index[group.key()] = revenue
+			revenues = append(revenues, revenue)
+		}
+		if payment.Type == PaymentTypeRefund {
+			revenue.RefundCount++
+			revenue.RefundAmount += payment.Amount
+			continue
+		}
+		revenue.PaymentCount++
+		revenue.Revenue += payment.Amount
+	}
+	for _, revenue := range revenues {
+		revenue.Revenue = roundMoney(revenue.Revenue)
+		revenue.RefundAmount = roundMoney(revenue.RefundAmount)
+		revenue.NetRevenue = roundMoney(revenue.Revenue - revenue.RefundAmount)
+	}
+	sort.SliceStable(revenues, func(i, j int) bool {
+		return revenues[i].ReportGroup.less(revenues[j].ReportGroup)
+	})
+	return revenues
+}
+
+// NewReportLoadFactors will calculate load factor of every trip or summarize it by route.
+func NewReportLoadFactors(trips []*ReportTripSeats, groupBy string) ReportLoadFactors {
+	var loadFactors ReportLoadFactors
+	index := map[string]*ReportLoadFactor{}
+	for _, trip := range trips {
+		if groupBy == ReportGroupTrip {
+			departureTime := trip.DepartureTime
+			loadFactors = append(loadFactors, &ReportLoadFactor{
+				TripUUID:      trip.TripUUID,
+				DepartureTime: &departureTime,
+				RouteUUID:     trip.RouteUUID,
+				RouteName:     reportRouteName(trip.FromName, trip.ToName),
+				TripCount:     1,
+				Seats:         trip.Seats,
+				SoldSeats:     trip.SoldSeats,
+			})
+			continue
+		}
+		loadFactor, ok := index[trip.RouteUUID]
+		if !ok {
+			loadFactor = &ReportLoadFactor{
+				RouteUUID: trip.RouteUUID,
+				RouteName: reportRouteName(trip.FromName, trip.ToName),
+			}
+			index[trip.RouteUUID] = loadFactor
+			loadFactors = append(loadFactors, loadFactor)
+		}
+		loadFactor.TripCount++
+		loadFactor.Seats += trip.Seats
+		loadFactor.SoldSeats += trip.SoldSeats
+	}
+	for _, loadFactor := range loadFactors {
+		loadFactor.LoadFactor = reportRatio(loadFactor.SoldSeats, loadFactor.Seats)
+	}
+	sort.SliceStable(loadFactors, func(i, j int) bool {
+		if loadFactors[i].DepartureTime != nil && loadFactors[j].DepartureTime != nil {
+			return loadFactors[i].DepartureTime.Before(*loadFactors[j].DepartureTime)
+		}
+		return loadFactors[i].RouteName < loadFactors[j].RouteName
+	})
+	return loadFactors
+}
+
+// NewReportAverageFares will summarize fares paid for tickets by passenger type.
+// Every payment is shared between tickets of its orders in proportion to route price of passenger type of ticket,
+// as tickets are priced in receipt, and equally when route has no prices. Refunds are subtracted from fare,
+// tickets refunded in full are left out.
+func NewReportAverageFares(payments []*ReportTicketPayment) ReportAverageFares {
+	type ticket struct {
+		typeUUID string
+		typeName string
+		fare     float64
+	}
+	var keys []string
+	tickets := map[string]*ticket{}
+	for _, payment := range payments {
+		if payment.TicketCount == 0 {
+			continue
+		}
+		key := payment.OrderUUID + "/" + payment.PassengerUUID
+		t, ok := tickets[key]
+		if !ok {
+			t = &ticket{typeUUID: payment.PassengerTypeUUID, typeName: payment.PassengerType}
+			tickets[key] = t
+			keys = append(keys, key)
+		}
+		share := payment.Amount / float64(payment.TicketCount)
+		if payment.PriceTotal > 0 {
+			share = payment.Amount * payment.Price / payment.PriceTotal
+		}
+		if payment.Type == PaymentTypeRefund {
+			share = -share
+		}
+		t.fare += share
+	}
+
+	var fares ReportAverageFares
+	index := map[string]*ReportAverageFare{}
+	for _, key := range keys {
+		t := tickets[key]
+		if roundMoney(t.fare) <= 0 {
+			continue
+		}
+		fare, ok := index[t.typeUUID]
+		if !ok {
+			fare = &ReportAverageFare{PassengerTypeUUID: t.typeUUID, PassengerType: t.typeName}
+			index[t.typeUUID] = fare
+			fares = append(fares, fare)
+		}
+		fare.TicketCount++
+		fare.TotalFare += t.fare
+	}
+	for _, fare := range fares {
+		fare.AverageFare = fare.TotalFare / float64(fare.TicketCount)
+		fare.Calculate()
+	}
+	sort.SliceStable(fares, func(i, j int) bool {
+		return fares[i].PassengerType < fares[j].PassengerType
+	})
+	return fares
+}
+
+// Calculate will round fares of passenger type.
+func (u *ReportAverageFare) Calculate() {
+	u.TotalFare = roundMoney(u.TotalFare)
+	u.AverageFare = roundMoney(u.AverageFare)
+}
+
+// NewReportCancellations will summarize canceled orders and refunds by route or period.
+func NewReportCancellations(orders []*ReportOrder, refunds []*ReportPayment, groupBy string) ReportCancellations {
+	var cancellations ReportCancellations
+	index := map[string]*ReportCancellation{}
+	row := func(group ReportGroup) *ReportCancellation {
+		cancellation, ok := index[group.key()]
+		if !ok {
+			cancellation = &ReportCancellation{ReportGroup: group}
+			index[group.key()] = cancellation
+			cancellations = append(cancellations, cancellation)
+		}
+		return cancellation
+	}
+	for _, order := range orders {
+		cancellation := row(newReportGroup(groupBy, order.OrderDate, order.RouteUUID, order.FromName, order.ToName))
+		cancellation.OrderCount++
+		if order.Canceled {
+			cancellation.CanceledCount++
+			cancellation.CanceledSeats += order.Seats
+		}
+	}
+	for _, refund := range refunds {
+		cancellation := row(newReportGroup(groupBy, refund.PaymentDate, refund.RouteUUID, refund.FromName, refund.ToName))
+		cancellation.RefundCount++
+		cancellation.RefundAmount += refund.Amount
+	}
+	for _, cancellation := range cancellations {
+		cancellation.RefundAmount = roundMoney(cancellation.RefundAmount)
+		cancellation.CancellationRate = reportRatio(cancellation.CanceledCount, cancellation.OrderCount)
+	}
+	sort.SliceStable(cancellations, func(i, j int) bool {
+		return cancellations[i].ReportGroup.less(cancellations[j].ReportGroup)
+	})
+	return cancellations
+}
+
+// DetailReport will return rows of revenue report.
+func (revenues ReportRevenues) DetailReport() []interface{} {
+	result := make([]interface{}, len(revenues))
+	for index, revenue := range revenues {
+		result[index] = revenue
+	}
+	return result
+}
+
+// CSV will return header and rows of revenue report.
+func (revenues ReportRevenues) CSV(groupBy string) [][]string {
+	header := append(
+		ReportGroup{}.csvHeader(groupBy),
+		"payment_count", "revenue", "refund_count", "refund_amount", "net_revenue",
+	)
+	rows := [][]string{header}
+	for _, revenue := range revenues {
+		rows = append(rows, append(
+			revenue.ReportGroup.csv(groupBy),
+			strconv.Itoa(revenue.PaymentCount),
+			reportMoney(revenue.Revenue),
+			strconv.Itoa(revenue.RefundCount),
+			reportMoney(revenue.RefundAmount),
+			reportMoney(revenue.NetRevenue),
+		))
+	}
+	return rows
+}
+
+// DetailReport will return rows of load factor report.
+func (loadFactors ReportLoadFactors) DetailReport() []interface{} {
+	result := make([]interface{}, len(loadFactors))
+	for index, loadFactor := range loadFactors {
+		result[index] = loadFactor
+	}
+	return result
+}
+
+// CSV will return header and rows of load factor report.
+func (loadFactors ReportLoadFactors) CSV(groupBy string) [][]string {
+	var header []string
+	if groupBy == ReportGroupTrip {
+		header = []string{"trip_uuid", "departure_time"}
+	}
+	header = append(header, "route_uuid", "route_name", "trip_count", "seats", "sold_seats", "load_factor")
+	rows := [][]string{header}
+	for _, loadFactor := range loadFactors {
+		var row []string
+		if groupBy == ReportGroupTrip {
+			row = []string{loadFactor.TripUUID, loadFactor.DepartureTime.In(time.Local).Format(reportTimeLayout)}
+		}
+		rows = append(rows, append(
+			row,
+			loadFactor.RouteUUID,
+			loadFactor.RouteName,
+			strconv.Itoa(loadFactor.TripCount),
+			strconv.Itoa(loadFactor.Seats),
+			strconv.Itoa(loadFactor.SoldSeats),
+			strconv.FormatFloat(loadFactor.LoadFactor, 'f', 4, 64),
+		))
+	}
+	return rows
+}
+
+// DetailReport will return rows of average fare report.
+func (fares ReportAverageFares) DetailReport() []interface{} {
+	result := make([]interface{}, len(fares))
+	for index, fare := range fares {
+		result[index] = fare
+	}
+	return result
+}
+
+// CSV will return header and rows of average fare report.
+func (fares ReportAverageFares) CSV() [][]string {
+	rows := [][]string{{"passenger_type_uuid", "passenger_type", "ticket_count", "total_fare", "average_fare"}}
+	for _, fare := range fares {
+		rows = append(rows, []string{
+			fare.PassengerTypeUUID,
+			fare.PassengerType,
+			strconv.Itoa(fare.TicketCount),
+			reportMoney(fare.TotalFare),
+			reportMoney(fare.AverageFare),
+		})
+	}
+	return rows
+}
+
+// DetailReport will return rows of cancellation report.
+func (cancellations ReportCancellations) DetailReport() []interface{} {
+	result := make([]interface{}, len(cancellations))
+	for index, cancellation := range cancellations {
+		result[index] = cancellation
+	}
+	return result
+}
+
+// CSV will return header and rows of cancellation report.
+func (cancellations ReportCancellations) CSV(groupBy string) [][]string {
+	header := append(
+		ReportGroup{}.csvHeader(groupBy),
+		"order_count", "canceled_count", "canceled_seats", "cancellation_rate", "refund_count", "refund_amount",
+	)
+	rows := [][]string{header}
+	for _, cancellation := range cancellations {
+		rows = append(rows, append(
+			cancellation.ReportGroup.csv(groupBy),
+			strconv.Itoa(cancellation.OrderCount),
+			strconv.Itoa(cancellation.CanceledCount),
+			strconv.Itoa(cancellation.CanceledSeats),
+			strconv.FormatFloat(cancellation.CancellationRate, 'f', 4, 64),
+			strconv.Itoa(cancellation.RefundCount),
+			reportMoney(cancellation.RefundAmount),
+		))
+	}
+	return rows
+}
+
+// reportRouteName return name of route from names of its cities.
+func reportRouteName(fromName string, toName string) string {
+	if fromName == "" && toName == "" {
+		return ""
+	}
+	return fromName + " - " + toName
+}
+
+// reportRatio return share of part in total, zero total gives zero share.
+func reportRatio(part int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 10000
+}
+
+// reportMoney format amount for csv.
+func reportMoney(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,45 @@
+package entity_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReportAverageFares(t *testing.T) {
+	adult := func(payment, paymentType, order, passenger string, amount float64, tickets int) *entity.ReportTicketPayment {
+		return &entity.ReportTicketPayment{
+			PaymentUUID: payment, Type: paymentType, Amount: amount, TicketCount: tickets,
+			OrderUUID: order, PassengerUUID: passenger, PassengerTypeUUID: "adult", PassengerType: "Adult",
+		}
+	}
+	priced := func(payment *entity.ReportTicketPayment, price, total float64) *entity.ReportTicketPayment {
+		payment.Price, payment.PriceTotal = price, total
+		return payment
+	}
+	payments := []*entity.ReportTicketPayment{
+		priced(adult("p1", entity.PaymentTypeOrder, "o1", "a", 900, 3), 400, 1000),
+		priced(adult("p1", entity.PaymentTypeOrder, "o1", "b", 900, 3), 400, 1000),
+		{
+			PaymentUUID: "p1", Type: entity.PaymentTypeOrder, Amount: 900, TicketCount: 3,
+			OrderUUID: "o1", PassengerUUID: "c", PassengerTypeUUID: "child", PassengerType: "Child",
+			Price: 200, PriceTotal: 1000,
+		},
+		priced(adult("p2", entity.PaymentTypeOrder, "o2", "a", 500, 1), 400, 400),
+		priced(adult("p3", entity.PaymentTypeRefund, "o2", "a", 500, 1), 400, 400),
+		adult("p4", entity.PaymentTypeOrder, "o3", "d", 250, 1),
+		adult("p5", entity.PaymentTypeOrder, "o3", "d", 250, 1),
+	}
+
+	fares := entity.NewReportAverageFares(payments)
+
+	assert.Len(t, fares, 2)
+	assert.Equal(t, "Adult", fares[0].PassengerType)
+	assert.Equal(t, 3, fares[0].TicketCount)
+	assert.Equal(t, 1220.0, fares[0].TotalFare)
+	assert.Equal(t, 406.67, fares[0].AverageFare)
+	assert.Equal(t, "Child", fares[1].PassengerType)
+	assert.Equal(t, 1, fares[1].TicketCount)
+	assert.Equal(t, 180.0, fares[1].TotalFare)
+}
@@ -47,7 +47,7 @@ type Routes []*Route
 // DetailRoute represent format of detail Route.
 type DetailRoute struct {
 	RouteFieldsForDetail
-	Prices []interface{} `json:"prices,omitempty`
+	Prices []interface{} `json:"prices,omitempty"`
 }
 
 // DetailRouteList represent format of DetailRoute for Route list.
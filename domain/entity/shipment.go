@@ -0,0 +1,371 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"crypto/rand"
+	"fmt"
+	"html"
+	"math/big"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// trackingNumberPrefix is a prefix of generated shipment tracking number.
+	trackingNumberPrefix = "CRG"
+
+	// cubicCentimetersInCubicMeter uses to convert dimensions in centimeters to volume in cubic meters.
+	cubicCentimetersInCubicMeter = 1000000
+)
+
+// Shipment represent schema of table shipment.
+type Shipment struct {
+	UUID           string `json:"uuid,omitempty"            gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	TrackingNumber string `json:"tracking_number,omitempty" gorm:"size:20;not null;uniqueIndex;"`
+
+	SenderName     string `json:"sender_name"     gorm:"size:100;" form:"sender_name"`
+	SenderPhone    string `json:"sender_phone"    gorm:"size:20;"  form:"sender_phone"`
+	SenderEmail    string `json:"sender_email"    gorm:"size:100;" form:"sender_email"`
+	RecipientName  string `json:"recipient_name"  gorm:"size:100;" form:"recipient_name"`
+	RecipientPhone string `json:"recipient_phone" gorm:"size:20;"  form:"recipient_phone"`
+	RecipientEmail string `json:"recipient_email" gorm:"size:100;" form:"recipient_email"`
+
+	FromUUID string `json:"from_uuid" form:"from_uuid"`
+	SityFrom Sity   `json:"sity_from" gorm:"foreignKey:FromUUID"`
+	ToUUID   string `json:"to_uuid"   form:"to_uuid"`
+	SityTo   Sity   `json:"sity_to"   gorm:"foreignKey:ToUUID"`
+
+	DestinationAddress   string  `json:"destination_address"   gorm:"size:255;" form:"destination_address"`
+	DestinationLatitude  float64 `json:"destination_latitude"                   form:"destination_latitude"`
+	DestinationLongitude float64 `json:"destination_longitude"                  form:"destination_longitude"`
+
+	Weight        float64 `json:"weight"         form:"weight"`
+	Length        float64 `json:"length"         form:"length"`
+	Width         float64 `json:"width"          form:"width"`
+	Height        float64 `json:"height"         form:"height"`
+	DeclaredValue float64 `json:"declared_value" form:"declared_value"`
+
+	TripUUID string `json:"trip_uuid" form:"trip_uuid"`
+	Trip     Trip   `json:"trip"      gorm:"foreignKey:TripUUID"`
+
+	CargoTariffUUID string  `json:"cargo_tariff_uuid" gorm:"size:36;"`
+	Price           float64 `json:"price"`
+
+	CODAmount float64 `json:"cod_amount" gorm:"default:0" form:"cod_amount"`
+	CODFee    float64 `json:"cod_fee"    gorm:"default:0"`
+
+	Status      string         `json:"status"       gorm:"size:20;default:accepted;"`
+	NotifySMS   bool           `json:"notify_sms"   form:"notify_sms"`
+	NotifyEmail bool           `json:"notify_email" form:"notify_email"`
+	Events      ShipmentEvents `json:"events"       gorm:"foreignKey:ShipmentUUID"`
+
+	DeliveryCode         string            `json:"-"                  gorm:"size:6;"`
+	DeliveryCodeAttempts int               `json:"-"                  gorm:"default:0"`
+	Delivery             *ShipmentDelivery `json:"delivery,omitempty" gorm:"foreignKey:ShipmentUUID"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	DeletedAt gorm.DeletedAt
+}
+
+// ShipmentFaker represent content when generate fake data of shipment.
+type ShipmentFaker struct {
+	UUID           string  `faker:"uuid_hyphenated"`
+	SenderName     string  `faker:"name"`
+	SenderPhone    string  `faker:"phone_number"`
+	RecipientName  string  `faker:"name"`
+	RecipientPhone string  `faker:"phone_number"`
+	FromUUID       string  `faker:"from_uuid"`
+	ToUUID         string  `faker:"to_uuid"`
+	Weight         float64 `faker:"weight"`
+	DeclaredValue  float64 `faker:"declared_value"`
+	TripUUID       string  `faker:"trip_uuid"`
+}
+
+// Shipments represent multiple Shipment.
+type Shipments []*Shipment
+
+// DetailShipment represent format of detail Shipment.
+type DetailShipment struct {
+	ShipmentFieldsForDetail
+	SityFrom interface{} `json:"sity_from,omitempty"`
+	SityTo   interface{} `json:"sity_to,omitempty"`
+	Trip     interface{} `json:"trip,omitempty"`
+	Events   interface{} `json:"events,omitempty"`
+}
+
+// DetailShipmentList represent format of DetailShipment for Shipment list.
+type DetailShipmentList struct {
+	ShipmentFieldsForDetail
+	ShipmentFieldsForList
+}
+
+// ShipmentFieldsForDetail represent fields of detail Shipment.
+type ShipmentFieldsForDetail struct {
+	UUID           string `json:"uuid"`
+	TrackingNumber string `json:"tracking_number"`
+
+	SenderName     string `json:"sender_name"`
+	SenderPhone    string `json:"sender_phone"`
+	SenderEmail    string `json:"sender_email"`
+	RecipientName  string `json:"recipient_name"`
+	RecipientPhone string `json:"recipient_phone"`
+	RecipientEmail string `json:"recipient_email"`
+
+	FromUUID string `json:"from_uuid"`
+	ToUUID   string `json:"to_uuid"`
+
+	DestinationAddress   string  `json:"destination_address"`
+	DestinationLatitude  float64 `json:"destination_latitude"`
+	DestinationLongitude float64 `json:"destination_longitude"`
+
+	Weight        float64 `json:"weight"`
+	Length        float64 `json:"length"`
+	Width         float64 `json:"width"`
+	Height        float64 `json:"height"`
+	Volume        float64 `json:"volume"`
+	DeclaredValue float64 `json:"declared_value"`
+
+	TripUUID string `json:"trip_uuid"`
+
+	CargoTariffUUID string  `json:"cargo_tariff_uuid"`
+	Price           float64 `json:"price"`
+
+	CODAmount float64 `json:"cod_amount"`
+	CODFee    float64 `json:"cod_fee"`
+
+	Status      string `json:"status"`
+	NotifySMS   bool   `json:"notify_sms"`
+	NotifyEmail bool   `json:"notify_email"`
+}
+
+// ShipmentFieldsForList represent fields of detail Shipment for Shipment list.
+type ShipmentFieldsForList struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName return name of table.
+func (u *Shipment) TableName() string {
+	return "shipments"
+}
+
+// FilterableFields return fields.
+func (u *Shipment) FilterableFields() []interface{} {
+	return []interface{}{
+		"uuid",
+		"tracking_number",
+		"sender_name",
+		"sender_phone",
+		"recipient_name",
+		"recipient_phone",
+		"from_uuid",
+		"to_uuid",
+		"weight",
+		"declared_value",
+		"trip_uuid",
+		"cargo_tariff_uuid",
+		"price",
+		"cod_amount",
+		"status",
+	}
+}
+
+// Prepare will prepare submitted data of shipment.
+func (u *Shipment) Prepare() {
+	u.SenderName = html.EscapeString(strings.TrimSpace(u.SenderName))
+	u.SenderPhone = html.EscapeString(strings.TrimSpace(u.SenderPhone))
+	u.SenderEmail = html.EscapeString(strings.TrimSpace(u.SenderEmail))
+	u.RecipientName = html.EscapeString(strings.TrimSpace(u.RecipientName))
+	u.RecipientPhone = html.EscapeString(strings.TrimSpace(u.RecipientPhone))
+	u.RecipientEmail = html.EscapeString(strings.TrimSpace(u.RecipientEmail))
+	u.FromUUID = html.EscapeString(strings.TrimSpace(u.FromUUID))
+	u.ToUUID = html.EscapeString(strings.TrimSpace(u.ToUUID))
+	u.DestinationAddress = html.EscapeString(strings.TrimSpace(u.DestinationAddress))
+	u.TripUUID = html.EscapeString(strings.TrimSpace(u.TripUUID))
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// BeforeCreate handle uuid and tracking number generation.
+func (u *Shipment) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	if u.TrackingNumber == "" {
+		trackingNumber, err := NewTrackingNumber()
+		if err != nil {
+			return err
+		}
+		u.TrackingNumber = trackingNumber
+	}
+	return nil
+}
+
+// NewTrackingNumber will generate a random shipment tracking number.
+func NewTrackingNumber() (string, error) {
+	number, err := rand.Int(rand.Reader, big.NewInt(10000000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%010d", trackingNumberPrefix, number), nil
+}
+
+// Volume return volume of shipment in cubic meters, dimensions are measured in centimeters.
+func (u *Shipment) Volume() float64 {
+	return u.Length * u.Width * u.Height / cubicCentimetersInCubicMeter
+}
+
+// PricedAs will check whether shipment has the same data price is calculated from as priced shipment:
+// route, weight, dimensions, declared value and cash on delivery.
+func (u *Shipment) PricedAs(priced *Shipment) bool {
+	return u.FromUUID == priced.FromUUID &&
+		u.ToUUID == priced.ToUUID &&
+		u.Weight == priced.Weight &&
+		u.Length == priced.Length &&
+		u.Width == priced.Width &&
+		u.Height == priced.Height &&
+		u.DeclaredValue == priced.DeclaredValue &&
+		u.CODAmount == priced.CODAmount
+}
+
+// MatchCODAmount will check whether collected amount equals cash on delivery of shipment to a cent.
+func (u *Shipment) MatchCODAmount(amount float64) bool {
+	return roundMoney(amount) == roundMoney(u.CODAmount)
+}
+
+// HasDestination will check whether destination coordinates of shipment are known.
+func (u *Shipment) HasDestination() bool {
+	return u.DestinationLatitude != 0 || u.DestinationLongitude != 0
+}
+
+// TotalWeight return summary weight of shipments.
+func (shipment Shipments) TotalWeight() float64 {
+	var total float64
+	for _, s := range shipment {
+		total += s.Weight
+	}
+	return total
+}
+
+// TotalVolume return summary volume of shipments.
+func (shipment Shipments) TotalVolume() float64 {
+	var total float64
+	for _, s := range shipment {
+		total += s.Volume()
+	}
+	return total
+}
+
+// DetailShipments will return formatted shipment detail of multiple shipment.
+func (shipment Shipments) DetailShipments() []interface{} {
+	result := make([]interface{}, len(shipment))
+	for index, shipment := range shipment {
+		result[index] = shipment.DetailShipmentList()
+	}
+	return result
+}
+
+// shipmentFieldsForDetail will return shared fields of shipment detail.
+func (u *Shipment) shipmentFieldsForDetail() ShipmentFieldsForDetail {
+	return ShipmentFieldsForDetail{
+		UUID:                 u.UUID,
+		TrackingNumber:       u.TrackingNumber,
+		SenderName:           u.SenderName,
+		SenderPhone:          u.SenderPhone,
+		SenderEmail:          u.SenderEmail,
+		RecipientName:        u.RecipientName,
+		RecipientPhone:       u.RecipientPhone,
+		RecipientEmail:       u.RecipientEmail,
+		FromUUID:             u.FromUUID,
+		ToUUID:               u.ToUUID,
+		DestinationAddress:   u.DestinationAddress,
+		DestinationLatitude:  u.DestinationLatitude,
+		DestinationLongitude: u.DestinationLongitude,
+		Weight:               u.Weight,
+		Length:               u.Length,
+		Width:                u.Width,
+		Height:               u.Height,
+		Volume:               u.Volume(),
+		DeclaredValue:        u.DeclaredValue,
+		TripUUID:             u.TripUUID,
+		CargoTariffUUID:      u.CargoTariffUUID,
+		Price:                u.Price,
+		CODAmount:            u.CODAmount,
+		CODFee:               u.CODFee,
+		Status:               u.Status,
+		NotifySMS:            u.NotifySMS,
+		NotifyEmail:          u.NotifyEmail,
+	}
+}
+
+// DetailShipment will return formatted shipment detail of shipment.
+func (u *Shipment) DetailShipment() interface{} {
+	return &DetailShipment{
+		ShipmentFieldsForDetail: u.shipmentFieldsForDetail(),
+		SityFrom:                u.SityFrom.DetailSity(),
+		SityTo:                  u.SityTo.DetailSity(),
+		Trip:                    u.Trip.DetailTrip(),
+		Events:                  u.Events.DetailShipmentEvents(),
+	}
+}
+
+// DetailShipmentList will return formatted shipment detail of shipment for shipment list.
+func (u *Shipment) DetailShipmentList() interface{} {
+	return &DetailShipmentList{
+		ShipmentFieldsForDetail: u.shipmentFieldsForDetail(),
+		ShipmentFieldsForList: ShipmentFieldsForList{
+			CreatedAt: u.CreatedAt,
+		},
+	}
+}
+
+// DetailShipmentTracking will return public timeline of shipment without personal data.
+func (u *Shipment) DetailShipmentTracking() interface{} {
+	events := make([]ShipmentTrackingEvent, len(u.Events))
+	for index, event := range u.Events {
+		events[index] = event.DetailShipmentTrackingEvent()
+	}
+	return &ShipmentTracking{
+		TrackingNumber: u.TrackingNumber,
+		Status:         u.Status,
+		From:           u.SityFrom.Name,
+		To:             u.SityTo.Name,
+		Events:         events,
+	}
+}
+
+// ValidateSaveShipment will validate create a new shipment request.
+func (u *Shipment) ValidateSaveShipment() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("sender_name", u.SenderName, validation.AddRule().Required().Length(3, 100).Apply()).
+		Set("sender_phone", u.SenderPhone, validation.AddRule().Required().IsPhone().Apply()).
+		Set("sender_email", u.SenderEmail, validation.AddRule().IsEmail().Apply()).
+		Set("recipient_name", u.RecipientName, validation.AddRule().Required().Length(3, 100).Apply()).
+		Set("recipient_phone", u.RecipientPhone, validation.AddRule().Required().IsPhone().Apply()).
+		Set("recipient_email", u.RecipientEmail, validation.AddRule().IsEmail().Apply()).
+		Set("from_uuid", u.FromUUID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("to_uuid", u.ToUUID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("destination_address", u.DestinationAddress, validation.AddRule().Length(0, 255).Apply()).
+		Set("destination_latitude", u.DestinationLatitude, validation.AddRule().MinValue(-90.0).MaxValue(90.0).Apply()).
+		Set("destination_longitude", u.DestinationLongitude, validation.AddRule().MinValue(-180.0).MaxValue(180.0).Apply()).
+		Set("weight", u.Weight, validation.AddRule().Required().MinValue(0.0).Apply()).
+		Set("length", u.Length, validation.AddRule().MinValue(0.0).Apply()).
+		Set("width", u.Width, validation.AddRule().MinValue(0.0).Apply()).
+		Set("height", u.Height, validation.AddRule().MinValue(0.0).Apply()).
+		Set("declared_value", u.DeclaredValue, validation.AddRule().MinValue(0.0).Apply()).
+		Set("cod_amount", u.CODAmount, validation.AddRule().MinValue(0.0).Apply()).
+		Set("trip_uuid", u.TripUUID, validation.AddRule().IsUUID().Apply())
+	return validation.Validate()
+}
+
+// ValidateUpdateShipment will validate update a new shipment request.
+func (u *Shipment) ValidateUpdateShipment() []response.ErrorForm {
+	return u.ValidateSaveShipment()
+}
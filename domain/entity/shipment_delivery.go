@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"html"
+	"math/big"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+// deliveryCodeLength is a number of digits of confirmation code sent to shipment recipient.
+const deliveryCodeLength = 6
+
+// MaxDeliveryCodeAttempts is a number of wrong attempts after which confirmation code is invalidated.
+const MaxDeliveryCodeAttempts = 5
+
+// ShipmentDelivery represent schema of table shipment_deliveries, it is a proof of shipment delivery.
+type ShipmentDelivery struct {
+	UUID          string         `json:"uuid,omitempty" gorm:"size:36;not null;uniqueIndex;primary_key;" form:"-"`
+	ShipmentUUID  string         `json:"shipment_uuid"  gorm:"size:36;not null;uniqueIndex;"             form:"-"`
+	RecipientName string         `json:"recipient_name" gorm:"size:100;not null;"                        form:"recipient_name"`
+	Code          string         `json:"-"              gorm:"-"                                         form:"code"`
+	CODAmount     float64        `json:"cod_amount"     gorm:"default:0"                                 form:"cod_amount"`
+	PhotoUUID     string         `json:"photo_uuid"     gorm:"size:36;"                                  form:"-"`
+	SignatureUUID string         `json:"signature_uuid" gorm:"size:36;"                                  form:"-"`
+	DeliveredBy   string         `json:"delivered_by"   gorm:"size:36;"                                  form:"-"`
+	DeliveredAt   time.Time      `json:"delivered_at"                                                    form:"-"`
+	CreatedAt     time.Time      `json:"created_at,omitempty"                                            form:"-"`
+	UpdatedAt     time.Time      `json:"updated_at,omitempty"                                            form:"-"`
+	DeletedAt     gorm.DeletedAt `                                                                 form:"-"`
+}
+
+// DetailShipmentDelivery represent format of detail ShipmentDelivery with links to download evidence.
+type DetailShipmentDelivery struct {
+	UUID          string      `json:"uuid"`
+	ShipmentUUID  string      `json:"shipment_uuid"`
+	RecipientName string      `json:"recipient_name"`
+	CODAmount     float64     `json:"cod_amount"`
+	PhotoUUID     string      `json:"photo_uuid,omitempty"`
+	PhotoURL      interface{} `json:"photo_url,omitempty"`
+	SignatureUUID string      `json:"signature_uuid,omitempty"`
+	SignatureURL  interface{} `json:"signature_url,omitempty"`
+	DeliveredBy   string      `json:"delivered_by"`
+	DeliveredAt   time.Time   `json:"delivered_at"`
+}
+
+// TableName return name of table.
+func (u *ShipmentDelivery) TableName() string {
+	return "shipment_deliveries"
+}
+
+// Prepare will prepare submitted data of shipment delivery.
+func (u *ShipmentDelivery) Prepare() {
+	u.RecipientName = html.EscapeString(strings.TrimSpace(u.RecipientName))
+	u.Code = strings.TrimSpace(u.Code)
+	if u.DeliveredAt.IsZero() {
+		u.DeliveredAt = time.Now()
+	}
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// BeforeCreate handle uuid generation.
+func (u *ShipmentDelivery) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// NewDeliveryCode will generate a random confirmation code of shipment delivery.
+func NewDeliveryCode() (string, error) {
+	number, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", deliveryCodeLength, number), nil
+}
+
+// DeliveryCodeExhausted will check whether confirmation code is invalidated by too many wrong attempts.
+func (u *Shipment) DeliveryCodeExhausted() bool {
+	return u.DeliveryCodeAttempts >= MaxDeliveryCodeAttempts
+}
+
+// VerifyDeliveryCode will check confirmation code given by recipient against issued one.
+func (u *Shipment) VerifyDeliveryCode(code string) bool {
+	if u.DeliveryCode == "" || u.DeliveryCodeExhausted() {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(u.DeliveryCode), []byte(code)) == 1
+}
+
+// DetailShipmentDelivery will return formatted shipment delivery detail with signed URLs of evidence.
+func (u *ShipmentDelivery) DetailShipmentDelivery(photoURL interface{}, signatureURL interface{}) interface{} {
+	return &DetailShipmentDelivery{
+		UUID:          u.UUID,
+		ShipmentUUID:  u.ShipmentUUID,
+		RecipientName: u.RecipientName,
+		CODAmount:     u.CODAmount,
+		PhotoUUID:     u.PhotoUUID,
+		PhotoURL:      photoURL,
+		SignatureUUID: u.SignatureUUID,
+		SignatureURL:  signatureURL,
+		DeliveredBy:   u.DeliveredBy,
+		DeliveredAt:   u.DeliveredAt,
+	}
+}
+
+// ValidateSaveShipmentDelivery will validate delivery confirmation request, a photo or a signature is required.
+func (u *ShipmentDelivery) ValidateSaveShipmentDelivery(hasPhoto bool, hasSignature bool) []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("recipient_name", u.RecipientName, validation.AddRule().Required().Length(3, 100).Apply()).
+		Set("code", u.Code, validation.AddRule().Required().Length(deliveryCodeLength, deliveryCodeLength).Apply()).
+		Set("cod_amount", u.CODAmount, validation.AddRule().MinValue(0.0).Apply()).
+		Set("photo", hasPhoto || hasSignature, validation.AddRule().Required().Apply())
+	return validation.Validate()
+}
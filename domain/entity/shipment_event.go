@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"html"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+// Types of shipment event, the type of the latest event is also a status of shipment.
+const (
+	ShipmentEventAccepted  = "accepted"
+	ShipmentEventLoaded    = "loaded"
+	ShipmentEventDeparted  = "departed"
+	ShipmentEventArrived   = "arrived"
+	ShipmentEventDelivered = "delivered"
+)
+
+// ShipmentEvent represent schema of table shipment_events.
+type ShipmentEvent struct {
+	UUID         string    `json:"uuid,omitempty"     gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	ShipmentUUID string    `json:"shipment_uuid"      gorm:"size:36;not null;index;"`
+	Type         string    `json:"type"               gorm:"size:20;not null;"  form:"type"`
+	TripUUID     string    `json:"trip_uuid"          gorm:"size:36;"           form:"trip_uuid"`
+	Trip         Trip      `json:"trip"               gorm:"foreignKey:TripUUID"`
+	SityUUID     string    `json:"sity_uuid"          gorm:"size:36;"           form:"sity_uuid"`
+	Sity         Sity      `json:"sity"               gorm:"foreignKey:SityUUID"`
+	Note         string    `json:"note"               gorm:"size:255;"          form:"note"`
+	EventTime    time.Time `json:"event_time"                                   form:"event_time"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	DeletedAt    gorm.DeletedAt
+}
+
+// ShipmentEvents represent multiple ShipmentEvent.
+type ShipmentEvents []*ShipmentEvent
+
+// DetailShipmentEvent represent format of detail ShipmentEvent.
+type DetailShipmentEvent struct {
+	UUID         string      `json:"uuid"`
+	ShipmentUUID string      `json:"shipment_uuid"`
+	Type         string      `json:"type"`
+	TripUUID     string      `json:"trip_uuid"`
+	SityUUID     string      `json:"sity_uuid"`
+	Sity         interface{} `json:"sity,omitempty"`
+	Note         string      `json:"note"`
+	EventTime    time.Time   `json:"event_time"`
+}
+
+// ShipmentTracking represent public timeline of shipment, it must not contain personal data.
+type ShipmentTracking struct {
+	TrackingNumber string                  `json:"tracking_number"`
+	Status         string                  `json:"status"`
+	From           string                  `json:"from"`
+	To             string                  `json:"to"`
+	Events         []ShipmentTrackingEvent `json:"events"`
+}
+
+// ShipmentTrackingEvent represent event of public shipment timeline.
+type ShipmentTrackingEvent struct {
+	Type          string     `json:"type"`
+	EventTime     time.Time  `json:"event_time"`
+	Sity          string     `json:"sity,omitempty"`
+	TripUUID      string     `json:"trip_uuid,omitempty"`
+	DepartureTime *time.Time `json:"departure_time,omitempty"`
+}
+
+// ShipmentEventTypes return all available types of shipment event.
+func ShipmentEventTypes() []interface{} {
+	return []interface{}{
+		ShipmentEventAccepted,
+		ShipmentEventLoaded,
+		ShipmentEventDeparted,
+		ShipmentEventArrived,
+		ShipmentEventDelivered,
+	}
+}
+
+// TableName return name of table.
+func (u *ShipmentEvent) TableName() string {
+	return "shipment_events"
+}
+
+// Prepare will prepare submitted data of shipment event.
+func (u *ShipmentEvent) Prepare() {
+	u.Type = html.EscapeString(strings.TrimSpace(u.Type))
+	u.TripUUID = html.EscapeString(strings.TrimSpace(u.TripUUID))
+	u.SityUUID = html.EscapeString(strings.TrimSpace(u.SityUUID))
+	u.Note = html.EscapeString(strings.TrimSpace(u.Note))
+	if u.EventTime.IsZero() {
+		u.EventTime = time.Now()
+	}
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// BeforeCreate handle uuid generation.
+func (u *ShipmentEvent) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	if u.EventTime.IsZero() {
+		u.EventTime = time.Now()
+	}
+	return nil
+}
+
+// DetailShipmentEvents will return formatted shipment event detail of multiple shipment event.
+func (events ShipmentEvents) DetailShipmentEvents() []interface{} {
+	result := make([]interface{}, len(events))
+	for index, event := range events {
+		result[index] = event.DetailShipmentEvent()
+	}
+	return result
+}
+
+// DetailShipmentEvent will return formatted shipment event detail of shipment event.
+func (u *ShipmentEvent) DetailShipmentEvent() interface{} {
+	var sity interface{}
+	if u.Sity.UUID != "" {
+		sity = u.Sity.DetailSity()
+	}
+	return &DetailShipmentEvent{
+		UUID:         u.UUID,
+		ShipmentUUID: u.ShipmentUUID,
+		Type:         u.Type,
+		TripUUID:     u.TripUUID,
+		SityUUID:     u.SityUUID,
+		Sity:         sity,
+		Note:         u.Note,
+		EventTime:    u.EventTime,
+	}
+}
+
+// DetailShipmentTrackingEvent will return shipment event for public timeline.
+func (u *ShipmentEvent) DetailShipmentTrackingEvent() ShipmentTrackingEvent {
+	event := ShipmentTrackingEvent{
+		Type:      u.Type,
+		EventTime: u.EventTime,
+		Sity:      u.Sity.Name,
+		TripUUID:  u.TripUUID,
+	}
+	if !u.Trip.DepartureTime.IsZero() {
+		departureTime := u.Trip.DepartureTime
+		event.DepartureTime = &departureTime
+	}
+	return event
+}
+
+// ValidateSaveShipmentEvent will validate create a new shipment event request.
+func (u *ShipmentEvent) ValidateSaveShipmentEvent() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("type", u.Type, validation.AddRule().Required().In(ShipmentEventTypes()...).Apply()).
+		Set("trip_uuid", u.TripUUID, validation.AddRule().IsUUID().Apply()).
+		Set("sity_uuid", u.SityUUID, validation.AddRule().IsUUID().
+			When(u.Type == ShipmentEventArrived, validation.AddRule().Required()).Apply()).
+		Set("note", u.Note, validation.AddRule().MaxLength(255).Apply())
+	return validation.Validate()
+}
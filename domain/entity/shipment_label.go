@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"strings"
+)
+
+const (
+	// ShipmentLabelFormatPDF is a format of shipping label printed as A6 PDF document.
+	ShipmentLabelFormatPDF = "pdf"
+
+	// ShipmentLabelFormatZPL is a format of shipping label printed as raw ZPL for thermal printers.
+	ShipmentLabelFormatZPL = "zpl"
+)
+
+// ShipmentLabelRequest represent options of printable shipping label request.
+type ShipmentLabelRequest struct {
+	Format string `form:"format"`
+}
+
+// Prepare will prepare submitted data of shipping label request, PDF is printed by default.
+func (u *ShipmentLabelRequest) Prepare() {
+	u.Format = strings.ToLower(strings.TrimSpace(u.Format))
+	if u.Format == "" {
+		u.Format = ShipmentLabelFormatPDF
+	}
+}
+
+// ValidateShipmentLabelRequest will validate shipping label request.
+func (u *ShipmentLabelRequest) ValidateShipmentLabelRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("format", u.Format, validation.AddRule().In(ShipmentLabelFormatPDF, ShipmentLabelFormatZPL).Apply())
+	return validation.Validate()
+}
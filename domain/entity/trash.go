@@ -0,0 +1,116 @@
+package entity
+
+// TrashReference represent column of record referencing record of another table,
+// referenced record must exist when record is restored.
+type TrashReference struct {
+	Column string
+	Table  string
+}
+
+// TrashResource represent resource which soft-deleted records are listed, restored and purged.
+// Name of resource is a name of its table. Record is not purged when any of its retained columns
+// is filled or any record of dependents references it.
+type TrashResource struct {
+	Name       string
+	Model      func() interface{}
+	References []TrashReference
+	Retained   []string
+	Dependents []TrashReference
+}
+
+// TrashResources is a list of resources available in trash.
+var TrashResources = []*TrashResource{
+	{Name: "sities", Model: func() interface{} { return &Sity{} }},
+	{Name: "vehicles", Model: func() interface{} { return &Vehicle{} }},
+	{
+		Name:       "drivers",
+		Model:      func() interface{} { return &Driver{} },
+		References: []TrashReference{{Column: "user_uuid", Table: "users"}},
+	},
+	{
+		Name:  "routes",
+		Model: func() interface{} { return &Route{} },
+		References: []TrashReference{
+			{Column: "from_uuid", Table: "sities"},
+			{Column: "to_uuid", Table: "sities"},
+		},
+	},
+	{
+		Name:  "trips",
+		Model: func() interface{} { return &Trip{} },
+		References: []TrashReference{
+			{Column: "route_uuid", Table: "routes"},
+			{Column: "vehicle_uuid", Table: "vehicles"},
+			{Column: "regularity_type_uuid", Table: "regularity_types"},
+			{Column: "driver_uuid", Table: "drivers"},
+		},
+	},
+	{
+		Name:  "orders",
+		Model: func() interface{} { return &Order{} },
+		References: []TrashReference{
+			{Column: "trip_uuid", Table: "trips"},
+			{Column: "status_uuid", Table: "order_status_types"},
+		},
+	},
+	{
+		Name:  "payments",
+		Model: func() interface{} { return &Payment{} },
+		References: []TrashReference{
+			{Column: "trip_uuid", Table: "trips"},
+			{Column: "shipment_uuid", Table: "shipments"},
+		},
+		Retained:   []string{"fiscal_status", "payout_uuid"},
+		Dependents: []TrashReference{{Column: "payment_uuid", Table: "bank_statement_lines"}},
+	},
+	{
+		Name:  "passengers",
+		Model: func() interface{} { return &Passenger{} },
+		References: []TrashReference{
+			{Column: "document_type_uuid", Table: "document_types"},
+			{Column: "passenger_type_uuid", Table: "passenger_types"},
+		},
+	},
+	{Name: "passenger_types", Model: func() interface{} { return &PassengerType{} }},
+	{
+		Name:       "prices",
+		Model:      func() interface{} { return &Price{} },
+		References: []TrashReference{{Column: "passenger_type_uuid", Table: "passenger_types"}},
+	},
+	{Name: "document_types", Model: func() interface{} { return &DocumentType{} }},
+	{Name: "regularity_types", Model: func() interface{} { return &RegularityType{} }},
+	{Name: "order_status_types", Model: func() interface{} { return &OrderStatusType{} }},
+	{
+		Name:  "shipments",
+		Model: func() interface{} { return &Shipment{} },
+		References: []TrashReference{
+			{Column: "from_uuid", Table: "sities"},
+			{Column: "to_uuid", Table: "sities"},
+			{Column: "trip_uuid", Table: "trips"},
+			{Column: "cargo_tariff_uuid", Table: "cargo_tariffs"},
+		},
+	},
+	{
+		Name:       "cargo_tariffs",
+		Model:      func() interface{} { return &CargoTariff{} },
+		References: []TrashReference{{Column: "route_uuid", Table: "routes"}},
+	},
+}
+
+// GetTrashResource return resource of trash by name.
+func GetTrashResource(name string) (*TrashResource, bool) {
+	for _, resource := range TrashResources {
+		if resource.Name == name {
+			return resource, true
+		}
+	}
+	return nil, false
+}
+
+// FilterableFields return fields of resource, deleted records are filtered by uuid when model has no fields.
+func (u *TrashResource) FilterableFields() []interface{} {
+	if model, ok := u.Model().(interface{ FilterableFields() []interface{} }); ok {
+		return model.FilterableFields()
+	}
+	return []interface{}{"uuid"}
+}
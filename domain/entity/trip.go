@@ -27,6 +27,9 @@ type Trip struct {
 	DriverUUID         string         `json:"driver_uuid"`
 	Driver             Driver         `json:"driver"               gorm:"foreignKey:DriverUUID"`
 
+	// Warnings are violations of driver working-time rules accepted when rules only warn about them.
+	Warnings []DriverWorkViolation `json:"-" gorm:"-"`
+
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	DeletedAt gorm.DeletedAt
@@ -49,6 +52,7 @@ type Trips []*Trip
 // DetailTrip represent format of detail Trip.
 type DetailTrip struct {
 	TripFieldsForDetail
+	Warnings []DriverWorkViolation `json:"warnings,omitempty"`
 }
 
 // DetailTripList represent format of DetailTrip for Trip list.
@@ -133,6 +137,7 @@ func (u *Trip) DetailTrip() interface{} {
 			RegularityTypeUUID: u.RegularityTypeUUID,
 			DriverUUID:         u.DriverUUID,
 		},
+		Warnings: u.Warnings,
 	}
 }
 
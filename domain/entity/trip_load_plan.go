@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"time"
+)
+
+// TripLoadPlan represent load of luggage compartment of trip vehicle by shipments and passenger baggage.
+// Zero capacity means the limit is not configured and is not checked.
+type TripLoadPlan struct {
+	TripUUID       string    `json:"trip_uuid"`
+	RouteUUID      string    `json:"route_uuid"`
+	VehicleUUID    string    `json:"vehicle_uuid"`
+	DepartureTime  time.Time `json:"departure_time"`
+	CapacityWeight float64   `json:"capacity_weight"`
+	CapacityVolume float64   `json:"capacity_volume"`
+
+	ShipmentCount  int     `json:"shipment_count"`
+	ShipmentWeight float64 `json:"shipment_weight"`
+	ShipmentVolume float64 `json:"shipment_volume"`
+	OrderCount     int     `json:"order_count"`
+	BaggageWeight  float64 `json:"baggage_weight"`
+	BaggageVolume  float64 `json:"baggage_volume"`
+	TotalWeight    float64 `json:"total_weight"`
+	TotalVolume    float64 `json:"total_volume"`
+
+	RemainingWeight *float64 `json:"remaining_weight,omitempty"`
+	RemainingVolume *float64 `json:"remaining_volume,omitempty"`
+	Overloaded      bool     `json:"overloaded"`
+
+	RequestedWeight float64         `json:"requested_weight,omitempty" form:"weight"`
+	RequestedVolume float64         `json:"requested_volume,omitempty" form:"volume"`
+	Fits            bool            `json:"fits"`
+	Alternatives    []*TripLoadPlan `json:"alternatives,omitempty"`
+}
+
+// NewTripLoadPlan will calculate load plan of trip with preloaded vehicle.
+func NewTripLoadPlan(trip *Trip, shipments Shipments, orders Orders) *TripLoadPlan {
+	plan := &TripLoadPlan{
+		TripUUID:       trip.UUID,
+		RouteUUID:      trip.RouteUUID,
+		VehicleUUID:    trip.VehicleUUID,
+		DepartureTime:  trip.DepartureTime,
+		CapacityWeight: trip.Vehicle.LuggageWeight,
+		CapacityVolume: trip.Vehicle.LuggageVolume,
+		ShipmentCount:  len(shipments),
+		ShipmentWeight: roundWeight(shipments.TotalWeight()),
+		ShipmentVolume: roundVolume(shipments.TotalVolume()),
+		OrderCount:     len(orders),
+		BaggageWeight:  roundWeight(orders.TotalBaggageWeight()),
+		BaggageVolume:  roundVolume(orders.TotalBaggageVolume()),
+	}
+	plan.TotalWeight = roundWeight(plan.ShipmentWeight + plan.BaggageWeight)
+	plan.TotalVolume = roundVolume(plan.ShipmentVolume + plan.BaggageVolume)
+	if plan.CapacityWeight > 0 {
+		remaining := roundWeight(plan.CapacityWeight - plan.TotalWeight)
+		plan.RemainingWeight = &remaining
+	}
+	if plan.CapacityVolume > 0 {
+		remaining := roundVolume(plan.CapacityVolume - plan.TotalVolume)
+		plan.RemainingVolume = &remaining
+	}
+	plan.Overloaded = !plan.HasRoom(0, 0)
+	plan.Fits = !plan.Overloaded
+	return plan
+}
+
+// HasRoom will check whether additional weight (kg) and volume (m³) fit into the rest of luggage capacity.
+func (p *TripLoadPlan) HasRoom(weight float64, volume float64) bool {
+	vehicle := Vehicle{LuggageWeight: p.CapacityWeight, LuggageVolume: p.CapacityVolume}
+	return vehicle.FitsLuggage(p.TotalWeight+weight, p.TotalVolume+volume)
+}
+
+// roundWeight will round weight to hundredths of kilogram.
+func roundWeight(weight float64) float64 {
+	return roundTo(weight, 2)
+}
+
+// roundVolume will round volume to thousandths of cubic meter.
+func roundVolume(volume float64) float64 {
+	return roundTo(volume, 3)
+}
+
+// ValidateTripLoadPlan will validate requested load of trip load plan request.
+func (p *TripLoadPlan) ValidateTripLoadPlan() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("weight", p.RequestedWeight, validation.AddRule().MinValue(0.0).Apply()).
+		Set("volume", p.RequestedVolume, validation.AddRule().MinValue(0.0).Apply())
+	return validation.Validate()
+}
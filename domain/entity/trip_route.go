@@ -0,0 +1,244 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/routing"
+	"cargo-rest-api/pkg/validator"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTripRouteAverageSpeed is an average speed of vehicle in city in km/h used to derive ETAs.
+const DefaultTripRouteAverageSpeed = 30.0
+
+// TripRoute represent schema of table trip_routes.
+// Route is an optimized sequence of drop-off stops of trip shipments, the run starts at depot
+// at departure time of trip. Route is replaced each time the trip is optimized again.
+type TripRoute struct {
+	UUID           string         `json:"uuid,omitempty"  gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	TripUUID       string         `json:"trip_uuid"       gorm:"size:36;not null;uniqueIndex;"`
+	DepotLatitude  float64        `json:"depot_latitude"  form:"depot_latitude"`
+	DepotLongitude float64        `json:"depot_longitude" form:"depot_longitude"`
+	AverageSpeed   float64        `json:"average_speed"   form:"average_speed"`
+	StopDuration   int            `json:"stop_duration"   form:"stop_duration"`
+	StartTime      time.Time      `json:"start_time"`
+	FinishTime     time.Time      `json:"finish_time"`
+	TotalDistance  float64        `json:"total_distance"`
+	Stops          TripRouteStops `json:"stops"           gorm:"foreignKey:RouteUUID"`
+
+	SkippedShipments []string `json:"skipped_shipments" gorm:"-"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// TripRouteStop represent schema of table trip_route_stops.
+// Distance is measured from previous stop (or depot) in kilometers.
+type TripRouteStop struct {
+	UUID               string    `json:"uuid,omitempty"      gorm:"size:36;not null;uniqueIndex;primary_key;"`
+	RouteUUID          string    `json:"route_uuid"          gorm:"size:36;not null;index;"`
+	ShipmentUUID       string    `json:"shipment_uuid"       gorm:"size:36;not null;"`
+	Shipment           Shipment  `json:"shipment"            gorm:"foreignKey:ShipmentUUID"`
+	Sequence           int       `json:"sequence"`
+	Latitude           float64   `json:"latitude"`
+	Longitude          float64   `json:"longitude"`
+	Distance           float64   `json:"distance"`
+	CumulativeDistance float64   `json:"cumulative_distance"`
+	ETA                time.Time `json:"eta"`
+}
+
+// TripRouteStops represent multiple TripRouteStop.
+type TripRouteStops []*TripRouteStop
+
+// TripRoutes represent multiple TripRoute.
+type TripRoutes []*TripRoute
+
+// DetailTripRoute represent format of detail TripRoute.
+type DetailTripRoute struct {
+	UUID             string                `json:"uuid"`
+	TripUUID         string                `json:"trip_uuid"`
+	DepotLatitude    float64               `json:"depot_latitude"`
+	DepotLongitude   float64               `json:"depot_longitude"`
+	AverageSpeed     float64               `json:"average_speed"`
+	StopDuration     int                   `json:"stop_duration"`
+	StartTime        time.Time             `json:"start_time"`
+	FinishTime       time.Time             `json:"finish_time"`
+	TotalDistance    float64               `json:"total_distance"`
+	Stops            []DetailTripRouteStop `json:"stops"`
+	SkippedShipments []string              `json:"skipped_shipments,omitempty"`
+	CreatedAt        time.Time             `json:"created_at"`
+}
+
+// DetailTripRouteStop represent format of detail TripRouteStop with data driver needs to hand over shipment.
+type DetailTripRouteStop struct {
+	Sequence           int       `json:"sequence"`
+	ShipmentUUID       string    `json:"shipment_uuid"`
+	TrackingNumber     string    `json:"tracking_number"`
+	RecipientName      string    `json:"recipient_name"`
+	RecipientPhone     string    `json:"recipient_phone"`
+	Address            string    `json:"address"`
+	Latitude           float64   `json:"latitude"`
+	Longitude          float64   `json:"longitude"`
+	Distance           float64   `json:"distance"`
+	CumulativeDistance float64   `json:"cumulative_distance"`
+	ETA                time.Time `json:"eta"`
+	CODAmount          float64   `json:"cod_amount"`
+	Status             string    `json:"status"`
+}
+
+// TableName return name of table.
+func (u *TripRoute) TableName() string {
+	return "trip_routes"
+}
+
+// TableName return name of table.
+func (u *TripRouteStop) TableName() string {
+	return "trip_route_stops"
+}
+
+// BeforeCreate handle uuid generation.
+func (u *TripRoute) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// BeforeCreate handle uuid generation.
+func (u *TripRouteStop) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// Prepare will set defaults of submitted trip route request.
+func (u *TripRoute) Prepare() {
+	if u.AverageSpeed == 0 {
+		u.AverageSpeed = DefaultTripRouteAverageSpeed
+	}
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// ValidateSaveTripRoute will validate optimize trip route request.
+func (u *TripRoute) ValidateSaveTripRoute() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("depot_latitude", u.DepotLatitude, validation.AddRule().Required().MinValue(-90.0).MaxValue(90.0).Apply()).
+		Set("depot_longitude", u.DepotLongitude, validation.AddRule().Required().MinValue(-180.0).MaxValue(180.0).Apply()).
+		Set("average_speed", u.AverageSpeed, validation.AddRule().MinValue(1.0).MaxValue(150.0).Apply()).
+		Set("stop_duration", u.StopDuration, validation.AddRule().MinValue(0).MaxValue(240).Apply())
+	return validation.Validate()
+}
+
+// Plan will order shipments with known destination into stops starting at depot at start time.
+// Shipments without destination coordinates are left out of the route and listed as skipped.
+func (u *TripRoute) Plan(shipments Shipments) {
+	depot := routing.Point{Latitude: u.DepotLatitude, Longitude: u.DepotLongitude}
+	routed := Shipments{}
+	points := []routing.Point{}
+	u.SkippedShipments = []string{}
+	for _, shipment := range shipments {
+		if !shipment.HasDestination() {
+			u.SkippedShipments = append(u.SkippedShipments, shipment.UUID)
+			continue
+		}
+		routed = append(routed, shipment)
+		points = append(points, routing.Point{
+			Latitude:  shipment.DestinationLatitude,
+			Longitude: shipment.DestinationLongitude,
+		})
+	}
+
+	stopDuration := time.Duration(u.StopDuration) * time.Minute
+	previous := depot
+	eta := u.StartTime
+	var total float64
+	u.Stops = TripRouteStops{}
+	for sequence, index := range routing.Optimize(depot, points) {
+		distance := routing.Distance(previous, points[index])
+		total += distance
+		if sequence > 0 {
+			eta = eta.Add(stopDuration)
+		}
+		eta = eta.Add(time.Duration(distance / u.AverageSpeed * float64(time.Hour))).Truncate(time.Second)
+		u.Stops = append(u.Stops, &TripRouteStop{
+			ShipmentUUID:       routed[index].UUID,
+			Shipment:           *routed[index],
+			Sequence:           sequence + 1,
+			Latitude:           points[index].Latitude,
+			Longitude:          points[index].Longitude,
+			Distance:           roundDistance(distance),
+			CumulativeDistance: roundDistance(total),
+			ETA:                eta,
+		})
+		previous = points[index]
+	}
+	u.TotalDistance = roundDistance(total)
+	u.FinishTime = eta
+	if len(u.Stops) > 0 {
+		u.FinishTime = eta.Add(stopDuration)
+	}
+}
+
+// roundDistance will round distance to tens of meters.
+func roundDistance(distance float64) float64 {
+	return math.Round(distance*100) / 100
+}
+
+// DetailTripRoute will return formatted trip route with its stops.
+func (u *TripRoute) DetailTripRoute() interface{} {
+	stops := make([]DetailTripRouteStop, len(u.Stops))
+	for index, stop := range u.Stops {
+		stops[index] = stop.DetailTripRouteStop()
+	}
+	return &DetailTripRoute{
+		UUID:             u.UUID,
+		TripUUID:         u.TripUUID,
+		DepotLatitude:    u.DepotLatitude,
+		DepotLongitude:   u.DepotLongitude,
+		AverageSpeed:     u.AverageSpeed,
+		StopDuration:     u.StopDuration,
+		StartTime:        u.StartTime,
+		FinishTime:       u.FinishTime,
+		TotalDistance:    u.TotalDistance,
+		Stops:            stops,
+		SkippedShipments: u.SkippedShipments,
+		CreatedAt:        u.CreatedAt,
+	}
+}
+
+// DetailTripRouteStop will return formatted stop of trip route.
+func (u *TripRouteStop) DetailTripRouteStop() DetailTripRouteStop {
+	return DetailTripRouteStop{
+		Sequence:           u.Sequence,
+		ShipmentUUID:       u.ShipmentUUID,
+		TrackingNumber:     u.Shipment.TrackingNumber,
+		RecipientName:      u.Shipment.RecipientName,
+		RecipientPhone:     u.Shipment.RecipientPhone,
+		Address:            u.Shipment.DestinationAddress,
+		Latitude:           u.Latitude,
+		Longitude:          u.Longitude,
+		Distance:           u.Distance,
+		CumulativeDistance: u.CumulativeDistance,
+		ETA:                u.ETA,
+		CODAmount:          u.Shipment.CODAmount,
+		Status:             u.Shipment.Status,
+	}
+}
+
+// DetailTripRoutes will return formatted trip route detail of multiple trip route.
+func (routes TripRoutes) DetailTripRoutes() []interface{} {
+	result := make([]interface{}, len(routes))
+	for index, route := range routes {
+		result[index] = route.DetailTripRoute()
+	}
+	return result
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"cargo-rest-api/pkg/etag"
 	"cargo-rest-api/pkg/response"
 	"cargo-rest-api/pkg/security"
 	"cargo-rest-api/pkg/validator"
@@ -79,6 +80,11 @@ func (u *User) TableName() string {
 	return "users"
 }
 
+// Version return version of user, it is compared with version sent in GraphQL mutations.
+func (u *User) Version() string {
+	return etag.Version(u.UpdatedAt)
+}
+
 // FilterableFields return fields.
 func (u *User) FilterableFields() []interface{} {
 	return []interface{}{"uuid", "name", "email", "phone"}
@@ -19,6 +19,8 @@ type Vehicle struct {
 	Model         string    `gorm:"size:100;not null;" json:"model" form:"model"`
 	NumberOfSeats int       `gorm:"default:0" json:"number_of_seats" form:"number_of_seats"`
 	Class         string    `gorm:"size:100;" json:"class" form:"class"`
+	LuggageWeight float64   `gorm:"default:0" json:"luggage_weight" form:"luggage_weight"`
+	LuggageVolume float64   `gorm:"default:0" json:"luggage_volume" form:"luggage_volume"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt
@@ -26,11 +28,13 @@ type Vehicle struct {
 
 // VehicleFaker represent content when generate fake data of vehicle.
 type VehicleFaker struct {
-	UUID          string `faker:"uuid_hyphenated"`
-	Model         string `faker:"model"`
-	RegCode       string `faker:"reg_code"`
-	NumberOfSeats int    `faker:"number_of_seats"`
-	Class         string `faker:"class"`
+	UUID          string  `faker:"uuid_hyphenated"`
+	Model         string  `faker:"model"`
+	RegCode       string  `faker:"reg_code"`
+	NumberOfSeats int     `faker:"number_of_seats"`
+	Class         string  `faker:"class"`
+	LuggageWeight float64 `faker:"luggage_weight"`
+	LuggageVolume float64 `faker:"luggage_volume"`
 }
 
 // Vehicles represent multiple Vehicle.
@@ -49,11 +53,13 @@ type DetailVehicleList struct {
 
 // VehicleFieldsForDetail represent fields of detail Vehicle.
 type VehicleFieldsForDetail struct {
-	UUID          string `json:"uuid"`
-	Model         string `json:"model"`
-	RegCode       string `json:"reg_code"`
-	NumberOfSeats int    `json:"number_of_seats"`
-	Class         string `json:"class"`
+	UUID          string  `json:"uuid"`
+	Model         string  `json:"model"`
+	RegCode       string  `json:"reg_code"`
+	NumberOfSeats int     `json:"number_of_seats"`
+	Class         string  `json:"class"`
+	LuggageWeight float64 `json:"luggage_weight"`
+	LuggageVolume float64 `json:"luggage_volume"`
 }
 
 // VehicleFieldsForList represent fields of detail Vehicle for Vehicle list.
@@ -68,7 +74,7 @@ func (u *Vehicle) TableName() string {
 
 // FilterableFields return fields.
 func (u *Vehicle) FilterableFields() []interface{} {
-	return []interface{}{"uuid", "model", "reg_code", "number_of_seats", "class"}
+	return []interface{}{"uuid", "model", "reg_code", "number_of_seats", "class", "luggage_weight", "luggage_volume"}
 }
 
 // Prepare will prepare submitted data of vehicle.
@@ -117,6 +123,8 @@ func (u *Vehicle) DetailVehicle() interface{} {
 			RegCode:       u.RegCode,
 			NumberOfSeats: u.NumberOfSeats,
 			Class:         u.Class,
+			LuggageWeight: u.LuggageWeight,
+			LuggageVolume: u.LuggageVolume,
 		},
 	}
 }
@@ -130,6 +138,8 @@ func (u *Vehicle) DetailVehicleList() interface{} {
 			RegCode:       u.RegCode,
 			NumberOfSeats: u.NumberOfSeats,
 			Class:         u.Class,
+			LuggageWeight: u.LuggageWeight,
+			LuggageVolume: u.LuggageVolume,
 		},
 		VehicleFieldsForList: VehicleFieldsForList{
 			CreatedAt: u.CreatedAt,
@@ -137,6 +147,23 @@ func (u *Vehicle) DetailVehicleList() interface{} {
 	}
 }
 
+// HasLuggageCapacity will return true when at least one of luggage capacity limits is configured.
+func (u *Vehicle) HasLuggageCapacity() bool {
+	return u.LuggageWeight > 0 || u.LuggageVolume > 0
+}
+
+// FitsLuggage will check whether weight (kg) and volume (m³) fit into vehicle luggage capacity.
+// Zero capacity means that the limit is not configured and is not checked.
+func (u *Vehicle) FitsLuggage(weight float64, volume float64) bool {
+	if u.LuggageWeight > 0 && weight > u.LuggageWeight {
+		return false
+	}
+	if u.LuggageVolume > 0 && volume > u.LuggageVolume {
+		return false
+	}
+	return true
+}
+
 // ValidateSaveVehicle will validate create a new vehicle request.
 func (u *Vehicle) ValidateSaveVehicle() []response.ErrorForm {
 	validation := validator.New()
@@ -144,7 +171,9 @@ func (u *Vehicle) ValidateSaveVehicle() []response.ErrorForm {
 		Set("model", u.Model, validation.AddRule().Required().IsAlphaNumericSpaceAndSpecialCharacter().Length(3, 64).Apply()).
 		Set("reg_code", u.RegCode, validation.AddRule().Required().IsAlphaNumericSpaceAndSpecialCharacter().Length(3, 64).Apply()).
 		Set("number_of_seats", u.NumberOfSeats, validation.AddRule().Required().Apply()).
-		Set("class", u.Class, validation.AddRule().Required().IsAlphaSpace().Length(3, 64).Apply())
+		Set("class", u.Class, validation.AddRule().Required().IsAlphaSpace().Length(3, 64).Apply()).
+		Set("luggage_weight", u.LuggageWeight, validation.AddRule().MinValue(0.0).Apply()).
+		Set("luggage_volume", u.LuggageVolume, validation.AddRule().MinValue(0.0).Apply())
 	return validation.Validate()
 }
 
@@ -155,6 +184,8 @@ func (u *Vehicle) ValidateUpdateVehicle() []response.ErrorForm {
 		Set("model", u.Model, validation.AddRule().Required().IsAlphaNumericSpaceAndSpecialCharacter().Length(3, 64).Apply()).
 		Set("reg_code", u.RegCode, validation.AddRule().Required().IsAlphaNumericSpaceAndSpecialCharacter().Length(3, 64).Apply()).
 		Set("number_of_seats", u.NumberOfSeats, validation.AddRule().Required().Apply()).
-		Set("class", u.Class, validation.AddRule().Required().IsAlphaSpace().Length(3, 64).Apply())
+		Set("class", u.Class, validation.AddRule().Required().IsAlphaSpace().Length(3, 64).Apply()).
+		Set("luggage_weight", u.LuggageWeight, validation.AddRule().MinValue(0.0).Apply()).
+		Set("luggage_volume", u.LuggageVolume, validation.AddRule().MinValue(0.0).Apply())
 	return validation.Validate()
 }
@@ -0,0 +1,260 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"html"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// VehicleComplianceInsurance is a compulsory motor third party liability insurance (OSAGO).
+	VehicleComplianceInsurance = "insurance"
+
+	// VehicleComplianceInspection is a technical inspection of vehicle.
+	VehicleComplianceInspection = "inspection"
+
+	// VehicleComplianceTachograph is a calibration of tachograph.
+	VehicleComplianceTachograph = "tachograph"
+
+	// VehicleComplianceMaintenance is a maintenance window, vehicle is out of service in this period.
+	VehicleComplianceMaintenance = "maintenance"
+
+	// VehicleComplianceDateLayout is a layout of dates of compliance record.
+	VehicleComplianceDateLayout = "2006-01-02"
+
+	// DefaultVehicleComplianceReminderDays is a number of days before expiry when reminder is sent.
+	DefaultVehicleComplianceReminderDays = 30
+)
+
+// VehicleComplianceRequired are types of documents every vehicle must have to make a trip.
+// Tachograph is checked only for vehicles it is recorded for, since not every vehicle is equipped with it.
+var VehicleComplianceRequired = []string{VehicleComplianceInsurance, VehicleComplianceInspection}
+
+// VehicleCompliance represent schema of table vehicle_compliances.
+// For documents ValidFrom and ValidUntil is a validity period, for maintenance it is a window when the vehicle
+// is out of service. Both dates are inclusive.
+type VehicleCompliance struct {
+	UUID        string     `json:"uuid,omitempty" gorm:"size:36;not null;uniqueIndex;primary_key;" form:"-"`
+	VehicleUUID string     `json:"vehicle_uuid"   gorm:"size:36;not null;index;"                   form:"vehicle_uuid"`
+	Vehicle     Vehicle    `json:"vehicle"        gorm:"foreignKey:VehicleUUID"                    form:"-"`
+	Type        string     `json:"type"           gorm:"size:20;not null;index;"                   form:"type"`
+	Number      string     `json:"number"         gorm:"size:100;"                                 form:"number"`
+	ValidFrom   time.Time  `json:"valid_from"                                                      form:"valid_from"  time_format:"2006-01-02"`
+	ValidUntil  time.Time  `json:"valid_until"    gorm:"index;"                                    form:"valid_until" time_format:"2006-01-02"`
+	FileUUID    string     `json:"file_uuid"      gorm:"size:36;"                                  form:"-"`
+	Note        string     `json:"note"           gorm:"size:255;"                                 form:"note"`
+	RemindedAt  *time.Time `json:"reminded_at"                                                     form:"-"`
+
+	CreatedAt time.Time      `json:"created_at,omitempty" form:"-"`
+	UpdatedAt time.Time      `json:"updated_at,omitempty" form:"-"`
+	DeletedAt gorm.DeletedAt `                            form:"-"`
+}
+
+// VehicleCompliances represent multiple VehicleCompliance.
+type VehicleCompliances []*VehicleCompliance
+
+// DetailVehicleCompliance represent format of detail VehicleCompliance with link to download scan.
+type DetailVehicleCompliance struct {
+	VehicleComplianceFieldsForDetail
+	FileURL interface{} `json:"file_url,omitempty"`
+}
+
+// DetailVehicleComplianceList represent format of DetailVehicleCompliance for VehicleCompliance list.
+type DetailVehicleComplianceList struct {
+	VehicleComplianceFieldsForDetail
+	VehicleComplianceFieldsForList
+}
+
+// VehicleComplianceFieldsForDetail represent fields of detail VehicleCompliance.
+type VehicleComplianceFieldsForDetail struct {
+	UUID        string     `json:"uuid"`
+	VehicleUUID string     `json:"vehicle_uuid"`
+	Type        string     `json:"type"`
+	Number      string     `json:"number"`
+	ValidFrom   time.Time  `json:"valid_from"`
+	ValidUntil  time.Time  `json:"valid_until"`
+	FileUUID    string     `json:"file_uuid,omitempty"`
+	Note        string     `json:"note"`
+	RemindedAt  *time.Time `json:"reminded_at,omitempty"`
+}
+
+// VehicleComplianceFieldsForList represent fields of detail VehicleCompliance for VehicleCompliance list.
+type VehicleComplianceFieldsForList struct {
+	RegCode   string    `json:"reg_code"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// VehicleComplianceExpiringRequest represent request of compliance records expiring soon.
+type VehicleComplianceExpiringRequest struct {
+	Days int `form:"days"`
+}
+
+// TableName return name of table.
+func (u *VehicleCompliance) TableName() string {
+	return "vehicle_compliances"
+}
+
+// FilterableFields return fields.
+func (u *VehicleCompliance) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "vehicle_uuid", "type", "number", "valid_from", "valid_until"}
+}
+
+// BeforeCreate handle uuid generation.
+func (u *VehicleCompliance) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// Prepare will prepare submitted data of vehicle compliance.
+func (u *VehicleCompliance) Prepare() {
+	u.VehicleUUID = html.EscapeString(strings.TrimSpace(u.VehicleUUID))
+	u.Type = strings.ToLower(strings.TrimSpace(u.Type))
+	u.Number = html.EscapeString(strings.TrimSpace(u.Number))
+	u.Note = html.EscapeString(strings.TrimSpace(u.Note))
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// IsMaintenance will check whether record is a maintenance window rather than a document.
+func (u *VehicleCompliance) IsMaintenance() bool {
+	return u.Type == VehicleComplianceMaintenance
+}
+
+// Covers will check whether document is valid during the whole period.
+func (u *VehicleCompliance) Covers(from time.Time, to time.Time) bool {
+	return (u.ValidFrom.IsZero() || !u.ValidFrom.After(from)) && u.end().After(to)
+}
+
+// Overlaps will check whether maintenance window intersects the period.
+func (u *VehicleCompliance) Overlaps(from time.Time, to time.Time) bool {
+	return !u.ValidFrom.After(to) && u.end().After(from)
+}
+
+// end return moment the record stops being valid, valid until date is inclusive.
+func (u *VehicleCompliance) end() time.Time {
+	return u.ValidUntil.AddDate(0, 0, 1)
+}
+
+// Blocking return record which forbids vehicle to make a trip in the period, nil means the vehicle is allowed.
+// Maintenance window must not intersect the period, and required documents together with other types of documents
+// known for the vehicle must have a document valid during the whole period.
+// Missing required document is returned as a record without UUID.
+func (records VehicleCompliances) Blocking(from time.Time, to time.Time) *VehicleCompliance {
+	latest := map[string]*VehicleCompliance{}
+	covered := map[string]bool{}
+	for _, record := range records {
+		if record.IsMaintenance() {
+			if record.Overlaps(from, to) {
+				return record
+			}
+			continue
+		}
+		if record.Covers(from, to) {
+			covered[record.Type] = true
+		}
+		if latest[record.Type] == nil || record.ValidUntil.After(latest[record.Type].ValidUntil) {
+			latest[record.Type] = record
+		}
+	}
+	for _, documentType := range []string{
+		VehicleComplianceInsurance,
+		VehicleComplianceInspection,
+		VehicleComplianceTachograph,
+	} {
+		if covered[documentType] {
+			continue
+		}
+		if latest[documentType] != nil {
+			return latest[documentType]
+		}
+		for _, requiredType := range VehicleComplianceRequired {
+			if documentType == requiredType {
+				return &VehicleCompliance{Type: documentType}
+			}
+		}
+	}
+	return nil
+}
+
+// DetailVehicleCompliances will return formatted compliance detail of multiple compliance record.
+func (records VehicleCompliances) DetailVehicleCompliances() []interface{} {
+	result := make([]interface{}, len(records))
+	for index, record := range records {
+		result[index] = record.DetailVehicleComplianceList()
+	}
+	return result
+}
+
+// vehicleComplianceFieldsForDetail will return shared fields of compliance detail.
+func (u *VehicleCompliance) vehicleComplianceFieldsForDetail() VehicleComplianceFieldsForDetail {
+	return VehicleComplianceFieldsForDetail{
+		UUID:        u.UUID,
+		VehicleUUID: u.VehicleUUID,
+		Type:        u.Type,
+		Number:      u.Number,
+		ValidFrom:   u.ValidFrom,
+		ValidUntil:  u.ValidUntil,
+		FileUUID:    u.FileUUID,
+		Note:        u.Note,
+		RemindedAt:  u.RemindedAt,
+	}
+}
+
+// DetailVehicleCompliance will return formatted compliance detail with signed URL of scan.
+func (u *VehicleCompliance) DetailVehicleCompliance(fileURL interface{}) interface{} {
+	return &DetailVehicleCompliance{
+		VehicleComplianceFieldsForDetail: u.vehicleComplianceFieldsForDetail(),
+		FileURL:                          fileURL,
+	}
+}
+
+// DetailVehicleComplianceList will return formatted compliance detail for compliance list.
+func (u *VehicleCompliance) DetailVehicleComplianceList() interface{} {
+	return &DetailVehicleComplianceList{
+		VehicleComplianceFieldsForDetail: u.vehicleComplianceFieldsForDetail(),
+		VehicleComplianceFieldsForList: VehicleComplianceFieldsForList{
+			RegCode:   u.Vehicle.RegCode,
+			CreatedAt: u.CreatedAt,
+		},
+	}
+}
+
+// ValidateSaveVehicleCompliance will validate create a new vehicle compliance request.
+func (u *VehicleCompliance) ValidateSaveVehicleCompliance() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("vehicle_uuid", u.VehicleUUID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("type", u.Type, validation.AddRule().Required().In(
+			VehicleComplianceInsurance,
+			VehicleComplianceInspection,
+			VehicleComplianceTachograph,
+			VehicleComplianceMaintenance,
+		).Apply()).
+		Set("number", u.Number, validation.AddRule().Length(0, 100).Apply()).
+		Set("valid_from", u.ValidFrom, validation.AddRule().When(u.IsMaintenance(), validation.AddRule().Required()).Apply()).
+		Set("valid_until", u.ValidUntil, validation.AddRule().Required().Apply()).
+		Set("note", u.Note, validation.AddRule().Length(0, 255).Apply())
+	return validation.Validate()
+}
+
+// ValidateUpdateVehicleCompliance will validate update vehicle compliance request.
+func (u *VehicleCompliance) ValidateUpdateVehicleCompliance() []response.ErrorForm {
+	return u.ValidateSaveVehicleCompliance()
+}
+
+// ValidateVehicleComplianceExpiringRequest will validate request of compliance records expiring soon.
+func (u *VehicleComplianceExpiringRequest) ValidateVehicleComplianceExpiringRequest() []response.ErrorForm {
+	validation := validator.New()
+	validation.
+		Set("days", u.Days, validation.AddRule().MinValue(0).MaxValue(365).Apply())
+	return validation.Validate()
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVehicleCompliances_Blocking(t *testing.T) {
+	from := time.Date(2021, 3, 10, 8, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 3, 10, 18, 0, 0, 0, time.UTC)
+	document := func(uuid string, documentType string, validUntil time.Time) *entity.VehicleCompliance {
+		return &entity.VehicleCompliance{
+			UUID:       uuid,
+			Type:       documentType,
+			ValidFrom:  from.AddDate(-1, 0, 0),
+			ValidUntil: validUntil,
+		}
+	}
+	insurance := document("insurance", entity.VehicleComplianceInsurance, from.AddDate(0, 6, 0))
+	inspection := document("inspection", entity.VehicleComplianceInspection, from.AddDate(0, 6, 0))
+	expiredTachograph := document("tachograph", entity.VehicleComplianceTachograph, from.AddDate(0, 0, -1))
+
+	assert.Nil(t, entity.VehicleCompliances{insurance, inspection}.Blocking(from, to))
+	assert.Equal(t, expiredTachograph, entity.VehicleCompliances{insurance, inspection, expiredTachograph}.Blocking(from, to))
+
+	missing := entity.VehicleCompliances{insurance}.Blocking(from, to)
+	assert.Empty(t, missing.UUID)
+	assert.Equal(t, entity.VehicleComplianceInspection, missing.Type)
+
+	maintenance := &entity.VehicleCompliance{UUID: "maintenance", Type: entity.VehicleComplianceMaintenance, ValidFrom: from, ValidUntil: from}
+	assert.Equal(t, maintenance, entity.VehicleCompliances{insurance, inspection, maintenance}.Blocking(from, to))
+}
@@ -0,0 +1,468 @@
+package entity
+
+import (
+	"cargo-rest-api/pkg/outbox"
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/validator"
+	"cargo-rest-api/pkg/webhook"
+	"encoding/json"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// WebhookEventOrderCreated is an event of created order.
+	WebhookEventOrderCreated = "order.created"
+
+	// WebhookEventOrderStatusChanged is an event of changed status of order.
+	WebhookEventOrderStatusChanged = "order.status_changed"
+
+	// WebhookEventPaymentSucceeded is an event of received payment of orders.
+	WebhookEventPaymentSucceeded = "payment.succeeded"
+
+	// WebhookEventTripDelayed is an event of trip which departure or arrival is moved later.
+	WebhookEventTripDelayed = "trip.delayed"
+
+	// WebhookEventAll is a subscription to every event.
+	WebhookEventAll = "*"
+
+	// WebhookDeliveryStatusPending is a status of delivery waiting to be sent.
+	WebhookDeliveryStatusPending = "pending"
+
+	// WebhookDeliveryStatusSucceeded is a status of delivery accepted by subscriber.
+	WebhookDeliveryStatusSucceeded = "succeeded"
+
+	// WebhookDeliveryStatusFailed is a status of delivery not accepted after all attempts.
+	WebhookDeliveryStatusFailed = "failed"
+
+	// DefaultWebhookMaxAttempts is a default number of attempts to deliver event.
+	DefaultWebhookMaxAttempts = 8
+
+	// WebhookDeliveryBatchSize is a number of deliveries sent at once.
+	WebhookDeliveryBatchSize = 50
+
+	// WebhookBackoffBase is a delay before the second attempt, delay doubles after every failed attempt.
+	WebhookBackoffBase = time.Minute
+
+	// WebhookBackoffMax is a max delay between attempts.
+	WebhookBackoffMax = 6 * time.Hour
+
+	// WebhookLockDuration is a time delivery claimed by worker is hidden from other workers,
+	// delivery of worker stopped while sending is claimed again after it.
+	WebhookLockDuration = 5 * time.Minute
+
+	webhookEventSeparator = ","
+	webhookErrorLength    = 255
+)
+
+// WebhookEvents is a list of events available for subscription.
+var WebhookEvents = []string{
+	WebhookEventOrderCreated,
+	WebhookEventOrderStatusChanged,
+	WebhookEventPaymentSucceeded,
+	WebhookEventTripDelayed,
+}
+
+// WebhookSubscription represent schema of table webhook_subscriptions.
+// Subscription belongs to application and receives events listed in Events, secret signs every delivery.
+type WebhookSubscription struct {
+	UUID            string         `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid,omitempty"`
+	ApplicationUUID string         `gorm:"size:36;not null;index;"                   json:"application_uuid" form:"application_uuid"`
+	URL             string         `gorm:"size:255;not null;"                        json:"url"              form:"url"`
+	Events          []string       `gorm:"-"                                         json:"events"           form:"events"`
+	EventTypes      string         `gorm:"column:events;size:255;not null;"          json:"-"`
+	Secret          string         `gorm:"size:64;not null;"                         json:"-"`
+	Comment         string         `gorm:"size:255;"                                 json:"comment"          form:"comment"`
+	Disabled        bool           `gorm:"default:false"                             json:"disabled"         form:"disabled"`
+	CreatedAt       time.Time      `                                                 json:"created_at,omitempty"`
+	UpdatedAt       time.Time      `                                                 json:"updated_at,omitempty"`
+	DeletedAt       gorm.DeletedAt `                                                 json:"deleted_at,omitempty"`
+}
+
+// WebhookDelivery represent schema of table webhook_deliveries.
+// Delivery is an event sent to subscription, replay of delivery is a new delivery of the same event.
+type WebhookDelivery struct {
+	UUID             string              `gorm:"size:36;not null;uniqueIndex;primary_key;" json:"uuid"`
+	SubscriptionUUID string              `gorm:"size:36;not null;index;"                   json:"subscription_uuid"`
+	Subscription     WebhookSubscription `gorm:"foreignKey:SubscriptionUUID"               json:"-"`
+	EventID          string              `gorm:"size:36;not null;index;"                   json:"event_id"`
+	Event            string              `gorm:"size:50;not null;"                         json:"event"`
+	Payload          string              `gorm:"type:text;"                                json:"payload"`
+	Status           string              `gorm:"size:20;not null;index;"                   json:"status"`
+	Attempts         int                 `gorm:"default:0"                                 json:"attempts"`
+	ResponseStatus   int                 `gorm:"default:0"                                 json:"response_status"`
+	ResponseBody     string              `gorm:"type:text;"                                json:"response_body"`
+	Error            string              `gorm:"size:255;"                                 json:"error"`
+	NextAttemptAt    *time.Time          `gorm:"index;"                                    json:"next_attempt_at"`
+	LockedUntil      *time.Time          `gorm:"index;"                                    json:"-"`
+	DeliveredAt      *time.Time          `                                                 json:"delivered_at"`
+	ReplayOf         string              `gorm:"size:36;"                                  json:"replay_of"`
+	CreatedAt        time.Time           `                                                 json:"created_at"`
+	UpdatedAt        time.Time           `                                                 json:"updated_at"`
+}
+
+// WebhookEvent represent domain event, payload of event is the same for every subscription.
+type WebhookEvent struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"event"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookOrderStatusChanged represent data of order.status_changed event.
+type WebhookOrderStatusChanged struct {
+	Order          interface{} `json:"order"`
+	PreviousStatus string      `json:"previous_status_uuid"`
+}
+
+// WebhookTripDelayed represent data of trip.delayed event, delays are in minutes.
+type WebhookTripDelayed struct {
+	Trip              interface{} `json:"trip"`
+	PreviousDeparture time.Time   `json:"previous_departure_time"`
+	PreviousArrival   time.Time   `json:"previous_arravial_tive"`
+	DepartureDelay    int         `json:"departure_delay"`
+	ArrivalDelay      int         `json:"arrival_delay"`
+}
+
+// WebhookSubscriptions represent multiple WebhookSubscription.
+type WebhookSubscriptions []*WebhookSubscription
+
+// WebhookDeliveries represent multiple WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
+
+// DetailWebhookSubscription represent format of detail WebhookSubscription.
+type DetailWebhookSubscription struct {
+	WebhookSubscriptionFieldsForDetail
+	WebhookSubscriptionFieldsForList
+}
+
+// DetailWebhookSubscriptionSecret represent format of created WebhookSubscription, secret is shown once.
+type DetailWebhookSubscriptionSecret struct {
+	WebhookSubscriptionFieldsForDetail
+	Secret string `json:"secret"`
+}
+
+// WebhookSubscriptionFieldsForDetail represent fields of detail WebhookSubscription.
+type WebhookSubscriptionFieldsForDetail struct {
+	UUID            string   `json:"uuid"`
+	ApplicationUUID string   `json:"application_uuid"`
+	URL             string   `json:"url"`
+	Events          []string `json:"events"`
+	Comment         string   `json:"comment"`
+	Disabled        bool     `json:"disabled"`
+}
+
+// WebhookSubscriptionFieldsForList represent fields of detail WebhookSubscription for WebhookSubscription list.
+type WebhookSubscriptionFieldsForList struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DetailWebhookDelivery represent format of detail WebhookDelivery.
+type DetailWebhookDelivery struct {
+	UUID             string     `json:"uuid"`
+	SubscriptionUUID string     `json:"subscription_uuid"`
+	EventID          string     `json:"event_id"`
+	Event            string     `json:"event"`
+	Payload          string     `json:"payload"`
+	Status           string     `json:"status"`
+	Attempts         int        `json:"attempts"`
+	ResponseStatus   int        `json:"response_status"`
+	ResponseBody     string     `json:"response_body"`
+	Error            string     `json:"error"`
+	NextAttemptAt    *time.Time `json:"next_attempt_at"`
+	DeliveredAt      *time.Time `json:"delivered_at"`
+	ReplayOf         string     `json:"replay_of"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// TableName return name of table.
+func (u *WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// TableName return name of table.
+func (u *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// FilterableFields return fields.
+func (u *WebhookSubscription) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "application_uuid", "url", "disabled"}
+}
+
+// FilterableFields return fields.
+func (u *WebhookDelivery) FilterableFields() []interface{} {
+	return []interface{}{"uuid", "event_id", "event", "status"}
+}
+
+// Prepare will prepare submitted data of webhook subscription.
+func (u *WebhookSubscription) Prepare() {
+	u.ApplicationUUID = html.EscapeString(strings.TrimSpace(u.ApplicationUUID))
+	u.URL = strings.TrimSpace(u.URL)
+	u.Comment = html.EscapeString(strings.TrimSpace(u.Comment))
+	events := make([]string, 0, len(u.Events))
+	for _, event := range u.Events {
+		if event = strings.TrimSpace(event); event != "" {
+			events = append(events, event)
+		}
+	}
+	u.Events = events
+	u.EventTypes = strings.Join(events, webhookEventSeparator)
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+}
+
+// BeforeCreate handle uuid and secret generation.
+func (u *WebhookSubscription) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	if u.Secret == "" {
+		secret, err := webhook.NewSecret()
+		if err != nil {
+			return err
+		}
+		u.Secret = secret
+	}
+	return nil
+}
+
+// AfterFind handle split of stored events.
+func (u *WebhookSubscription) AfterFind(tx *gorm.DB) error {
+	u.Events = nil
+	if u.EventTypes != "" {
+		u.Events = strings.Split(u.EventTypes, webhookEventSeparator)
+	}
+	return nil
+}
+
+// BeforeCreate handle uuid generation.
+func (u *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	generateUUID := uuid.New()
+	if u.UUID == "" {
+		u.UUID = generateUUID.String()
+	}
+	return nil
+}
+
+// Subscribed return true when enabled subscription receives event.
+func (u *WebhookSubscription) Subscribed(event string) bool {
+	if u.Disabled {
+		return false
+	}
+	for _, subscribed := range u.Events {
+		if subscribed == event || subscribed == WebhookEventAll {
+			return true
+		}
+	}
+	return false
+}
+
+// NewWebhookEventFromMessage will construct event of message published by outbox, ID of message is ID of event
+// so repeated publication of message does not queue deliveries again.
+func NewWebhookEventFromMessage(message *outbox.Message) *WebhookEvent {
+	return &WebhookEvent{
+		ID:        message.ID,
+		Name:      message.Event,
+		CreatedAt: message.CreatedAt,
+		Data:      json.RawMessage(message.Payload),
+	}
+}
+
+// Deliveries will return pending deliveries of event to subscriptions which receive event.
+func (e *WebhookEvent) Deliveries(subscriptions []*WebhookSubscription) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribed(e.Name) {
+			continue
+		}
+		deliveries = append(deliveries, &WebhookDelivery{
+			SubscriptionUUID: subscription.UUID,
+			EventID:          e.ID,
+			Event:            e.Name,
+			Payload:          string(payload),
+			Status:           WebhookDeliveryStatusPending,
+		})
+	}
+	return deliveries, nil
+}
+
+// NewWebhookTripDelayed will return data of trip.delayed event or nil when trip is not delayed.
+func NewWebhookTripDelayed(previous *Trip, trip *Trip) *WebhookTripDelayed {
+	departureDelay := trip.DepartureTime.Sub(previous.DepartureTime)
+	arrivalDelay := trip.ArravialTive.Sub(previous.ArravialTive)
+	if departureDelay <= 0 && arrivalDelay <= 0 {
+		return nil
+	}
+	return &WebhookTripDelayed{
+		Trip:              trip.DetailTripList(),
+		PreviousDeparture: previous.DepartureTime,
+		PreviousArrival:   previous.ArravialTive,
+		DepartureDelay:    int(departureDelay.Minutes()),
+		ArrivalDelay:      int(arrivalDelay.Minutes()),
+	}
+}
+
+// Request will return signed request of delivery sent at now.
+func (u *WebhookDelivery) Request(now time.Time) *webhook.Request {
+	return &webhook.Request{
+		URL:        u.Subscription.URL,
+		Secret:     u.Subscription.Secret,
+		Event:      u.Event,
+		EventID:    u.EventID,
+		DeliveryID: u.UUID,
+		Body:       []byte(u.Payload),
+		Timestamp:  now,
+	}
+}
+
+// Delivered will store response of subscriber which accepted delivery.
+func (u *WebhookDelivery) Delivered(response *webhook.Response, now time.Time) {
+	u.Attempts++
+	u.Status = WebhookDeliveryStatusSucceeded
+	u.ResponseStatus = response.StatusCode
+	u.ResponseBody = response.Body
+	u.Error = ""
+	u.NextAttemptAt = nil
+	u.DeliveredAt = &now
+}
+
+// DeliveryFailed will schedule next attempt with exponential backoff, delivery is failed when attempts are exhausted.
+// Response is nil when subscriber is not reached.
+func (u *WebhookDelivery) DeliveryFailed(response *webhook.Response, err error, maxAttempts int, now time.Time) {
+	u.Attempts++
+	u.ResponseStatus = 0
+	u.ResponseBody = ""
+	if response != nil {
+		u.ResponseStatus = response.StatusCode
+		u.ResponseBody = response.Body
+	}
+	u.Error = err.Error()
+	if len(u.Error) > webhookErrorLength {
+		u.Error = u.Error[:webhookErrorLength]
+	}
+	if u.Attempts >= maxAttempts {
+		u.Status = WebhookDeliveryStatusFailed
+		u.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(webhook.Backoff(u.Attempts, WebhookBackoffBase, WebhookBackoffMax))
+	u.NextAttemptAt = &next
+}
+
+// Replay will return a new pending delivery of the same event, subscriber recognizes repeated event by event ID.
+func (u *WebhookDelivery) Replay() *WebhookDelivery {
+	return &WebhookDelivery{
+		SubscriptionUUID: u.SubscriptionUUID,
+		EventID:          u.EventID,
+		Event:            u.Event,
+		Payload:          u.Payload,
+		Status:           WebhookDeliveryStatusPending,
+		ReplayOf:         u.UUID,
+	}
+}
+
+// DetailWebhookSubscriptions will return formatted webhook subscription detail of multiple webhook subscription.
+func (subscriptions WebhookSubscriptions) DetailWebhookSubscriptions() []interface{} {
+	result := make([]interface{}, len(subscriptions))
+	for index, subscription := range subscriptions {
+		result[index] = subscription.DetailWebhookSubscription()
+	}
+	return result
+}
+
+// webhookSubscriptionFieldsForDetail will return shared fields of webhook subscription detail.
+func (u *WebhookSubscription) webhookSubscriptionFieldsForDetail() WebhookSubscriptionFieldsForDetail {
+	return WebhookSubscriptionFieldsForDetail{
+		UUID:            u.UUID,
+		ApplicationUUID: u.ApplicationUUID,
+		URL:             u.URL,
+		Events:          u.Events,
+		Comment:         u.Comment,
+		Disabled:        u.Disabled,
+	}
+}
+
+// DetailWebhookSubscription will return formatted webhook subscription detail of webhook subscription.
+func (u *WebhookSubscription) DetailWebhookSubscription() interface{} {
+	return &DetailWebhookSubscription{
+		WebhookSubscriptionFieldsForDetail: u.webhookSubscriptionFieldsForDetail(),
+		WebhookSubscriptionFieldsForList: WebhookSubscriptionFieldsForList{
+			CreatedAt: u.CreatedAt,
+			UpdatedAt: u.UpdatedAt,
+		},
+	}
+}
+
+// DetailWebhookSubscriptionSecret will return formatted webhook subscription detail with secret of created
+// webhook subscription, subscriber verifies signatures of deliveries by the secret.
+func (u *WebhookSubscription) DetailWebhookSubscriptionSecret() interface{} {
+	return &DetailWebhookSubscriptionSecret{
+		WebhookSubscriptionFieldsForDetail: u.webhookSubscriptionFieldsForDetail(),
+		Secret:                             u.Secret,
+	}
+}
+
+// DetailWebhookDeliveries will return formatted webhook delivery detail of multiple webhook delivery.
+func (deliveries WebhookDeliveries) DetailWebhookDeliveries() []interface{} {
+	result := make([]interface{}, len(deliveries))
+	for index, delivery := range deliveries {
+		result[index] = delivery.DetailWebhookDelivery()
+	}
+	return result
+}
+
+// DetailWebhookDelivery will return formatted webhook delivery detail of webhook delivery.
+func (u *WebhookDelivery) DetailWebhookDelivery() interface{} {
+	return &DetailWebhookDelivery{
+		UUID:             u.UUID,
+		SubscriptionUUID: u.SubscriptionUUID,
+		EventID:          u.EventID,
+		Event:            u.Event,
+		Payload:          u.Payload,
+		Status:           u.Status,
+		Attempts:         u.Attempts,
+		ResponseStatus:   u.ResponseStatus,
+		ResponseBody:     u.ResponseBody,
+		Error:            u.Error,
+		NextAttemptAt:    u.NextAttemptAt,
+		DeliveredAt:      u.DeliveredAt,
+		ReplayOf:         u.ReplayOf,
+		CreatedAt:        u.CreatedAt,
+	}
+}
+
+// ValidateSaveWebhookSubscription will validate create a new webhook subscription request.
+func (u *WebhookSubscription) ValidateSaveWebhookSubscription() []response.ErrorForm {
+	events := make([]interface{}, 0, len(WebhookEvents)+1)
+	for _, event := range WebhookEvents {
+		events = append(events, event)
+	}
+	events = append(events, WebhookEventAll)
+
+	validation := validator.New()
+	validation.
+		Set("application_uuid", u.ApplicationUUID, validation.AddRule().Required().IsUUID().Apply()).
+		Set("url", u.URL, validation.AddRule().Required().IsURL().Length(3, 255).Apply()).
+		Set("events", u.Events, validation.AddRule().Required().Apply()).
+		Set("comment", u.Comment, validation.AddRule().Length(0, 255).Apply())
+	for _, event := range u.Events {
+		validation.Set("events", event, validation.AddRule().In(events...).Apply())
+	}
+	return validation.Validate()
+}
+
+// ValidateUpdateWebhookSubscription will validate update a webhook subscription request.
+func (u *WebhookSubscription) ValidateUpdateWebhookSubscription() []response.ErrorForm {
+	return u.ValidateSaveWebhookSubscription()
+}
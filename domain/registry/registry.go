@@ -42,6 +42,24 @@ func CollectEntities() []entities {
 		{Entity: entity.Trip{}},
 		{Entity: entity.Order{}},
 		{Entity: entity.Payment{}},
+		{Entity: entity.Shipment{}},
+		{Entity: entity.ShipmentEvent{}},
+		{Entity: entity.ShipmentDelivery{}},
+		{Entity: entity.CargoTariff{}},
+		{Entity: entity.CargoTariffBracket{}},
+		{Entity: entity.CODPayout{}},
+		{Entity: entity.TripRoute{}},
+		{Entity: entity.TripRouteStop{}},
+		{Entity: entity.VehicleCompliance{}},
+		{Entity: entity.DriverWorkRule{}},
+		{Entity: entity.BankStatement{}},
+		{Entity: entity.BankStatementLine{}},
+		{Entity: entity.AccountingExport{}},
+		{Entity: entity.CalendarToken{}},
+		{Entity: entity.WebhookSubscription{}},
+		{Entity: entity.WebhookDelivery{}},
+		{Entity: entity.OutboxEvent{}},
+		{Entity: entity.AuditLog{}},
 	}
 }
 
@@ -76,6 +94,24 @@ func CollectTableNames() []table {
 	var trip entity.Trip
 	var order entity.Order
 	var payment entity.Payment
+	var shipment entity.Shipment
+	var shipmentEvent entity.ShipmentEvent
+	var shipmentDelivery entity.ShipmentDelivery
+	var cargoTariff entity.CargoTariff
+	var cargoTariffBracket entity.CargoTariffBracket
+	var codPayout entity.CODPayout
+	var tripRoute entity.TripRoute
+	var tripRouteStop entity.TripRouteStop
+	var vehicleCompliance entity.VehicleCompliance
+	var driverWorkRule entity.DriverWorkRule
+	var bankStatement entity.BankStatement
+	var bankStatementLine entity.BankStatementLine
+	var accountingExport entity.AccountingExport
+	var calendarToken entity.CalendarToken
+	var webhookSubscription entity.WebhookSubscription
+	var webhookDelivery entity.WebhookDelivery
+	var outboxEvent entity.OutboxEvent
+	var auditLog entity.AuditLog
 
 	return []table{
 		{Name: application.TableName()},
@@ -108,5 +144,23 @@ func CollectTableNames() []table {
 		{Name: trip.TableName()},
 		{Name: order.TableName()},
 		{Name: payment.TableName()},
+		{Name: shipment.TableName()},
+		{Name: shipmentEvent.TableName()},
+		{Name: shipmentDelivery.TableName()},
+		{Name: cargoTariff.TableName()},
+		{Name: cargoTariffBracket.TableName()},
+		{Name: codPayout.TableName()},
+		{Name: tripRoute.TableName()},
+		{Name: tripRouteStop.TableName()},
+		{Name: vehicleCompliance.TableName()},
+		{Name: driverWorkRule.TableName()},
+		{Name: bankStatement.TableName()},
+		{Name: bankStatementLine.TableName()},
+		{Name: accountingExport.TableName()},
+		{Name: calendarToken.TableName()},
+		{Name: webhookSubscription.TableName()},
+		{Name: webhookDelivery.TableName()},
+		{Name: outboxEvent.TableName()},
+		{Name: auditLog.TableName()},
 	}
 }
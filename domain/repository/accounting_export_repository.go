@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// AccountingExportRepository is an interface.
+type AccountingExportRepository interface {
+	SaveCommerceMLExport(
+		request *entity.AccountingExportRequest,
+		source string,
+		createdBy string,
+	) (*entity.AccountingExport, error)
+	GetAccountingExports(parameters *Parameters) ([]*entity.AccountingExport, *Meta, error)
+}
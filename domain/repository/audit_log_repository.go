@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+	"time"
+)
+
+// AuditLogRepository is an interface.
+type AuditLogRepository interface {
+	GetAuditLogs(parameters *Parameters) ([]*entity.AuditLog, *Meta, error)
+	GetEntityAuditLogs(entityType string, entityUUID string, parameters *Parameters) ([]*entity.AuditLog, *Meta, error)
+	GetUserAuditLogs(userUUID string, parameters *Parameters) ([]*entity.AuditLog, *Meta, error)
+	PurgeAuditLogs(before time.Time) (int64, error)
+	GetAPIKeyApplication(apiKey string) (string, string, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// BankStatementRepository is an interface.
+type BankStatementRepository interface {
+	SaveBankStatement(statement *entity.BankStatement) (*entity.BankStatement, error)
+	GetBankStatements(parameters *Parameters) ([]*entity.BankStatement, *Meta, error)
+	GetBankStatement(UUID string) (*entity.BankStatement, error)
+	ResolveBankStatementLine(
+		UUID string,
+		request *entity.BankStatementLineResolveRequest,
+		resolvedBy string,
+	) (*entity.BankStatementLine, map[string]string, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// CalendarRepository is an interface.
+type CalendarRepository interface {
+	GetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error)
+	ResetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error)
+	GetCalendarFeed(token string) (*entity.CalendarFeed, error)
+}
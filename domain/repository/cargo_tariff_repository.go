@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// CargoTariffRepository is an interface.
+type CargoTariffRepository interface {
+	SaveCargoTariff(tariff *entity.CargoTariff) (*entity.CargoTariff, map[string]string, error)
+	UpdateCargoTariff(UUID string, tariff *entity.CargoTariff) (*entity.CargoTariff, map[string]string, error)
+	DeleteCargoTariff(UUID string) error
+	GetCargoTariff(UUID string) (*entity.CargoTariff, error)
+	GetCargoTariffs(parameters *Parameters) ([]*entity.CargoTariff, *Meta, error)
+	QuoteShipment(shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error)
+}
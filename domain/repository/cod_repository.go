@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// CODRepository is an interface.
+type CODRepository interface {
+	GetCODSettlements(request *entity.CODSettlementRequest) ([]*entity.CODSettlement, error)
+	SaveCODPayouts(request *entity.CODSettlementRequest, createdBy string) ([]*entity.CODPayout, error)
+	GetCODPayouts(parameters *Parameters) ([]*entity.CODPayout, *Meta, error)
+	GetCODPayout(UUID string) (*entity.CODPayout, error)
+}
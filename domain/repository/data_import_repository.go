@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// DataImportRepository is an interface.
+type DataImportRepository interface {
+	SaveDataImport(data *entity.DataImport) (*entity.DataImport, error)
+}
@@ -14,4 +14,11 @@ type DriverRepository interface {
 
 	AddDriverVehicle(driver *entity.Driver) (*entity.Driver, map[string]string, error)
 	DeleteDriverVehicle(driver *entity.Driver) (*entity.Driver, map[string]string, error)
+
+	GetDriverWorkRule() (*entity.DriverWorkRule, error)
+	UpdateDriverWorkRule(rule *entity.DriverWorkRule) (*entity.DriverWorkRule, error)
+	GetDriverTimesheet(
+		UUID string,
+		request *entity.DriverTimesheetRequest,
+	) (*entity.DriverTimesheet, map[string]string, error)
 }
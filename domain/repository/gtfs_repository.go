@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// GTFSRepository is an interface.
+type GTFSRepository interface {
+	GetGTFSExport(export *entity.GTFSExport) (*entity.GTFSExport, error)
+	SaveGTFSImport(data *entity.GTFSImport) (*entity.GTFSImport, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+	"time"
+)
+
+// OutboxRepository is an interface.
+type OutboxRepository interface {
+	ClaimPendingOutboxEvents(now time.Time, limit int) ([]*entity.OutboxEvent, error)
+	UpdateOutboxEvent(event *entity.OutboxEvent) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"cargo-rest-api/domain/entity"
+	"time"
 )
 
 // PaymentRepository is an interface.
@@ -14,4 +15,8 @@ type PaymentRepository interface {
 
 	AddOrderPayment(paynemnt *entity.Payment) (*entity.Payment, map[string]string, error)
 	DeleteOrderPayment(paynemnt *entity.Payment) (*entity.Payment, map[string]string, error)
+
+	ClaimReceiptPayments(now time.Time, limit int) ([]*entity.Payment, error)
+	UpdatePaymentReceipt(payment *entity.Payment) error
+	GetFailedReceiptPayments() ([]*entity.Payment, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// ReportRepository is an interface.
+type ReportRepository interface {
+	GetRevenueReport(request *entity.ReportRequest) (entity.ReportRevenues, error)
+	GetLoadFactorReport(request *entity.ReportRequest) (entity.ReportLoadFactors, error)
+	GetAverageFareReport(request *entity.ReportRequest) (entity.ReportAverageFares, error)
+	GetCancellationReport(request *entity.ReportRequest) (entity.ReportCancellations, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// ShipmentRepository is an interface.
+type ShipmentRepository interface {
+	SaveShipment(shipment *entity.Shipment) (*entity.Shipment, map[string]string, error)
+	UpdateShipment(UUID string, shipment *entity.Shipment) (*entity.Shipment, map[string]string, error)
+	DeleteShipment(UUID string) error
+	GetShipment(UUID string) (*entity.Shipment, error)
+	GetShipments(parameters *Parameters) ([]*entity.Shipment, *Meta, error)
+	GetShipmentByTrackingNumber(trackingNumber string) (*entity.Shipment, error)
+	GetTripShipments(tripUUID string) ([]*entity.Shipment, error)
+	SaveShipmentEvent(UUID string, event *entity.ShipmentEvent) (*entity.ShipmentEvent, map[string]string, error)
+	IssueShipmentDeliveryCode(UUID string) (*entity.Shipment, map[string]string, error)
+	VerifyShipmentDelivery(UUID string, delivery *entity.ShipmentDelivery) (map[string]string, error)
+	SaveShipmentDelivery(UUID string, delivery *entity.ShipmentDelivery) (*entity.ShipmentDelivery, map[string]string, error)
+	GetShipmentDelivery(UUID string) (*entity.ShipmentDelivery, error)
+	GetShipmentEvents(UUID string) ([]*entity.ShipmentEvent, error)
+}
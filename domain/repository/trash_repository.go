@@ -0,0 +1,10 @@
+package repository
+
+import "cargo-rest-api/domain/entity"
+
+// TrashRepository is an interface.
+type TrashRepository interface {
+	GetTrash(resource *entity.TrashResource, parameters *Parameters) ([]interface{}, *Meta, error)
+	RestoreTrash(resource *entity.TrashResource, UUID string) (interface{}, map[string]string, error)
+	PurgeTrash(resource *entity.TrashResource, UUID string) error
+}
@@ -11,4 +11,8 @@ type TripRepository interface {
 	DeleteTrip(UUID string) error
 	GetTrip(UUID string) (*entity.Trip, error)
 	GetTrips(parameters *Parameters) ([]*entity.Trip, *Meta, error)
+	GetTripLoadPlan(UUID string, weight float64, volume float64) (*entity.TripLoadPlan, error)
+	SaveTripRoute(UUID string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error)
+	GetTripRoute(UUID string) (*entity.TripRoute, error)
+	GetDriverTripRoutes(userUUID string) ([]*entity.TripRoute, error)
 }
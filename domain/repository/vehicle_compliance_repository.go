@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// VehicleComplianceRepository is an interface.
+type VehicleComplianceRepository interface {
+	SaveVehicleCompliance(record *entity.VehicleCompliance) (*entity.VehicleCompliance, map[string]string, error)
+	UpdateVehicleCompliance(
+		UUID string,
+		record *entity.VehicleCompliance,
+	) (*entity.VehicleCompliance, map[string]string, error)
+	DeleteVehicleCompliance(UUID string) error
+	GetVehicleCompliance(UUID string) (*entity.VehicleCompliance, error)
+	GetVehicleCompliances(parameters *Parameters) ([]*entity.VehicleCompliance, *Meta, error)
+	GetExpiringVehicleCompliances(days int) ([]*entity.VehicleCompliance, error)
+	GetVehicleComplianceRecipients() ([]string, error)
+	MarkVehicleCompliancesReminded(UUIDs []string) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"cargo-rest-api/domain/entity"
+	"time"
+)
+
+// WebhookRepository is an interface.
+type WebhookRepository interface {
+	SaveWebhookSubscription(
+		subscription *entity.WebhookSubscription,
+	) (*entity.WebhookSubscription, map[string]string, error)
+	UpdateWebhookSubscription(
+		UUID string,
+		subscription *entity.WebhookSubscription,
+	) (*entity.WebhookSubscription, map[string]string, error)
+	DeleteWebhookSubscription(UUID string) error
+	GetWebhookSubscription(UUID string) (*entity.WebhookSubscription, error)
+	GetWebhookSubscriptions(parameters *Parameters) ([]*entity.WebhookSubscription, *Meta, error)
+	GetWebhookDeliveries(subscriptionUUID string, parameters *Parameters) ([]*entity.WebhookDelivery, *Meta, error)
+	ReplayWebhookDelivery(UUID string) (*entity.WebhookDelivery, error)
+	ClaimPendingWebhookDeliveries(now time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	UpdateWebhookDelivery(delivery *entity.WebhookDelivery) error
+	QueueWebhookEvent(event *entity.WebhookEvent) error
+}
@@ -96,6 +96,8 @@ var (
 		{UUID: uuid.New().String(), ModuleKey: "driver", PermissionKey: "delete"},
 		{UUID: uuid.New().String(), ModuleKey: "driver", PermissionKey: "bulk_delete"},
 		{UUID: uuid.New().String(), ModuleKey: "driver", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "driver", PermissionKey: "timesheet"},
+		{UUID: uuid.New().String(), ModuleKey: "driver", PermissionKey: "work_rule"},
 		{UUID: uuid.New().String(), ModuleKey: "route", PermissionKey: "read"},
 		{UUID: uuid.New().String(), ModuleKey: "route", PermissionKey: "create"},
 		{UUID: uuid.New().String(), ModuleKey: "route", PermissionKey: "update"},
@@ -120,6 +122,47 @@ var (
 		{UUID: uuid.New().String(), ModuleKey: "payment", PermissionKey: "delete"},
 		{UUID: uuid.New().String(), ModuleKey: "payment", PermissionKey: "bulk_delete"},
 		{UUID: uuid.New().String(), ModuleKey: "payment", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "shipment", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "shipment", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "shipment", PermissionKey: "update"},
+		{UUID: uuid.New().String(), ModuleKey: "shipment", PermissionKey: "delete"},
+		{UUID: uuid.New().String(), ModuleKey: "shipment", PermissionKey: "bulk_delete"},
+		{UUID: uuid.New().String(), ModuleKey: "shipment", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "cargo_tariff", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "cargo_tariff", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "cargo_tariff", PermissionKey: "update"},
+		{UUID: uuid.New().String(), ModuleKey: "cargo_tariff", PermissionKey: "delete"},
+		{UUID: uuid.New().String(), ModuleKey: "cargo_tariff", PermissionKey: "bulk_delete"},
+		{UUID: uuid.New().String(), ModuleKey: "cargo_tariff", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "cod", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "cod", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "cod", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "vehicle_compliance", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "vehicle_compliance", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "vehicle_compliance", PermissionKey: "update"},
+		{UUID: uuid.New().String(), ModuleKey: "vehicle_compliance", PermissionKey: "delete"},
+		{UUID: uuid.New().String(), ModuleKey: "vehicle_compliance", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "report", PermissionKey: "revenue"},
+		{UUID: uuid.New().String(), ModuleKey: "report", PermissionKey: "load_factor"},
+		{UUID: uuid.New().String(), ModuleKey: "report", PermissionKey: "average_fare"},
+		{UUID: uuid.New().String(), ModuleKey: "report", PermissionKey: "cancellation"},
+		{UUID: uuid.New().String(), ModuleKey: "bank_statement", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "bank_statement", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "bank_statement", PermissionKey: "update"},
+		{UUID: uuid.New().String(), ModuleKey: "bank_statement", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "accounting_export", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "accounting_export", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "data_import", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "gtfs_import", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "webhook", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "webhook", PermissionKey: "create"},
+		{UUID: uuid.New().String(), ModuleKey: "webhook", PermissionKey: "update"},
+		{UUID: uuid.New().String(), ModuleKey: "webhook", PermissionKey: "delete"},
+		{UUID: uuid.New().String(), ModuleKey: "webhook", PermissionKey: "detail"},
+		{UUID: uuid.New().String(), ModuleKey: "audit", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "trash", PermissionKey: "read"},
+		{UUID: uuid.New().String(), ModuleKey: "trash", PermissionKey: "restore"},
+		{UUID: uuid.New().String(), ModuleKey: "trash", PermissionKey: "purge"},
 	}
 	userRole = &entity.UserRole{
 		UUID:     uuid.New().String(),
@@ -155,6 +198,20 @@ var (
 			Name:      "Thumbnail",
 			MimeTypes: "image/png",
 		},
+		{
+			UUID:      uuid.New().String(),
+			Slug:      "delivery",
+			Path:      "delivery",
+			Name:      "Proof Of Delivery",
+			MimeTypes: "image/jpg,image/jpeg,image/png",
+		},
+		{
+			UUID:      uuid.New().String(),
+			Slug:      "compliance",
+			Path:      "compliance",
+			Name:      "Vehicle Compliance",
+			MimeTypes: "application/pdf,image/jpg,image/jpeg,image/png",
+		},
 	}
 	application = &entity.Application{
 		UUID: uuid.New().String(),
@@ -225,9 +282,9 @@ var (
 		{UUID: uuid.New().String(), Model: "Газель", RegCode: "а666дд132", NumberOfSeats: 13, Class: "Бомж"},
 	}
 	passengerTypes = []*entity.PassengerType{
-		{UUID: "04e9b29e-064b-4a13-8bab-074b14ae465d", Type: "Взрослый"},
-		{UUID: "1c888dfd-78be-40ca-a85a-61cc3ab7fb1e", Type: "Детский"},
-		{UUID: "7f3eb88e-98bd-4f5b-8a8c-34aaed1c7ffd", Type: "Пенсионный"},
+		{UUID: "04e9b29e-064b-4a13-8bab-074b14ae465d", Type: "Взрослый", MinAge: 12, MaxAge: 59},
+		{UUID: "1c888dfd-78be-40ca-a85a-61cc3ab7fb1e", Type: "Детский", MinAge: 0, MaxAge: 11},
+		{UUID: "7f3eb88e-98bd-4f5b-8a8c-34aaed1c7ffd", Type: "Пенсионный", MinAge: 60},
 	}
 	prices = []*entity.Price{
 		{
@@ -283,7 +340,7 @@ var (
 	orderStatusTypes = []*entity.OrderStatusType{
 		{UUID: "04e9be9e-064b-4a13-8bab-074b14ae465d", Type: "Оплачен"},
 		{UUID: "1c888sfd-78ie-40ca-a85a-61cc3ab7fb1e", Type: "Не оплачен"},
-		{UUID: "7f3ebl8e-98bd-4f5b-8a8c-34aaed1c7ffd", Type: "Отменен"},
+		{UUID: "7f3ebl8e-98bd-4f5b-8a8c-34aaed1c7ffd", Type: "Отменен", IsCanceled: true},
 	}
 	drivers = []*entity.Driver{
 		{
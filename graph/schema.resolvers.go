@@ -9,6 +9,7 @@ import (
 	"cargo-rest-api/graph/model"
 	"cargo-rest-api/graph/resolver"
 	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/etag"
 	"cargo-rest-api/pkg/response"
 	"context"
 	"encoding/json"
@@ -23,23 +24,66 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.CreateUse
 }
 
 func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UpdateUserInput) (*entity.User, error) {
-	panic(fmt.Errorf("not implemented"))
+	c, err := ginContextFromContext(ctx)
+	if err != nil {
+		return nil, newError(c, err)
+	}
+
+	userEntity := entity.User{Name: input.Name, Email: input.Email, Phone: input.Phone}
+	if validateErr := userEntity.ValidateUpdateUser(); len(validateErr) > 0 {
+		return nil, newError(c, exception.ErrorTextUnprocessableEntity)
+	}
+
+	ctx, condition, err := versionCondition(ctx, input.Version)
+	if err != nil {
+		return nil, newError(c, err)
+	}
+	users := r.DBServices.WithContext(ctx).User
+
+	user, err := users.GetUser(input.UUID)
+	if err != nil {
+		return nil, newError(c, versionError(condition, err))
+	}
+
+	if userEntity.Email != user.Email {
+		if _, _, err := users.GetUserByEmail(&userEntity); err == nil {
+			return nil, newError(c, exception.ErrorTextUnprocessableEntity)
+		}
+	}
+	if userEntity.Phone != user.Phone {
+		if _, _, err := users.GetUserByPhone(&userEntity); err == nil {
+			return nil, newError(c, exception.ErrorTextUnprocessableEntity)
+		}
+	}
+
+	_, _, err = users.UpdateUser(input.UUID, &userEntity)
+	if err != nil {
+		return nil, newError(c, versionError(condition, err))
+	}
+
+	updatedUser, err := users.GetUser(input.UUID)
+	if err != nil {
+		return nil, newError(c, err)
+	}
+	return updatedUser, nil
 }
 
-func (r *mutationResolver) DeleteUser(ctx context.Context, uuid string) (bool, error) {
+func (r *mutationResolver) DeleteUser(ctx context.Context, uuid string, version *string) (bool, error) {
 	c, err := ginContextFromContext(ctx)
 	if err != nil {
 		return false, newError(c, err)
 	}
 
-	err = r.DBServices.User.DeleteUser(uuid)
+	ctx, condition, err := versionCondition(ctx, version)
 	if err != nil {
-		if errors.Is(err, exception.ErrorTextUserNotFound) {
-			return false, newError(c, err)
-		}
 		return false, newError(c, err)
 	}
 
+	err = r.DBServices.WithContext(ctx).User.DeleteUser(uuid)
+	if err != nil {
+		return false, newError(c, versionError(condition, err))
+	}
+
 	return true, nil
 }
 
@@ -54,7 +98,7 @@ func (r *queryResolver) Users(
 	}
 
 	parameters := repository.NewGqlParameters(c, newGqlParameters(search, orderBy, pagination))
-	users, meta, err := r.DBServices.User.GetUsers(parameters)
+	users, meta, err := r.DBServices.WithContext(ctx).User.GetUsers(parameters)
 	if err != nil {
 		return nil, newError(c, err)
 	}
@@ -129,3 +173,28 @@ func newError(c *gin.Context, err error) error {
 
 	return errors.New(message)
 }
+
+// versionCondition return context of change of user conditional on version, change without version
+// is not conditional.
+func versionCondition(ctx context.Context, version *string) (context.Context, *etag.Condition, error) {
+	if version == nil {
+		return ctx, nil, nil
+	}
+	condition := etag.NewCondition(&entity.User{}, *version)
+	if !condition.Matchable() {
+		return ctx, nil, exception.ErrorTextPreconditionFailed
+	}
+	return etag.WithCondition(ctx, condition), condition, nil
+}
+
+// versionError return precondition error when conditional change did not match version of user or
+// user does not exist.
+func versionError(condition *etag.Condition, err error) error {
+	if condition == nil {
+		return err
+	}
+	if condition.Failed || (!condition.Checked && errors.Is(err, exception.ErrorTextUserNotFound)) {
+		return exception.ErrorTextPreconditionFailed
+	}
+	return err
+}
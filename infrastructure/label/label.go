@@ -0,0 +1,83 @@
+// Package label prints shipping labels of shipments.
+// Label contains tracking number as Code128 barcode and QR code, origin, destination and the trip.
+// Each output format is printed by its own printer, such as A6 PDF or raw ZPL for thermal printers.
+// Possible to add a new format by implementing PrinterInterface.
+// Design pattern: Strategy - Behavioral Design Pattern.
+package label
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// tripTimeLayout is a layout of trip departure time printed on label.
+const tripTimeLayout = "02.01.2006 15:04"
+
+// Label represent content of printable shipping label.
+type Label struct {
+	TrackingNumber string
+	SenderName     string
+	RecipientName  string
+	RecipientPhone string
+	From           string
+	To             string
+	Trip           string
+	Weight         float64
+}
+
+// PrinterInterface is an interface. Needs to be implemented by label printer of each format.
+type PrinterInterface interface {
+	Print(labels []*Label) ([]byte, error)
+	ContentType() string
+	Extension() string
+}
+
+// NewPrinter will return printer of given label format.
+func NewPrinter(format string) (PrinterInterface, error) {
+	switch format {
+	case entity.ShipmentLabelFormatPDF:
+		return &PDFPrinter{}, nil
+	case entity.ShipmentLabelFormatZPL:
+		return &ZPLPrinter{}, nil
+	}
+	return nil, exception.ErrorTextShipmentLabelFormatUnsupported
+}
+
+// NewLabel will return label of shipment with preloaded cities, trip and vehicle.
+// Submitted data of shipment is html escaped, so it is unescaped back for printing.
+func NewLabel(shipment *entity.Shipment) *Label {
+	label := &Label{
+		TrackingNumber: shipment.TrackingNumber,
+		SenderName:     html.UnescapeString(shipment.SenderName),
+		RecipientName:  html.UnescapeString(shipment.RecipientName),
+		RecipientPhone: html.UnescapeString(shipment.RecipientPhone),
+		From:           shipment.SityFrom.Name,
+		To:             shipment.SityTo.Name,
+		Weight:         shipment.Weight,
+	}
+	if shipment.TripUUID != "" && !shipment.Trip.DepartureTime.IsZero() {
+		label.Trip = strings.TrimSpace(fmt.Sprintf(
+			"%s %s",
+			shipment.Trip.DepartureTime.Format(tripTimeLayout),
+			shipment.Trip.Vehicle.RegCode,
+		))
+	}
+	return label
+}
+
+// NewLabels will return labels of multiple shipment.
+func NewLabels(shipments []*entity.Shipment) []*Label {
+	labels := make([]*Label, len(shipments))
+	for index, shipment := range shipments {
+		labels[index] = NewLabel(shipment)
+	}
+	return labels
+}
+
+// weightText return printable weight of shipment in kilograms.
+func (l *Label) weightText() string {
+	return fmt.Sprintf("%.2f kg", l.Weight)
+}
@@ -0,0 +1,58 @@
+package label
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+)
+
+const (
+	// code128ModuleWidth is a width in pixels of the narrowest bar of Code128 barcode image.
+	code128ModuleWidth = 4
+
+	// code128Height is a height in pixels of Code128 barcode image.
+	code128Height = 160
+
+	// qrSize is a width and height in pixels of QR code image.
+	qrSize = 400
+)
+
+// code128Image will encode tracking number as Code128 barcode PNG image.
+func code128Image(code string) ([]byte, error) {
+	bc, err := code128.Encode(code)
+	if err != nil {
+		return nil, err
+	}
+	return barcodeImage(bc, bc.Bounds().Dx()*code128ModuleWidth, code128Height)
+}
+
+// qrImage will encode tracking number as QR code PNG image.
+func qrImage(code string) ([]byte, error) {
+	bc, err := qr.Encode(code, qr.M, qr.Auto)
+	if err != nil {
+		return nil, err
+	}
+	return barcodeImage(bc, qrSize, qrSize)
+}
+
+// barcodeImage will scale barcode without blurring and encode it as 8-bit grayscale PNG image,
+// since barcodes are encoded with 16-bit color model which is not supported by PDF printer.
+func barcodeImage(bc barcode.Barcode, width int, height int) ([]byte, error) {
+	scaled, err := barcode.Scale(bc, width, height)
+	if err != nil {
+		return nil, err
+	}
+	gray := image.NewGray(scaled.Bounds())
+	draw.Draw(gray, gray.Bounds(), scaled, scaled.Bounds().Min, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, gray); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,140 @@
+package label
+
+import (
+	"bytes"
+
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const (
+	// pdfFontFamily is a name of embedded font, Go font covers both latin and cyrillic letters.
+	pdfFontFamily = "go"
+
+	// pdfMargin is a margin of A6 page in millimeters.
+	pdfMargin = 5.0
+
+	// pdfWidth is a printable width of A6 page in millimeters.
+	pdfWidth = 95.0
+)
+
+// pdfImageOptions represent options of barcode images embedded into PDF document.
+var pdfImageOptions = gofpdf.ImageOptions{ImageType: "PNG"}
+
+// PDFPrinter prints labels as A6 PDF document, one label per page.
+type PDFPrinter struct{}
+
+// PDFPrinter implements the PrinterInterface interface.
+var _ PrinterInterface = &PDFPrinter{}
+
+// Print will print labels as A6 PDF document.
+func (p *PDFPrinter) Print(labels []*Label) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A6", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, pdfMargin)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "B", gobold.TTF)
+
+	for _, label := range labels {
+		if err := p.printLabel(pdf, label); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ContentType return MIME type of PDF document.
+func (p *PDFPrinter) ContentType() string {
+	return "application/pdf"
+}
+
+// Extension return file extension of PDF document.
+func (p *PDFPrinter) Extension() string {
+	return "pdf"
+}
+
+// printLabel will print label on a new page.
+func (p *PDFPrinter) printLabel(pdf *gofpdf.Fpdf, label *Label) error {
+	code128, err := code128Image(label.TrackingNumber)
+	if err != nil {
+		return err
+	}
+	qrCode, err := qrImage(label.TrackingNumber)
+	if err != nil {
+		return err
+	}
+	code128Name := "code128-" + label.TrackingNumber
+	qrName := "qr-" + label.TrackingNumber
+	pdf.RegisterImageOptionsReader(code128Name, pdfImageOptions, bytes.NewReader(code128))
+	pdf.RegisterImageOptionsReader(qrName, pdfImageOptions, bytes.NewReader(qrCode))
+
+	pdf.AddPage()
+
+	// Route.
+	p.text(pdf, 5, 8, "", "FROM")
+	p.text(pdf, 10, 14, "B", label.From)
+	p.text(pdf, 22, 8, "", "TO")
+	p.text(pdf, 27, 20, "B", label.To)
+	pdf.Line(pdfMargin, 40, pdfMargin+pdfWidth, 40)
+
+	// Tracking number.
+	pdf.ImageOptions(code128Name, pdfMargin, 44, pdfWidth, 22, false, pdfImageOptions, 0, "")
+	pdf.SetFont(pdfFontFamily, "B", 12)
+	pdf.SetXY(pdfMargin, 67)
+	pdf.CellFormat(pdfWidth, 6, label.TrackingNumber, "", 0, "C", false, 0, "")
+	pdf.Line(pdfMargin, 75, pdfMargin+pdfWidth, 75)
+
+	// QR code and parcel details.
+	pdf.ImageOptions(qrName, pdfMargin, 79, 35, 35, false, pdfImageOptions, 0, "")
+	details := []struct {
+		caption string
+		value   string
+	}{
+		{caption: "RECIPIENT", value: label.RecipientName},
+		{caption: "PHONE", value: label.RecipientPhone},
+		{caption: "SENDER", value: label.SenderName},
+		{caption: "WEIGHT", value: label.weightText()},
+		{caption: "TRIP", value: label.Trip},
+	}
+	y := 79.0
+	for _, detail := range details {
+		if detail.value == "" {
+			continue
+		}
+		p.textAt(pdf, 44, y, 56, 6, "", detail.caption)
+		p.textAt(pdf, 44, y+2.5, 56, 9, "B", detail.value)
+		y += 7.5
+	}
+
+	return pdf.Error()
+}
+
+// text will print a line of text with full printable width of page.
+func (p *PDFPrinter) text(pdf *gofpdf.Fpdf, y float64, size float64, style string, text string) {
+	p.textAt(pdf, pdfMargin, y, pdfWidth, size, style, text)
+}
+
+// textAt will print a line of text cut to given width.
+func (p *PDFPrinter) textAt(pdf *gofpdf.Fpdf, x, y, width, size float64, style string, text string) {
+	pdf.SetFont(pdfFontFamily, style, size)
+	pdf.SetXY(x, y)
+	pdf.CellFormat(width, size*0.4, p.fit(pdf, text, width), "", 0, "L", false, 0, "")
+}
+
+// fit will cut text with ellipsis when it is wider than given width.
+func (p *PDFPrinter) fit(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"…") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
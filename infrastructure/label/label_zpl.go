@@ -0,0 +1,99 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// zplWidth is a width of A6 label in dots of 203 dpi thermal printer.
+	zplWidth = 840
+
+	// zplLength is a length of A6 label in dots of 203 dpi thermal printer.
+	zplLength = 1184
+)
+
+// zplReplacer removes characters which are reserved as ZPL command prefixes or break the field.
+var zplReplacer = strings.NewReplacer("^", " ", "~", " ", "\r", " ", "\n", " ")
+
+// ZPLPrinter prints labels as raw ZPL for thermal printers, one label format per label.
+type ZPLPrinter struct{}
+
+// ZPLPrinter implements the PrinterInterface interface.
+var _ PrinterInterface = &ZPLPrinter{}
+
+// Print will print labels as raw ZPL, barcodes are rendered by printer itself.
+func (p *ZPLPrinter) Print(labels []*Label) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, label := range labels {
+		p.printLabel(&buf, label)
+	}
+	return buf.Bytes(), nil
+}
+
+// ContentType return MIME type of ZPL.
+func (p *ZPLPrinter) ContentType() string {
+	return "application/zpl"
+}
+
+// Extension return file extension of ZPL.
+func (p *ZPLPrinter) Extension() string {
+	return "zpl"
+}
+
+// printLabel will write label format, text is encoded as UTF-8 (^CI28).
+func (p *ZPLPrinter) printLabel(buf *bytes.Buffer, label *Label) {
+	buf.WriteString("^XA\n^CI28\n")
+	fmt.Fprintf(buf, "^PW%d\n^LL%d\n", zplWidth, zplLength)
+
+	// Route.
+	p.text(buf, 40, 40, 24, "FROM")
+	p.text(buf, 40, 70, 40, label.From)
+	p.text(buf, 40, 140, 24, "TO")
+	p.text(buf, 40, 170, 64, label.To)
+	fmt.Fprintf(buf, "^FO40,300^GB%d,3,3^FS\n", zplWidth-80)
+
+	// Tracking number.
+	fmt.Fprintf(buf, "^FO40,340^BY3^BCN,180,Y,N,N^FD%s^FS\n", zplText(label.TrackingNumber))
+	fmt.Fprintf(buf, "^FO40,600^GB%d,3,3^FS\n", zplWidth-80)
+
+	// QR code and parcel details.
+	fmt.Fprintf(buf, "^FO30,630^BQN,2,8^FDMA,%s^FS\n", zplText(label.TrackingNumber))
+	details := []struct {
+		caption string
+		value   string
+	}{
+		{caption: "RECIPIENT", value: label.RecipientName},
+		{caption: "PHONE", value: label.RecipientPhone},
+		{caption: "SENDER", value: label.SenderName},
+		{caption: "WEIGHT", value: label.weightText()},
+		{caption: "TRIP", value: label.Trip},
+	}
+	y := 650
+	for _, detail := range details {
+		if detail.value == "" {
+			continue
+		}
+		p.text(buf, 340, y, 22, detail.caption)
+		p.text(buf, 340, y+26, 30, detail.value)
+		y += 80
+	}
+
+	buf.WriteString("^XZ\n")
+}
+
+// text will write a line of text with scalable font of given height in dots.
+// Text is placed into single line field block, so it does not overflow the label.
+func (p *ZPLPrinter) text(buf *bytes.Buffer, x int, y int, height int, text string) {
+	fmt.Fprintf(
+		buf,
+		"^FO%d,%d^A0N,%d,%d^FB%d,1,0,L^FD%s^FS\n",
+		x, y, height, height, zplWidth-40-x, zplText(text),
+	)
+}
+
+// zplText return field data without reserved characters.
+func zplText(text string) string {
+	return zplReplacer.Replace(text)
+}
@@ -44,6 +44,18 @@ var (
 
 	// ErrorTextPerPage is an error representing request per page over the limit.
 	ErrorTextPerPage = errors.New("api.msg.error.common.per_page")
+
+	// ErrorTextTooManyRequests is an error representing request rate limit is exceeded.
+	ErrorTextTooManyRequests = errors.New("api.msg.error.common.too_many_requests")
+
+	// ErrorTextPreconditionFailed is an error representing record was changed after version sent in If-Match.
+	ErrorTextPreconditionFailed = errors.New("api.msg.error.common.precondition_failed")
+
+	// ErrorTextIdempotencyKeyInProgress is an error representing request with the same Idempotency-Key is processed.
+	ErrorTextIdempotencyKeyInProgress = errors.New("api.msg.error.common.idempotency_key_in_progress")
+
+	// ErrorTextIdempotencyKeyReused is an error representing Idempotency-Key was used for another request.
+	ErrorTextIdempotencyKeyReused = errors.New("api.msg.error.common.idempotency_key_reused")
 )
 
 // Errors for document
@@ -146,6 +158,12 @@ var (
 
 	// ErrorTextRoleInvalidUUID is an error representing UUID not found in database.
 	ErrorTextPassengerTypeInvalidUUID = errors.New("api.msg.error.passenger_type.invalid_uuid")
+
+	// ErrorTextPassengerTypeAgeMismatch is an error representing passenger age does not fit passenger_type age band.
+	ErrorTextPassengerTypeAgeMismatch = errors.New("api.msg.error.passenger_type.age_mismatch")
+
+	// ErrorTextPassengerTypeAgeNotCovered is an error representing no passenger_type covers passenger age.
+	ErrorTextPassengerTypeAgeNotCovered = errors.New("api.msg.error.passenger_type.age_not_covered")
 )
 
 // Errors for price.
@@ -200,6 +218,12 @@ var (
 
 	// ErrorTextDriverInvalidUUID is an error representing UUID not found in database.
 	ErrorTextDriverInvalidUUID = errors.New("api.msg.error.driver.invalid_uuid")
+
+	// ErrorTextDriverWorkTimeViolated is an error representing trip assignment violates driver working-time rules.
+	ErrorTextDriverWorkTimeViolated = errors.New("api.msg.error.driver.work_time_violated")
+
+	// ErrorTextDriverTimesheetPeriodInvalid is an error representing timesheet period is reversed or too long.
+	ErrorTextDriverTimesheetPeriodInvalid = errors.New("api.msg.error.driver.timesheet_period_invalid")
 )
 
 // Errors for route.
@@ -218,6 +242,12 @@ var (
 
 	// ErrorTextTripInvalidUUID is an error representing UUID not found in database.
 	ErrorTextTripInvalidUUID = errors.New("api.msg.error.trip.invalid_uuid")
+
+	// ErrorTextTripRouteNotFound is an error representing trip route is not optimized yet.
+	ErrorTextTripRouteNotFound = errors.New("api.msg.error.trip.route_not_found")
+
+	// ErrorTextTripRouteNoStops is an error representing trip has no shipment with destination to deliver.
+	ErrorTextTripRouteNoStops = errors.New("api.msg.error.trip.route_no_stops")
 )
 
 // Errors for order.
@@ -227,6 +257,9 @@ var (
 
 	// ErrorTextOrderInvalidUUID is an error representing UUID not found in database.
 	ErrorTextOrderInvalidUUID = errors.New("api.msg.error.order.invalid_uuid")
+
+	// ErrorTextOrderBaggageCapacityExceeded is an error representing passenger baggage does not fit into trip vehicle luggage capacity.
+	ErrorTextOrderBaggageCapacityExceeded = errors.New("api.msg.error.order.baggage_capacity_exceeded")
 )
 
 // Errors for payment.
@@ -237,3 +270,192 @@ var (
 	// ErrorTextPaymentInvalidUUID is an error representing UUID not found in database.
 	ErrorTextPaymentInvalidUUID = errors.New("api.msg.error.payment.invalid_uuid")
 )
+
+// Errors for shipment.
+var (
+	// ErrorTextShipmentNotFound is an error representing shipment not found in database.
+	ErrorTextShipmentNotFound = errors.New("api.msg.error.shipment.not_found")
+
+	// ErrorTextShipmentInvalidUUID is an error representing UUID not found in database.
+	ErrorTextShipmentInvalidUUID = errors.New("api.msg.error.shipment.invalid_uuid")
+
+	// ErrorTextShipmentLuggageCapacityExceeded is an error representing shipment does not fit into trip vehicle luggage capacity.
+	ErrorTextShipmentLuggageCapacityExceeded = errors.New("api.msg.error.shipment.luggage_capacity_exceeded")
+
+	// ErrorTextShipmentAlreadyDelivered is an error representing shipment is already delivered and closed.
+	ErrorTextShipmentAlreadyDelivered = errors.New("api.msg.error.shipment.already_delivered")
+
+	// ErrorTextShipmentDeliveryCodeInvalid is an error representing confirmation code of delivery does not match issued one.
+	ErrorTextShipmentDeliveryCodeInvalid = errors.New("api.msg.error.shipment.delivery_code_invalid")
+
+	// ErrorTextShipmentDeliveryCodeAttemptsExceeded is an error representing confirmation code is invalidated after too many wrong attempts.
+	ErrorTextShipmentDeliveryCodeAttemptsExceeded = errors.New("api.msg.error.shipment.delivery_code_attempts_exceeded")
+
+	// ErrorTextShipmentDeliveryCodeNotSent is an error representing confirmation code is not delivered to any contact of recipient.
+	ErrorTextShipmentDeliveryCodeNotSent = errors.New("api.msg.error.shipment.delivery_code_not_sent")
+
+	// ErrorTextShipmentDeliveryNotFound is an error representing proof of delivery not found in database.
+	ErrorTextShipmentDeliveryNotFound = errors.New("api.msg.error.shipment.delivery_not_found")
+
+	// ErrorTextShipmentCODNotCollected is an error representing cash on delivery is not collected from recipient.
+	ErrorTextShipmentCODNotCollected = errors.New("api.msg.error.shipment.cod_not_collected")
+
+	// ErrorTextShipmentCODAmountMismatch is an error representing collected amount differs from cash on delivery.
+	ErrorTextShipmentCODAmountMismatch = errors.New("api.msg.error.shipment.cod_amount_mismatch")
+
+	// ErrorTextShipmentCODNotRequired is an error representing cash is collected for shipment without cash on delivery.
+	ErrorTextShipmentCODNotRequired = errors.New("api.msg.error.shipment.cod_not_required")
+
+	// ErrorTextShipmentCODAlreadyCollected is an error representing cash on delivery can not be changed after collection.
+	ErrorTextShipmentCODAlreadyCollected = errors.New("api.msg.error.shipment.cod_already_collected")
+
+	// ErrorTextShipmentLabelFormatUnsupported is an error representing requested format of shipping label is not supported.
+	ErrorTextShipmentLabelFormatUnsupported = errors.New("api.msg.error.shipment.label_format_unsupported")
+
+	// ErrorTextShipmentLabelNoShipments is an error representing trip has no shipments to print labels for.
+	ErrorTextShipmentLabelNoShipments = errors.New("api.msg.error.shipment.label_no_shipments")
+
+	// ErrorTextCargoTariffNotFound is an error representing cargo tariff not found in database.
+	ErrorTextCargoTariffNotFound = errors.New("api.msg.error.cargo_tariff.not_found")
+
+	// ErrorTextCargoTariffInvalidUUID is an error representing UUID not found in database.
+	ErrorTextCargoTariffInvalidUUID = errors.New("api.msg.error.cargo_tariff.invalid_uuid")
+
+	// ErrorTextCargoTariffRouteNotFound is an error representing there is no route between shipment origin and destination.
+	ErrorTextCargoTariffRouteNotFound = errors.New("api.msg.error.cargo_tariff.route_not_found")
+
+	// ErrorTextCargoTariffNotConfigured is an error representing there is neither route tariff nor default tariff.
+	ErrorTextCargoTariffNotConfigured = errors.New("api.msg.error.cargo_tariff.not_configured")
+
+	// ErrorTextCargoTariffWeightNotCovered is an error representing chargeable weight exceeds all tariff brackets.
+	ErrorTextCargoTariffWeightNotCovered = errors.New("api.msg.error.cargo_tariff.weight_not_covered")
+
+	// ErrorTextCODPayoutNotFound is an error representing payout of cash on delivery not found in database.
+	ErrorTextCODPayoutNotFound = errors.New("api.msg.error.cod.payout_not_found")
+
+	// ErrorTextCODPayoutNothingToPay is an error representing there is no collected cash on delivery to pay out.
+	ErrorTextCODPayoutNothingToPay = errors.New("api.msg.error.cod.payout_nothing_to_pay")
+
+	// ErrorTextCODPayoutConflict is an error representing payments are taken by another payout at the same time.
+	ErrorTextCODPayoutConflict = errors.New("api.msg.error.cod.payout_conflict")
+
+	// ErrorTextVehicleComplianceNotFound is an error representing vehicle compliance record not found in database.
+	ErrorTextVehicleComplianceNotFound = errors.New("api.msg.error.vehicle_compliance.not_found")
+
+	// ErrorTextVehicleComplianceInvalidUUID is an error representing UUID not found in database.
+	ErrorTextVehicleComplianceInvalidUUID = errors.New("api.msg.error.vehicle_compliance.invalid_uuid")
+
+	// ErrorTextVehicleCompliancePeriodInvalid is an error representing valid until date is before valid from date.
+	ErrorTextVehicleCompliancePeriodInvalid = errors.New("api.msg.error.vehicle_compliance.period_invalid")
+
+	// ErrorTextVehicleComplianceExpired is an error representing vehicle document is expired during the trip.
+	ErrorTextVehicleComplianceExpired = errors.New("api.msg.error.vehicle_compliance.expired")
+
+	// ErrorTextVehicleMaintenanceScheduled is an error representing vehicle maintenance scheduled during the trip.
+	ErrorTextVehicleMaintenanceScheduled = errors.New("api.msg.error.vehicle_compliance.maintenance_scheduled")
+
+	// ErrorTextVehicleComplianceMissing is an error representing vehicle has no record of required document.
+	ErrorTextVehicleComplianceMissing = errors.New("api.msg.error.vehicle_compliance.missing")
+
+	// ErrorTextBankStatementNotFound is an error representing bank statement not found in database.
+	ErrorTextBankStatementNotFound = errors.New("api.msg.error.bank_statement.not_found")
+
+	// ErrorTextBankStatementLineNotFound is an error representing line of bank statement not found in database.
+	ErrorTextBankStatementLineNotFound = errors.New("api.msg.error.bank_statement.line_not_found")
+
+	// ErrorTextBankStatementFileRequired is an error representing statement file is not uploaded.
+	ErrorTextBankStatementFileRequired = errors.New("api.msg.error.bank_statement.file_required")
+
+	// ErrorTextBankStatementInvalidFileSize is an error representing statement file is too large.
+	ErrorTextBankStatementInvalidFileSize = errors.New("api.msg.error.bank_statement.invalid_file_size")
+
+	// ErrorTextBankStatementFormatUnsupported is an error representing statement is neither CSV nor 1CClientBankExchange.
+	ErrorTextBankStatementFormatUnsupported = errors.New("api.msg.error.bank_statement.format_unsupported")
+
+	// ErrorTextBankStatementEmpty is an error representing statement has no lines.
+	ErrorTextBankStatementEmpty = errors.New("api.msg.error.bank_statement.empty")
+
+	// ErrorTextBankStatementInvalidLine is an error representing statement has invalid value at line.
+	ErrorTextBankStatementInvalidLine = errors.New("api.msg.error.bank_statement.invalid_line")
+
+	// ErrorTextBankStatementPaymentReconciled is an error representing payment is matched by another statement line.
+	ErrorTextBankStatementPaymentReconciled = errors.New("api.msg.error.bank_statement.payment_reconciled")
+
+	// ErrorTextDataImportEntityUnsupported is an error representing entity can not be imported.
+	ErrorTextDataImportEntityUnsupported = errors.New("api.msg.error.data_import.entity_unsupported")
+
+	// ErrorTextDataImportFileRequired is an error representing import file is not uploaded.
+	ErrorTextDataImportFileRequired = errors.New("api.msg.error.data_import.file_required")
+
+	// ErrorTextDataImportInvalidFileSize is an error representing import file is too large.
+	ErrorTextDataImportInvalidFileSize = errors.New("api.msg.error.data_import.invalid_file_size")
+
+	// ErrorTextDataImportFormatUnsupported is an error representing import is neither CSV nor JSON array.
+	ErrorTextDataImportFormatUnsupported = errors.New("api.msg.error.data_import.format_unsupported")
+
+	// ErrorTextDataImportEmpty is an error representing import has no rows.
+	ErrorTextDataImportEmpty = errors.New("api.msg.error.data_import.empty")
+
+	// ErrorTextDataImportInvalidRow is an error representing row of import can not be read.
+	ErrorTextDataImportInvalidRow = errors.New("api.msg.error.data_import.invalid_row")
+
+	// ErrorTextDataImportReferenceNotFound is an error representing record referenced by row not found in database.
+	ErrorTextDataImportReferenceNotFound = errors.New("api.msg.error.data_import.reference_not_found")
+
+	// ErrorTextDataImportReferenceAmbiguous is an error representing row references more than one record.
+	ErrorTextDataImportReferenceAmbiguous = errors.New("api.msg.error.data_import.reference_ambiguous")
+
+	// ErrorTextDataImportDuplicateRow is an error representing row has the same key as previous row.
+	ErrorTextDataImportDuplicateRow = errors.New("api.msg.error.data_import.duplicate_row")
+
+	// ErrorTextDataImportRowsInvalid is an error representing import is not committed because of invalid rows.
+	ErrorTextDataImportRowsInvalid = errors.New("api.msg.error.data_import.rows_invalid")
+
+	// ErrorTextGTFSEmpty is an error representing there are no trips to export to GTFS feed.
+	ErrorTextGTFSEmpty = errors.New("api.msg.error.gtfs.empty")
+
+	// ErrorTextGTFSInvalidPeriod is an error representing period of exported trips is invalid.
+	ErrorTextGTFSInvalidPeriod = errors.New("api.msg.error.gtfs.invalid_period")
+
+	// ErrorTextGTFSImportFileRequired is an error representing GTFS archive is not uploaded.
+	ErrorTextGTFSImportFileRequired = errors.New("api.msg.error.gtfs_import.file_required")
+
+	// ErrorTextGTFSImportInvalidFileSize is an error representing GTFS archive is too large.
+	ErrorTextGTFSImportInvalidFileSize = errors.New("api.msg.error.gtfs_import.invalid_file_size")
+
+	// ErrorTextGTFSImportInvalidFeed is an error representing GTFS archive is not a valid feed.
+	ErrorTextGTFSImportInvalidFeed = errors.New("api.msg.error.gtfs_import.invalid_feed")
+
+	// ErrorTextGTFSImportInvalidHorizon is an error representing period of imported trips is invalid.
+	ErrorTextGTFSImportInvalidHorizon = errors.New("api.msg.error.gtfs_import.invalid_horizon")
+
+	// ErrorTextCalendarNotFound is an error representing calendar feed of token not found or revoked.
+	ErrorTextCalendarNotFound = errors.New("api.msg.error.calendar.not_found")
+
+	// ErrorTextWebhookNotFound is an error representing webhook subscription not found in database.
+	ErrorTextWebhookNotFound = errors.New("api.msg.error.webhook.not_found")
+
+	// ErrorTextWebhookInvalidUUID is an error representing UUID not found in database.
+	ErrorTextWebhookInvalidUUID = errors.New("api.msg.error.webhook.invalid_uuid")
+
+	// ErrorTextWebhookInvalidApplication is an error representing application of subscription not found in database.
+	ErrorTextWebhookInvalidApplication = errors.New("api.msg.error.webhook.invalid_application")
+
+	// ErrorTextWebhookDeliveryNotFound is an error representing webhook delivery not found in database.
+	ErrorTextWebhookDeliveryNotFound = errors.New("api.msg.error.webhook.delivery_not_found")
+
+	// ErrorTextTrashResourceNotFound is an error representing resource of trash not found.
+	ErrorTextTrashResourceNotFound = errors.New("api.msg.error.trash.resource_not_found")
+
+	// ErrorTextTrashNotFound is an error representing deleted record not found in database.
+	ErrorTextTrashNotFound = errors.New("api.msg.error.trash.not_found")
+
+	// ErrorTextTrashInvalidUUID is an error representing UUID of deleted record not found in database.
+	ErrorTextTrashInvalidUUID = errors.New("api.msg.error.trash.invalid_uuid")
+
+	// ErrorTextTrashInvalidReference is an error representing record referenced by restored record is deleted.
+	ErrorTextTrashInvalidReference = errors.New("api.msg.error.trash.invalid_reference")
+
+	// ErrorTextTrashRetained is an error representing deleted record has fiscal or accounting data and can not be purged.
+	ErrorTextTrashRetained = errors.New("api.msg.error.trash.retained")
+)
@@ -133,6 +133,9 @@ const (
 	DriverSuccessfullyDeleteDriver        = "api.msg.success.driver.successfully_delete_driver"
 	DriverSuccessfullyAddDriverVehicle    = "api.msg.success.driver.successfully_add_driver_vehicle"
 	DriverSuccessfullyDeleteDriverVehicle = "api.msg.success.driver.successfully_delete_driver_vehicle"
+	DriverSuccessfullyGetWorkRule         = "api.msg.success.driver.successfully_get_work_rule"
+	DriverSuccessfullyUpdateWorkRule      = "api.msg.success.driver.successfully_update_work_rule"
+	DriverSuccessfullyGetTimesheet        = "api.msg.success.driver.successfully_get_timesheet"
 )
 
 // Success message for route.
@@ -148,11 +151,15 @@ const (
 
 // Success message for trip.
 const (
-	TripSuccessfullyGetTripList   = "api.msg.success.trip.successfully_get_trip_list"
-	TripSuccessfullyGetTripDetail = "api.msg.success.trip.successfully_get_trip_detail"
-	TripSuccessfullyCreateTrip    = "api.msg.success.trip.successfully_create_trip"
-	TripSuccessfullyUpdateTrip    = "api.msg.success.trip.successfully_update_trip"
-	TripSuccessfullyDeleteTrip    = "api.msg.success.trip.successfully_delete_trip"
+	TripSuccessfullyGetTripList     = "api.msg.success.trip.successfully_get_trip_list"
+	TripSuccessfullyGetTripDetail   = "api.msg.success.trip.successfully_get_trip_detail"
+	TripSuccessfullyCreateTrip      = "api.msg.success.trip.successfully_create_trip"
+	TripSuccessfullyUpdateTrip      = "api.msg.success.trip.successfully_update_trip"
+	TripSuccessfullyDeleteTrip      = "api.msg.success.trip.successfully_delete_trip"
+	TripSuccessfullyGetLoadPlan     = "api.msg.success.trip.successfully_get_load_plan"
+	TripSuccessfullyOptimizeRoute   = "api.msg.success.trip.successfully_optimize_route"
+	TripSuccessfullyGetRoute        = "api.msg.success.trip.successfully_get_route"
+	TripSuccessfullyGetDriverRoutes = "api.msg.success.trip.successfully_get_driver_routes"
 )
 
 // Success message for order.
@@ -174,3 +181,99 @@ const (
 	PaymentSuccessfullyAddOrderPayment    = "api.msg.success.payment.successfully_add_order_payment"
 	PaymentSuccessfullyDeleteOrderPayment = "api.msg.success.payment.successfully_delete_order_payment"
 )
+
+// Success message for shipment.
+const (
+	ShipmentSuccessfullyGetShipmentList     = "api.msg.success.shipment.successfully_get_shipment_list"
+	ShipmentSuccessfullyGetShipmentDetail   = "api.msg.success.shipment.successfully_get_shipment_detail"
+	ShipmentSuccessfullyCreateShipment      = "api.msg.success.shipment.successfully_create_shipment"
+	ShipmentSuccessfullyUpdateShipment      = "api.msg.success.shipment.successfully_update_shipment"
+	ShipmentSuccessfullyDeleteShipment      = "api.msg.success.shipment.successfully_delete_shipment"
+	ShipmentSuccessfullyCreateShipmentEvent = "api.msg.success.shipment.successfully_create_shipment_event"
+	ShipmentSuccessfullyGetShipmentEvents   = "api.msg.success.shipment.successfully_get_shipment_events"
+	ShipmentSuccessfullyTrackShipment       = "api.msg.success.shipment.successfully_track_shipment"
+	ShipmentSuccessfullyIssueDeliveryCode   = "api.msg.success.shipment.successfully_issue_delivery_code"
+	ShipmentSuccessfullyConfirmDelivery     = "api.msg.success.shipment.successfully_confirm_delivery"
+	ShipmentSuccessfullyGetDelivery         = "api.msg.success.shipment.successfully_get_delivery"
+
+	CargoTariffSuccessfullyGetCargoTariffList   = "api.msg.success.cargo_tariff.successfully_get_cargo_tariff_list"
+	CargoTariffSuccessfullyGetCargoTariffDetail = "api.msg.success.cargo_tariff.successfully_get_cargo_tariff_detail"
+	CargoTariffSuccessfullyCreateCargoTariff    = "api.msg.success.cargo_tariff.successfully_create_cargo_tariff"
+	CargoTariffSuccessfullyUpdateCargoTariff    = "api.msg.success.cargo_tariff.successfully_update_cargo_tariff"
+	CargoTariffSuccessfullyDeleteCargoTariff    = "api.msg.success.cargo_tariff.successfully_delete_cargo_tariff"
+	CargoTariffSuccessfullyQuoteShipment        = "api.msg.success.cargo_tariff.successfully_quote_shipment"
+
+	CODSuccessfullyGetSettlementReport = "api.msg.success.cod.successfully_get_settlement_report"
+	CODSuccessfullyCreatePayouts       = "api.msg.success.cod.successfully_create_payouts"
+	CODSuccessfullyGetPayoutList       = "api.msg.success.cod.successfully_get_payout_list"
+	CODSuccessfullyGetPayoutDetail     = "api.msg.success.cod.successfully_get_payout_detail"
+
+	VehicleComplianceSuccessfullyGetVehicleComplianceList   = "api.msg.success.vehicle_compliance.successfully_get_vehicle_compliance_list"
+	VehicleComplianceSuccessfullyGetVehicleComplianceDetail = "api.msg.success.vehicle_compliance.successfully_get_vehicle_compliance_detail"
+	VehicleComplianceSuccessfullyCreateVehicleCompliance    = "api.msg.success.vehicle_compliance.successfully_create_vehicle_compliance"
+	VehicleComplianceSuccessfullyUpdateVehicleCompliance    = "api.msg.success.vehicle_compliance.successfully_update_vehicle_compliance"
+	VehicleComplianceSuccessfullyDeleteVehicleCompliance    = "api.msg.success.vehicle_compliance.successfully_delete_vehicle_compliance"
+	VehicleComplianceSuccessfullyGetExpiring                = "api.msg.success.vehicle_compliance.successfully_get_expiring"
+)
+
+// Success message for report.
+const (
+	ReportSuccessfullyGetRevenueReport      = "api.msg.success.report.successfully_get_revenue_report"
+	ReportSuccessfullyGetLoadFactorReport   = "api.msg.success.report.successfully_get_load_factor_report"
+	ReportSuccessfullyGetAverageFareReport  = "api.msg.success.report.successfully_get_average_fare_report"
+	ReportSuccessfullyGetCancellationReport = "api.msg.success.report.successfully_get_cancellation_report"
+)
+
+// Success message for bank statement.
+const (
+	BankStatementSuccessfullyImportBankStatement    = "api.msg.success.bank_statement.successfully_import_bank_statement"
+	BankStatementSuccessfullyGetBankStatementList   = "api.msg.success.bank_statement.successfully_get_bank_statement_list"
+	BankStatementSuccessfullyGetBankStatementDetail = "api.msg.success.bank_statement.successfully_get_bank_statement_detail"
+	BankStatementSuccessfullyResolveLine            = "api.msg.success.bank_statement.successfully_resolve_line"
+)
+
+// Success message for accounting export.
+const (
+	AccountingExportSuccessfullyGetAccountingExportList = "api.msg.success.accounting_export.successfully_get_accounting_export_list"
+)
+
+// Success message for data import.
+const (
+	DataImportSuccessfullyImport = "api.msg.success.data_import.successfully_import"
+	DataImportSuccessfullyDryRun = "api.msg.success.data_import.successfully_dry_run"
+)
+
+// Success message for GTFS import.
+const (
+	GTFSImportSuccessfullyImport = "api.msg.success.gtfs_import.successfully_import"
+	GTFSImportSuccessfullyDryRun = "api.msg.success.gtfs_import.successfully_dry_run"
+)
+
+// Success message for calendar feeds.
+const (
+	CalendarSuccessfullyGetCalendarTokens   = "api.msg.success.calendar.successfully_get_calendar_tokens"
+	CalendarSuccessfullyResetCalendarTokens = "api.msg.success.calendar.successfully_reset_calendar_tokens"
+)
+
+// Success message for webhooks.
+const (
+	WebhookSuccessfullyGetWebhookList         = "api.msg.success.webhook.successfully_get_webhook_list"
+	WebhookSuccessfullyGetWebhookDetail       = "api.msg.success.webhook.successfully_get_webhook_detail"
+	WebhookSuccessfullyCreateWebhook          = "api.msg.success.webhook.successfully_create_webhook"
+	WebhookSuccessfullyUpdateWebhook          = "api.msg.success.webhook.successfully_update_webhook"
+	WebhookSuccessfullyDeleteWebhook          = "api.msg.success.webhook.successfully_delete_webhook"
+	WebhookSuccessfullyGetWebhookDeliveryList = "api.msg.success.webhook.successfully_get_webhook_delivery_list"
+	WebhookSuccessfullyReplayWebhookDelivery  = "api.msg.success.webhook.successfully_replay_webhook_delivery"
+)
+
+// Success message for audit logs.
+const (
+	AuditLogSuccessfullyGetAuditLogList = "api.msg.success.audit_log.successfully_get_audit_log_list"
+)
+
+// Success message for trash.
+const (
+	TrashSuccessfullyGetTrashList = "api.msg.success.trash.successfully_get_trash_list"
+	TrashSuccessfullyRestore      = "api.msg.success.trash.successfully_restore"
+	TrashSuccessfullyPurge        = "api.msg.success.trash.successfully_purge"
+)
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"errors"
+)
+
+// ErrDeliveryCodeNoChannel is returned when recipient has no contact confirmation code can be sent to.
+var ErrDeliveryCodeNoChannel = errors.New("recipient has no contact to send delivery code to")
+
+type ShipmentDeliveryCode struct {
+	Notification application.NotifyAppInterface
+	Email        string
+	Phone        string
+	Template     string
+	TemplateData interface{}
+	Language     string
+}
+
+// NewShipmentDeliveryCode will prepare notification with confirmation code of shipment delivery.
+// Code is sent to every contact of recipient regardless of subscription to shipment events.
+func NewShipmentDeliveryCode(
+	shipment *entity.Shipment,
+	notification application.NotifyAppInterface,
+	language string) *ShipmentDeliveryCode {
+	template := "shipment_delivery_code"
+	templateData := struct {
+		Name           string
+		TrackingNumber string
+		Code           string
+	}{
+		Name:           shipment.RecipientName,
+		TrackingNumber: shipment.TrackingNumber,
+		Code:           shipment.DeliveryCode,
+	}
+
+	return &ShipmentDeliveryCode{
+		Notification: notification,
+		Email:        shipment.RecipientEmail,
+		Phone:        shipment.RecipientPhone,
+		Template:     template,
+		TemplateData: templateData,
+		Language:     language,
+	}
+}
+
+// Send will send confirmation code to email and phone of recipient, phone is skipped when sms gateway is not configured.
+// Error is returned when the code is not sent to any of channels.
+func (n *ShipmentDeliveryCode) Send() error {
+	err := ErrDeliveryCodeNoChannel
+	if n.Notification == nil {
+		return err
+	}
+	var sent int
+	if n.Email != "" {
+		if errs := n.Notification.Notify([]string{n.Email}, n.Template, n.TemplateData, n.Language).ToEmail().Send(); len(errs) > 0 {
+			err = errs[0]
+		} else {
+			sent++
+		}
+	}
+	if n.Phone != "" && n.Notification.SMSEnabled() {
+		if errs := n.Notification.Notify([]string{n.Phone}, n.Template, n.TemplateData, n.Language).ToSMS().Send(); len(errs) > 0 {
+			err = errs[0]
+		} else {
+			sent++
+		}
+	}
+	if sent > 0 {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+)
+
+type ShipmentEvent struct {
+	Notification application.NotifyAppInterface
+	Email        string
+	Phone        string
+	Template     string
+	TemplateData interface{}
+	Language     string
+}
+
+func NewShipmentEvent(
+	shipment *entity.Shipment,
+	event *entity.ShipmentEvent,
+	notification application.NotifyAppInterface,
+	language string) *ShipmentEvent {
+	template := "shipment_event"
+	templateData := struct {
+		Name           string
+		TrackingNumber string
+		Event          string
+		Sity           string
+		EventTime      string
+	}{
+		Name:           shipment.RecipientName,
+		TrackingNumber: shipment.TrackingNumber,
+		Event:          event.Type,
+		Sity:           event.Sity.Name,
+		EventTime:      event.EventTime.Format("2006-01-02 15:04"),
+	}
+
+	n := &ShipmentEvent{
+		Notification: notification,
+		Template:     template,
+		TemplateData: templateData,
+		Language:     language,
+	}
+	if shipment.NotifyEmail {
+		n.Email = shipment.RecipientEmail
+	}
+	if shipment.NotifySMS {
+		n.Phone = shipment.RecipientPhone
+	}
+	return n
+}
+
+// Send will send notification to the channels recipient subscribed to, sms is skipped when gateway is not configured.
+func (n *ShipmentEvent) Send() map[int]error {
+	errs := make(map[int]error)
+	if n.Notification == nil {
+		return errs
+	}
+	if n.Email != "" {
+		for i, err := range n.Notification.Notify([]string{n.Email}, n.Template, n.TemplateData, n.Language).ToEmail().Send() {
+			errs[i] = err
+		}
+	}
+	if n.Phone != "" && n.Notification.SMSEnabled() {
+		for i, err := range n.Notification.Notify([]string{n.Phone}, n.Template, n.TemplateData, n.Language).ToSMS().Send() {
+			errs[len(errs)+i] = err
+		}
+	}
+	return errs
+}
@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"time"
+)
+
+type VehicleComplianceReminder struct {
+	Notification application.NotifyAppInterface
+	Receivers    []string
+	Template     string
+	TemplateData interface{}
+	Language     string
+}
+
+// vehicleComplianceReminderRow is a line of reminder about single document.
+type vehicleComplianceReminderRow struct {
+	RegCode    string
+	Type       string
+	Number     string
+	ValidUntil string
+	DaysLeft   int
+}
+
+// NewVehicleComplianceReminder will prepare reminder to administrators about vehicle documents expiring soon.
+func NewVehicleComplianceReminder(
+	records []*entity.VehicleCompliance,
+	receivers []string,
+	notification application.NotifyAppInterface,
+	language string) *VehicleComplianceReminder {
+	template := "vehicle_compliance_reminder"
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	rows := make([]vehicleComplianceReminderRow, len(records))
+	for i, record := range records {
+		year, month, day := record.ValidUntil.Date()
+		validUntil := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+		rows[i] = vehicleComplianceReminderRow{
+			RegCode:    record.Vehicle.RegCode,
+			Type:       record.Type,
+			Number:     record.Number,
+			ValidUntil: record.ValidUntil.Format(entity.VehicleComplianceDateLayout),
+			DaysLeft:   int(validUntil.Sub(today).Hours() / 24),
+		}
+	}
+	templateData := struct {
+		Documents []vehicleComplianceReminderRow
+	}{
+		Documents: rows,
+	}
+
+	return &VehicleComplianceReminder{
+		Notification: notification,
+		Receivers:    receivers,
+		Template:     template,
+		TemplateData: templateData,
+		Language:     language,
+	}
+}
+
+// Send will send reminder to email of every administrator.
+func (n *VehicleComplianceReminder) Send() map[int]error {
+	return n.Notification.Notify(n.Receivers, n.Template, n.TemplateData, n.Language).ToEmail().Send()
+}
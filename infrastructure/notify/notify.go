@@ -14,6 +14,7 @@ type NotificationInterface interface {
 	SetTemplateData(data interface{})
 	GenerateMessage()
 	SendNotification() error
+	Clone() NotificationInterface
 }
 
 // Notification represent it self.
@@ -60,27 +61,38 @@ func (ni *Notification) ToFireBase() *Notification {
 	return ni
 }
 
-// Notify will initialize notification.
+// Notify will initialize notification. Notification is shared between requests, so a new one is returned
+// with its own data and channels.
 func (ni *Notification) Notify(
 	receiver []string,
 	template string,
 	templateData interface{},
 	language string) *Notification {
-	ni.NotificationData = NotificationData{
-		receiver:     receiver,
-		template:     template,
-		templateData: templateData,
-		language:     language,
+	return &Notification{
+		NotificationData: NotificationData{
+			receiver:     receiver,
+			template:     template,
+			templateData: templateData,
+			language:     language,
+		},
+		EmailNotification:    ni.EmailNotification,
+		SMSNotification:      ni.SMSNotification,
+		FirebaseNotification: ni.FirebaseNotification,
 	}
+}
 
-	return ni
+// SMSEnabled return true when sms channel has a configured gateway.
+func (ni *Notification) SMSEnabled() bool {
+	channel, ok := ni.SMSNotification.(*SMSChannel)
+	return ok && channel.Gateway != nil
 }
 
 // Send will send notification to all selected channels.
 func (ni *Notification) Send() map[int]error {
 	var errors = make(map[int]error)
 
-	for i, n := range ni.Notifications {
+	for i, channel := range ni.Notifications {
+		n := channel.Clone()
 		n.SetReceiver(ni.NotificationData.receiver)
 		n.SetLanguage(ni.NotificationData.language)
 		n.SetTemplate(ni.NotificationData.template)
@@ -128,3 +128,8 @@ func (e *EmailChannel) SendNotification() error {
 
 	return nil
 }
+
+// Clone will return a copy of channel with the same client, so message is generated apart from other sendings.
+func (e *EmailChannel) Clone() NotificationInterface {
+	return &EmailChannel{EmailClient: e.EmailClient}
+}
@@ -28,3 +28,7 @@ func (f FirebaseChannel) GenerateMessage() {
 func (f FirebaseChannel) SendNotification() error {
 	panic("implement me")
 }
+
+func (f FirebaseChannel) Clone() NotificationInterface {
+	return &FirebaseChannel{}
+}
@@ -1,32 +1,82 @@
 package notify
 
+import (
+	"bytes"
+	"cargo-rest-api/pkg/util"
+	"errors"
+	"fmt"
+	"log"
+	"text/template"
+)
+
+// ErrSMSGatewayNotConfigured is returned when sms is sent without configured gateway.
+var ErrSMSGatewayNotConfigured = errors.New("sms gateway is not configured")
+
+// SMSGatewayInterface is an interface of sms provider which delivers generated message.
+type SMSGatewayInterface interface {
+	Send(receiver []string, message string) error
+}
+
 type SMSChannel struct {
+	Gateway SMSGatewayInterface
+	smsData
+	message string
+}
+
+type smsData struct {
+	receiver     []string
+	template     string
+	templateData interface{}
+	language     string
 }
 
 // SetReceiver sets a value to the receiver.
-func (s SMSChannel) SetReceiver(receiver []string) {
-	panic("implement me")
+func (s *SMSChannel) SetReceiver(receiver []string) {
+	s.smsData.receiver = receiver
 }
 
 // SetLanguage sets a value to the language.
 func (s *SMSChannel) SetLanguage(language string) {
-	panic("implement me")
+	s.smsData.language = language
 }
 
 // SetTemplate sets a value to the template.
-func (s SMSChannel) SetTemplate(template string) {
-	panic("implement me")
+func (s *SMSChannel) SetTemplate(template string) {
+	s.smsData.template = template
 }
 
 // SetTemplateData sets a value to the templateData.
-func (s SMSChannel) SetTemplateData(data interface{}) {
-	panic("implement me")
+func (s *SMSChannel) SetTemplateData(data interface{}) {
+	s.smsData.templateData = data
+}
+
+// GenerateMessage generates plain text message from template.
+func (s *SMSChannel) GenerateMessage() {
+	templateName := fmt.Sprintf("%s_%s", s.smsData.language, s.smsData.template)
+	templatePath := fmt.Sprintf("%s/infrastructure/notify/template/%s.txt", util.RootDir(), templateName)
+	t, errParsing := template.ParseFiles(templatePath)
+	if errParsing != nil {
+		log.Println(errParsing)
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if errBind := t.Execute(buf, s.smsData.templateData); errBind != nil {
+		log.Println(errBind)
+	}
+
+	s.message = buf.String()
 }
 
-func (s SMSChannel) GenerateMessage() {
-	panic("implement me")
+// SendNotification will send sms notification through configured gateway.
+func (s *SMSChannel) SendNotification() error {
+	if s.Gateway == nil {
+		return ErrSMSGatewayNotConfigured
+	}
+	return s.Gateway.Send(s.smsData.receiver, s.message)
 }
 
-func (s SMSChannel) SendNotification() error {
-	panic("implement me")
+// Clone will return a copy of channel with the same gateway, so message is generated apart from other sendings.
+func (s *SMSChannel) Clone() NotificationInterface {
+	return &SMSChannel{Gateway: s.Gateway}
 }
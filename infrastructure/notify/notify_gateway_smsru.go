@@ -0,0 +1,102 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// SMSProviderSMSRu is a name of SMS.ru provider in config.
+	SMSProviderSMSRu = "smsru"
+
+	// SMSRuURL is an address of SMS.ru HTTP API.
+	SMSRuURL = "https://sms.ru"
+
+	smsRuStatusOK = "OK"
+)
+
+// ErrSMSGatewayCredentials is returned when gateway is initialized without credentials.
+var ErrSMSGatewayCredentials = errors.New("sms gateway credentials are not set")
+
+// SMSRuConfig represent credentials of SMS.ru.
+type SMSRuConfig struct {
+	URL     string
+	APIID   string
+	Sender  string
+	Timeout time.Duration
+}
+
+// SMSRuGateway is a gateway of SMS.ru HTTP API.
+type SMSRuGateway struct {
+	config SMSRuConfig
+	client *http.Client
+}
+
+// SMSRuGateway implements the SMSGatewayInterface.
+var _ SMSGatewayInterface = &SMSRuGateway{}
+
+type smsRuMessage struct {
+	Status     string `json:"status"`
+	StatusCode int    `json:"status_code"`
+	StatusText string `json:"status_text"`
+}
+
+type smsRuResponse struct {
+	smsRuMessage
+	SMS map[string]smsRuMessage `json:"sms"`
+}
+
+// NewSMSRuGateway will initialize SMS.ru gateway.
+func NewSMSRuGateway(config SMSRuConfig) (*SMSRuGateway, error) {
+	if config.APIID == "" {
+		return nil, ErrSMSGatewayCredentials
+	}
+	if config.URL == "" {
+		config.URL = SMSRuURL
+	}
+	config.URL = strings.TrimRight(config.URL, "/")
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	return &SMSRuGateway{config: config, client: &http.Client{Timeout: config.Timeout}}, nil
+}
+
+// Send will send message to every receiver, error is returned when any of messages is not accepted.
+func (g *SMSRuGateway) Send(receiver []string, message string) error {
+	form := url.Values{}
+	form.Set("api_id", g.config.APIID)
+	form.Set("to", strings.Join(receiver, ","))
+	form.Set("msg", message)
+	form.Set("json", "1")
+	if g.config.Sender != "" {
+		form.Set("from", g.config.Sender)
+	}
+
+	resp, err := g.client.PostForm(g.config.URL+"/sms/send", form)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("sms.ru responded with status %d", resp.StatusCode)
+	}
+
+	var response smsRuResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("sms.ru response: %w", err)
+	}
+	if response.Status != smsRuStatusOK {
+		return fmt.Errorf("sms.ru: %d: %s", response.StatusCode, response.StatusText)
+	}
+	for _, phone := range receiver {
+		if sms, ok := response.SMS[phone]; ok && sms.Status != smsRuStatusOK {
+			return fmt.Errorf("sms.ru: %s: %d: %s", phone, sms.StatusCode, sms.StatusText)
+		}
+	}
+	return nil
+}
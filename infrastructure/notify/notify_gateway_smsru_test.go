@@ -0,0 +1,54 @@
+package notify_test
+
+import (
+	"cargo-rest-api/infrastructure/notify"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSMSRuServer(t *testing.T, body string) (*notify.SMSRuGateway, *http.Request) {
+	var received http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		received = *r
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	gateway, err := notify.NewSMSRuGateway(notify.SMSRuConfig{URL: server.URL, APIID: "api-id", Sender: "Cargo"})
+	assert.NoError(t, err)
+	return gateway, &received
+}
+
+func TestNewSMSRuGateway_NotConfigured(t *testing.T) {
+	_, err := notify.NewSMSRuGateway(notify.SMSRuConfig{})
+	assert.ErrorIs(t, err, notify.ErrSMSGatewayCredentials)
+}
+
+func TestSMSRuGatewaySend(t *testing.T) {
+	gateway, received := newSMSRuServer(t,
+		`{"status":"OK","status_code":100,"sms":{"79250000000":{"status":"OK","status_code":100,"sms_id":"1"}}}`)
+
+	err := gateway.Send([]string{"79250000000"}, "code 1234")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "/sms/send", received.URL.Path)
+	assert.Equal(t, "api-id", received.Form.Get("api_id"))
+	assert.Equal(t, "79250000000", received.Form.Get("to"))
+	assert.Equal(t, "code 1234", received.Form.Get("msg"))
+	assert.Equal(t, "Cargo", received.Form.Get("from"))
+}
+
+func TestSMSRuGatewaySend_Rejected(t *testing.T) {
+	gateway, _ := newSMSRuServer(t,
+		`{"status":"OK","status_code":100,"sms":{"79250000000":{"status":"ERROR","status_code":207,"status_text":"no route"}}}`)
+
+	assert.Error(t, gateway.Send([]string{"79250000000"}, "code 1234"))
+
+	gateway, _ = newSMSRuServer(t, `{"status":"ERROR","status_code":200,"status_text":"wrong api_id"}`)
+
+	assert.Error(t, gateway.Send([]string{"79250000000"}, "code 1234"))
+}
@@ -0,0 +1,35 @@
+package notify_test
+
+import (
+	"cargo-rest-api/infrastructure/notify"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memorySMSGateway struct {
+	receivers []string
+}
+
+func (g *memorySMSGateway) Send(receiver []string, message string) error {
+	g.receivers = append(g.receivers, receiver...)
+	return nil
+}
+
+func TestNotify_DoesNotShareChannels(t *testing.T) {
+	gateway := &memorySMSGateway{}
+	shared := &notify.Notification{SMSNotification: &notify.SMSChannel{Gateway: gateway}}
+
+	first := shared.Notify([]string{"79250000001"}, "shipment_event", nil, "en").ToSMS()
+	second := shared.Notify([]string{"79250000002"}, "shipment_event", nil, "en").ToSMS()
+
+	assert.Empty(t, shared.Notifications)
+	assert.Empty(t, first.Send())
+	assert.Empty(t, second.Send())
+	assert.Equal(t, []string{"79250000001", "79250000002"}, gateway.receivers)
+}
+
+func TestNotification_SMSEnabled(t *testing.T) {
+	assert.False(t, (&notify.Notification{SMSNotification: &notify.SMSChannel{}}).SMSEnabled())
+	assert.True(t, (&notify.Notification{SMSNotification: &notify.SMSChannel{Gateway: &memorySMSGateway{}}}).SMSEnabled())
+}
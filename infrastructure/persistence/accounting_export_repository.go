@@ -0,0 +1,139 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AccountingExportRepo is a struct to store db connection.
+type AccountingExportRepo struct {
+	db *gorm.DB
+}
+
+// NewAccountingExportRepository will initialize accounting export repository.
+func NewAccountingExportRepository(db *gorm.DB) *AccountingExportRepo {
+	return &AccountingExportRepo{db}
+}
+
+// AccountingExportRepo implements the repository.AccountingExportRepository interface.
+var _ repository.AccountingExportRepository = &AccountingExportRepo{}
+
+// SaveCommerceMLExport will export records changed since watermark of the latest export and record new watermark.
+// Deleted routes, orders and payments are exported with deletion mark, full export contains records not deleted.
+func (r AccountingExportRepo) SaveCommerceMLExport(
+	request *entity.AccountingExportRequest,
+	source string,
+	createdBy string,
+) (*entity.AccountingExport, error) {
+	var export *entity.AccountingExport
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var watermark time.Time
+		if !request.Full {
+			var latest entity.AccountingExport
+			err := tx.Where("format = ?", entity.AccountingExportCommerceML).
+				Order("changed_to DESC").
+				Take(&latest).
+				Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			watermark = latest.ChangedTo
+		}
+		export = entity.NewAccountingExport(entity.AccountingExportCommerceML, source, watermark, createdBy)
+
+		var data entity.AccountingExportData
+		if err := r.changed(tx, export, false).Order("name").Find(&data.Sities).Error; err != nil {
+			return err
+		}
+		if err := r.changed(tx, export, false).Order("type").Find(&data.PassengerTypes).Error; err != nil {
+			return err
+		}
+		err := r.changed(tx, export, true).
+			Preload("SityFrom").
+			Preload("SityTo").
+			Order("updated_at").
+			Find(&data.Routes).
+			Error
+		if err != nil {
+			return err
+		}
+		err = r.changed(tx, export, true).
+			Preload("Passengers").
+			Preload("Status").
+			Preload("Trip.Route.SityFrom").
+			Preload("Trip.Route.SityTo").
+			Preload("Trip.Route.Prices.PassengerType").
+			Order("order_date").
+			Find(&data.Orders).
+			Error
+		if err != nil {
+			return err
+		}
+		err = r.changed(tx, export, true).
+			Preload("Orders").
+			Where("type IN ?", []string{entity.PaymentTypeOrder, entity.PaymentTypeRefund}).
+			Order("payment_date").
+			Find(&data.Payments).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if err := export.CommerceML(&data); err != nil {
+			return err
+		}
+		return tx.Create(export).Error
+	})
+	if err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return export, nil
+}
+
+func (r AccountingExportRepo) GetAccountingExports(
+	p *repository.Parameters,
+) ([]*entity.AccountingExport, *repository.Meta, error) {
+	var total int64
+	var exports []*entity.AccountingExport
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&exports).Count(&total).Error
+	errList := r.db.Where(p.QueryKey, p.QueryValue...).
+		Order("changed_to DESC").
+		Limit(p.Limit).
+		Offset(p.Offset).
+		Find(&exports).
+		Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	if errors.Is(errList, gorm.ErrRecordNotFound) {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return exports, meta, nil
+}
+
+// changed will limit query to records changed in period of export,
+// records deleted in period are included when withDeleted is set and export is incremental.
+func (r AccountingExportRepo) changed(db *gorm.DB, export *entity.AccountingExport, withDeleted bool) *gorm.DB {
+	query := db.Where("updated_at <= ?", export.ChangedTo)
+	if export.ChangedFrom.IsZero() {
+		return query
+	}
+	if withDeleted {
+		return query.Unscoped().Where(
+			"(updated_at > ? OR (deleted_at > ? AND deleted_at <= ?))",
+			export.ChangedFrom,
+			export.ChangedFrom,
+			export.ChangedTo,
+		)
+	}
+	return query.Where("updated_at > ?", export.ChangedFrom)
+}
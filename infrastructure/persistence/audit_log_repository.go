@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogRepo is a struct to store db connection.
+type AuditLogRepo struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository will initialize AuditLog repository.
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepo {
+	return &AuditLogRepo{db}
+}
+
+// AuditLogRepo implements the repository.auditLogRepository interface.
+var _ repository.AuditLogRepository = &AuditLogRepo{}
+
+// GetAuditLogs will return audit logs, the latest first.
+func (r AuditLogRepo) GetAuditLogs(p *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error) {
+	return r.getAuditLogs(r.db, p)
+}
+
+// GetEntityAuditLogs will return audit logs of record, entity type is a name of table of record.
+func (r AuditLogRepo) GetEntityAuditLogs(
+	entityType string,
+	entityUUID string,
+	p *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return r.getAuditLogs(r.db.Where("entity_type = ? AND entity_uuid = ?", entityType, entityUUID), p)
+}
+
+// GetUserAuditLogs will return audit logs of changes made by user.
+func (r AuditLogRepo) GetUserAuditLogs(
+	userUUID string,
+	p *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return r.getAuditLogs(r.db.Where("user_uuid = ?", userUUID), p)
+}
+
+// PurgeAuditLogs will delete audit logs created before retention time, number of deleted logs is returned.
+func (r AuditLogRepo) PurgeAuditLogs(before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", before).Delete(&entity.AuditLog{})
+	return result.RowsAffected, result.Error
+}
+
+// GetAPIKeyApplication will return UUID of application and UUID of API key recorded as actor of changes.
+func (r AuditLogRepo) GetAPIKeyApplication(apiKey string) (string, string, error) {
+	var key entity.ApplicationApiKey
+	if err := r.db.Where("api_key = ?", apiKey).Take(&key).Error; err != nil {
+		return "", "", err
+	}
+	return key.ApplicationUUID, key.UUID, nil
+}
+
+// getAuditLogs will return page of audit logs matching query.
+func (r AuditLogRepo) getAuditLogs(
+	query *gorm.DB,
+	p *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	var total int64
+	var logs []*entity.AuditLog
+	errTotal := query.Session(&gorm.Session{}).
+		Model(&entity.AuditLog{}).
+		Where(p.QueryKey, p.QueryValue...).
+		Count(&total).Error
+	errList := query.Session(&gorm.Session{}).
+		Where(p.QueryKey, p.QueryValue...).
+		Order("created_at DESC").
+		Limit(p.Limit).
+		Offset(p.Offset).
+		Find(&logs).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return logs, meta, nil
+}
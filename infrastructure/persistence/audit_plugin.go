@@ -0,0 +1,277 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/pkg/audit"
+	"reflect"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	auditPluginName = "audit"
+	auditBeforeKey  = "audit:before"
+	auditMasked     = "******"
+)
+
+// auditIgnoredTables are tables which changes are not audited: audit log itself and bookkeeping of
+// event delivery written by background services.
+var auditIgnoredTables = map[string]bool{
+	"audit_logs":         true,
+	"outbox_events":      true,
+	"webhook_deliveries": true,
+}
+
+// auditIgnoredFields are fields which changes are not recorded.
+var auditIgnoredFields = []string{"created_at", "updated_at"}
+
+// auditMaskedFields are fields which values are hidden in audit log, only change of value is recorded.
+var auditMaskedFields = map[string]bool{
+	"password":      true,
+	"secret":        true,
+	"api_key":       true,
+	"token":         true,
+	"delivery_code": true,
+}
+
+// auditMaskedSuffixes are endings of names of fields which values are hidden in audit log.
+var auditMaskedSuffixes = []string{"_secret", "_token", "_key"}
+
+// AuditPlugin is a gorm plugin writing audit log of every created, updated and deleted record with UUID.
+// Audit log is written in transaction of change, so change is rolled back when audit log is not stored.
+type AuditPlugin struct{}
+
+// NewAuditPlugin will initialize audit plugin.
+func NewAuditPlugin() *AuditPlugin {
+	return &AuditPlugin{}
+}
+
+// AuditPlugin implements the gorm.Plugin interface.
+var _ gorm.Plugin = &AuditPlugin{}
+
+// Name return name of plugin.
+func (p *AuditPlugin) Name() string {
+	return auditPluginName
+}
+
+// Initialize will register callbacks of plugin.
+func (p *AuditPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	if err := callback.Create().After("gorm:create").Register("audit:after_create", p.afterCreate); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("gorm:update").Register("audit:before_update", p.before); err != nil {
+		return err
+	}
+	if err := callback.Update().After("gorm:update").Register("audit:after_update", p.afterUpdate); err != nil {
+		return err
+	}
+	if err := callback.Delete().Before("gorm:delete").Register("audit:before_delete", p.before); err != nil {
+		return err
+	}
+	return callback.Delete().After("gorm:delete").Register("audit:after_delete", p.afterDelete)
+}
+
+// afterCreate will write audit log of created records, values are taken from created models.
+func (p *AuditPlugin) afterCreate(db *gorm.DB) {
+	if !p.audited(db) || db.Error != nil {
+		return
+	}
+	records := p.modelRecords(db)
+	changes := make(map[string]map[string]audit.Change, len(records))
+	for id, record := range records {
+		changes[id] = audit.Diff(nil, record, auditIgnoredFields...)
+	}
+	p.write(db, audit.ActionCreate, changes)
+}
+
+// before will store records before update or delete.
+func (p *AuditPlugin) before(db *gorm.DB) {
+	if !p.audited(db) || db.Error != nil {
+		return
+	}
+	records, err := p.snapshots(db)
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+	db.InstanceSet(auditBeforeKey, records)
+}
+
+// afterUpdate will write audit log of changed fields of updated records.
+func (p *AuditPlugin) afterUpdate(db *gorm.DB) {
+	before, ok := p.beforeRecords(db)
+	if !ok || len(before) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(before))
+	for id := range before {
+		ids = append(ids, id)
+	}
+	after, err := p.find(db, db.Session(&gorm.Session{NewDB: true}).Where("uuid IN ?", ids))
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+	changes := make(map[string]map[string]audit.Change, len(before))
+	for id, record := range before {
+		if diff := audit.Diff(record, after[id], auditIgnoredFields...); len(diff) > 0 {
+			changes[id] = diff
+		}
+	}
+	p.write(db, audit.ActionUpdate, changes)
+}
+
+// afterDelete will write audit log of deleted records with their values before delete.
+func (p *AuditPlugin) afterDelete(db *gorm.DB) {
+	before, ok := p.beforeRecords(db)
+	if !ok {
+		return
+	}
+	changes := make(map[string]map[string]audit.Change, len(before))
+	for id, record := range before {
+		changes[id] = audit.Diff(record, nil, auditIgnoredFields...)
+	}
+	p.write(db, audit.ActionDelete, changes)
+}
+
+// audited return true when changes of statement are audited.
+func (p *AuditPlugin) audited(db *gorm.DB) bool {
+	stmt := db.Statement
+	if stmt.Schema == nil || auditIgnoredTables[stmt.Table] {
+		return false
+	}
+	return stmt.Schema.LookUpField("UUID") != nil
+}
+
+// beforeRecords return records stored before change of successful statement.
+func (p *AuditPlugin) beforeRecords(db *gorm.DB) (map[string]map[string]interface{}, bool) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return nil, false
+	}
+	value, ok := db.InstanceGet(auditBeforeKey)
+	if !ok {
+		return nil, false
+	}
+	records, ok := value.(map[string]map[string]interface{})
+	return records, ok
+}
+
+// models return models of statement with UUID.
+func (p *AuditPlugin) models(db *gorm.DB) map[string]reflect.Value {
+	stmt := db.Statement
+	field := stmt.Schema.LookUpField("UUID")
+	models := map[string]reflect.Value{}
+	collect := func(value reflect.Value) {
+		if id, zero := field.ValueOf(value); !zero {
+			if s, ok := id.(string); ok && s != "" {
+				models[s] = value
+			}
+		}
+	}
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			collect(reflect.Indirect(stmt.ReflectValue.Index(i)))
+		}
+	case reflect.Struct:
+		collect(stmt.ReflectValue)
+	}
+	return models
+}
+
+// modelRecords return values of columns of models of statement by UUID.
+func (p *AuditPlugin) modelRecords(db *gorm.DB) map[string]map[string]interface{} {
+	schema := db.Statement.Schema
+	records := map[string]map[string]interface{}{}
+	for id, model := range p.models(db) {
+		record := make(map[string]interface{}, len(schema.DBNames))
+		for _, name := range schema.DBNames {
+			record[name], _ = schema.FieldsByDBName[name].ValueOf(model)
+		}
+		records[id] = record
+	}
+	return records
+}
+
+// snapshots return stored values of records of statement by UUID, records of model without UUID are found
+// by conditions of statement.
+func (p *AuditPlugin) snapshots(db *gorm.DB) (map[string]map[string]interface{}, error) {
+	query := db.Session(&gorm.Session{NewDB: true})
+	if models := p.models(db); len(models) > 0 {
+		ids := make([]string, 0, len(models))
+		for id := range models {
+			ids = append(ids, id)
+		}
+		return p.find(db, query.Where("uuid IN ?", ids))
+	}
+	where, ok := db.Statement.Clauses["WHERE"]
+	if !ok {
+		return map[string]map[string]interface{}{}, nil
+	}
+	return p.find(db, query.Clauses(where.Expression))
+}
+
+// find return stored values of records of table of statement matching query by UUID.
+func (p *AuditPlugin) find(db *gorm.DB, query *gorm.DB) (map[string]map[string]interface{}, error) {
+	var rows []map[string]interface{}
+	if err := query.Table(db.Statement.Table).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	records := make(map[string]map[string]interface{}, len(rows))
+	for _, row := range rows {
+		if id, ok := row["uuid"].(string); ok {
+			records[id] = row
+		}
+	}
+	return records, nil
+}
+
+// write will store audit logs of changes of records made by actor of statement in one insert,
+// values of masked fields are hidden.
+func (p *AuditPlugin) write(db *gorm.DB, action string, changes map[string]map[string]audit.Change) {
+	if len(changes) == 0 {
+		return
+	}
+	actor := audit.ActorFromContext(db.Statement.Context)
+	now := time.Now()
+	logs := make([]*entity.AuditLog, 0, len(changes))
+	for entityUUID, fields := range changes {
+		for field, change := range fields {
+			if !auditMaskedField(field) {
+				continue
+			}
+			if change.Before != nil {
+				change.Before = auditMasked
+			}
+			if change.After != nil {
+				change.After = auditMasked
+			}
+			fields[field] = change
+		}
+		log, err := entity.NewAuditLog(db.Statement.Table, entityUUID, action, fields, actor, now)
+		if err != nil {
+			_ = db.AddError(err)
+			return
+		}
+		logs = append(logs, log)
+	}
+	if err := db.Session(&gorm.Session{NewDB: true}).Create(&logs).Error; err != nil {
+		_ = db.AddError(err)
+	}
+}
+
+// auditMaskedField return true when value of field is hidden in audit log.
+func auditMaskedField(field string) bool {
+	if auditMaskedFields[field] {
+		return true
+	}
+	for _, suffix := range auditMaskedSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// BankStatementRepo is a struct to store db connection.
+type BankStatementRepo struct {
+	db *gorm.DB
+}
+
+// NewBankStatementRepository will initialize bank statement repository.
+func NewBankStatementRepository(db *gorm.DB) *BankStatementRepo {
+	return &BankStatementRepo{db}
+}
+
+// BankStatementRepo implements the repository.BankStatementRepository interface.
+var _ repository.BankStatementRepository = &BankStatementRepo{}
+
+// SaveBankStatement will match lines of statement to order payments and refunds and save statement.
+// Candidates are payments referenced by lines or paid within period of statement,
+// payment matched by line of another statement is reconciled already.
+func (r BankStatementRepo) SaveBankStatement(statement *entity.BankStatement) (*entity.BankStatement, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var payments entity.Payments
+		from, to := statement.Period()
+		query := tx.Where("type IN ?", []string{entity.PaymentTypeOrder, entity.PaymentTypeRefund})
+		if references := statement.References(); len(references) > 0 {
+			query = query.Where(
+				tx.Where("external_uuid IN ?", references).
+					Or("payment_date >= ? AND payment_date < ?", from, to),
+			)
+		} else {
+			query = query.Where("payment_date >= ? AND payment_date < ?", from, to)
+		}
+		if err := query.Order("payment_date").Find(&payments).Error; err != nil {
+			return err
+		}
+
+		reconciled, err := r.reconciled(tx, payments, "")
+		if err != nil {
+			return err
+		}
+		statement.Reconcile(payments, reconciled)
+		return tx.Create(statement).Error
+	})
+	if err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return statement, nil
+}
+
+func (r BankStatementRepo) GetBankStatements(p *repository.Parameters) ([]*entity.BankStatement, *repository.Meta, error) {
+	var total int64
+	var statements []*entity.BankStatement
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&statements).Count(&total).Error
+	errList := r.db.Where(p.QueryKey, p.QueryValue...).Limit(p.Limit).Offset(p.Offset).Find(&statements).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	if errors.Is(errList, gorm.ErrRecordNotFound) {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return statements, meta, nil
+}
+
+func (r BankStatementRepo) GetBankStatement(uuid string) (*entity.BankStatement, error) {
+	var statement entity.BankStatement
+	err := r.db.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("line_number")
+	}).Where("uuid = ?", uuid).Take(&statement).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextBankStatementNotFound
+		}
+		return nil, err
+	}
+	return &statement, nil
+}
+
+// ResolveBankStatementLine will match line to payment or ignore it and recalculate counters of its statement.
+// Payment must exist and must not be matched by another line.
+func (r BankStatementRepo) ResolveBankStatementLine(
+	uuid string,
+	request *entity.BankStatementLineResolveRequest,
+	resolvedBy string,
+) (*entity.BankStatementLine, map[string]string, error) {
+	errDesc := map[string]string{}
+	var line entity.BankStatementLine
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("uuid = ?", uuid).Take(&line).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return exception.ErrorTextBankStatementLineNotFound
+			}
+			return err
+		}
+
+		if request.Action == entity.BankStatementResolveMatch {
+			var payment entity.Payment
+			err := tx.Where("uuid = ?", request.PaymentUUID).Take(&payment).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				errDesc["payment_uuid"] = exception.ErrorTextPaymentNotFound.Error()
+				return exception.ErrorTextUnprocessableEntity
+			}
+			if err != nil {
+				return err
+			}
+			reconciled, err := r.reconciled(tx, entity.Payments{&payment}, line.UUID)
+			if err != nil {
+				return err
+			}
+			if reconciled[payment.UUID] {
+				errDesc["payment_uuid"] = exception.ErrorTextBankStatementPaymentReconciled.Error()
+				return exception.ErrorTextUnprocessableEntity
+			}
+		}
+
+		line.Resolve(request, resolvedBy)
+		if err := tx.Save(&line).Error; err != nil {
+			return err
+		}
+
+		var statement entity.BankStatement
+		if err := tx.Preload("Lines").Where("uuid = ?", line.StatementUUID).Take(&statement).Error; err != nil {
+			return err
+		}
+		statement.Count()
+		return tx.Model(&statement).Select(
+			"matched_count",
+			"unmatched_count",
+			"discrepancy_count",
+			"resolved_count",
+		).Updates(&statement).Error
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextBankStatementLineNotFound) {
+			return nil, nil, err
+		}
+		if errors.Is(err, exception.ErrorTextUnprocessableEntity) {
+			return nil, errDesc, err
+		}
+		return nil, nil, exception.ErrorTextAnErrorOccurred
+	}
+	return &line, nil, nil
+}
+
+// reconciled return UUID of payments matched by lines of statements other than excluded line.
+func (r BankStatementRepo) reconciled(
+	db *gorm.DB,
+	payments entity.Payments,
+	excludedLineUUID string,
+) (map[string]bool, error) {
+	reconciled := map[string]bool{}
+	if len(payments) == 0 {
+		return reconciled, nil
+	}
+	paymentUUIDs := make([]string, len(payments))
+	for i, payment := range payments {
+		paymentUUIDs[i] = payment.UUID
+	}
+	var matchedUUIDs []string
+	err := db.Model(&entity.BankStatementLine{}).
+		Where("payment_uuid IN ? AND status IN ? AND uuid <> ?", paymentUUIDs, []string{
+			entity.BankStatementLineMatched,
+			entity.BankStatementLineResolved,
+		}, excludedLineUUID).
+		Pluck("payment_uuid", &matchedUUIDs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	for _, paymentUUID := range matchedUUIDs {
+		reconciled[paymentUUID] = true
+	}
+	return reconciled, nil
+}
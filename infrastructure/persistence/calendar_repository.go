@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CalendarRepo is a struct to store db connection.
+type CalendarRepo struct {
+	db *gorm.DB
+}
+
+// NewCalendarRepository will initialize calendar repository.
+func NewCalendarRepository(db *gorm.DB) *CalendarRepo {
+	return &CalendarRepo{db}
+}
+
+// CalendarRepo implements the repository.CalendarRepository interface.
+var _ repository.CalendarRepository = &CalendarRepo{}
+
+// GetCalendarTokens will return tokens of feeds of user, missing tokens are issued.
+// Every user has passenger feed, driver feed is issued when user is a driver.
+func (r CalendarRepo) GetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error) {
+	var tokens []*entity.CalendarToken
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tokens, err = r.issueCalendarTokens(tx, userUUID)
+		return err
+	})
+	if err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return tokens, nil
+}
+
+// ResetCalendarTokens will revoke tokens of feeds of user and issue new ones, old subscription URLs stop working.
+func (r CalendarRepo) ResetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error) {
+	var tokens []*entity.CalendarToken
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_uuid = ?", userUUID).Delete(&entity.CalendarToken{}).Error; err != nil {
+			return err
+		}
+		var err error
+		tokens, err = r.issueCalendarTokens(tx, userUUID)
+		return err
+	})
+	if err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return tokens, nil
+}
+
+// GetCalendarFeed will return trips or orders of feed of token departing within CalendarFeedPastDays or later.
+// Deleted trips and orders are included so subscribed calendars cancel them.
+func (r CalendarRepo) GetCalendarFeed(token string) (*entity.CalendarFeed, error) {
+	var calendarToken entity.CalendarToken
+	if err := r.db.Where("token = ?", token).Take(&calendarToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextCalendarNotFound
+		}
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+
+	feed := &entity.CalendarFeed{Kind: calendarToken.Kind, Refunded: map[string]bool{}}
+	since := time.Now().AddDate(0, 0, -entity.CalendarFeedPastDays)
+	var err error
+	switch calendarToken.Kind {
+	case entity.CalendarFeedDriver:
+		feed.Trips, err = r.driverTrips(calendarToken.UserUUID, since)
+	case entity.CalendarFeedPassenger:
+		feed.Orders, feed.Refunded, err = r.passengerOrders(calendarToken.UserUUID, since)
+	}
+	if err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return feed, nil
+}
+
+// issueCalendarTokens will create missing tokens of feeds of user and return all tokens of user.
+func (r CalendarRepo) issueCalendarTokens(tx *gorm.DB, userUUID string) ([]*entity.CalendarToken, error) {
+	kinds := []string{entity.CalendarFeedPassenger}
+	var drivers int64
+	if err := tx.Model(&entity.Driver{}).Where("user_uuid = ?", userUUID).Count(&drivers).Error; err != nil {
+		return nil, err
+	}
+	if drivers > 0 {
+		kinds = append([]string{entity.CalendarFeedDriver}, kinds...)
+	}
+
+	var existing []*entity.CalendarToken
+	if err := tx.Where("user_uuid = ?", userUUID).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	byKind := map[string]*entity.CalendarToken{}
+	for _, token := range existing {
+		byKind[token.Kind] = token
+	}
+
+	tokens := make([]*entity.CalendarToken, 0, len(kinds))
+	for _, kind := range kinds {
+		token := byKind[kind]
+		if token == nil {
+			token = &entity.CalendarToken{UserUUID: userUUID, Kind: kind}
+			if err := tx.Create(token).Error; err != nil {
+				return nil, err
+			}
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// driverTrips will return trips of drivers of user departing since time.
+func (r CalendarRepo) driverTrips(userUUID string, since time.Time) ([]*entity.Trip, error) {
+	var trips []*entity.Trip
+	err := r.calendarTripQuery(r.db.Unscoped()).
+		Where("driver_uuid IN (?)", r.db.Model(&entity.Driver{}).Select("uuid").Where("user_uuid = ?", userUUID)).
+		Where("departure_time >= ?", since).
+		Order("departure_time").
+		Find(&trips).
+		Error
+	return trips, err
+}
+
+// passengerOrders will return orders paid by user with trips departing since time,
+// orders returned by refund payments are reported as refunded.
+func (r CalendarRepo) passengerOrders(userUUID string, since time.Time) ([]*entity.Order, map[string]bool, error) {
+	payments := func(paymentType string) *gorm.DB {
+		return r.db.Table("payment_orders").
+			Select("payment_orders.order_uuid").
+			Joins("JOIN payments ON payments.uuid = payment_orders.payment_uuid AND payments.deleted_at IS NULL").
+			Where("payments.type = ? AND payments.user_uuid = ?", paymentType, userUUID)
+	}
+
+	var orders []*entity.Order
+	err := r.db.Unscoped().
+		Preload("Trip", func(db *gorm.DB) *gorm.DB {
+			return r.calendarTripQuery(db.Unscoped())
+		}).
+		Joins("JOIN trips ON trips.uuid = orders.trip_uuid").
+		Where("orders.uuid IN (?)", payments(entity.PaymentTypeOrder)).
+		Where("trips.departure_time >= ?", since).
+		Order("trips.departure_time").
+		Find(&orders).
+		Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var refunds []string
+	if err := payments(entity.PaymentTypeRefund).Pluck("payment_orders.order_uuid", &refunds).Error; err != nil {
+		return nil, nil, err
+	}
+	refunded := map[string]bool{}
+	for _, orderUUID := range refunds {
+		refunded[orderUUID] = true
+	}
+	return orders, refunded, nil
+}
+
+// calendarTripQuery will return query of trips with sities of route and vehicle.
+func (r CalendarRepo) calendarTripQuery(db *gorm.DB) *gorm.DB {
+	return db.Preload("Route.SityFrom").Preload("Route.SityTo").Preload("Vehicle")
+}
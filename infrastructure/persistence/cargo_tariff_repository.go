@@ -0,0 +1,221 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// CargoTariffRepo is a struct to store db connection.
+type CargoTariffRepo struct {
+	db *gorm.DB
+}
+
+// NewCargoTariffRepository will initialize CargoTariff repository.
+func NewCargoTariffRepository(db *gorm.DB) *CargoTariffRepo {
+	return &CargoTariffRepo{db}
+}
+
+// CargoTariffRepo implements the repository.cargoTariffRepository interface.
+var _ repository.CargoTariffRepository = &CargoTariffRepo{}
+
+// SaveCargoTariff will create a new cargo tariff.
+func (r CargoTariffRepo) SaveCargoTariff(
+	CargoTariff *entity.CargoTariff,
+) (*entity.CargoTariff, map[string]string, error) {
+	errDesc, errType := r.checkRoute(CargoTariff.RouteUUID)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	err := r.db.Create(&CargoTariff).Error
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return CargoTariff, nil, nil
+}
+
+// UpdateCargoTariff will update cargo tariff and replace its weight brackets.
+func (r CargoTariffRepo) UpdateCargoTariff(
+	uuid string,
+	tariff *entity.CargoTariff,
+) (*entity.CargoTariff, map[string]string, error) {
+	errDesc, errType := r.checkRoute(tariff.RouteUUID)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	tariffData := &entity.CargoTariff{
+		Name:              tariff.Name,
+		RouteUUID:         tariff.RouteUUID,
+		VolumetricDivisor: tariff.VolumetricDivisor,
+		PricePerKm:        tariff.PricePerKm,
+		InsurancePercent:  tariff.InsurancePercent,
+		MinimumCharge:     tariff.MinimumCharge,
+		CODFeePercent:     tariff.CODFeePercent,
+	}
+	brackets := tariff.Brackets
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Numeric fields are selected explicitly so they can be reset to zero.
+		err := tx.First(&tariff, "uuid = ?", uuid).
+			Select(
+				"name",
+				"route_uuid",
+				"volumetric_divisor",
+				"price_per_km",
+				"insurance_percent",
+				"minimum_charge",
+				"cod_fee_percent",
+			).
+			Updates(tariffData).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("cargo_tariff_uuid = ?", uuid).Delete(&entity.CargoTariffBracket{}).Error; err != nil {
+			return err
+		}
+		for _, bracket := range brackets {
+			bracket.UUID = ""
+			bracket.CargoTariffUUID = uuid
+			if err := tx.Create(bracket).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		//If record not found
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextCargoTariffInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextCargoTariffNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	tariff.Brackets = brackets
+	return tariff, nil, nil
+}
+
+func (r CargoTariffRepo) DeleteCargoTariff(uuid string) error {
+	var tariff entity.CargoTariff
+	err := r.db.Where("uuid = ?", uuid).Take(&tariff).Delete(&tariff).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.ErrorTextCargoTariffNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r CargoTariffRepo) GetCargoTariff(uuid string) (*entity.CargoTariff, error) {
+	var tariff entity.CargoTariff
+	err := r.db.Preload("Brackets").Where("uuid = ?", uuid).Take(&tariff).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextCargoTariffNotFound
+		}
+		return nil, err
+	}
+	return &tariff, nil
+}
+
+func (r CargoTariffRepo) GetCargoTariffs(p *repository.Parameters) ([]*entity.CargoTariff, *repository.Meta, error) {
+	var total int64
+	var tariffs []*entity.CargoTariff
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&tariffs).Count(&total).Error
+	errList := r.db.Where(p.QueryKey, p.QueryValue...).Limit(p.Limit).Offset(p.Offset).Find(&tariffs).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	if errors.Is(errList, gorm.ErrRecordNotFound) {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return tariffs, meta, nil
+}
+
+// QuoteShipment will calculate price of shipment by tariff of route between shipment origin and destination.
+func (r CargoTariffRepo) QuoteShipment(shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error) {
+	return quoteShipment(r.db, shipment)
+}
+
+// checkRoute will verify that route of cargo tariff exists.
+func (r CargoTariffRepo) checkRoute(routeUUID string) (map[string]string, error) {
+	errDesc := map[string]string{}
+	if routeUUID == "" {
+		return errDesc, nil
+	}
+	var total int64
+	if err := r.db.Model(&entity.Route{}).Where("uuid = ?", routeUUID).Count(&total).Error; err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if total == 0 {
+		errDesc["route_uuid"] = exception.ErrorTextRouteInvalidUUID.Error()
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// quoteShipment will find route between shipment origin and destination and calculate price by its tariff.
+func quoteShipment(db *gorm.DB, shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error) {
+	errDesc := map[string]string{}
+
+	route, tariff, err := shipmentTariff(db, shipment)
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if route == nil {
+		errDesc["to_uuid"] = exception.ErrorTextCargoTariffRouteNotFound.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	if tariff == nil {
+		errDesc["cargo_tariff_uuid"] = exception.ErrorTextCargoTariffNotConfigured.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	quote, covered := tariff.Quote(shipment, route.Distance)
+	if !covered {
+		errDesc["weight"] = exception.ErrorTextCargoTariffWeightNotCovered.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	quote.RouteUUID = route.UUID
+	return quote, errDesc, nil
+}
+
+// shipmentTariff will find route between shipment origin and destination and tariff applied to it.
+// Tariff of the route takes precedence over the default tariff without route.
+// Route and tariff are nil when they are not found.
+func shipmentTariff(db *gorm.DB, shipment *entity.Shipment) (*entity.Route, *entity.CargoTariff, error) {
+	var route entity.Route
+	err := db.Where("from_uuid = ? AND to_uuid = ?", shipment.FromUUID, shipment.ToUUID).Take(&route).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	var tariff entity.CargoTariff
+	err = db.Preload("Brackets").Where("route_uuid = ?", route.UUID).Order("created_at").Take(&tariff).Error
+	if err == nil {
+		return &route, &tariff, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+	err = db.Preload("Brackets").Where("route_uuid = '' OR route_uuid IS NULL").Order("created_at").Take(&tariff).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &route, nil, nil
+		}
+		return nil, nil, err
+	}
+	return &route, &tariff, nil
+}
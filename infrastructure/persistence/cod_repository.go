@@ -0,0 +1,170 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// codSettlementColumns are aggregated columns of cash on delivery collected for sender.
+// Fee of shipment is withheld from collected amount, payment which has payout is already paid out.
+const codSettlementColumns = "shipments.sender_phone AS sender_phone, " +
+	"MAX(shipments.sender_name) AS sender_name, " +
+	"COUNT(*) AS shipment_count, " +
+	"SUM(payments.amount) AS collected_amount, " +
+	"SUM(shipments.cod_fee) AS fee_amount, " +
+	"SUM(CASE WHEN COALESCE(payments.payout_uuid, '') <> '' " +
+	"THEN payments.amount - shipments.cod_fee ELSE 0 END) AS paid_out_amount"
+
+// codCollectionColumns are columns of cash on delivery payment with sender and fee of its shipment.
+const codCollectionColumns = "payments.uuid AS payment_uuid, " +
+	"shipments.sender_phone AS sender_phone, " +
+	"shipments.sender_name AS sender_name, " +
+	"payments.amount AS amount, " +
+	"shipments.cod_fee AS fee"
+
+// CODRepo is a struct to store db connection.
+type CODRepo struct {
+	db *gorm.DB
+}
+
+// NewCODRepository will initialize cash on delivery repository.
+func NewCODRepository(db *gorm.DB) *CODRepo {
+	return &CODRepo{db}
+}
+
+// CODRepo implements the repository.CODRepository interface.
+var _ repository.CODRepository = &CODRepo{}
+
+// GetCODSettlements will return report of cash on delivery collected for each sender.
+func (r CODRepo) GetCODSettlements(request *entity.CODSettlementRequest) ([]*entity.CODSettlement, error) {
+	var settlements []*entity.CODSettlement
+	err := r.collectedQuery(r.db, request).
+		Select(codSettlementColumns).
+		Group("shipments.sender_phone").
+		Order("shipments.sender_phone").
+		Scan(&settlements).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	for _, settlement := range settlements {
+		settlement.Calculate()
+	}
+	return settlements, nil
+}
+
+// SaveCODPayouts will create payout batch for each sender from collected cash on delivery not paid out yet.
+// Payments are locked while they are read, totals of payout are calculated from exactly the payments it claims.
+func (r CODRepo) SaveCODPayouts(request *entity.CODSettlementRequest, createdBy string) ([]*entity.CODPayout, error) {
+	var payouts []*entity.CODPayout
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var collections entity.CODCollections
+		err := r.unpaidQuery(tx, request).
+			Select(codCollectionColumns).
+			Order("shipments.sender_phone, payments.uuid").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scan(&collections).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for start := 0; start < len(collections); {
+			end := start + 1
+			for end < len(collections) && collections[end].SenderPhone == collections[start].SenderPhone {
+				end++
+			}
+			senderCollections := collections[start:end]
+			start = end
+
+			payout := entity.NewCODPayout(senderCollections.Settlement(), nil)
+			payout.CreatedBy = createdBy
+			if err := tx.Create(payout).Error; err != nil {
+				return err
+			}
+			// Payment taken by concurrent payout must not be paid out twice.
+			paymentUUIDs := senderCollections.PaymentUUIDs()
+			result := tx.Model(&entity.Payment{}).
+				Where("uuid IN ? AND COALESCE(payout_uuid, '') = ''", paymentUUIDs).
+				Update("payout_uuid", payout.UUID)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected != int64(len(paymentUUIDs)) {
+				return exception.ErrorTextCODPayoutConflict
+			}
+			payouts = append(payouts, payout)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCODPayoutConflict) {
+			return nil, err
+		}
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	if len(payouts) == 0 {
+		return nil, exception.ErrorTextCODPayoutNothingToPay
+	}
+	return payouts, nil
+}
+
+func (r CODRepo) GetCODPayouts(p *repository.Parameters) ([]*entity.CODPayout, *repository.Meta, error) {
+	var total int64
+	var payouts []*entity.CODPayout
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&payouts).Count(&total).Error
+	errList := r.db.Where(p.QueryKey, p.QueryValue...).Limit(p.Limit).Offset(p.Offset).Find(&payouts).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	if errors.Is(errList, gorm.ErrRecordNotFound) {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return payouts, meta, nil
+}
+
+func (r CODRepo) GetCODPayout(uuid string) (*entity.CODPayout, error) {
+	var payout entity.CODPayout
+	err := r.db.Preload("Payments", func(db *gorm.DB) *gorm.DB {
+		return db.Order("payment_date")
+	}).Where("uuid = ?", uuid).Take(&payout).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextCODPayoutNotFound
+		}
+		return nil, err
+	}
+	return &payout, nil
+}
+
+// collectedQuery will return query of cash on delivery payments joined with their shipments.
+func (r CODRepo) collectedQuery(db *gorm.DB, request *entity.CODSettlementRequest) *gorm.DB {
+	query := db.Table("payments").
+		Joins("JOIN shipments ON shipments.uuid = payments.shipment_uuid").
+		Where("payments.type = ? AND payments.deleted_at IS NULL", entity.PaymentTypeCOD)
+	from, to := request.Period()
+	if !from.IsZero() {
+		query = query.Where("payments.payment_date >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("payments.payment_date < ?", to)
+	}
+	if request.SenderPhone != "" {
+		query = query.Where("shipments.sender_phone = ?", request.SenderPhone)
+	}
+	return query
+}
+
+// unpaidQuery will return query of cash on delivery payments which are not paid out yet.
+func (r CODRepo) unpaidQuery(db *gorm.DB, request *entity.CODSettlementRequest) *gorm.DB {
+	return r.collectedQuery(db, request).Where("COALESCE(payments.payout_uuid, '') = ''")
+}
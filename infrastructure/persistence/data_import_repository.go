@@ -0,0 +1,372 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// errDataImportRollback rolls back transaction of import which is dry run or has failed rows.
+var errDataImportRollback = errors.New("rollback data import")
+
+// DataImportRepo is a struct to store db connection.
+type DataImportRepo struct {
+	db *gorm.DB
+}
+
+// NewDataImportRepository will initialize data import repository.
+func NewDataImportRepository(db *gorm.DB) *DataImportRepo {
+	return &DataImportRepo{db}
+}
+
+// DataImportRepo implements the repository.DataImportRepository interface.
+var _ repository.DataImportRepository = &DataImportRepo{}
+
+// dataImporter imports rows in transaction, keys of imported rows are kept to find duplicates.
+type dataImporter struct {
+	tx   *gorm.DB
+	keys map[string]bool
+}
+
+// SaveDataImport will create or update record of every row in one transaction.
+// Transaction is rolled back when any row failed or import is dry run, so rows are checked against database anyway.
+func (r DataImportRepo) SaveDataImport(data *entity.DataImport) (*entity.DataImport, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		importer := &dataImporter{tx: tx, keys: map[string]bool{}}
+		for _, row := range data.Rows {
+			var err error
+			switch data.Entity {
+			case entity.DataImportSities:
+				err = importer.sity(row)
+			case entity.DataImportVehicles:
+				err = importer.vehicle(row)
+			case entity.DataImportDrivers:
+				err = importer.driver(row)
+			case entity.DataImportRoutes:
+				err = importer.route(row)
+			case entity.DataImportTrips:
+				err = importer.trip(row)
+			default:
+				return exception.ErrorTextDataImportEntityUnsupported
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if data.DryRun || data.Failed() {
+			return errDataImportRollback
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDataImportRollback) {
+		if errors.Is(err, exception.ErrorTextDataImportEntityUnsupported) {
+			return nil, err
+		}
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	data.Committed = err == nil
+	return data, nil
+}
+
+// duplicate return true when row has the same key as previous row, row is failed then.
+func (i *dataImporter) duplicate(row *entity.DataImportRow) bool {
+	key := strings.ToLower(row.Key)
+	if i.keys[key] {
+		row.Fail("row", exception.ErrorTextDataImportDuplicateRow)
+		return true
+	}
+	i.keys[key] = true
+	return false
+}
+
+// sity will create or update sity found by name and region.
+func (i *dataImporter) sity(row *entity.DataImportRow) error {
+	sity := row.Sity()
+	if i.duplicate(row) {
+		return nil
+	}
+	var existing entity.Sity
+	found, err := dataImportFind(i.tx.Where("name = ? AND region = ?", sity.Name, sity.Region), &existing)
+	if err != nil {
+		return err
+	}
+	if found {
+		row.Validate(sity.ValidateUpdateSity())
+	} else {
+		row.Validate(sity.ValidateSaveSity())
+	}
+	if row.Failed() {
+		return nil
+	}
+	if found {
+		row.Action, row.UUID = entity.DataImportActionUpdate, existing.UUID
+		return i.tx.Model(&existing).Omit("uuid", "created_at").Updates(sity).Error
+	}
+	row.Action = entity.DataImportActionCreate
+	if err := i.tx.Create(sity).Error; err != nil {
+		return err
+	}
+	row.UUID = sity.UUID
+	return nil
+}
+
+// vehicle will create or update vehicle found by registration code.
+func (i *dataImporter) vehicle(row *entity.DataImportRow) error {
+	vehicle := row.Vehicle()
+	if i.duplicate(row) {
+		return nil
+	}
+	var existing entity.Vehicle
+	found, err := dataImportFind(i.tx.Where("reg_code = ?", vehicle.RegCode), &existing)
+	if err != nil {
+		return err
+	}
+	if found {
+		row.Validate(vehicle.ValidateUpdateVehicle())
+	} else {
+		row.Validate(vehicle.ValidateSaveVehicle())
+	}
+	if row.Failed() {
+		return nil
+	}
+	if found {
+		row.Action, row.UUID = entity.DataImportActionUpdate, existing.UUID
+		return i.tx.Model(&existing).Omit("uuid", "created_at").Updates(vehicle).Error
+	}
+	row.Action = entity.DataImportActionCreate
+	if err := i.tx.Create(vehicle).Error; err != nil {
+		return err
+	}
+	row.UUID = vehicle.UUID
+	return nil
+}
+
+// driver will create or update driver found by name, vehicles of driver are replaced when they are listed in row.
+func (i *dataImporter) driver(row *entity.DataImportRow) error {
+	driver := row.Driver()
+	if i.duplicate(row) {
+		return nil
+	}
+	if email := row.Value("user_email"); email != "" {
+		var user entity.User
+		if err := i.reference(row, "user_email", i.tx.Where("email = ?", email), &user); err != nil {
+			return err
+		}
+		driver.UserUUID = user.UUID
+	}
+	var vehicles []*entity.Vehicle
+	for _, regCode := range row.List("vehicles") {
+		var vehicle entity.Vehicle
+		if err := i.reference(row, "vehicles", i.tx.Where("reg_code = ?", regCode), &vehicle); err != nil {
+			return err
+		}
+		vehicles = append(vehicles, &vehicle)
+	}
+
+	var existing entity.Driver
+	found, err := dataImportFind(i.tx.Where("name = ?", driver.Name), &existing)
+	if err != nil {
+		return err
+	}
+	if found {
+		row.Validate(driver.ValidateUpdateDriver())
+	} else {
+		row.Validate(driver.ValidateSaveDriver())
+	}
+	if row.Failed() {
+		return nil
+	}
+	if found {
+		row.Action, row.UUID = entity.DataImportActionUpdate, existing.UUID
+		err = i.tx.Model(&existing).Omit("uuid", "created_at", "Vehicles", "User").Updates(driver).Error
+		driver = &existing
+	} else {
+		row.Action = entity.DataImportActionCreate
+		err = i.tx.Omit("Vehicles", "User").Create(driver).Error
+		row.UUID = driver.UUID
+	}
+	if err != nil || len(vehicles) == 0 {
+		return err
+	}
+	return i.tx.Model(driver).Association("Vehicles").Replace(vehicles)
+}
+
+// route will create or update route found by sities of departure and destination.
+func (i *dataImporter) route(row *entity.DataImportRow) error {
+	route := row.Route()
+	if i.duplicate(row) {
+		return nil
+	}
+	from, err := i.sityReference(row, "from", "from_region")
+	if err != nil {
+		return err
+	}
+	to, err := i.sityReference(row, "to", "to_region")
+	if err != nil {
+		return err
+	}
+	route.FromUUID, route.ToUUID = from.UUID, to.UUID
+	if row.Failed() {
+		return nil
+	}
+
+	var existing entity.Route
+	found, err := dataImportFind(i.tx.Where("from_uuid = ? AND to_uuid = ?", from.UUID, to.UUID), &existing)
+	if err != nil {
+		return err
+	}
+	if found {
+		row.Validate(route.ValidateUpdateRoute())
+	} else {
+		row.Validate(route.ValidateSaveRoute())
+	}
+	if row.Failed() {
+		return nil
+	}
+	if found {
+		row.Action, row.UUID = entity.DataImportActionUpdate, existing.UUID
+		return i.tx.Model(&existing).Omit("uuid", "created_at", "SityFrom", "SityTo", "Prices").Updates(route).Error
+	}
+	row.Action = entity.DataImportActionCreate
+	if err := i.tx.Omit("SityFrom", "SityTo", "Prices").Create(route).Error; err != nil {
+		return err
+	}
+	row.UUID = route.UUID
+	return nil
+}
+
+// trip will create or update trip found by vehicle and departure time,
+// vehicle documents and driver working time are checked like for trip saved by API.
+func (i *dataImporter) trip(row *entity.DataImportRow) error {
+	trip := row.Trip()
+	if i.duplicate(row) {
+		return nil
+	}
+	from, err := i.sityReference(row, "from", "from_region")
+	if err != nil {
+		return err
+	}
+	to, err := i.sityReference(row, "to", "to_region")
+	if err != nil {
+		return err
+	}
+	if from.UUID != "" && to.UUID != "" {
+		var route entity.Route
+		query := i.tx.Where("from_uuid = ? AND to_uuid = ?", from.UUID, to.UUID)
+		if err := i.reference(row, "route", query, &route); err != nil {
+			return err
+		}
+		trip.RouteUUID = route.UUID
+	}
+	if regCode := row.Value("vehicle"); regCode != "" {
+		var vehicle entity.Vehicle
+		if err := i.reference(row, "vehicle", i.tx.Where("reg_code = ?", regCode), &vehicle); err != nil {
+			return err
+		}
+		trip.VehicleUUID = vehicle.UUID
+	}
+	if name := row.Value("driver"); name != "" {
+		var driver entity.Driver
+		if err := i.reference(row, "driver", i.tx.Where("name = ?", name), &driver); err != nil {
+			return err
+		}
+		trip.DriverUUID = driver.UUID
+	}
+	if regularity := row.Value("regularity_type"); regularity != "" {
+		var regularityType entity.RegularityType
+		if err := i.reference(row, "regularity_type", i.tx.Where("type = ?", regularity), &regularityType); err != nil {
+			return err
+		}
+		trip.RegularityTypeUUID = regularityType.UUID
+	}
+	if row.Failed() {
+		return nil
+	}
+
+	var existing entity.Trip
+	query := i.tx.Where("vehicle_uuid = ? AND departure_time = ?", trip.VehicleUUID, trip.DepartureTime)
+	found, err := dataImportFind(query, &existing)
+	if err != nil {
+		return err
+	}
+	if found {
+		trip.UUID = existing.UUID
+		row.Validate(trip.ValidateUpdateTrip())
+	} else {
+		row.Validate(trip.ValidateSaveTrip())
+	}
+	errDesc, errType := checkVehicleCompliance(i.tx, trip.VehicleUUID, trip.DepartureTime, trip.ArravialTive)
+	if errType != nil {
+		if !errors.Is(errType, exception.ErrorTextUnprocessableEntity) {
+			return errType
+		}
+		row.FailAll(errDesc)
+	}
+	_, errDesc, errType = checkDriverWorkTime(i.tx, trip)
+	if errType != nil {
+		if !errors.Is(errType, exception.ErrorTextUnprocessableEntity) {
+			return errType
+		}
+		row.FailAll(errDesc)
+	}
+	if row.Failed() {
+		return nil
+	}
+	if found {
+		row.Action, row.UUID = entity.DataImportActionUpdate, existing.UUID
+		return i.tx.Model(&existing).
+			Omit("uuid", "created_at", "Route", "Vehicle", "Driver", "RegularityType").
+			Updates(trip).
+			Error
+	}
+	row.Action = entity.DataImportActionCreate
+	if err := i.tx.Omit("Route", "Vehicle", "Driver", "RegularityType").Create(trip).Error; err != nil {
+		return err
+	}
+	row.UUID = trip.UUID
+	return nil
+}
+
+// sityReference will find sity of row by name, region is required only when name is not unique.
+func (i *dataImporter) sityReference(row *entity.DataImportRow, field string, regionField string) (*entity.Sity, error) {
+	var sity entity.Sity
+	name := row.Value(field)
+	if name == "" {
+		return &sity, nil
+	}
+	query := i.tx.Where("name = ?", name)
+	if region := row.Value(regionField); region != "" {
+		query = query.Where("region = ?", region)
+	}
+	return &sity, i.reference(row, field, query, &sity)
+}
+
+// reference will find the only record referenced by field of row, row is failed when record is missing or ambiguous.
+func (i *dataImporter) reference(row *entity.DataImportRow, field string, query *gorm.DB, record interface{}) error {
+	var count int64
+	if err := query.Session(&gorm.Session{}).Model(record).Count(&count).Error; err != nil {
+		return err
+	}
+	switch {
+	case count == 0:
+		row.Fail(field, exception.ErrorTextDataImportReferenceNotFound)
+		return nil
+	case count > 1:
+		row.Fail(field, exception.ErrorTextDataImportReferenceAmbiguous)
+		return nil
+	}
+	return query.Take(record).Error
+}
+
+// dataImportFind will find existing record of row by natural key.
+func dataImportFind(query *gorm.DB, record interface{}) (bool, error) {
+	err := query.Take(record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
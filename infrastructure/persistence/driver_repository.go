@@ -4,8 +4,12 @@ import (
 	"cargo-rest-api/domain/entity"
 	"cargo-rest-api/domain/repository"
 	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/worktime"
 	"errors"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -118,3 +122,125 @@ func (r DriverRepo) DeleteDriverVehicle(driver *entity.Driver) (*entity.Driver,
 	}
 	return driver, nil, nil
 }
+
+// GetDriverWorkRule will return working-time rules of drivers, default rules are returned until rules are saved.
+func (r DriverRepo) GetDriverWorkRule() (*entity.DriverWorkRule, error) {
+	return driverWorkRule(r.db)
+}
+
+// UpdateDriverWorkRule will save working-time rules of drivers.
+func (r DriverRepo) UpdateDriverWorkRule(rule *entity.DriverWorkRule) (*entity.DriverWorkRule, error) {
+	var ruleExists entity.DriverWorkRule
+	err := r.db.Take(&ruleExists).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		rule.UUID = ruleExists.UUID
+		rule.CreatedAt = ruleExists.CreatedAt
+	}
+	// Save writes zero limits too, zero limit disables the rule.
+	if err := r.db.Save(rule).Error; err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return rule, nil
+}
+
+// GetDriverTimesheet will return driving of driver by days in period with violations of working-time rules.
+func (r DriverRepo) GetDriverTimesheet(
+	uuid string,
+	request *entity.DriverTimesheetRequest,
+) (*entity.DriverTimesheet, map[string]string, error) {
+	errDesc := map[string]string{}
+	from, to := request.Period()
+	if !to.After(from) || to.Sub(from) > entity.DriverTimesheetMaxDays*24*time.Hour {
+		errDesc["date_to"] = exception.ErrorTextDriverTimesheetPeriodInvalid.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	var driver entity.Driver
+	if err := r.db.Where("uuid = ?", uuid).Take(&driver).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errDesc, exception.ErrorTextDriverNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	rule, err := driverWorkRule(r.db)
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	trips, err := driverTrips(r.db, driver.UUID, "", from, to)
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return entity.NewDriverTimesheet(&driver, rule, trips, from, to), nil, nil
+}
+
+// driverWorkRule will return saved working-time rules or default rules.
+func driverWorkRule(db *gorm.DB) (*entity.DriverWorkRule, error) {
+	var rule entity.DriverWorkRule
+	err := db.Take(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.DefaultDriverWorkRule(), nil
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// driverTrips will return trips of driver around the period, whole weeks and a day before are loaded
+// so weekly limits and rest before the period can be evaluated.
+func driverTrips(db *gorm.DB, driverUUID string, exceptUUID string, from time.Time, to time.Time) (entity.Trips, error) {
+	windowFrom := worktime.StartOfWeek(from, time.Local).AddDate(0, 0, -1)
+	windowTo := worktime.StartOfWeek(to.Add(-time.Nanosecond), time.Local).AddDate(0, 0, 8)
+	var trips entity.Trips
+	err := db.Where("driver_uuid = ? AND uuid <> ?", driverUUID, exceptUUID).
+		Where("arravial_tive > ? AND departure_time < ?", windowFrom, windowTo).
+		Order("departure_time").
+		Find(&trips).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return trips, nil
+}
+
+// checkDriverWorkTime will verify that assignment of trip to driver does not violate working-time rules.
+// Violations are returned as warnings when rules only warn about them.
+func checkDriverWorkTime(db *gorm.DB, trip *entity.Trip) ([]entity.DriverWorkViolation, map[string]string, error) {
+	errDesc := map[string]string{}
+	if trip.DriverUUID == "" || trip.DepartureTime.IsZero() || !trip.ArravialTive.After(trip.DepartureTime) {
+		return nil, errDesc, nil
+	}
+	if trip.UUID == "" {
+		trip.UUID = uuid.New().String()
+	}
+
+	rule, err := driverWorkRule(db)
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	trips, err := driverTrips(db, trip.DriverUUID, trip.UUID, trip.DepartureTime, trip.ArravialTive)
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+
+	var violations []entity.DriverWorkViolation
+	var rules []string
+	for _, violation := range rule.Check(append(trips, trip)) {
+		for _, tripUUID := range violation.TripUUIDs {
+			if tripUUID == trip.UUID {
+				violations = append(violations, violation)
+				rules = append(rules, violation.Rule)
+				break
+			}
+		}
+	}
+	if len(violations) == 0 || rule.IsWarning() {
+		return violations, errDesc, nil
+	}
+	errDesc["driver_uuid"] = exception.ErrorTextDriverWorkTimeViolated.Error()
+	errDesc["rules"] = strings.Join(rules, ",")
+	return nil, errDesc, exception.ErrorTextUnprocessableEntity
+}
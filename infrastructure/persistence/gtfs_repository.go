@@ -0,0 +1,177 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GTFSRepo is a struct to store db connection.
+type GTFSRepo struct {
+	db *gorm.DB
+}
+
+// NewGTFSRepository will initialize GTFS repository.
+func NewGTFSRepository(db *gorm.DB) *GTFSRepo {
+	return &GTFSRepo{db}
+}
+
+// GTFSRepo implements the repository.GTFSRepository interface.
+var _ repository.GTFSRepository = &GTFSRepo{}
+
+// GetGTFSExport will write GTFS feed of trips departing in period of export.
+func (r GTFSRepo) GetGTFSExport(export *entity.GTFSExport) (*entity.GTFSExport, error) {
+	var trips []*entity.Trip
+	query := r.db.Preload("Route.SityFrom").Preload("Route.SityTo").Where("departure_time >= ?", export.From)
+	query = query.Where("departure_time < ?", export.To)
+	if err := query.Order("departure_time").Find(&trips).Error; err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	if err := export.GTFS(trips); err != nil {
+		if errors.Is(err, entity.ErrGTFSEmpty) {
+			return nil, exception.ErrorTextGTFSEmpty
+		}
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return export, nil
+}
+
+// SaveGTFSImport will match stops of import to sities, create missing routes between sities and trips
+// which do not exist yet, trip exists when trip of the same route departs at the same time.
+// Everything is saved in one transaction which is rolled back in dry run.
+func (r GTFSRepo) SaveGTFSImport(data *entity.GTFSImport) (*entity.GTFSImport, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var sities []*entity.Sity
+		if err := tx.Find(&sities).Error; err != nil {
+			return err
+		}
+		for _, stop := range data.Stops {
+			stop.MatchSity(sities, data.Radius)
+			if stop.Matched() || !data.CreateSities {
+				continue
+			}
+			sity := stop.Sity()
+			if err := tx.Create(sity).Error; err != nil {
+				return err
+			}
+			stop.Created(sity)
+			sities = append(sities, sity)
+		}
+
+		routes, err := r.importRoutes(tx, data)
+		if err != nil {
+			return err
+		}
+		if err := r.importTrips(tx, data, routes); err != nil {
+			return err
+		}
+		if data.DryRun {
+			return errGTFSImportRollback
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errGTFSImportRollback) {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	data.Committed = err == nil
+	return data, nil
+}
+
+// errGTFSImportRollback rolls back transaction of dry run of GTFS import.
+var errGTFSImportRollback = errors.New("rollback GTFS import")
+
+// importRoutes will find or create route between sities of every imported trip, routes are returned by sities.
+func (r GTFSRepo) importRoutes(tx *gorm.DB, data *entity.GTFSImport) (map[string]*entity.Route, error) {
+	routes := map[string]*entity.Route{}
+	for _, trip := range data.Trips {
+		key := gtfsRouteKey(trip)
+		if key == "" || routes[key] != nil {
+			continue
+		}
+		var route entity.Route
+		err := tx.Where("from_uuid = ? AND to_uuid = ?", trip.From.SityUUID, trip.To.SityUUID).Take(&route).Error
+		if err == nil {
+			data.RouteExisting++
+			routes[key] = &route
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		created := trip.Route()
+		if err := tx.Omit("SityFrom", "SityTo", "Prices").Create(created).Error; err != nil {
+			return nil, err
+		}
+		data.RouteCreated++
+		routes[key] = created
+	}
+	return routes, nil
+}
+
+// importTrips will create trips which do not exist, trips of unmatched stops or of one sity are not imported.
+func (r GTFSRepo) importTrips(tx *gorm.DB, data *entity.GTFSImport, routes map[string]*entity.Route) error {
+	var routeUUIDs []string
+	for _, route := range routes {
+		routeUUIDs = append(routeUUIDs, route.UUID)
+	}
+	existing := map[string]bool{}
+	if len(routeUUIDs) > 0 && len(data.Trips) > 0 {
+		var trips []*entity.Trip
+		err := tx.Where("route_uuid IN ?", routeUUIDs).
+			Where(
+				"departure_time BETWEEN ? AND ?",
+				data.Trips[0].DepartureTime,
+				data.Trips[len(data.Trips)-1].DepartureTime,
+			).
+			Find(&trips).
+			Error
+		if err != nil {
+			return err
+		}
+		for _, trip := range trips {
+			existing[gtfsTripKey(trip.RouteUUID, trip.DepartureTime)] = true
+		}
+	}
+
+	var created []*entity.Trip
+	for _, trip := range data.Trips {
+		route := routes[gtfsRouteKey(trip)]
+		if route == nil {
+			data.TripUnmatched++
+			continue
+		}
+		key := gtfsTripKey(route.UUID, trip.DepartureTime)
+		if existing[key] {
+			data.TripExisting++
+			continue
+		}
+		existing[key] = true
+		created = append(created, &entity.Trip{
+			RouteUUID:     route.UUID,
+			DepartureTime: trip.DepartureTime,
+			ArravialTive:  trip.ArrivalTime,
+		})
+	}
+	data.TripCreated = len(created)
+	if len(created) == 0 {
+		return nil
+	}
+	return tx.Omit("Route", "Vehicle", "Driver", "RegularityType").CreateInBatches(created, 100).Error
+}
+
+// gtfsRouteKey return key of route of trip, key is empty when stops are unmatched or matched to the same sity.
+func gtfsRouteKey(trip *entity.GTFSImportTrip) string {
+	if !trip.From.Matched() || !trip.To.Matched() || trip.From.SityUUID == trip.To.SityUUID {
+		return ""
+	}
+	return trip.From.SityUUID + " " + trip.To.SityUUID
+}
+
+// gtfsTripKey return key of trip of route departing at time.
+func gtfsTripKey(routeUUID string, departure time.Time) string {
+	return routeUUID + " " + departure.UTC().Format(time.RFC3339)
+}
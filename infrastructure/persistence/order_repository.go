@@ -5,10 +5,15 @@ import (
 	"cargo-rest-api/domain/repository"
 	"cargo-rest-api/infrastructure/message/exception"
 	"errors"
+	"fmt"
 
 	"gorm.io/gorm"
 )
 
+// orderNotCanceled is a condition of orders which status is not marked as status of canceled order.
+const orderNotCanceled = "NOT EXISTS (SELECT 1 FROM order_status_types AS canceled_statuses " +
+	"WHERE canceled_statuses.uuid = orders.status_uuid AND canceled_statuses.is_canceled = ?)"
+
 // OrderRepo is a struct to store db connection.
 type OrderRepo struct {
 	db *gorm.DB
@@ -24,11 +29,35 @@ var _ repository.OrderRepository = &OrderRepo{}
 
 // SaveOrder will create a new order.
 func (r OrderRepo) SaveOrder(Order *entity.Order) (*entity.Order, map[string]string, error) {
-	errDesc := map[string]string{}
+	errDesc, errType := r.assignPassengerTypes(Order.TripUUID, Order.Passengers)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
 
 	r.db.Model(&Order).Association("Passengers")
 
-	err := r.db.Create(&Order).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		errDesc, errType = checkBaggageCapacity(tx, "", Order.TripUUID, Order)
+		if errType != nil {
+			return errType
+		}
+		if err := tx.Create(&Order).Error; err != nil {
+			return err
+		}
+		if err := savePassengerTypes(tx, Order.Passengers); err != nil {
+			return err
+		}
+		return emitOutboxEvent(
+			tx,
+			entity.WebhookEventOrderCreated,
+			entity.OutboxAggregateOrder,
+			Order.UUID,
+			Order.DetailOrderList(),
+		)
+	})
+	if errType != nil {
+		return nil, errDesc, errType
+	}
 	if err != nil {
 		return nil, errDesc, exception.ErrorTextAnErrorOccurred
 	}
@@ -36,7 +65,6 @@ func (r OrderRepo) SaveOrder(Order *entity.Order) (*entity.Order, map[string]str
 }
 
 func (r OrderRepo) UpdateOrder(uuid string, order *entity.Order) (*entity.Order, map[string]string, error) {
-	errDesc := map[string]string{}
 	dirverData := &entity.Order{
 		OrderDate:    order.OrderDate,
 		TripUUID:     order.TripUUID,
@@ -44,10 +72,51 @@ func (r OrderRepo) UpdateOrder(uuid string, order *entity.Order) (*entity.Order,
 		Seat:         order.Seat,
 		StatusUUID:   order.StatusUUID,
 		Passengers:   order.Passengers,
+
+		BaggageWeight: order.BaggageWeight,
+		BaggageVolume: order.BaggageVolume,
+	}
+	// Missing order is reported by update, stored trip and status are compared with submitted ones.
+	var orderExists entity.Order
+	r.db.Select("trip_uuid", "status_uuid").Where("uuid = ?", uuid).Take(&orderExists)
+	tripUUID := order.TripUUID
+	if tripUUID == "" {
+		tripUUID = orderExists.TripUUID
+	}
+	errDesc, errType := r.assignPassengerTypes(tripUUID, dirverData.Passengers)
+	if errType != nil {
+		return nil, errDesc, errType
 	}
+
 	r.db.Model(order).Association("Passengers")
 
-	err := r.db.First(&order, "uuid = ?", uuid).Updates(dirverData).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		errDesc, errType = checkBaggageCapacity(tx, uuid, tripUUID, dirverData)
+		if errType != nil {
+			return errType
+		}
+		if err := tx.First(&order, "uuid = ?", uuid).Updates(dirverData).Error; err != nil {
+			return err
+		}
+		// Baggage fields are selected explicitly so they can be reset to zero.
+		if err := tx.Model(order).Select("baggage_weight", "baggage_volume").Updates(dirverData).Error; err != nil {
+			return err
+		}
+		if err := savePassengerTypes(tx, dirverData.Passengers); err != nil {
+			return err
+		}
+		if dirverData.StatusUUID == "" || dirverData.StatusUUID == orderExists.StatusUUID {
+			return nil
+		}
+		changed := &entity.WebhookOrderStatusChanged{
+			Order:          order.DetailOrderList(),
+			PreviousStatus: orderExists.StatusUUID,
+		}
+		return emitOutboxEvent(tx, entity.WebhookEventOrderStatusChanged, entity.OutboxAggregateOrder, uuid, changed)
+	})
+	if errType != nil {
+		return nil, errDesc, errType
+	}
 	if err != nil {
 		//If record not found
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -102,3 +171,110 @@ func (r OrderRepo) GetOrders(p *repository.Parameters) ([]*entity.Order, *reposi
 	meta := repository.NewMeta(p, total)
 	return orders, meta, nil
 }
+
+// assignPassengerTypes will derive or verify passenger_type of every passenger by its age at trip departure time.
+func (r OrderRepo) assignPassengerTypes(tripUUID string, passengers []*entity.Passenger) (map[string]string, error) {
+	errDesc := map[string]string{}
+	if len(passengers) == 0 {
+		return errDesc, nil
+	}
+
+	var trip entity.Trip
+	err := r.db.Where("uuid = ?", tripUUID).Take(&trip).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["trip_uuid"] = exception.ErrorTextTripInvalidUUID.Error()
+			return errDesc, exception.ErrorTextUnprocessableEntity
+		}
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+
+	var passengerTypes entity.PassengerTypes
+	err = r.db.Find(&passengerTypes).Error
+	if err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+
+	for i, passenger := range passengers {
+		field := fmt.Sprintf("passengers[%d].passenger_type_uuid", i)
+
+		// Passengers may be referenced by UUID only, so take missing data from the stored record.
+		if passenger.UUID != "" && passenger.BirthDay.IsZero() {
+			var passengerExists entity.Passenger
+			if errExists := r.db.Where("uuid = ?", passenger.UUID).Take(&passengerExists).Error; errExists == nil {
+				passenger.BirthDay = passengerExists.BirthDay
+				if passenger.PassengerTypeUUID == "" {
+					passenger.PassengerTypeUUID = passengerExists.PassengerTypeUUID
+				}
+			}
+		}
+
+		if passenger.BirthDay.IsZero() {
+			if passenger.PassengerTypeUUID == "" {
+				errDesc[field] = exception.ErrorTextPassengerTypeAgeNotCovered.Error()
+			}
+			continue
+		}
+
+		if passenger.PassengerTypeUUID == "" {
+			passengerType := passengerTypes.ByBirthDay(passenger.BirthDay, trip.DepartureTime)
+			if passengerType == nil {
+				errDesc[field] = exception.ErrorTextPassengerTypeAgeNotCovered.Error()
+				continue
+			}
+			passenger.PassengerTypeUUID = passengerType.UUID
+			continue
+		}
+
+		var passengerType *entity.PassengerType
+		for _, pt := range passengerTypes {
+			if pt.UUID == passenger.PassengerTypeUUID {
+				passengerType = pt
+				break
+			}
+		}
+		if passengerType == nil {
+			errDesc[field] = exception.ErrorTextPassengerTypeInvalidUUID.Error()
+			continue
+		}
+		if !passengerType.CoversBirthDay(passenger.BirthDay, trip.DepartureTime) {
+			errDesc[field] = exception.ErrorTextPassengerTypeAgeMismatch.Error()
+		}
+	}
+
+	if len(errDesc) > 0 {
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// savePassengerTypes will store derived passenger_type for passengers which have none yet.
+func savePassengerTypes(tx *gorm.DB, passengers []*entity.Passenger) error {
+	for _, passenger := range passengers {
+		if passenger.UUID == "" || passenger.PassengerTypeUUID == "" {
+			continue
+		}
+		err := tx.Model(&entity.Passenger{}).
+			Where("uuid = ? AND (passenger_type_uuid = '' OR passenger_type_uuid IS NULL)", passenger.UUID).
+			Update("passenger_type_uuid", passenger.PassengerTypeUUID).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBaggageCapacity will verify that passenger baggage of order together with shipments and baggage
+// of other orders of the trip fits into luggage capacity of the trip vehicle, trip is locked in transaction of db.
+func checkBaggageCapacity(db *gorm.DB, uuid string, tripUUID string, order *entity.Order) (map[string]string, error) {
+	return checkTripLoad(
+		db,
+		tripUUID,
+		"",
+		uuid,
+		order.BaggageWeight,
+		order.BaggageVolume,
+		exception.ErrorTextOrderBaggageCapacityExceeded,
+	)
+}
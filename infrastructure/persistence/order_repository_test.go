@@ -0,0 +1,89 @@
+package persistence_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/persistence"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func seedOrderPassengerTypes(db *gorm.DB) (*entity.Trip, map[string]*entity.PassengerType, error) {
+	trip := entity.Trip{
+		UUID:          uuid.New().String(),
+		DepartureTime: time.Date(2022, time.April, 22, 11, 0, 0, 0, time.UTC),
+		ArravialTive:  time.Date(2022, time.April, 22, 19, 30, 0, 0, time.UTC),
+	}
+	if err := db.Create(&trip).Error; err != nil {
+		return nil, nil, err
+	}
+	types := map[string]*entity.PassengerType{
+		"any":   {Type: "Any"},
+		"adult": {Type: "Adult", MinAge: 12},
+		"child": {Type: "Child", MinAge: 0, MaxAge: 11},
+	}
+	for _, passengerType := range types {
+		if err := db.Create(passengerType).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+	return &trip, types, nil
+}
+
+func TestSaveOrder_DerivePassengerType(t *testing.T) {
+	SkipThis(t)
+
+	conn, errConn := DBConn()
+	if errConn != nil {
+		t.Fatalf("want non error, got %#v", errConn)
+	}
+	trip, types, errSeed := seedOrderPassengerTypes(conn)
+	if errSeed != nil {
+		t.Fatalf("want non error, got %#v", errSeed)
+	}
+	passenger := entity.Passenger{UUID: uuid.New().String(), BirthDay: trip.DepartureTime.AddDate(-12, 0, 1)}
+	if err := conn.Create(&passenger).Error; err != nil {
+		t.Fatalf("want non error, got %#v", err)
+	}
+	repo := persistence.NewOrderRepository(conn)
+
+	order, _, errSave := repo.SaveOrder(&entity.Order{
+		TripUUID:   trip.UUID,
+		Passengers: []*entity.Passenger{{UUID: passenger.UUID}},
+	})
+
+	assert.NoError(t, errSave)
+	assert.Equal(t, types["child"].UUID, order.Passengers[0].PassengerTypeUUID)
+	var stored entity.Passenger
+	assert.NoError(t, conn.Where("uuid = ?", passenger.UUID).Take(&stored).Error)
+	assert.Equal(t, types["child"].UUID, stored.PassengerTypeUUID)
+}
+
+func TestSaveOrder_PassengerTypeAgeMismatch(t *testing.T) {
+	SkipThis(t)
+
+	conn, errConn := DBConn()
+	if errConn != nil {
+		t.Fatalf("want non error, got %#v", errConn)
+	}
+	trip, types, errSeed := seedOrderPassengerTypes(conn)
+	if errSeed != nil {
+		t.Fatalf("want non error, got %#v", errSeed)
+	}
+	repo := persistence.NewOrderRepository(conn)
+
+	_, errDesc, errSave := repo.SaveOrder(&entity.Order{
+		TripUUID: trip.UUID,
+		Passengers: []*entity.Passenger{{
+			BirthDay:          trip.DepartureTime.AddDate(-12, 0, 0),
+			PassengerTypeUUID: types["child"].UUID,
+		}},
+	})
+
+	assert.ErrorIs(t, errSave, exception.ErrorTextUnprocessableEntity)
+	assert.Equal(t, exception.ErrorTextPassengerTypeAgeMismatch.Error(), errDesc["passengers[0].passenger_type_uuid"])
+}
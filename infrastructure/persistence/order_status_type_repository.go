@@ -41,10 +41,12 @@ func (r OrderStatusTypeRepo) UpdateOrderStatusType(
 ) (*entity.OrderStatusType, map[string]string, error) {
 	errDesc := map[string]string{}
 	regularityData := &entity.OrderStatusType{
-		Type: regularity.Type,
+		Type:       regularity.Type,
+		IsCanceled: regularity.IsCanceled,
 	}
 
-	err := r.db.First(&regularity, "uuid = ?", uuid).Updates(regularityData).Error
+	// Flag of canceled orders is selected explicitly so it can be reset to false.
+	err := r.db.First(&regularity, "uuid = ?", uuid).Select("type", "is_canceled").Updates(regularityData).Error
 	if err != nil {
 		//If record not found
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OutboxRepo is a struct to store db connection.
+type OutboxRepo struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository will initialize Outbox repository.
+func NewOutboxRepository(db *gorm.DB) *OutboxRepo {
+	return &OutboxRepo{db}
+}
+
+// OutboxRepo implements the repository.outboxRepository interface.
+var _ repository.OutboxRepository = &OutboxRepo{}
+
+// ClaimPendingOutboxEvents will return events due to be published, the oldest first.
+// Every event is locked for OutboxLockDuration by conditional update, so event is claimed by one relay only
+// when several instances are running.
+func (r OutboxRepo) ClaimPendingOutboxEvents(now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var events []*entity.OutboxEvent
+	err := r.db.
+		Where("status = ?", entity.OutboxStatusPending).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Where("locked_until IS NULL OR locked_until <= ?", now).
+		Order("created_at").
+		Limit(limit).
+		Find(&events).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	lockedUntil := now.Add(entity.OutboxLockDuration)
+	claimed := make([]*entity.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		result := r.db.Model(&entity.OutboxEvent{}).
+			Where("uuid = ? AND status = ?", event.UUID, entity.OutboxStatusPending).
+			Where("locked_until IS NULL OR locked_until <= ?", now).
+			UpdateColumn("locked_until", lockedUntil)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 1 {
+			event.LockedUntil = &lockedUntil
+			claimed = append(claimed, event)
+		}
+	}
+	return claimed, nil
+}
+
+// UpdateOutboxEvent will store result of attempt of publication and release claim of event.
+func (r OutboxRepo) UpdateOutboxEvent(event *entity.OutboxEvent) error {
+	return r.db.Model(&entity.OutboxEvent{}).Where("uuid = ?", event.UUID).Updates(map[string]interface{}{
+		"status":          event.Status,
+		"attempts":        event.Attempts,
+		"error":           event.Error,
+		"next_attempt_at": event.NextAttemptAt,
+		"published_at":    event.PublishedAt,
+		"locked_until":    nil,
+	}).Error
+}
+
+// emitOutboxEvent will store event of aggregate in transaction of change, so event is published only when
+// change is stored.
+func emitOutboxEvent(tx *gorm.DB, name string, aggregateType string, aggregateUUID string, data interface{}) error {
+	event, err := entity.NewOutboxEvent(name, aggregateType, aggregateUUID, data, time.Now())
+	if err != nil {
+		return err
+	}
+	return tx.Create(event).Error
+}
@@ -36,10 +36,15 @@ func (r PassengerTypeRepo) SavePassengerType(PassengerType *entity.PassengerType
 func (r PassengerTypeRepo) UpdatePassengerType(uuid string, passengerType *entity.PassengerType) (*entity.PassengerType, map[string]string, error) {
 	errDesc := map[string]string{}
 	passengerTypeData := &entity.PassengerType{
-		Type: passengerType.Type,
+		Type:   passengerType.Type,
+		MinAge: passengerType.MinAge,
+		MaxAge: passengerType.MaxAge,
 	}
 
-	err := r.db.First(&passengerType, "uuid = ?", uuid).Updates(passengerTypeData).Error
+	// Age band fields are selected explicitly so they can be reset to zero.
+	err := r.db.First(&passengerType, "uuid = ?", uuid).
+		Select("type", "min_age", "max_age").
+		Updates(passengerTypeData).Error
 	if err != nil {
 		//If record not found
 		if errors.Is(err, gorm.ErrRecordNotFound) {
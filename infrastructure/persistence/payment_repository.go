@@ -5,6 +5,7 @@ import (
 	"cargo-rest-api/domain/repository"
 	"cargo-rest-api/infrastructure/message/exception"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -28,7 +29,21 @@ func (r PaymentRepo) SavePayment(Payment *entity.Payment) (*entity.Payment, map[
 
 	r.db.Model(&Payment).Association("Orders")
 
-	err := r.db.Create(&Payment).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&Payment).Error; err != nil {
+			return err
+		}
+		if Payment.Type != entity.PaymentTypeOrder {
+			return nil
+		}
+		return emitOutboxEvent(
+			tx,
+			entity.WebhookEventPaymentSucceeded,
+			entity.OutboxAggregatePayment,
+			Payment.UUID,
+			Payment.DetailPaymentList(),
+		)
+	})
 	if err != nil {
 		return nil, errDesc, exception.ErrorTextAnErrorOccurred
 	}
@@ -121,3 +136,68 @@ func (r PaymentRepo) DeleteOrderPayment(payment *entity.Payment) (*entity.Paymen
 	}
 	return payment, nil, nil
 }
+
+// ClaimReceiptPayments will return payments with fiscal receipt to send or to check, the oldest first.
+// Next attempt of every payment is postponed for PaymentFiscalLockDuration by conditional update, so receipt
+// is sent by one worker only when several instances or the command are running. Stored result of attempt
+// sets the next attempt again.
+func (r PaymentRepo) ClaimReceiptPayments(now time.Time, limit int) ([]*entity.Payment, error) {
+	statuses := []string{entity.PaymentFiscalStatusPending, entity.PaymentFiscalStatusWait}
+	var payments []*entity.Payment
+	err := r.db.
+		Preload("User").
+		Preload("Orders.Passengers").
+		Preload("Orders.Trip.Route.SityFrom").
+		Preload("Orders.Trip.Route.SityTo").
+		Preload("Orders.Trip.Route.Prices.PassengerType").
+		Where("fiscal_status IN ?", statuses).
+		Where("fiscal_next_attempt_at IS NULL OR fiscal_next_attempt_at <= ?", now).
+		Order("created_at").
+		Limit(limit).
+		Find(&payments).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	lockedUntil := now.Add(entity.PaymentFiscalLockDuration)
+	claimed := make([]*entity.Payment, 0, len(payments))
+	for _, payment := range payments {
+		result := r.db.Model(&entity.Payment{}).
+			Where("uuid = ? AND fiscal_status IN ?", payment.UUID, statuses).
+			Where("fiscal_next_attempt_at IS NULL OR fiscal_next_attempt_at <= ?", now).
+			UpdateColumn("fiscal_next_attempt_at", lockedUntil)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 1 {
+			claimed = append(claimed, payment)
+		}
+	}
+	return claimed, nil
+}
+
+// UpdatePaymentReceipt will store state of fiscal receipt of payment.
+func (r PaymentRepo) UpdatePaymentReceipt(payment *entity.Payment) error {
+	return r.db.Model(&entity.Payment{}).Where("uuid = ?", payment.UUID).Updates(map[string]interface{}{
+		"fiscal_status":          payment.FiscalStatus,
+		"fiscal_uuid":            payment.FiscalUUID,
+		"fiscal_document_number": payment.FiscalDocumentNumber,
+		"fiscal_sign":            payment.FiscalSign,
+		"fiscal_storage_number":  payment.FiscalStorageNumber,
+		"fiscal_attempts":        payment.FiscalAttempts,
+		"fiscal_error":           payment.FiscalError,
+		"fiscal_next_attempt_at": payment.FiscalNextAttemptAt,
+		"fiscalized_at":          payment.FiscalizedAt,
+	}).Error
+}
+
+// GetFailedReceiptPayments will return payments with failed fiscal receipt, the oldest first.
+func (r PaymentRepo) GetFailedReceiptPayments() ([]*entity.Payment, error) {
+	var payments []*entity.Payment
+	err := r.db.Where("fiscal_status = ?", entity.PaymentFiscalStatusFailed).Order("created_at").Find(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
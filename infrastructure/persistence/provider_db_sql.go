@@ -5,6 +5,7 @@ import (
 	"cargo-rest-api/domain/registry"
 	"cargo-rest-api/domain/repository"
 	"cargo-rest-api/domain/seeds"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -46,6 +47,20 @@ type Repositories struct {
 	Trip               repository.TripRepository
 	Order              repository.OrderRepository
 	Payment            repository.PaymentRepository
+	Shipment           repository.ShipmentRepository
+	CargoTariff        repository.CargoTariffRepository
+	COD                repository.CODRepository
+	VehicleCompliance  repository.VehicleComplianceRepository
+	Report             repository.ReportRepository
+	BankStatement      repository.BankStatementRepository
+	AccountingExport   repository.AccountingExportRepository
+	DataImport         repository.DataImportRepository
+	GTFS               repository.GTFSRepository
+	Calendar           repository.CalendarRepository
+	Webhook            repository.WebhookRepository
+	Outbox             repository.OutboxRepository
+	AuditLog           repository.AuditLogRepository
+	Trash              repository.TrashRepository
 	DB                 *gorm.DB
 }
 
@@ -115,7 +130,20 @@ func NewDBService(config config.DBConfig) (*Repositories, error) {
 	if err != nil {
 		return nil, err
 	}
+	if db != nil {
+		if err := db.Use(NewAuditPlugin()); err != nil {
+			return nil, err
+		}
+		if err := db.Use(NewVersionPlugin()); err != nil {
+			return nil, err
+		}
+	}
 
+	return newRepositories(db), nil
+}
+
+// newRepositories will construct repositories sharing db connection.
+func newRepositories(db *gorm.DB) *Repositories {
 	return &Repositories{
 		Document:           NewDocumentRepository(db),
 		Permission:         NewPermissionRepository(db),
@@ -139,8 +167,30 @@ func NewDBService(config config.DBConfig) (*Repositories, error) {
 		Trip:               NewTripRepository(db),
 		Order:              NewOrderRepository(db),
 		Payment:            NewPaymentRepository(db),
+		Shipment:           NewShipmentRepository(db),
+		CargoTariff:        NewCargoTariffRepository(db),
+		COD:                NewCODRepository(db),
+		VehicleCompliance:  NewVehicleComplianceRepository(db),
+		Report:             NewReportRepository(db),
+		BankStatement:      NewBankStatementRepository(db),
+		AccountingExport:   NewAccountingExportRepository(db),
+		DataImport:         NewDataImportRepository(db),
+		GTFS:               NewGTFSRepository(db),
+		Calendar:           NewCalendarRepository(db),
+		Webhook:            NewWebhookRepository(db),
+		Outbox:             NewOutboxRepository(db),
+		AuditLog:           NewAuditLogRepository(db),
+		Trash:              NewTrashRepository(db),
 		DB:                 db,
-	}, nil
+	}
+}
+
+// WithContext return repositories running statements with context, actor of context is recorded by audit plugin.
+func (s *Repositories) WithContext(ctx context.Context) *Repositories {
+	if s.DB == nil {
+		return s
+	}
+	return newRepositories(s.DB.WithContext(ctx))
 }
 
 // AutoMigrate will migrate all tables.
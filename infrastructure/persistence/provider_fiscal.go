@@ -0,0 +1,158 @@
+package persistence
+
+import (
+	"cargo-rest-api/config"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/pkg/fiscal"
+	"errors"
+	"log"
+	"time"
+)
+
+const (
+	// FiscalProviderStub is a name of local provider printing receipts without cash register,
+	// it is allowed outside of production only.
+	FiscalProviderStub = "stub"
+
+	// FiscalProviderAtol is a name of ATOL Online provider.
+	FiscalProviderAtol = "atol"
+)
+
+// ErrFiscalStubInProduction is returned when stub provider is configured in production.
+var ErrFiscalStubInProduction = errors.New("fiscal stub provider is not allowed in production")
+
+// FiscalService sends fiscal receipts of payments to fiscal provider.
+type FiscalService struct {
+	Provider    fiscal.Provider
+	payments    repository.PaymentRepository
+	vat         string
+	maxAttempts int
+	interval    time.Duration
+}
+
+// NewFiscalProvider will initialize fiscal provider by name.
+func NewFiscalProvider(config config.FiscalConfig) (fiscal.Provider, error) {
+	switch config.Provider {
+	case FiscalProviderStub:
+		return fiscal.NewStub(), nil
+	case FiscalProviderAtol:
+		return fiscal.NewAtol(fiscal.AtolConfig{
+			URL:       config.URL,
+			Login:     config.Login,
+			Password:  config.Password,
+			GroupCode: config.GroupCode,
+			Company: fiscal.Company{
+				INN:            config.INN,
+				Email:          config.Email,
+				SNO:            config.SNO,
+				PaymentAddress: config.PaymentAddress,
+			},
+		})
+	default:
+		return nil, fiscal.ErrUnknownProvider
+	}
+}
+
+// NewFiscalService will initialize fiscal provider and construct fiscal service.
+// Service is nil when provider is not configured, receipts of payments are left pending then.
+func NewFiscalService(config *config.Config, payments repository.PaymentRepository) (*FiscalService, error) {
+	if config.FiscalConfig.Provider == "" {
+		return nil, nil
+	}
+	if config.FiscalConfig.Provider == FiscalProviderStub && config.AppEnvironment == "production" {
+		return nil, ErrFiscalStubInProduction
+	}
+	provider, err := NewFiscalProvider(config.FiscalConfig)
+	if err != nil {
+		return nil, err
+	}
+	service := &FiscalService{
+		Provider:    provider,
+		payments:    payments,
+		vat:         config.FiscalConfig.VAT,
+		maxAttempts: config.FiscalConfig.MaxAttempts,
+		interval:    time.Duration(config.FiscalConfig.Interval) * time.Second,
+	}
+	if service.maxAttempts <= 0 {
+		service.maxAttempts = entity.DefaultPaymentFiscalMaxAttempts
+	}
+	if service.interval <= 0 {
+		service.interval = time.Minute
+	}
+	return service, nil
+}
+
+// Process will send pending receipts and check receipts registered by provider,
+// number of processed receipts is returned.
+func (s *FiscalService) Process() (int, error) {
+	now := time.Now()
+	payments, err := s.payments.ClaimReceiptPayments(now, entity.PaymentFiscalBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for index, payment := range payments {
+		var result *fiscal.Result
+		if payment.FiscalStatus == entity.PaymentFiscalStatusWait && payment.FiscalUUID != "" {
+			result, err = s.Provider.Report(payment.FiscalUUID)
+		} else {
+			result, err = s.Provider.Register(payment.Receipt(s.vat, now))
+		}
+		if err != nil {
+			payment.FiscalizeFailed(err, s.maxAttempts, now)
+		} else {
+			payment.Fiscalize(result, now)
+		}
+		if err := s.payments.UpdatePaymentReceipt(payment); err != nil {
+			return index, err
+		}
+	}
+	return len(payments), nil
+}
+
+// Retry will queue failed receipts to be sent again, number of queued receipts is returned.
+// Receipt registered at provider is checked first and sent again only when provider reports it failed,
+// so receipt printed after all is never registered twice.
+func (s *FiscalService) Retry() (int, error) {
+	now := time.Now()
+	payments, err := s.payments.GetFailedReceiptPayments()
+	if err != nil {
+		return 0, err
+	}
+	queued := 0
+	for _, payment := range payments {
+		if payment.FiscalUUID != "" {
+			result, err := s.Provider.Report(payment.FiscalUUID)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			payment.FiscalStatus = entity.PaymentFiscalStatusWait
+			payment.Fiscalize(result, now)
+		}
+		if payment.FiscalStatus == entity.PaymentFiscalStatusFailed {
+			payment.RequeueReceipt()
+			queued++
+		}
+		if err := s.payments.UpdatePaymentReceipt(payment); err != nil {
+			return queued, err
+		}
+	}
+	return queued, nil
+}
+
+// Run will process receipts periodically until stop is closed.
+func (s *FiscalService) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Process(); err != nil {
+			log.Println(err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,6 +3,7 @@ package persistence
 import (
 	"cargo-rest-api/config"
 	"cargo-rest-api/infrastructure/notify"
+	"fmt"
 
 	"gopkg.in/gomail.v2"
 )
@@ -26,6 +27,22 @@ func NewSMTPClient(config config.SMTPConfig) (*SMTPClient, error) {
 	return &SMTPClient{Client: dialer}, nil
 }
 
+// NewSMSGateway will initialize gateway of configured sms provider, nil gateway is returned when provider is not set.
+func NewSMSGateway(config config.SMSConfig) (notify.SMSGatewayInterface, error) {
+	switch config.SMSProvider {
+	case "":
+		return nil, nil
+	case notify.SMSProviderSMSRu:
+		return notify.NewSMSRuGateway(notify.SMSRuConfig{
+			URL:    config.SMSURL,
+			APIID:  config.SMSAPIID,
+			Sender: config.SMSSender,
+		})
+	default:
+		return nil, fmt.Errorf("unknown sms provider %q", config.SMSProvider)
+	}
+}
+
 func NewNotificationService(config *config.Config) (*NotificationService, error) {
 	smtpClient, errSMTP := NewSMTPClient(config.SMTPConfig)
 	if errSMTP != nil {
@@ -33,7 +50,11 @@ func NewNotificationService(config *config.Config) (*NotificationService, error)
 	}
 
 	emailChannel := &notify.EmailChannel{EmailClient: smtpClient.Client}
-	smsChannel := &notify.SMSChannel{}
+	smsGateway, errSMS := NewSMSGateway(config.SMSConfig)
+	if errSMS != nil {
+		return &NotificationService{}, errSMS
+	}
+	smsChannel := &notify.SMSChannel{Gateway: smsGateway}
 	firebaseChannel := &notify.FirebaseChannel{}
 	notification := notify.Notification{
 		EmailNotification:    emailChannel,
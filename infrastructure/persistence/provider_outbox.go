@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"cargo-rest-api/config"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/pkg/outbox"
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// OutboxService relays stored outbox events to sinks.
+type OutboxService struct {
+	Sinks       []outbox.Sink
+	events      repository.OutboxRepository
+	maxAttempts int
+	interval    time.Duration
+}
+
+// NewOutboxService will construct relay of outbox events to sinks.
+func NewOutboxService(config *config.Config, events repository.OutboxRepository, sinks ...outbox.Sink) *OutboxService {
+	service := &OutboxService{
+		Sinks:       sinks,
+		events:      events,
+		maxAttempts: config.OutboxConfig.MaxAttempts,
+		interval:    time.Duration(config.OutboxConfig.Interval) * time.Second,
+	}
+	if service.maxAttempts <= 0 {
+		service.maxAttempts = entity.DefaultOutboxMaxAttempts
+	}
+	if service.interval <= 0 {
+		service.interval = 5 * time.Second
+	}
+	return service
+}
+
+// NewOutboxSinks will construct sinks of outbox events, in-process handlers queue webhook deliveries
+// and events are appended to Redis Stream when stream is configured and redis client is given.
+func NewOutboxSinks(config *config.Config, webhooks repository.WebhookRepository, client *redis.Client) []outbox.Sink {
+	handlers := outbox.NewHandlers()
+	handlers.Handle(outbox.AllEvents, func(ctx context.Context, message *outbox.Message) error {
+		return webhooks.QueueWebhookEvent(entity.NewWebhookEventFromMessage(message))
+	})
+	sinks := []outbox.Sink{handlers}
+	if config.OutboxConfig.RedisStream != "" && client != nil {
+		sinks = append(sinks, outbox.NewRedisStream(
+			client,
+			config.OutboxConfig.RedisStream,
+			int64(config.OutboxConfig.RedisStreamMaxLen),
+		))
+	}
+	return sinks
+}
+
+// Process will publish due events and schedule failed ones, number of processed events is returned.
+// Event is published again to every sink until all of them accept it.
+func (s *OutboxService) Process() (int, error) {
+	now := time.Now()
+	events, err := s.events.ClaimPendingOutboxEvents(now, entity.OutboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for index, event := range events {
+		if err := outbox.Publish(context.Background(), s.Sinks, event.Message()); err != nil {
+			event.PublishFailed(err, s.maxAttempts, now)
+		} else {
+			event.Published(now)
+		}
+		if err := s.events.UpdateOutboxEvent(event); err != nil {
+			return index, err
+		}
+	}
+	return len(events), nil
+}
+
+// Run will publish events periodically until stop is closed.
+func (s *OutboxService) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Process(); err != nil {
+			log.Println(err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"cargo-rest-api/config"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/pkg/webhook"
+	"log"
+	"time"
+)
+
+// WebhookService sends queued webhook deliveries to subscribers.
+type WebhookService struct {
+	Client      *webhook.Client
+	deliveries  repository.WebhookRepository
+	maxAttempts int
+	interval    time.Duration
+}
+
+// NewWebhookService will construct webhook service.
+func NewWebhookService(config *config.Config, deliveries repository.WebhookRepository) *WebhookService {
+	service := &WebhookService{
+		Client:      webhook.NewClient(time.Duration(config.WebhookConfig.Timeout) * time.Second),
+		deliveries:  deliveries,
+		maxAttempts: config.WebhookConfig.MaxAttempts,
+		interval:    time.Duration(config.WebhookConfig.Interval) * time.Second,
+	}
+	if service.maxAttempts <= 0 {
+		service.maxAttempts = entity.DefaultWebhookMaxAttempts
+	}
+	if service.interval <= 0 {
+		service.interval = 30 * time.Second
+	}
+	return service
+}
+
+// Process will send due deliveries and schedule failed ones, number of processed deliveries is returned.
+func (s *WebhookService) Process() (int, error) {
+	now := time.Now()
+	deliveries, err := s.deliveries.ClaimPendingWebhookDeliveries(now, entity.WebhookDeliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for index, delivery := range deliveries {
+		response, err := s.Client.Send(delivery.Request(time.Now()))
+		if err != nil {
+			delivery.DeliveryFailed(response, err, s.maxAttempts, now)
+		} else {
+			delivery.Delivered(response, now)
+		}
+		if err := s.deliveries.UpdateWebhookDelivery(delivery); err != nil {
+			return index, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// Run will send deliveries periodically until stop is closed.
+func (s *WebhookService) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Process(); err != nil {
+			log.Println(err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
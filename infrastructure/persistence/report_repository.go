@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+// reportRouteJoins join route of trip with its cities, rows without trip or route are kept.
+const reportRouteJoins = "LEFT JOIN routes ON routes.uuid = trips.route_uuid " +
+	"LEFT JOIN sities AS sity_from ON sity_from.uuid = routes.from_uuid " +
+	"LEFT JOIN sities AS sity_to ON sity_to.uuid = routes.to_uuid"
+
+// reportRouteColumns are columns of route of trip.
+const reportRouteColumns = "COALESCE(trips.route_uuid, '') AS route_uuid, " +
+	"COALESCE(sity_from.name, '') AS from_name, " +
+	"COALESCE(sity_to.name, '') AS to_name"
+
+// reportSoldSeats counts passengers of orders of trip which are not canceled.
+const reportSoldSeats = "(SELECT COUNT(*) FROM order_passengers " +
+	"JOIN orders ON orders.uuid = order_passengers.order_uuid " +
+	"WHERE orders.trip_uuid = trips.uuid AND orders.deleted_at IS NULL AND " + orderNotCanceled + ")"
+
+// reportTicketPrice selects route price of passenger type of ticket, passenger type without price pays nothing.
+func reportTicketPrice(trips string, passengers string) string {
+	return "(SELECT COALESCE(MAX(prices.price), 0) FROM route_prices " +
+		"JOIN prices ON prices.uuid = route_prices.price_uuid " +
+		"WHERE route_prices.route_uuid = " + trips + ".route_uuid AND prices.deleted_at IS NULL " +
+		"AND prices.passenger_type_uuid = " + passengers + ".passenger_type_uuid)"
+}
+
+// reportPaymentPrices sums route prices of tickets of all orders of payment.
+var reportPaymentPrices = "(SELECT COALESCE(SUM(" + reportTicketPrice("paid_trips", "paid_passengers") + "), 0) " +
+	"FROM payment_orders AS paid_orders " +
+	"JOIN orders AS paid_order_rows ON paid_order_rows.uuid = paid_orders.order_uuid " +
+	"JOIN trips AS paid_trips ON paid_trips.uuid = paid_order_rows.trip_uuid " +
+	"JOIN order_passengers AS paid_tickets ON paid_tickets.order_uuid = paid_orders.order_uuid " +
+	"JOIN passengers AS paid_passengers ON paid_passengers.uuid = paid_tickets.passenger_uuid " +
+	"WHERE paid_orders.payment_uuid = payments.uuid)"
+
+// reportPaymentTickets counts tickets of all orders of payment.
+const reportPaymentTickets = "(SELECT COUNT(*) FROM payment_orders AS paid_orders " +
+	"JOIN order_passengers AS paid_tickets ON paid_tickets.order_uuid = paid_orders.order_uuid " +
+	"WHERE paid_orders.payment_uuid = payments.uuid)"
+
+// ReportRepo is a struct to store db connection.
+type ReportRepo struct {
+	db *gorm.DB
+}
+
+// NewReportRepository will initialize report repository.
+func NewReportRepository(db *gorm.DB) *ReportRepo {
+	return &ReportRepo{db}
+}
+
+// ReportRepo implements the repository.ReportRepository interface.
+var _ repository.ReportRepository = &ReportRepo{}
+
+// GetRevenueReport will return order payments and refunds summarized by route or period.
+func (r ReportRepo) GetRevenueReport(request *entity.ReportRequest) (entity.ReportRevenues, error) {
+	payments, err := r.payments(request, entity.PaymentTypeOrder, entity.PaymentTypeRefund)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewReportRevenues(payments, request.GroupBy), nil
+}
+
+// GetLoadFactorReport will return sold seats against seats of vehicles for every trip or route.
+func (r ReportRepo) GetLoadFactorReport(request *entity.ReportRequest) (entity.ReportLoadFactors, error) {
+	var trips []*entity.ReportTripSeats
+	query := r.db.Table("trips").
+		Select(
+			"trips.uuid AS trip_uuid, trips.departure_time AS departure_time, "+
+				"COALESCE(vehicles.number_of_seats, 0) AS seats, "+
+				reportSoldSeats+" AS sold_seats, "+
+				reportRouteColumns,
+			true,
+		).
+		Joins("LEFT JOIN vehicles ON vehicles.uuid = trips.vehicle_uuid").
+		Joins(reportRouteJoins).
+		Where("trips.deleted_at IS NULL")
+	err := r.filter(query, request, "trips.departure_time").
+		Order("trips.departure_time").
+		Scan(&trips).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewReportLoadFactors(trips, request.GroupBy), nil
+}
+
+// GetAverageFareReport will return fares paid for tickets sold to passengers summarized by passenger type.
+func (r ReportRepo) GetAverageFareReport(request *entity.ReportRequest) (entity.ReportAverageFares, error) {
+	var payments []*entity.ReportTicketPayment
+	query := r.db.Table("payments").
+		Select("payments.uuid AS payment_uuid, payments.type AS type, payments.amount AS amount, "+
+			reportPaymentTickets+" AS ticket_count, "+
+			reportTicketPrice("trips", "passengers")+" AS price, "+
+			reportPaymentPrices+" AS price_total, "+
+			"orders.uuid AS order_uuid, passengers.uuid AS passenger_uuid, "+
+			"COALESCE(passengers.passenger_type_uuid, '') AS passenger_type_uuid, "+
+			"COALESCE(passenger_types.type, '') AS passenger_type").
+		Joins("JOIN payment_orders ON payment_orders.payment_uuid = payments.uuid").
+		Joins("JOIN orders ON orders.uuid = payment_orders.order_uuid").
+		Joins("JOIN trips ON trips.uuid = orders.trip_uuid").
+		Joins("JOIN order_passengers ON order_passengers.order_uuid = orders.uuid").
+		Joins("JOIN passengers ON passengers.uuid = order_passengers.passenger_uuid").
+		Joins("LEFT JOIN passenger_types ON passenger_types.uuid = passengers.passenger_type_uuid").
+		Where("payments.type IN ? AND payments.deleted_at IS NULL",
+			[]string{entity.PaymentTypeOrder, entity.PaymentTypeRefund}).
+		Where("orders.deleted_at IS NULL AND "+orderNotCanceled, true)
+	err := r.filter(query, request, "trips.departure_time").Scan(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewReportAverageFares(payments), nil
+}
+
+// GetCancellationReport will return canceled orders and refunds summarized by route or period.
+func (r ReportRepo) GetCancellationReport(request *entity.ReportRequest) (entity.ReportCancellations, error) {
+	var orders []*entity.ReportOrder
+	query := r.db.Table("orders").
+		Select(
+			"orders.order_date AS order_date, COALESCE(order_status_types.is_canceled, false) AS canceled, " +
+				"(SELECT COUNT(*) FROM order_passengers WHERE order_passengers.order_uuid = orders.uuid) AS seats, " +
+				reportRouteColumns,
+		).
+		Joins("LEFT JOIN order_status_types ON order_status_types.uuid = orders.status_uuid").
+		Joins("LEFT JOIN trips ON trips.uuid = orders.trip_uuid").
+		Joins(reportRouteJoins).
+		Where("orders.deleted_at IS NULL")
+	err := r.filter(query, request, "orders.order_date").Scan(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := r.payments(request, entity.PaymentTypeRefund)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewReportCancellations(orders, refunds, request.GroupBy), nil
+}
+
+// payments will return payments of given types with route of their trip.
+func (r ReportRepo) payments(request *entity.ReportRequest, types ...string) ([]*entity.ReportPayment, error) {
+	var payments []*entity.ReportPayment
+	query := r.db.Table("payments").
+		Select("payments.payment_date AS payment_date, payments.type AS type, payments.amount AS amount, "+
+			reportRouteColumns).
+		Joins("LEFT JOIN trips ON trips.uuid = payments.trip_uuid").
+		Joins(reportRouteJoins).
+		Where("payments.type IN ? AND payments.deleted_at IS NULL", types)
+	err := r.filter(query, request, "payments.payment_date").Scan(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// filter will limit query by period on given date column and by route of trip.
+func (r ReportRepo) filter(query *gorm.DB, request *entity.ReportRequest, dateColumn string) *gorm.DB {
+	from, to := request.Period()
+	if !from.IsZero() {
+		query = query.Where(dateColumn+" >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where(dateColumn+" < ?", to)
+	}
+	if request.RouteUUID != "" {
+		query = query.Where("trips.route_uuid = ?", request.RouteUUID)
+	}
+	return query
+}
@@ -0,0 +1,622 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ShipmentRepo is a struct to store db connection.
+type ShipmentRepo struct {
+	db *gorm.DB
+}
+
+// NewShipmentRepository will initialize Shipment repository.
+func NewShipmentRepository(db *gorm.DB) *ShipmentRepo {
+	return &ShipmentRepo{db}
+}
+
+// ShipmentRepo implements the repository.shipmentRepository interface.
+var _ repository.ShipmentRepository = &ShipmentRepo{}
+
+// SaveShipment will create a new shipment.
+func (r ShipmentRepo) SaveShipment(Shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+	errDesc, errType := r.checkReferences(Shipment)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	errDesc, errType = r.applyTariff(Shipment)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	// Shipment is accepted at origin and loaded onto the trip at once when the trip is already known.
+	events := entity.ShipmentEvents{
+		{Type: entity.ShipmentEventAccepted, SityUUID: Shipment.FromUUID},
+	}
+	if Shipment.TripUUID != "" {
+		events = append(events, &entity.ShipmentEvent{Type: entity.ShipmentEventLoaded, TripUUID: Shipment.TripUUID})
+	}
+	Shipment.Status = events[len(events)-1].Type
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		errDesc, errType = checkLuggageCapacity(tx, "", Shipment)
+		if errType != nil {
+			return errType
+		}
+		if err := tx.Omit("Events").Create(&Shipment).Error; err != nil {
+			return err
+		}
+		for _, event := range events {
+			event.ShipmentUUID = Shipment.UUID
+			event.Prepare()
+			if err := tx.Create(event).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	Shipment.Events = events
+	return Shipment, nil, nil
+}
+
+func (r ShipmentRepo) UpdateShipment(uuid string, shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+	shipmentData := &entity.Shipment{
+		SenderName:           shipment.SenderName,
+		SenderPhone:          shipment.SenderPhone,
+		SenderEmail:          shipment.SenderEmail,
+		RecipientName:        shipment.RecipientName,
+		RecipientPhone:       shipment.RecipientPhone,
+		RecipientEmail:       shipment.RecipientEmail,
+		FromUUID:             shipment.FromUUID,
+		ToUUID:               shipment.ToUUID,
+		DestinationAddress:   shipment.DestinationAddress,
+		DestinationLatitude:  shipment.DestinationLatitude,
+		DestinationLongitude: shipment.DestinationLongitude,
+		Weight:               shipment.Weight,
+		Length:               shipment.Length,
+		Width:                shipment.Width,
+		Height:               shipment.Height,
+		DeclaredValue:        shipment.DeclaredValue,
+		CODAmount:            shipment.CODAmount,
+		TripUUID:             shipment.TripUUID,
+	}
+
+	errDesc, errType := r.checkReferences(shipmentData)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	var shipmentExists entity.Shipment
+	err := r.db.Where("uuid = ?", uuid).Take(&shipmentExists).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextShipmentInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextShipmentNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	// Shipment keeps its price until data it is calculated from is changed, so change of tariff does not reprice it.
+	if shipmentData.PricedAs(&shipmentExists) {
+		shipmentData.CargoTariffUUID = shipmentExists.CargoTariffUUID
+		shipmentData.Price = shipmentExists.Price
+		shipmentData.CODFee = shipmentExists.CODFee
+	} else {
+		errDesc, errType = r.applyTariff(shipmentData)
+		if errType != nil {
+			return nil, errDesc, errType
+		}
+	}
+	// Collected cash on delivery is already settled with sender.
+	if shipmentExists.Status == entity.ShipmentEventDelivered && shipmentData.CODAmount != shipmentExists.CODAmount {
+		errDesc["cod_amount"] = exception.ErrorTextShipmentCODAlreadyCollected.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		errDesc, errType = checkLuggageCapacity(tx, uuid, shipmentData)
+		if errType != nil {
+			return errType
+		}
+		if err := tx.First(&shipment, "uuid = ?", uuid).Omit("Events").Updates(shipmentData).Error; err != nil {
+			return err
+		}
+		// Price and cash on delivery are updated explicitly so they can be reset by zero.
+		priceData := map[string]interface{}{
+			"cargo_tariff_uuid": shipmentData.CargoTariffUUID,
+			"price":             shipmentData.Price,
+			"cod_amount":        shipmentData.CODAmount,
+			"cod_fee":           shipmentData.CODFee,
+		}
+		if err := tx.Model(&entity.Shipment{}).Where("uuid = ?", uuid).Updates(priceData).Error; err != nil {
+			return err
+		}
+		shipment.CargoTariffUUID = shipmentData.CargoTariffUUID
+		shipment.Price = shipmentData.Price
+		shipment.CODAmount = shipmentData.CODAmount
+		shipment.CODFee = shipmentData.CODFee
+		// Moving shipment onto another trip is recorded in the timeline.
+		if shipmentData.TripUUID != "" && shipmentData.TripUUID != shipmentExists.TripUUID {
+			event := &entity.ShipmentEvent{
+				ShipmentUUID: uuid,
+				Type:         entity.ShipmentEventLoaded,
+				TripUUID:     shipmentData.TripUUID,
+			}
+			event.Prepare()
+			if err := tx.Create(event).Error; err != nil {
+				return err
+			}
+			return tx.Model(&entity.Shipment{}).Where("uuid = ?", uuid).Update("status", event.Type).Error
+		}
+		return nil
+	})
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	if err != nil {
+		//If record not found
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextShipmentInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextShipmentNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return shipment, nil, nil
+}
+
+func (r ShipmentRepo) DeleteShipment(uuid string) error {
+	var shipment entity.Shipment
+	err := r.db.Where("uuid = ?", uuid).Take(&shipment).Delete(&shipment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.ErrorTextShipmentNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r ShipmentRepo) GetShipment(uuid string) (*entity.Shipment, error) {
+	var shipment entity.Shipment
+	err := r.db.Preload("SityFrom").
+		Preload("SityTo").
+		Preload("Trip.Vehicle").
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("event_time")
+		}).
+		Preload("Events.Sity").
+		Where("uuid = ?", uuid).
+		Take(&shipment).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextShipmentNotFound
+		}
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+func (r ShipmentRepo) GetShipments(p *repository.Parameters) ([]*entity.Shipment, *repository.Meta, error) {
+	var total int64
+	var shipments []*entity.Shipment
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&shipments).Count(&total).Error
+	errList := r.db.Where(p.QueryKey, p.QueryValue...).Limit(p.Limit).Offset(p.Offset).Find(&shipments).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	if errors.Is(errList, gorm.ErrRecordNotFound) {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return shipments, meta, nil
+}
+
+// GetShipmentByTrackingNumber will return shipment with its timeline by tracking number.
+func (r ShipmentRepo) GetShipmentByTrackingNumber(trackingNumber string) (*entity.Shipment, error) {
+	var shipment entity.Shipment
+	err := r.db.Preload("SityFrom").
+		Preload("SityTo").
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("event_time")
+		}).
+		Preload("Events.Sity").
+		Preload("Events.Trip").
+		Where("tracking_number = ?", trackingNumber).
+		Take(&shipment).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextShipmentNotFound
+		}
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+// GetTripShipments will return shipments of trip with cities, trip and vehicle to print labels.
+func (r ShipmentRepo) GetTripShipments(tripUUID string) ([]*entity.Shipment, error) {
+	var trip entity.Trip
+	if err := r.db.Where("uuid = ?", tripUUID).Take(&trip).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextTripNotFound
+		}
+		return nil, err
+	}
+
+	var shipments []*entity.Shipment
+	err := r.db.Preload("SityFrom").
+		Preload("SityTo").
+		Preload("Trip.Vehicle").
+		Where("trip_uuid = ?", tripUUID).
+		Order("tracking_number").
+		Find(&shipments).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return shipments, nil
+}
+
+// SaveShipmentEvent will add a new event to shipment timeline and update shipment status.
+func (r ShipmentRepo) SaveShipmentEvent(
+	uuid string,
+	event *entity.ShipmentEvent,
+) (*entity.ShipmentEvent, map[string]string, error) {
+	errDesc := map[string]string{}
+	var shipment entity.Shipment
+	err := r.db.Where("uuid = ?", uuid).Take(&shipment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextShipmentInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextShipmentNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if shipment.Status == entity.ShipmentEventDelivered {
+		errDesc["type"] = exception.ErrorTextShipmentAlreadyDelivered.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	event.ShipmentUUID = shipment.UUID
+	switch event.Type {
+	case entity.ShipmentEventAccepted:
+		if event.SityUUID == "" {
+			event.SityUUID = shipment.FromUUID
+		}
+	case entity.ShipmentEventLoaded, entity.ShipmentEventDeparted:
+		if event.TripUUID == "" {
+			event.TripUUID = shipment.TripUUID
+		}
+		if event.TripUUID == "" {
+			errDesc["trip_uuid"] = exception.ErrorTextTripInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextUnprocessableEntity
+		}
+	case entity.ShipmentEventDelivered:
+		if event.SityUUID == "" {
+			event.SityUUID = shipment.ToUUID
+		}
+	}
+
+	errDesc, errType := r.checkEventReferences(event)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if event.Type == entity.ShipmentEventLoaded && event.TripUUID != shipment.TripUUID {
+			shipment.TripUUID = event.TripUUID
+			errDesc, errType = checkLuggageCapacity(tx, shipment.UUID, &shipment)
+			if errType != nil {
+				return errType
+			}
+		}
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		shipmentData := map[string]interface{}{"status": event.Type}
+		if event.Type == entity.ShipmentEventLoaded {
+			shipmentData["trip_uuid"] = event.TripUUID
+		}
+		return tx.Model(&entity.Shipment{}).Where("uuid = ?", shipment.UUID).Updates(shipmentData).Error
+	})
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return event, nil, nil
+}
+
+// GetShipmentEvents will return timeline of shipment ordered by event time.
+func (r ShipmentRepo) GetShipmentEvents(uuid string) ([]*entity.ShipmentEvent, error) {
+	var total int64
+	err := r.db.Model(&entity.Shipment{}).Where("uuid = ?", uuid).Count(&total).Error
+	if err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		return nil, exception.ErrorTextShipmentNotFound
+	}
+
+	var events []*entity.ShipmentEvent
+	err = r.db.Preload("Sity").Where("shipment_uuid = ?", uuid).Order("event_time").Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// IssueShipmentDeliveryCode will generate a new confirmation code of shipment delivery,
+// the code issued before becomes invalid and wrong attempts are counted from scratch.
+func (r ShipmentRepo) IssueShipmentDeliveryCode(uuid string) (*entity.Shipment, map[string]string, error) {
+	shipment, errDesc, errType := r.takeUndeliveredShipment(uuid)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	code, err := entity.NewDeliveryCode()
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	codeData := map[string]interface{}{"delivery_code": code, "delivery_code_attempts": 0}
+	err = r.db.Model(&entity.Shipment{}).Where("uuid = ?", uuid).Updates(codeData).Error
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	shipment.DeliveryCode = code
+	return shipment, nil, nil
+}
+
+// VerifyShipmentDelivery will check confirmation code given by recipient and collected cash on delivery.
+func (r ShipmentRepo) VerifyShipmentDelivery(uuid string, delivery *entity.ShipmentDelivery) (map[string]string, error) {
+	shipment, errDesc, errType := r.takeUndeliveredShipment(uuid)
+	if errType != nil {
+		return errDesc, errType
+	}
+	return r.checkDelivery(shipment, delivery)
+}
+
+// SaveShipmentDelivery will store proof of delivery and close the shipment with delivered event.
+func (r ShipmentRepo) SaveShipmentDelivery(
+	uuid string,
+	delivery *entity.ShipmentDelivery,
+) (*entity.ShipmentDelivery, map[string]string, error) {
+	shipment, errDesc, errType := r.takeUndeliveredShipment(uuid)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	errDesc, errType = r.checkDelivery(shipment, delivery)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	delivery.ShipmentUUID = shipment.UUID
+	event := &entity.ShipmentEvent{
+		ShipmentUUID: shipment.UUID,
+		Type:         entity.ShipmentEventDelivered,
+		SityUUID:     shipment.ToUUID,
+		Note:         delivery.RecipientName,
+		EventTime:    delivery.DeliveredAt,
+	}
+	event.Prepare()
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(delivery).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		if shipment.CODAmount > 0 {
+			payment := &entity.Payment{
+				Type:         entity.PaymentTypeCOD,
+				PaymentDate:  delivery.DeliveredAt,
+				Amount:       delivery.CODAmount,
+				UserUUID:     delivery.DeliveredBy,
+				TripUUID:     shipment.TripUUID,
+				ShipmentUUID: shipment.UUID,
+			}
+			if err := tx.Omit("Orders").Create(payment).Error; err != nil {
+				return err
+			}
+		}
+		shipmentData := map[string]interface{}{"status": event.Type, "delivery_code": ""}
+		return tx.Model(&entity.Shipment{}).Where("uuid = ?", shipment.UUID).Updates(shipmentData).Error
+	})
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return delivery, nil, nil
+}
+
+// GetShipmentDelivery will return proof of delivery of shipment.
+func (r ShipmentRepo) GetShipmentDelivery(uuid string) (*entity.ShipmentDelivery, error) {
+	var delivery entity.ShipmentDelivery
+	err := r.db.Where("shipment_uuid = ?", uuid).Take(&delivery).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextShipmentDeliveryNotFound
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// checkDelivery will verify confirmation code and that cash on delivery is collected in full.
+// Code is invalidated after too many wrong attempts, so it can not be guessed.
+func (r ShipmentRepo) checkDelivery(
+	shipment *entity.Shipment,
+	delivery *entity.ShipmentDelivery,
+) (map[string]string, error) {
+	errDesc := map[string]string{}
+	counted, err := r.countDeliveryCodeAttempt(shipment.UUID)
+	if err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	switch {
+	case !counted:
+		errDesc["code"] = exception.ErrorTextShipmentDeliveryCodeAttemptsExceeded.Error()
+	case !shipment.VerifyDeliveryCode(delivery.Code):
+		errDesc["code"] = exception.ErrorTextShipmentDeliveryCodeInvalid.Error()
+	default:
+		// Attempt with the right code is given back, only wrong attempts invalidate the code.
+		err = r.db.Model(&entity.Shipment{}).Where("uuid = ?", shipment.UUID).
+			UpdateColumn("delivery_code_attempts", gorm.Expr("delivery_code_attempts - 1")).Error
+		if err != nil {
+			return errDesc, exception.ErrorTextAnErrorOccurred
+		}
+	}
+	switch {
+	case shipment.CODAmount == 0 && delivery.CODAmount > 0:
+		errDesc["cod_amount"] = exception.ErrorTextShipmentCODNotRequired.Error()
+	case shipment.CODAmount > 0 && delivery.CODAmount == 0:
+		errDesc["cod_amount"] = exception.ErrorTextShipmentCODNotCollected.Error()
+	case !shipment.MatchCODAmount(delivery.CODAmount):
+		errDesc["cod_amount"] = exception.ErrorTextShipmentCODAmountMismatch.Error()
+	}
+	if len(errDesc) > 0 {
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// countDeliveryCodeAttempt will count attempt to enter confirmation code, false is returned when attempts are exhausted.
+// Attempt is counted before the code is compared, so parallel requests can not exceed the limit.
+func (r ShipmentRepo) countDeliveryCodeAttempt(uuid string) (bool, error) {
+	result := r.db.Model(&entity.Shipment{}).
+		Where("uuid = ? AND delivery_code_attempts < ?", uuid, entity.MaxDeliveryCodeAttempts).
+		UpdateColumn("delivery_code_attempts", gorm.Expr("delivery_code_attempts + 1"))
+	return result.RowsAffected > 0, result.Error
+}
+
+// takeUndeliveredShipment will return shipment which is not delivered yet.
+func (r ShipmentRepo) takeUndeliveredShipment(uuid string) (*entity.Shipment, map[string]string, error) {
+	errDesc := map[string]string{}
+	var shipment entity.Shipment
+	err := r.db.Where("uuid = ?", uuid).Take(&shipment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextShipmentInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextShipmentNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if shipment.Status == entity.ShipmentEventDelivered {
+		errDesc["uuid"] = exception.ErrorTextShipmentAlreadyDelivered.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return &shipment, errDesc, nil
+}
+
+// checkEventReferences will verify that sity and trip of shipment event exist.
+func (r ShipmentRepo) checkEventReferences(event *entity.ShipmentEvent) (map[string]string, error) {
+	errDesc := map[string]string{}
+	if event.SityUUID != "" {
+		var total int64
+		if err := r.db.Model(&entity.Sity{}).Where("uuid = ?", event.SityUUID).Count(&total).Error; err != nil {
+			return errDesc, exception.ErrorTextAnErrorOccurred
+		}
+		if total == 0 {
+			errDesc["sity_uuid"] = exception.ErrorTextSityInvalidUUID.Error()
+		}
+	}
+	if event.TripUUID != "" {
+		var total int64
+		if err := r.db.Model(&entity.Trip{}).Where("uuid = ?", event.TripUUID).Count(&total).Error; err != nil {
+			return errDesc, exception.ErrorTextAnErrorOccurred
+		}
+		if total == 0 {
+			errDesc["trip_uuid"] = exception.ErrorTextTripInvalidUUID.Error()
+		}
+	}
+	if len(errDesc) > 0 {
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// checkReferences will verify that origin, destination and trip of shipment exist.
+func (r ShipmentRepo) checkReferences(shipment *entity.Shipment) (map[string]string, error) {
+	errDesc := map[string]string{}
+	sities := map[string]string{"from_uuid": shipment.FromUUID, "to_uuid": shipment.ToUUID}
+	for field, sityUUID := range sities {
+		if sityUUID == "" {
+			continue
+		}
+		var total int64
+		if err := r.db.Model(&entity.Sity{}).Where("uuid = ?", sityUUID).Count(&total).Error; err != nil {
+			return errDesc, exception.ErrorTextAnErrorOccurred
+		}
+		if total == 0 {
+			errDesc[field] = exception.ErrorTextSityInvalidUUID.Error()
+		}
+	}
+	if shipment.TripUUID != "" {
+		var total int64
+		if err := r.db.Model(&entity.Trip{}).Where("uuid = ?", shipment.TripUUID).Count(&total).Error; err != nil {
+			return errDesc, exception.ErrorTextAnErrorOccurred
+		}
+		if total == 0 {
+			errDesc["trip_uuid"] = exception.ErrorTextTripInvalidUUID.Error()
+		}
+	}
+	if len(errDesc) > 0 {
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// checkLuggageCapacity will verify that shipment together with other shipments and passenger baggage
+// of the trip fits into luggage capacity of the trip vehicle, trip is locked in transaction of db.
+func checkLuggageCapacity(db *gorm.DB, uuid string, shipment *entity.Shipment) (map[string]string, error) {
+	return checkTripLoad(
+		db,
+		shipment.TripUUID,
+		uuid,
+		"",
+		shipment.Weight,
+		shipment.Volume(),
+		exception.ErrorTextShipmentLuggageCapacityExceeded,
+	)
+}
+
+// applyTariff will price shipment by cargo tariff of its route.
+// Shipment stays without price when there is no route between its sities or no tariff is configured for the route.
+func (r ShipmentRepo) applyTariff(shipment *entity.Shipment) (map[string]string, error) {
+	errDesc := map[string]string{}
+	shipment.CargoTariffUUID, shipment.Price, shipment.CODFee = "", 0, 0
+
+	route, tariff, err := shipmentTariff(r.db, shipment)
+	if err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if route == nil || tariff == nil {
+		return errDesc, nil
+	}
+	quote, covered := tariff.Quote(shipment, route.Distance)
+	if !covered {
+		errDesc["weight"] = exception.ErrorTextCargoTariffWeightNotCovered.Error()
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	shipment.CargoTariffUUID = quote.CargoTariffUUID
+	shipment.Price = quote.Total
+	shipment.CODFee = quote.CODFee
+	return errDesc, nil
+}
@@ -0,0 +1,97 @@
+package persistence_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/persistence"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func seedShipmentSities(db *gorm.DB) (*entity.Sity, *entity.Sity, error) {
+	from := entity.Sity{UUID: uuid.New().String(), Name: "Moscow"}
+	to := entity.Sity{UUID: uuid.New().String(), Name: "Tver"}
+	if err := db.Create(&from).Error; err != nil {
+		return nil, nil, err
+	}
+	if err := db.Create(&to).Error; err != nil {
+		return nil, nil, err
+	}
+	return &from, &to, nil
+}
+
+func seedCargoTariff(db *gorm.DB, routeUUID string, price float64) (*entity.CargoTariff, error) {
+	tariff := entity.CargoTariff{
+		UUID:      uuid.New().String(),
+		Name:      "tariff",
+		RouteUUID: routeUUID,
+		Brackets:  entity.CargoTariffBrackets{{UUID: uuid.New().String(), Price: price}},
+	}
+	return &tariff, db.Create(&tariff).Error
+}
+
+func TestSaveShipment_RouteTariffPrecedence(t *testing.T) {
+	SkipThis(t)
+
+	conn, errConn := DBConn()
+	if errConn != nil {
+		t.Fatalf("want non error, got %#v", errConn)
+	}
+	from, to, errSeed := seedShipmentSities(conn)
+	if errSeed != nil {
+		t.Fatalf("want non error, got %#v", errSeed)
+	}
+	route := entity.Route{UUID: uuid.New().String(), FromUUID: from.UUID, ToUUID: to.UUID}
+	if err := conn.Create(&route).Error; err != nil {
+		t.Fatalf("want non error, got %#v", err)
+	}
+	if _, err := seedCargoTariff(conn, "", 100); err != nil {
+		t.Fatalf("want non error, got %#v", err)
+	}
+	routeTariff, errTariff := seedCargoTariff(conn, route.UUID, 250)
+	if errTariff != nil {
+		t.Fatalf("want non error, got %#v", errTariff)
+	}
+	repo := persistence.NewShipmentRepository(conn)
+
+	shipment, _, errSave := repo.SaveShipment(&entity.Shipment{FromUUID: from.UUID, ToUUID: to.UUID, Weight: 2})
+
+	assert.NoError(t, errSave)
+	assert.Equal(t, routeTariff.UUID, shipment.CargoTariffUUID)
+	assert.Equal(t, 250.0, shipment.Price)
+
+	// Change of tariff does not reprice shipment until its weight, size or route is changed.
+	assert.NoError(t, conn.Model(&entity.CargoTariffBracket{}).
+		Where("cargo_tariff_uuid = ?", routeTariff.UUID).Update("price", 300).Error)
+	updated, _, errUpdate := repo.UpdateShipment(shipment.UUID, &entity.Shipment{
+		FromUUID:      from.UUID,
+		ToUUID:        to.UUID,
+		Weight:        2,
+		RecipientName: "Ivan",
+	})
+
+	assert.NoError(t, errUpdate)
+	assert.Equal(t, 250.0, updated.Price)
+}
+
+func TestSaveShipment_WithoutRoute(t *testing.T) {
+	SkipThis(t)
+
+	conn, errConn := DBConn()
+	if errConn != nil {
+		t.Fatalf("want non error, got %#v", errConn)
+	}
+	from, to, errSeed := seedShipmentSities(conn)
+	if errSeed != nil {
+		t.Fatalf("want non error, got %#v", errSeed)
+	}
+	repo := persistence.NewShipmentRepository(conn)
+
+	shipment, _, errSave := repo.SaveShipment(&entity.Shipment{FromUUID: from.UUID, ToUUID: to.UUID, Weight: 2})
+
+	assert.NoError(t, errSave)
+	assert.Empty(t, shipment.CargoTariffUUID)
+	assert.Zero(t, shipment.Price)
+}
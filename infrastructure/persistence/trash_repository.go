@@ -0,0 +1,168 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TrashRepo is a struct to store db connection.
+type TrashRepo struct {
+	db *gorm.DB
+}
+
+// NewTrashRepository will initialize Trash repository.
+func NewTrashRepository(db *gorm.DB) *TrashRepo {
+	return &TrashRepo{db}
+}
+
+// TrashRepo implements the repository.trashRepository interface.
+var _ repository.TrashRepository = &TrashRepo{}
+
+// GetTrash will return soft-deleted records of resource, the latest deleted first.
+func (r TrashRepo) GetTrash(
+	resource *entity.TrashResource,
+	p *repository.Parameters,
+) ([]interface{}, *repository.Meta, error) {
+	var total int64
+	records := reflect.New(reflect.SliceOf(reflect.TypeOf(resource.Model())))
+	query := r.db.Unscoped().
+		Model(resource.Model()).
+		Where("deleted_at IS NOT NULL").
+		Where(p.QueryKey, p.QueryValue...)
+	errTotal := query.Session(&gorm.Session{}).Count(&total).Error
+	errList := query.Session(&gorm.Session{}).
+		Order("deleted_at DESC").
+		Limit(p.Limit).
+		Offset(p.Offset).
+		Find(records.Interface()).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	list := records.Elem()
+	result := make([]interface{}, list.Len())
+	for index := range result {
+		result[index] = list.Index(index).Interface()
+	}
+	meta := repository.NewMeta(p, total)
+	return result, meta, nil
+}
+
+// RestoreTrash will restore soft-deleted record of resource, record is not restored when any record
+// it references is deleted or does not exist. Restored trip is checked against vehicle compliance and
+// driver working time in the same transaction as it is restored.
+func (r TrashRepo) RestoreTrash(
+	resource *entity.TrashResource,
+	UUID string,
+) (interface{}, map[string]string, error) {
+	errDesc := map[string]string{}
+	record := resource.Model()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		row := map[string]interface{}{}
+		err := tx.Table(resource.Name).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uuid = ? AND deleted_at IS NOT NULL", UUID).
+			Take(&row).
+			Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				errDesc["uuid"] = exception.ErrorTextTrashInvalidUUID.Error()
+				return exception.ErrorTextTrashNotFound
+			}
+			return exception.ErrorTextAnErrorOccurred
+		}
+
+		for _, reference := range resource.References {
+			referenceUUID, _ := row[reference.Column].(string)
+			if referenceUUID == "" {
+				continue
+			}
+			var total int64
+			err := tx.Table(reference.Table).
+				Where("uuid = ? AND deleted_at IS NULL", referenceUUID).
+				Count(&total).Error
+			if err != nil {
+				return exception.ErrorTextAnErrorOccurred
+			}
+			if total == 0 {
+				errDesc[reference.Column] = exception.ErrorTextTrashInvalidReference.Error()
+			}
+		}
+		if len(errDesc) > 0 {
+			return exception.ErrorTextUnprocessableEntity
+		}
+
+		if trip, ok := record.(*entity.Trip); ok {
+			if err := tx.Unscoped().Where("uuid = ?", UUID).Take(trip).Error; err != nil {
+				return exception.ErrorTextAnErrorOccurred
+			}
+			var errType error
+			errDesc, errType = checkVehicleCompliance(tx, trip.VehicleUUID, trip.DepartureTime, trip.ArravialTive)
+			if errType != nil {
+				return errType
+			}
+			if _, errDesc, errType = checkDriverWorkTime(tx, trip); errType != nil {
+				return errType
+			}
+		}
+
+		err = tx.Unscoped().
+			Model(resource.Model()).
+			Where("uuid = ?", UUID).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return exception.ErrorTextAnErrorOccurred
+		}
+		if err := tx.Where("uuid = ?", UUID).Take(record).Error; err != nil {
+			return exception.ErrorTextAnErrorOccurred
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errDesc, err
+	}
+	return record, nil, nil
+}
+
+// PurgeTrash will permanently delete soft-deleted record of resource, record is not purged when it
+// has fiscal or accounting data.
+func (r TrashRepo) PurgeTrash(resource *entity.TrashResource, UUID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		row := map[string]interface{}{}
+		err := tx.Table(resource.Name).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uuid = ? AND deleted_at IS NOT NULL", UUID).
+			Take(&row).
+			Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return exception.ErrorTextTrashNotFound
+			}
+			return err
+		}
+		for _, column := range resource.Retained {
+			if value, _ := row[column].(string); value != "" {
+				return exception.ErrorTextTrashRetained
+			}
+		}
+		for _, dependent := range resource.Dependents {
+			var total int64
+			if err := tx.Table(dependent.Table).Where(dependent.Column+" = ?", UUID).Count(&total).Error; err != nil {
+				return err
+			}
+			if total > 0 {
+				return exception.ErrorTextTrashRetained
+			}
+		}
+
+		return tx.Unscoped().Where("uuid = ?", UUID).Delete(resource.Model()).Error
+	})
+}
@@ -5,8 +5,11 @@ import (
 	"cargo-rest-api/domain/repository"
 	"cargo-rest-api/infrastructure/message/exception"
 	"errors"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // TripRepo is a struct to store db connection.
@@ -24,11 +27,19 @@ var _ repository.TripRepository = &TripRepo{}
 
 // SaveTrip will create a new trip.
 func (r TripRepo) SaveTrip(Trip *entity.Trip) (*entity.Trip, map[string]string, error) {
-	errDesc := map[string]string{}
+	errDesc, errType := checkVehicleCompliance(r.db, Trip.VehicleUUID, Trip.DepartureTime, Trip.ArravialTive)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	warnings, errDesc, errType := checkDriverWorkTime(r.db, Trip)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
 	err := r.db.Create(&Trip).Error
 	if err != nil {
 		return nil, errDesc, exception.ErrorTextAnErrorOccurred
 	}
+	Trip.Warnings = warnings
 	return Trip, nil, nil
 }
 
@@ -43,7 +54,48 @@ func (r TripRepo) UpdateTrip(uuid string, trip *entity.Trip) (*entity.Trip, map[
 		DriverUUID:         trip.DriverUUID,
 	}
 
-	err := r.db.First(&trip, "uuid = ?", uuid).Updates(dirverData).Error
+	var tripExists entity.Trip
+	err := r.db.Where("uuid = ?", uuid).Take(&tripExists).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextTripInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextTripNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	// Empty fields are not updated, so vehicle and driver are checked against the trip as it will be stored.
+	planned := tripExists
+	if dirverData.VehicleUUID != "" {
+		planned.VehicleUUID = dirverData.VehicleUUID
+	}
+	if dirverData.DriverUUID != "" {
+		planned.DriverUUID = dirverData.DriverUUID
+	}
+	if !dirverData.DepartureTime.IsZero() {
+		planned.DepartureTime = dirverData.DepartureTime
+	}
+	if !dirverData.ArravialTive.IsZero() {
+		planned.ArravialTive = dirverData.ArravialTive
+	}
+	errDesc, errType := checkVehicleCompliance(r.db, planned.VehicleUUID, planned.DepartureTime, planned.ArravialTive)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+	warnings, errDesc, errType := checkDriverWorkTime(r.db, &planned)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&trip, "uuid = ?", uuid).Updates(dirverData).Error; err != nil {
+			return err
+		}
+		delayed := entity.NewWebhookTripDelayed(&tripExists, trip)
+		if delayed == nil {
+			return nil
+		}
+		return emitOutboxEvent(tx, entity.WebhookEventTripDelayed, entity.OutboxAggregateTrip, uuid, delayed)
+	})
 	if err != nil {
 		//If record not found
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -52,6 +104,7 @@ func (r TripRepo) UpdateTrip(uuid string, trip *entity.Trip) (*entity.Trip, map[
 		}
 		return nil, errDesc, exception.ErrorTextAnErrorOccurred
 	}
+	trip.Warnings = warnings
 	return trip, nil, nil
 }
 
@@ -101,3 +154,269 @@ func (r TripRepo) GetTrips(p *repository.Parameters) ([]*entity.Trip, *repositor
 	meta := repository.NewMeta(p, total)
 	return trips, meta, nil
 }
+
+// GetTripLoadPlan will return luggage load of trip together with requested additional load.
+// Alternative trips of the same route are suggested when requested load does not fit into the trip.
+func (r TripRepo) GetTripLoadPlan(uuid string, weight float64, volume float64) (*entity.TripLoadPlan, error) {
+	plan, err := tripLoadPlan(r.db, uuid, "", "")
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextTripNotFound
+		}
+		return nil, err
+	}
+	plan.RequestedWeight = weight
+	plan.RequestedVolume = volume
+	plan.Fits = plan.HasRoom(weight, volume)
+	if !plan.Fits {
+		plan.Alternatives, err = alternativeTrips(r.db, plan, weight, volume)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// maxAlternativeTrips is a number of upcoming trips checked for room when trip is full.
+const maxAlternativeTrips = 10
+
+// tripLoadPlan will calculate load plan of trip, shipment and order with given UUID are left out
+// so their own load is not counted twice while they are updated.
+func tripLoadPlan(db *gorm.DB, tripUUID string, shipmentUUID string, orderUUID string) (*entity.TripLoadPlan, error) {
+	var trip entity.Trip
+	if err := db.Preload("Vehicle").Where("uuid = ?", tripUUID).Take(&trip).Error; err != nil {
+		return nil, err
+	}
+	plans, err := tripLoadPlans(db, entity.Trips{&trip}, shipmentUUID, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+	return plans[0], nil
+}
+
+// tripLoadPlans will calculate load plans of trips with preloaded vehicle, shipments and orders of all trips
+// are loaded at once. Canceled orders do not take room in luggage compartment.
+func tripLoadPlans(db *gorm.DB, trips entity.Trips, shipmentUUID string, orderUUID string) ([]*entity.TripLoadPlan, error) {
+	plans := make([]*entity.TripLoadPlan, len(trips))
+	if len(trips) == 0 {
+		return plans, nil
+	}
+	tripUUIDs := make([]string, len(trips))
+	for i, trip := range trips {
+		tripUUIDs[i] = trip.UUID
+	}
+
+	var shipments entity.Shipments
+	shipmentQuery := db.Where("trip_uuid IN ?", tripUUIDs)
+	if shipmentUUID != "" {
+		shipmentQuery = shipmentQuery.Where("uuid <> ?", shipmentUUID)
+	}
+	if err := shipmentQuery.Find(&shipments).Error; err != nil {
+		return nil, err
+	}
+
+	var orders entity.Orders
+	orderQuery := db.Where("trip_uuid IN ?", tripUUIDs).
+		Where(orderNotCanceled, true)
+	if orderUUID != "" {
+		orderQuery = orderQuery.Where("uuid <> ?", orderUUID)
+	}
+	if err := orderQuery.Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	tripShipments := map[string]entity.Shipments{}
+	for _, shipment := range shipments {
+		tripShipments[shipment.TripUUID] = append(tripShipments[shipment.TripUUID], shipment)
+	}
+	tripOrders := map[string]entity.Orders{}
+	for _, order := range orders {
+		tripOrders[order.TripUUID] = append(tripOrders[order.TripUUID], order)
+	}
+	for i, trip := range trips {
+		plans[i] = entity.NewTripLoadPlan(trip, tripShipments[trip.UUID], tripOrders[trip.UUID])
+	}
+	return plans, nil
+}
+
+// alternativeTrips will find upcoming trips of the same route which still have room for the load.
+func alternativeTrips(db *gorm.DB, plan *entity.TripLoadPlan, weight float64, volume float64) ([]*entity.TripLoadPlan, error) {
+	var trips entity.Trips
+	err := db.Preload("Vehicle").
+		Where("route_uuid = ? AND uuid <> ? AND departure_time > ?", plan.RouteUUID, plan.TripUUID, time.Now()).
+		Order("departure_time").
+		Limit(maxAlternativeTrips).
+		Find(&trips).Error
+	if err != nil {
+		return nil, err
+	}
+	plans, err := tripLoadPlans(db, trips, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	alternatives := []*entity.TripLoadPlan{}
+	for _, alternative := range plans {
+		if alternative.HasRoom(weight, volume) {
+			alternatives = append(alternatives, alternative)
+		}
+	}
+	return alternatives, nil
+}
+
+// checkTripLoad will verify that additional load fits into luggage capacity of trip vehicle.
+// Trip is locked until the end of transaction of db, so load saved concurrently is checked after the load
+// saved in the transaction.
+// When it does not, UUIDs of alternative trips with enough room are returned as well.
+func checkTripLoad(
+	db *gorm.DB,
+	tripUUID string,
+	shipmentUUID string,
+	orderUUID string,
+	weight float64,
+	volume float64,
+	errExceeded error,
+) (map[string]string, error) {
+	errDesc := map[string]string{}
+	if tripUUID == "" {
+		return errDesc, nil
+	}
+
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("uuid").
+		Where("uuid = ?", tripUUID).
+		Take(&entity.Trip{}).
+		Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	plan, err := tripLoadPlan(db, tripUUID, shipmentUUID, orderUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["trip_uuid"] = exception.ErrorTextTripInvalidUUID.Error()
+			return errDesc, exception.ErrorTextUnprocessableEntity
+		}
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if plan.HasRoom(weight, volume) {
+		return errDesc, nil
+	}
+
+	errDesc["trip_uuid"] = errExceeded.Error()
+	alternatives, err := alternativeTrips(db, plan, weight, volume)
+	if err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if len(alternatives) > 0 {
+		alternativeUUIDs := make([]string, len(alternatives))
+		for i, alternative := range alternatives {
+			alternativeUUIDs[i] = alternative.TripUUID
+		}
+		errDesc["alternative_trips"] = strings.Join(alternativeUUIDs, ",")
+	}
+	return errDesc, exception.ErrorTextUnprocessableEntity
+}
+
+// SaveTripRoute will optimize sequence of drop-off stops of trip shipments which are not delivered yet.
+// Route previously saved for the trip is replaced.
+func (r TripRepo) SaveTripRoute(uuid string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error) {
+	errDesc := map[string]string{}
+	var trip entity.Trip
+	if err := r.db.Where("uuid = ?", uuid).Take(&trip).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errDesc, exception.ErrorTextTripNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+
+	var shipments entity.Shipments
+	err := r.db.Where("trip_uuid = ? AND status <> ?", uuid, entity.ShipmentEventDelivered).
+		Order("tracking_number").
+		Find(&shipments).
+		Error
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+
+	route.TripUUID = trip.UUID
+	route.StartTime = trip.DepartureTime
+	if route.StartTime.IsZero() {
+		route.StartTime = time.Now()
+	}
+	route.Plan(shipments)
+	if len(route.Stops) == 0 {
+		errDesc["trip_uuid"] = exception.ErrorTextTripRouteNoStops.Error()
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		var previousUUIDs []string
+		if err := tx.Model(&entity.TripRoute{}).Where("trip_uuid = ?", uuid).Pluck("uuid", &previousUUIDs).Error; err != nil {
+			return err
+		}
+		if len(previousUUIDs) > 0 {
+			if err := tx.Where("route_uuid IN ?", previousUUIDs).Delete(&entity.TripRouteStop{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("uuid IN ?", previousUUIDs).Delete(&entity.TripRoute{}).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Stops").Create(route).Error; err != nil {
+			return err
+		}
+		for _, stop := range route.Stops {
+			stop.RouteUUID = route.UUID
+		}
+		return tx.Omit("Shipment").Create(&route.Stops).Error
+	})
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return route, nil, nil
+}
+
+// GetTripRoute will return saved route of trip with its stops in order of visit.
+func (r TripRepo) GetTripRoute(uuid string) (*entity.TripRoute, error) {
+	var route entity.TripRoute
+	err := r.tripRouteQuery().Where("trip_uuid = ?", uuid).Take(&route).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextTripRouteNotFound
+		}
+		return nil, err
+	}
+	return &route, nil
+}
+
+// GetDriverTripRoutes will return routes of trips assigned to driver of given user which are not finished yet.
+func (r TripRepo) GetDriverTripRoutes(userUUID string) ([]*entity.TripRoute, error) {
+	var driver entity.Driver
+	if err := r.db.Where("user_uuid = ?", userUUID).Take(&driver).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextDriverNotFound
+		}
+		return nil, err
+	}
+
+	var routes []*entity.TripRoute
+	err := r.tripRouteQuery().
+		Joins("JOIN trips ON trips.uuid = trip_routes.trip_uuid AND trips.deleted_at IS NULL").
+		Where("trips.driver_uuid = ? AND trip_routes.finish_time >= ?", driver.UUID, time.Now()).
+		Order("trip_routes.start_time").
+		Find(&routes).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// tripRouteQuery will return query of trip routes with preloaded stops and their shipments.
+func (r TripRepo) tripRouteQuery() *gorm.DB {
+	return r.db.
+		Preload("Stops", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sequence")
+		}).
+		Preload("Stops.Shipment")
+}
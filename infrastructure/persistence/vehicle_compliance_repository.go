@@ -0,0 +1,252 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// vehicleComplianceModuleKey is a permission module of compliance records, users allowed to update them
+	// receive reminders about documents expiring soon.
+	vehicleComplianceModuleKey = "vehicle_compliance"
+
+	// vehicleComplianceSuperseded is a condition of document replaced by a newer one of the same type.
+	vehicleComplianceSuperseded = "EXISTS (SELECT 1 FROM vehicle_compliances newer " +
+		"WHERE newer.vehicle_uuid = vehicle_compliances.vehicle_uuid " +
+		"AND newer.type = vehicle_compliances.type " +
+		"AND newer.valid_until > vehicle_compliances.valid_until " +
+		"AND newer.deleted_at IS NULL)"
+)
+
+// VehicleComplianceRepo is a struct to store db connection.
+type VehicleComplianceRepo struct {
+	db *gorm.DB
+}
+
+// NewVehicleComplianceRepository will initialize VehicleCompliance repository.
+func NewVehicleComplianceRepository(db *gorm.DB) *VehicleComplianceRepo {
+	return &VehicleComplianceRepo{db}
+}
+
+// VehicleComplianceRepo implements the repository.VehicleComplianceRepository interface.
+var _ repository.VehicleComplianceRepository = &VehicleComplianceRepo{}
+
+// SaveVehicleCompliance will create a new compliance record of vehicle.
+func (r VehicleComplianceRepo) SaveVehicleCompliance(
+	record *entity.VehicleCompliance,
+) (*entity.VehicleCompliance, map[string]string, error) {
+	errDesc, errType := r.checkRecord(record)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	err := r.db.Omit("Vehicle").Create(record).Error
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return record, nil, nil
+}
+
+// UpdateVehicleCompliance will update compliance record, scan is kept when a new one is not uploaded.
+// Reminder is sent again when expiry date is changed.
+func (r VehicleComplianceRepo) UpdateVehicleCompliance(
+	uuid string,
+	record *entity.VehicleCompliance,
+) (*entity.VehicleCompliance, map[string]string, error) {
+	errDesc, errType := r.checkRecord(record)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	var recordExists entity.VehicleCompliance
+	err := r.db.Where("uuid = ?", uuid).Take(&recordExists).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextVehicleComplianceInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextVehicleComplianceNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+
+	recordData := &entity.VehicleCompliance{
+		VehicleUUID: record.VehicleUUID,
+		Type:        record.Type,
+		Number:      record.Number,
+		ValidFrom:   record.ValidFrom,
+		ValidUntil:  record.ValidUntil,
+		FileUUID:    record.FileUUID,
+		Note:        record.Note,
+		RemindedAt:  recordExists.RemindedAt,
+	}
+	if !recordData.ValidUntil.Equal(recordExists.ValidUntil) {
+		recordData.RemindedAt = nil
+	}
+	// Fields are selected explicitly so they can be cleared.
+	fields := []string{"vehicle_uuid", "type", "number", "valid_from", "valid_until", "note", "reminded_at"}
+	if recordData.FileUUID != "" {
+		fields = append(fields, "file_uuid")
+	}
+	err = r.db.Model(&recordExists).Select(fields).Updates(recordData).Error
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return &recordExists, nil, nil
+}
+
+func (r VehicleComplianceRepo) DeleteVehicleCompliance(uuid string) error {
+	var record entity.VehicleCompliance
+	err := r.db.Where("uuid = ?", uuid).Take(&record).Delete(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.ErrorTextVehicleComplianceNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r VehicleComplianceRepo) GetVehicleCompliance(uuid string) (*entity.VehicleCompliance, error) {
+	var record entity.VehicleCompliance
+	err := r.db.Preload("Vehicle").Where("uuid = ?", uuid).Take(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextVehicleComplianceNotFound
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r VehicleComplianceRepo) GetVehicleCompliances(
+	p *repository.Parameters,
+) ([]*entity.VehicleCompliance, *repository.Meta, error) {
+	var total int64
+	var records []*entity.VehicleCompliance
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&records).Count(&total).Error
+	errList := r.db.Preload("Vehicle").
+		Where(p.QueryKey, p.QueryValue...).
+		Order("valid_until").
+		Limit(p.Limit).
+		Offset(p.Offset).
+		Find(&records).
+		Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	if errors.Is(errList, gorm.ErrRecordNotFound) {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return records, meta, nil
+}
+
+// GetExpiringVehicleCompliances will return documents which expire within given number of days.
+// Documents already replaced by a newer one of the same type are left out.
+func (r VehicleComplianceRepo) GetExpiringVehicleCompliances(days int) ([]*entity.VehicleCompliance, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	var records []*entity.VehicleCompliance
+	err := r.db.Preload("Vehicle").
+		Where("type <> ?", entity.VehicleComplianceMaintenance).
+		Where("valid_until >= ? AND valid_until <= ?", today, today.AddDate(0, 0, days)).
+		Where("NOT " + vehicleComplianceSuperseded).
+		Order("valid_until").
+		Find(&records).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// GetVehicleComplianceRecipients will return emails of users allowed to update compliance records.
+func (r VehicleComplianceRepo) GetVehicleComplianceRecipients() ([]string, error) {
+	var emails []string
+	err := r.db.Model(&entity.User{}).
+		Distinct("users.email").
+		Joins("JOIN user_roles ON user_roles.user_uuid = users.uuid AND user_roles.deleted_at IS NULL").
+		Joins("JOIN role_permissions ON role_permissions.role_uuid = user_roles.role_uuid").
+		Joins("JOIN permissions ON permissions.uuid = role_permissions.permission_uuid").
+		Where("permissions.module_key = ? AND permissions.permission_key = ?", vehicleComplianceModuleKey, "update").
+		Pluck("users.email", &emails).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+// MarkVehicleCompliancesReminded will remember that reminder about records was sent.
+func (r VehicleComplianceRepo) MarkVehicleCompliancesReminded(uuids []string) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+	return r.db.Model(&entity.VehicleCompliance{}).
+		Where("uuid IN ?", uuids).
+		Update("reminded_at", time.Now()).
+		Error
+}
+
+// checkRecord will verify that vehicle of compliance record exists and its period is not reversed.
+func (r VehicleComplianceRepo) checkRecord(record *entity.VehicleCompliance) (map[string]string, error) {
+	errDesc := map[string]string{}
+	var total int64
+	if err := r.db.Model(&entity.Vehicle{}).Where("uuid = ?", record.VehicleUUID).Count(&total).Error; err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if total == 0 {
+		errDesc["vehicle_uuid"] = exception.ErrorTextVehicleInvalidUUID.Error()
+	}
+	if !record.ValidFrom.IsZero() && record.ValidUntil.Before(record.ValidFrom) {
+		errDesc["valid_until"] = exception.ErrorTextVehicleCompliancePeriodInvalid.Error()
+	}
+	if len(errDesc) > 0 {
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// checkVehicleCompliance will verify that vehicle has valid documents and no maintenance during trip.
+func checkVehicleCompliance(
+	db *gorm.DB,
+	vehicleUUID string,
+	departureTime time.Time,
+	arrivalTime time.Time,
+) (map[string]string, error) {
+	errDesc := map[string]string{}
+	if vehicleUUID == "" || departureTime.IsZero() {
+		return errDesc, nil
+	}
+	if arrivalTime.Before(departureTime) {
+		arrivalTime = departureTime
+	}
+
+	var records entity.VehicleCompliances
+	if err := db.Where("vehicle_uuid = ?", vehicleUUID).Find(&records).Error; err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	blocking := records.Blocking(departureTime, arrivalTime)
+	if blocking == nil {
+		return errDesc, nil
+	}
+	switch {
+	case blocking.IsMaintenance():
+		errDesc["vehicle_uuid"] = exception.ErrorTextVehicleMaintenanceScheduled.Error()
+	case blocking.UUID == "":
+		errDesc["vehicle_uuid"] = exception.ErrorTextVehicleComplianceMissing.Error()
+		errDesc["compliance_type"] = blocking.Type
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	default:
+		errDesc["vehicle_uuid"] = exception.ErrorTextVehicleComplianceExpired.Error()
+	}
+	errDesc["compliance_uuid"] = blocking.UUID
+	return errDesc, exception.ErrorTextUnprocessableEntity
+}
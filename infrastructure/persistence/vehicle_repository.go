@@ -40,9 +40,14 @@ func (r VehicleRepo) UpdateVehicle(uuid string, vehicle *entity.Vehicle) (*entit
 		RegCode:       vehicle.RegCode,
 		NumberOfSeats: vehicle.NumberOfSeats,
 		Class:         vehicle.Class,
+		LuggageWeight: vehicle.LuggageWeight,
+		LuggageVolume: vehicle.LuggageVolume,
 	}
 
-	err := r.db.First(&vehicle, "uuid = ?", uuid).Updates(vehicleData).Error
+	// Luggage capacity fields are selected explicitly so they can be reset to zero.
+	err := r.db.First(&vehicle, "uuid = ?", uuid).
+		Select("model", "reg_code", "number_of_seats", "class", "luggage_weight", "luggage_volume").
+		Updates(vehicleData).Error
 	if err != nil {
 		//If record not found
 		if errors.Is(err, gorm.ErrRecordNotFound) {
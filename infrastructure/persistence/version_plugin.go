@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/etag"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	versionPluginName = "version"
+	versionCheckedKey = "version:checked"
+)
+
+// VersionPlugin is a gorm plugin checking If-Match condition of request in the statement which changes record.
+// Record is changed only when its updated_at matches version of condition, change which affected no record
+// fails with precondition error.
+type VersionPlugin struct{}
+
+// NewVersionPlugin will initialize version plugin.
+func NewVersionPlugin() *VersionPlugin {
+	return &VersionPlugin{}
+}
+
+// VersionPlugin implements the gorm.Plugin interface.
+var _ gorm.Plugin = &VersionPlugin{}
+
+// Name return name of plugin.
+func (p *VersionPlugin) Name() string {
+	return versionPluginName
+}
+
+// Initialize will register callbacks of plugin.
+func (p *VersionPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	if err := callback.Update().Before("gorm:update").Register("version:before_update", p.before); err != nil {
+		return err
+	}
+	if err := callback.Update().After("gorm:update").Register("version:after_update", p.after); err != nil {
+		return err
+	}
+	if err := callback.Delete().Before("gorm:delete").Register("version:before_delete", p.before); err != nil {
+		return err
+	}
+	return callback.Delete().After("gorm:delete").Register("version:after_delete", p.after)
+}
+
+// before will add version of condition to the first change of record of model of condition.
+func (p *VersionPlugin) before(db *gorm.DB) {
+	condition := etag.ConditionFromContext(db.Statement.Context)
+	if db.Error != nil || condition == nil || condition.Checked || db.Statement.Schema == nil {
+		return
+	}
+	if db.Statement.Schema.ModelType != reflect.Indirect(reflect.ValueOf(condition.Model)).Type() || !targeted(db) {
+		return
+	}
+	condition.Checked = true
+	if !condition.Any {
+		versions := make([]interface{}, len(condition.Versions))
+		for index, version := range condition.Versions {
+			versions[index] = version
+		}
+		db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+			clause.IN{Column: clause.Column{Table: clause.CurrentTable, Name: "updated_at"}, Values: versions},
+		}})
+	}
+	db.InstanceSet(versionCheckedKey, condition)
+}
+
+// after will resolve condition checked by the change, change which affected no record fails.
+func (p *VersionPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(versionCheckedKey)
+	if !ok || db.Error != nil {
+		return
+	}
+	condition := value.(*etag.Condition)
+	condition.Resolve(db.Statement.RowsAffected)
+	if condition.Failed {
+		_ = db.AddError(exception.ErrorTextPreconditionFailed)
+	}
+}
+
+// targeted return true when statement has conditions or model with primary key, so version condition
+// never turns change of all records into a valid statement.
+func targeted(db *gorm.DB) bool {
+	if _, ok := db.Statement.Clauses["WHERE"]; ok {
+		return true
+	}
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	if field == nil || db.Statement.ReflectValue.Kind() != reflect.Struct {
+		return false
+	}
+	_, zero := field.ValueOf(db.Statement.ReflectValue)
+	return !zero
+}
@@ -0,0 +1,264 @@
+package persistence
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WebhookRepo is a struct to store db connection.
+type WebhookRepo struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository will initialize Webhook repository.
+func NewWebhookRepository(db *gorm.DB) *WebhookRepo {
+	return &WebhookRepo{db}
+}
+
+// WebhookRepo implements the repository.webhookRepository interface.
+var _ repository.WebhookRepository = &WebhookRepo{}
+
+// SaveWebhookSubscription will create a new webhook subscription of application.
+func (r WebhookRepo) SaveWebhookSubscription(
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, map[string]string, error) {
+	errDesc, errType := r.checkApplication(subscription.ApplicationUUID)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	err := r.db.Create(&subscription).Error
+	if err != nil {
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return subscription, nil, nil
+}
+
+// UpdateWebhookSubscription will update webhook subscription, secret is kept.
+func (r WebhookRepo) UpdateWebhookSubscription(
+	uuid string,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, map[string]string, error) {
+	errDesc, errType := r.checkApplication(subscription.ApplicationUUID)
+	if errType != nil {
+		return nil, errDesc, errType
+	}
+
+	subscriptionData := &entity.WebhookSubscription{
+		ApplicationUUID: subscription.ApplicationUUID,
+		URL:             subscription.URL,
+		EventTypes:      subscription.EventTypes,
+		Comment:         subscription.Comment,
+		Disabled:        subscription.Disabled,
+	}
+
+	// Disabled is selected explicitly so subscription can be enabled again.
+	err := r.db.First(&subscription, "uuid = ?", uuid).
+		Select("application_uuid", "url", "events", "comment", "disabled").
+		Updates(subscriptionData).Error
+	if err != nil {
+		//If record not found
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			errDesc["uuid"] = exception.ErrorTextWebhookInvalidUUID.Error()
+			return nil, errDesc, exception.ErrorTextWebhookNotFound
+		}
+		return nil, errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	return subscription, nil, nil
+}
+
+func (r WebhookRepo) DeleteWebhookSubscription(uuid string) error {
+	var subscription entity.WebhookSubscription
+	err := r.db.Where("uuid = ?", uuid).Take(&subscription).Delete(&subscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return exception.ErrorTextWebhookNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r WebhookRepo) GetWebhookSubscription(uuid string) (*entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+	err := r.db.Where("uuid = ?", uuid).Take(&subscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextWebhookNotFound
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r WebhookRepo) GetWebhookSubscriptions(
+	p *repository.Parameters,
+) ([]*entity.WebhookSubscription, *repository.Meta, error) {
+	var total int64
+	var subscriptions []*entity.WebhookSubscription
+	errTotal := r.db.Where(p.QueryKey, p.QueryValue...).Find(&subscriptions).Count(&total).Error
+	errList := r.db.Where(p.QueryKey, p.QueryValue...).Limit(p.Limit).Offset(p.Offset).Find(&subscriptions).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return subscriptions, meta, nil
+}
+
+// GetWebhookDeliveries will return delivery log of webhook subscription, the latest first.
+func (r WebhookRepo) GetWebhookDeliveries(
+	subscriptionUUID string,
+	p *repository.Parameters,
+) ([]*entity.WebhookDelivery, *repository.Meta, error) {
+	if _, err := r.GetWebhookSubscription(subscriptionUUID); err != nil {
+		return nil, nil, err
+	}
+
+	var total int64
+	var deliveries []*entity.WebhookDelivery
+	errTotal := r.db.Where("subscription_uuid = ?", subscriptionUUID).
+		Where(p.QueryKey, p.QueryValue...).
+		Find(&deliveries).
+		Count(&total).Error
+	errList := r.db.Where("subscription_uuid = ?", subscriptionUUID).
+		Where(p.QueryKey, p.QueryValue...).
+		Order("created_at DESC").
+		Limit(p.Limit).
+		Offset(p.Offset).
+		Find(&deliveries).Error
+	if errTotal != nil {
+		return nil, nil, errTotal
+	}
+	if errList != nil {
+		return nil, nil, errList
+	}
+	meta := repository.NewMeta(p, total)
+	return deliveries, meta, nil
+}
+
+// ReplayWebhookDelivery will queue a new delivery of event of delivery, delivery of any status is replayed.
+func (r WebhookRepo) ReplayWebhookDelivery(uuid string) (*entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+	err := r.db.Where("uuid = ?", uuid).Take(&delivery).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrorTextWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	replay := delivery.Replay()
+	if err := r.db.Omit("Subscription").Create(replay).Error; err != nil {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+	return replay, nil
+}
+
+// ClaimPendingWebhookDeliveries will return deliveries due to be sent with their subscriptions, the oldest first.
+// Deliveries of deleted or disabled subscriptions are not sent. Every delivery is locked for WebhookLockDuration
+// by conditional update, so delivery is sent by one worker only when several instances or the command are running.
+func (r WebhookRepo) ClaimPendingWebhookDeliveries(now time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	var deliveries []*entity.WebhookDelivery
+	enabled := r.db.Model(&entity.WebhookSubscription{}).Select("uuid").Where("disabled = ?", false)
+	err := r.db.
+		Preload("Subscription").
+		Where("status = ?", entity.WebhookDeliveryStatusPending).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Where("locked_until IS NULL OR locked_until <= ?", now).
+		Where("subscription_uuid IN (?)", enabled).
+		Order("created_at").
+		Limit(limit).
+		Find(&deliveries).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	lockedUntil := now.Add(entity.WebhookLockDuration)
+	claimed := make([]*entity.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result := r.db.Model(&entity.WebhookDelivery{}).
+			Where("uuid = ? AND status = ?", delivery.UUID, entity.WebhookDeliveryStatusPending).
+			Where("locked_until IS NULL OR locked_until <= ?", now).
+			UpdateColumn("locked_until", lockedUntil)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 1 {
+			delivery.LockedUntil = &lockedUntil
+			claimed = append(claimed, delivery)
+		}
+	}
+	return claimed, nil
+}
+
+// UpdateWebhookDelivery will store result of attempt of delivery and release claim of delivery.
+func (r WebhookRepo) UpdateWebhookDelivery(delivery *entity.WebhookDelivery) error {
+	return r.db.Model(&entity.WebhookDelivery{}).Where("uuid = ?", delivery.UUID).Updates(map[string]interface{}{
+		"status":          delivery.Status,
+		"attempts":        delivery.Attempts,
+		"response_status": delivery.ResponseStatus,
+		"response_body":   delivery.ResponseBody,
+		"error":           delivery.Error,
+		"next_attempt_at": delivery.NextAttemptAt,
+		"delivered_at":    delivery.DeliveredAt,
+		"locked_until":    nil,
+	}).Error
+}
+
+// checkApplication will verify that application of subscription exists.
+func (r WebhookRepo) checkApplication(applicationUUID string) (map[string]string, error) {
+	errDesc := map[string]string{}
+	var total int64
+	if err := r.db.Model(&entity.Application{}).Where("uuid = ?", applicationUUID).Count(&total).Error; err != nil {
+		return errDesc, exception.ErrorTextAnErrorOccurred
+	}
+	if total == 0 {
+		errDesc["application_uuid"] = exception.ErrorTextWebhookInvalidApplication.Error()
+		return errDesc, exception.ErrorTextUnprocessableEntity
+	}
+	return errDesc, nil
+}
+
+// QueueWebhookEvent will queue deliveries of event to enabled subscriptions of event. Subscriptions which
+// already have delivery of event are skipped, so event published again is not delivered twice.
+func (r WebhookRepo) QueueWebhookEvent(event *entity.WebhookEvent) error {
+	var subscriptions []*entity.WebhookSubscription
+	queued := r.db.Model(&entity.WebhookDelivery{}).Select("subscription_uuid").Where("event_id = ?", event.ID)
+	err := r.db.Where("disabled = ?", false).
+		Where(
+			"CONCAT(',', events, ',') LIKE ? OR CONCAT(',', events, ',') LIKE ?",
+			webhookEventPattern(event.Name),
+			webhookEventPattern(entity.WebhookEventAll),
+		).
+		Where("uuid NOT IN (?)", queued).
+		Find(&subscriptions).Error
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	deliveries, err := event.Deliveries(subscriptions)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Omit("Subscription").Create(&deliveries).Error
+}
+
+// webhookEventPattern return LIKE pattern matching exactly event in comma separated events of subscription
+// wrapped in commas, wildcards of event name are escaped.
+func webhookEventPattern(event string) string {
+	return "%," + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(event) + ",%"
+}
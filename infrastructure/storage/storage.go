@@ -21,10 +21,12 @@ const (
 	ReqExpired = 24 * 60 * 60 * time.Second
 
 	// Collections of category.
-	CategoryAvatar    = "avatar"
-	CategoryDocument  = "document"
-	CategoryFile      = "file"
-	CategoryThumbnail = "thumbnail"
+	CategoryAvatar     = "avatar"
+	CategoryDocument   = "document"
+	CategoryFile       = "file"
+	CategoryThumbnail  = "thumbnail"
+	CategoryDelivery   = "delivery"
+	CategoryCompliance = "compliance"
 )
 
 // FileName represents it self.
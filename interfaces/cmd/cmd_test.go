@@ -18,7 +18,7 @@ func TestNewCli(t *testing.T) {
 
 func TestNewCommand(t *testing.T) {
 	var repositories *persistence.Repositories
-	newCommand := cmd.NewCommand(repositories)
+	newCommand := cmd.NewCommand(repositories, nil, nil, nil)
 
 	var cliCommand []*cli.Command
 	assert.IsType(t, cliCommand, newCommand)
@@ -1,17 +1,32 @@
 package cmd
 
 import (
+	"cargo-rest-api/config"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/notify/notification"
 	"cargo-rest-api/infrastructure/persistence"
 	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/pkg/response"
 	"cargo-rest-api/pkg/security"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/urfave/cli/v2"
 )
 
 // NewCommand construct a CLI commands.
-func NewCommand(dbService *persistence.Repositories) []*cli.Command {
+func NewCommand(
+	dbService *persistence.Repositories,
+	notificationService *persistence.NotificationService,
+	fiscalService *persistence.FiscalService,
+	conf *config.Config,
+) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "create:secret",
@@ -58,5 +73,360 @@ func NewCommand(dbService *persistence.Repositories) []*cli.Command {
 				return nil
 			},
 		},
+		{
+			Name:  "vehicle:compliance-reminders",
+			Usage: "send reminders to administrators about vehicle documents expiring soon",
+			Flags: []cli.Flag{
+				&cli.IntFlag{Name: "days", Value: entity.DefaultVehicleComplianceReminderDays, Usage: "days before expiry"},
+				&cli.StringFlag{Name: "lang", Value: "en", Usage: "language of reminder"},
+			},
+			Action: func(c *cli.Context) error {
+				err := sendVehicleComplianceReminders(dbService, notificationService, c.Int("days"), c.String("lang"))
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "accounting:export-commerceml",
+			Usage: "export sales and reference data changed since the latest export to CommerceML file for 1C",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "dir", Value: ".", Usage: "directory of exported file"},
+				&cli.BoolFlag{Name: "full", Usage: "export all records ignoring watermark"},
+			},
+			Action: func(c *cli.Context) error {
+				err := exportCommerceML(dbService, c.String("dir"), c.Bool("full"))
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "fiscal:receipts",
+			Usage: "send pending fiscal receipts of payments and refunds and check receipts registered by provider",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "retry-failed", Usage: "send failed receipts again"},
+			},
+			Action: func(c *cli.Context) error {
+				err := processFiscalReceipts(fiscalService, c.Bool("retry-failed"))
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "webhook:deliveries",
+			Usage: "send pending webhook deliveries of domain events to subscribers",
+			Action: func(c *cli.Context) error {
+				err := processWebhookDeliveries(dbService, conf)
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "outbox:relay",
+			Usage: "publish pending outbox events of domain changes to webhooks and redis stream",
+			Action: func(c *cli.Context) error {
+				err := relayOutboxEvents(dbService, conf)
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "audit:purge",
+			Usage: "delete audit logs older than retention period, logs are never changed",
+			Action: func(c *cli.Context) error {
+				err := purgeAuditLogs(dbService, conf)
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "gtfs:export",
+			Usage: "export trips to static GTFS feed for trip planners and map applications",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Value: entity.GTFSFileName, Usage: "path of exported feed"},
+				&cli.StringFlag{Name: "from", Usage: "the first date of departure (2006-01-02), today when empty"},
+				&cli.StringFlag{Name: "to", Usage: "the last date of departure (2006-01-02), all future trips when empty"},
+			},
+			Action: func(c *cli.Context) error {
+				err := exportGTFS(dbService, conf, c.String("file"), &entity.GTFSRequest{
+					From: c.String("from"),
+					To:   c.String("to"),
+				})
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "gtfs:import",
+			Usage: "import routes and trips of partner GTFS feed, stops are matched to sities",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Required: true, Usage: "path of imported feed"},
+				&cli.StringFlag{Name: "from", Usage: "the first date of imported trips (2006-01-02), today when empty"},
+				&cli.StringFlag{Name: "to", Usage: "the last date of imported trips (2006-01-02), 30 days when empty"},
+				&cli.StringFlag{Name: "radius", Usage: "radius of match of stop to the nearest sity in kilometers"},
+				&cli.BoolFlag{Name: "create-sities", Usage: "create sities of unmatched stops"},
+				&cli.BoolFlag{Name: "dry-run", Usage: "report matches without import"},
+			},
+			Action: func(c *cli.Context) error {
+				err := importGTFS(dbService, c.String("file"), &entity.GTFSImportRequest{
+					From:         c.String("from"),
+					To:           c.String("to"),
+					Radius:       c.String("radius"),
+					CreateSities: c.Bool("create-sities"),
+					DryRun:       c.Bool("dry-run"),
+				})
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "data:import",
+			Usage: "import sities, vehicles, drivers, routes or trips from CSV or JSON file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "entity",
+					Required: true,
+					Usage:    "imported entity: " + strings.Join(entity.DataImportEntities, ", "),
+				},
+				&cli.StringFlag{Name: "file", Required: true, Usage: "path of imported file"},
+				&cli.StringFlag{Name: "format", Usage: "csv or json, detected by file when empty"},
+				&cli.BoolFlag{Name: "dry-run", Usage: "validate rows without import"},
+			},
+			Action: func(c *cli.Context) error {
+				err := importData(dbService, c.String("entity"), c.String("file"), c.String("format"), c.Bool("dry-run"))
+				if err != nil {
+					log.Println(err)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// sendVehicleComplianceReminders will remind administrators once about every vehicle document expiring soon.
+func sendVehicleComplianceReminders(
+	dbService *persistence.Repositories,
+	notificationService *persistence.NotificationService,
+	days int,
+	language string,
+) error {
+	if notificationService == nil || notificationService.Notification == nil {
+		return errors.New("notification service is not configured")
+	}
+	records, err := dbService.VehicleCompliance.GetExpiringVehicleCompliances(days)
+	if err != nil {
+		return err
+	}
+	var pending []*entity.VehicleCompliance
+	var uuids []string
+	for _, record := range records {
+		if record.RemindedAt == nil {
+			pending = append(pending, record)
+			uuids = append(uuids, record.UUID)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	receivers, err := dbService.VehicleCompliance.GetVehicleComplianceRecipients()
+	if err != nil {
+		return err
+	}
+	if len(receivers) == 0 {
+		return errors.New("there are no administrators to remind")
+	}
+
+	reminder := notification.NewVehicleComplianceReminder(pending, receivers, notificationService.Notification, language)
+	for _, errSend := range reminder.Send() {
+		if errSend != nil {
+			return errSend
+		}
+	}
+	fmt.Printf("sent reminder about %d vehicle documents to %d administrators\n", len(pending), len(receivers))
+	return dbService.VehicleCompliance.MarkVehicleCompliancesReminded(uuids)
+}
+
+// exportCommerceML will write CommerceML file of records changed since the latest export into directory.
+func exportCommerceML(dbService *persistence.Repositories, dir string, full bool) error {
+	export, err := dbService.AccountingExport.SaveCommerceMLExport(
+		&entity.AccountingExportRequest{Full: full},
+		entity.AccountingExportSourceCLI,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+	fileName := filepath.Join(dir, export.FileName())
+	if err := ioutil.WriteFile(fileName, export.Content, 0644); err != nil {
+		return err
+	}
+	fmt.Printf(
+		"exported %d orders and %d payments changed since %s to %s\n",
+		export.OrderCount,
+		export.PaymentCount,
+		export.ChangedFrom.Format(time.RFC3339),
+		fileName,
+	)
+	return nil
+}
+
+// processFiscalReceipts will send fiscal receipts once, failed receipts are queued again when retry is set.
+func processFiscalReceipts(fiscalService *persistence.FiscalService, retry bool) error {
+	if fiscalService == nil {
+		return errors.New("fiscal service is not configured")
+	}
+	if retry {
+		queued, err := fiscalService.Retry()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("queued %d failed receipts\n", queued)
+	}
+	processed, err := fiscalService.Process()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d receipts\n", processed)
+	return nil
+}
+
+// processWebhookDeliveries will send due webhook deliveries once.
+func processWebhookDeliveries(dbService *persistence.Repositories, conf *config.Config) error {
+	processed, err := persistence.NewWebhookService(conf, dbService.Webhook).Process()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d webhook deliveries\n", processed)
+	return nil
+}
+
+// relayOutboxEvents will publish due outbox events once, redis is connected only when stream is configured.
+func relayOutboxEvents(dbService *persistence.Repositories, conf *config.Config) error {
+	var redisClient *redis.Client
+	if conf.OutboxConfig.RedisStream != "" {
+		client, err := persistence.NewRedisConnection(conf.RedisConfig)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		redisClient = client
+	}
+	sinks := persistence.NewOutboxSinks(conf, dbService.Webhook, redisClient)
+	processed, err := persistence.NewOutboxService(conf, dbService.Outbox, sinks...).Process()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("processed %d outbox events\n", processed)
+	return nil
+}
+
+// purgeAuditLogs will delete audit logs older than retention days.
+func purgeAuditLogs(dbService *persistence.Repositories, conf *config.Config) error {
+	days := conf.AuditConfig.RetentionDays
+	if days <= 0 {
+		days = entity.DefaultAuditLogRetentionDays
+	}
+	purged, err := dbService.AuditLog.PurgeAuditLogs(time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("purged %d audit logs older than %d days\n", purged, days)
+	return nil
+}
+
+// importData will import rows of file and print result of every row, nothing is imported when any row failed.
+func importData(dbService *persistence.Repositories, entityName string, fileName string, format string, dryRun bool) error {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	data, err := entity.NewDataImport(entityName, filepath.Base(fileName), format, dryRun, content)
+	if err != nil {
+		return err
+	}
+	result, err := dbService.DataImport.SaveDataImport(data)
+	if err != nil {
+		return err
+	}
+	fmt.Println(encoder.PrettyJSONWithIndent(result.DetailDataImport(func(validation []response.ErrorForm) map[string]string {
+		errDesc := map[string]string{}
+		for _, form := range validation {
+			errDesc[form.Field] = form.Msg
+		}
+		return errDesc
+	})))
+	if !result.Committed && !result.DryRun {
+		return errors.New("import is not committed, fix failed rows and run it again")
+	}
+	return nil
+}
+
+// importGTFS will import feed of file and print report of import with unmatched stops.
+func importGTFS(dbService *persistence.Repositories, fileName string, request *entity.GTFSImportRequest) error {
+	if errForms := request.ValidateGTFSImportRequest(); len(errForms) > 0 {
+		return fmt.Errorf("%s is invalid", errForms[0].Field)
+	}
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	data, err := entity.NewGTFSImport(request, content, time.Now())
+	if err != nil {
+		return err
+	}
+	result, err := dbService.GTFS.SaveGTFSImport(data)
+	if err != nil {
+		return err
+	}
+	fmt.Println(encoder.PrettyJSONWithIndent(result.DetailGTFSImport()))
+	return nil
+}
+
+// exportGTFS will write GTFS feed of trips departing in requested period into file.
+func exportGTFS(dbService *persistence.Repositories, conf *config.Config, fileName string, request *entity.GTFSRequest) error {
+	if errForms := request.ValidateGTFSRequest(); len(errForms) > 0 {
+		return fmt.Errorf("%s must be date in format %s", errForms[0].Field, entity.GTFSDateLayout)
+	}
+	export, err := entity.NewGTFSExport(entity.GTFSAgency{
+		Name:     conf.GTFSConfig.AgencyName,
+		URL:      conf.GTFSConfig.AgencyURL,
+		Timezone: conf.AppTimezone,
+		Lang:     conf.GTFSConfig.AgencyLang,
+		Phone:    conf.GTFSConfig.AgencyPhone,
+	}, request, time.Now())
+	if err != nil {
+		return err
+	}
+	export, err = dbService.GTFS.GetGTFSExport(export)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(fileName, export.Content, 0644); err != nil {
+		return err
 	}
+	fmt.Printf(
+		"exported %d trips of %d routes with %d stops and %d services to %s, skipped %d trips of sities without coordinates or with invalid times\n",
+		export.TripCount,
+		export.RouteCount,
+		export.StopCount,
+		export.ServiceCount,
+		fileName,
+		export.SkippedCount,
+	)
+	return nil
 }
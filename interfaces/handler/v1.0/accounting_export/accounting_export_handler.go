@@ -0,0 +1,102 @@
+package accountingExportv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/response"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountingExports is a struct defines the dependencies that will be used.
+type AccountingExports struct {
+	us application.AccountingExportAppInterface
+}
+
+// NewAccountingExports is constructor will initialize accounting export handler.
+func NewAccountingExports(us application.AccountingExportAppInterface) *AccountingExports {
+	return &AccountingExports{
+		us: us,
+	}
+}
+
+// @Summary Export sales and reference data in CommerceML
+// @Description Export cities, routes, passenger types, orders, payments and refunds changed since the latest export
+// @Description as CommerceML 2 file for 1C, end of exported period becomes watermark of the next export.
+// @Tags accounting exports
+// @Produce xml
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param full query bool false "Export all records ignoring watermark"
+// @Success 200 {file} file
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/accountingExports/commerceml [post]
+// SaveCommerceMLExport is a function uses to handle export of accounting data in CommerceML.
+func (s *AccountingExports) SaveCommerceMLExport(c *gin.Context) {
+	var request entity.AccountingExportRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	var createdBy string
+	if userUUID, exists := c.Get("UUID"); exists {
+		createdBy, _ = userUUID.(string)
+	}
+	export, err := s.us.SaveCommerceMLExport(&request, entity.AccountingExportSourceAPI, createdBy)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.FileName()))
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", export.Content)
+}
+
+// @Summary Get accounting exports
+// @Description Get list of accounting exports with exported period and number of records, the latest first.
+// @Tags accounting exports
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/accountingExports [get]
+// GetAccountingExports is a function uses to handle get accounting export list.
+func (s *AccountingExports) GetAccountingExports(c *gin.Context) {
+	var export entity.AccountingExport
+	var exports entity.AccountingExports
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(export.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	exports, meta, err := s.us.GetAccountingExports(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, exports.DetailAccountingExports(), success.AccountingExportSuccessfullyGetAccountingExportList).
+		WithMeta(meta).
+		JSON()
+}
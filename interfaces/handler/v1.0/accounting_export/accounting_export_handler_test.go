@@ -0,0 +1,205 @@
+package accountingExportv1point00
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestSaveCommerceMLExport_Success Test.
+func TestSaveCommerceMLExport_Success(t *testing.T) {
+	var exportApp mock.AccountingExportAppInterface
+	exportHandler := NewAccountingExports(&exportApp)
+	watermark := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	adult := entity.PassengerType{UUID: uuid.New().String(), Type: "Взрослый"}
+	from := entity.Sity{UUID: uuid.New().String(), Name: "Москва"}
+	to := entity.Sity{UUID: uuid.New().String(), Name: "Тверь"}
+	route := entity.Route{
+		UUID:     uuid.New().String(),
+		FromUUID: from.UUID,
+		ToUUID:   to.UUID,
+		SityFrom: from,
+		SityTo:   to,
+		Distance: 180,
+		Prices: []*entity.Price{
+			{PassengerTypeUUID: adult.UUID, PassengerType: adult, Price: 750.25},
+		},
+	}
+	order := &entity.Order{
+		UUID:      uuid.New().String(),
+		OrderDate: watermark.Add(time.Hour),
+		Trip:      entity.Trip{Route: route},
+		Status:    entity.OrderStatusType{Type: "Отменен", IsCanceled: true},
+		Passengers: []*entity.Passenger{
+			{PassengerTypeUUID: adult.UUID},
+			{PassengerTypeUUID: adult.UUID},
+		},
+	}
+	refund := &entity.Payment{
+		UUID:         uuid.New().String(),
+		Type:         entity.PaymentTypeRefund,
+		Amount:       1500.5,
+		ExternalUUID: "ext-1",
+		Orders:       []*entity.Order{order},
+		DeletedAt:    gorm.DeletedAt{Time: watermark.Add(2 * time.Hour), Valid: true},
+	}
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/accountingExports/commerceml", exportHandler.SaveCommerceMLExport)
+
+	exportApp.SaveCommerceMLExportFn = func(
+		request *entity.AccountingExportRequest,
+		source string,
+		createdBy string,
+	) (*entity.AccountingExport, error) {
+		export := entity.NewAccountingExport(entity.AccountingExportCommerceML, source, watermark, createdBy)
+		err := export.CommerceML(&entity.AccountingExportData{
+			Sities:         []*entity.Sity{&from, &to},
+			Routes:         []*entity.Route{&route},
+			PassengerTypes: []*entity.PassengerType{&adult},
+			Orders:         []*entity.Order{order},
+			Payments:       []*entity.Payment{refund},
+		})
+		return export, err
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/accountingExports/commerceml", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	body := w.Body.String()
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/xml")
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "commerceml-")
+	assert.Contains(t, body, `<Каталог СодержитТолькоИзменения="true">`)
+	assert.Contains(t, body, "<Наименование>Проезд Москва - Тверь</Наименование>")
+	assert.Contains(t, body, "<Ид>"+route.UUID+"#"+adult.UUID+"</Ид>")
+	assert.Contains(t, body, "<Количество>2</Количество>")
+	assert.Contains(t, body, "<Сумма>1500.50</Сумма>")
+	assert.Contains(t, body, "<ХозОперация>Возврат безналичных денег</ХозОперация>")
+	assert.Contains(t, body, "<ПометкаУдаления>true</ПометкаУдаления>")
+	assert.Equal(t, 2, strings.Count(body, "<ИдЗначения>"+from.UUID+"</ИдЗначения>"))
+}
+
+// TestSaveCommerceMLExport_Full Test.
+func TestSaveCommerceMLExport_Full(t *testing.T) {
+	var exportApp mock.AccountingExportAppInterface
+	exportHandler := NewAccountingExports(&exportApp)
+	var full bool
+	var exportSource string
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/accountingExports/commerceml", exportHandler.SaveCommerceMLExport)
+
+	exportApp.SaveCommerceMLExportFn = func(
+		request *entity.AccountingExportRequest,
+		source string,
+		createdBy string,
+	) (*entity.AccountingExport, error) {
+		full, exportSource = request.Full, source
+		export := entity.NewAccountingExport(entity.AccountingExportCommerceML, source, time.Time{}, createdBy)
+		return export, export.CommerceML(&entity.AccountingExportData{})
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/accountingExports/commerceml?full=true", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.True(t, full)
+	assert.Equal(t, entity.AccountingExportSourceAPI, exportSource)
+	assert.Contains(t, w.Body.String(), `<Каталог СодержитТолькоИзменения="false">`)
+}
+
+// TestSaveCommerceMLExport_Failed Test.
+func TestSaveCommerceMLExport_Failed(t *testing.T) {
+	var exportApp mock.AccountingExportAppInterface
+	exportHandler := NewAccountingExports(&exportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/accountingExports/commerceml", exportHandler.SaveCommerceMLExport)
+
+	exportApp.SaveCommerceMLExportFn = func(
+		*entity.AccountingExportRequest,
+		string,
+		string,
+	) (*entity.AccountingExport, error) {
+		return nil, exception.ErrorTextAnErrorOccurred
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/accountingExports/commerceml", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusInternalServerError)
+}
+
+// TestGetAccountingExports_Success Test.
+func TestGetAccountingExports_Success(t *testing.T) {
+	var exportsData []entity.DetailAccountingExport
+	var exportApp mock.AccountingExportAppInterface
+	exportHandler := NewAccountingExports(&exportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/accountingExports", exportHandler.GetAccountingExports)
+
+	exportApp.GetAccountingExportsFn = func(
+		params *repository.Parameters,
+	) ([]*entity.AccountingExport, *repository.Meta, error) {
+		return []*entity.AccountingExport{
+			{UUID: uuid.New().String(), Format: entity.AccountingExportCommerceML, OrderCount: 3, PaymentCount: 2},
+			{UUID: uuid.New().String(), Format: entity.AccountingExportCommerceML, Source: "cli"},
+		}, repository.NewMeta(params, 2), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/accountingExports", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &exportsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, exportsData, 2)
+	assert.EqualValues(t, exportsData[0].OrderCount, 3)
+	assert.EqualValues(t, exportsData[1].Source, "cli")
+}
@@ -0,0 +1,133 @@
+package auditLogv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditLogs is a struct defines the dependencies that will be used.
+type AuditLogs struct {
+	us application.AuditLogAppInterface
+}
+
+// NewAuditLogs is constructor will initialize audit log handler.
+func NewAuditLogs(us application.AuditLogAppInterface) *AuditLogs {
+	return &AuditLogs{
+		us: us,
+	}
+}
+
+// @Summary Get audit logs
+// @Description Get changes of data, the latest first. Every create, update and delete of record is logged with
+// @Description user, application of API key and request ID of request which made it and changed fields with values
+// @Description before and after change. Logs are append-only and are kept for retention period.
+// @Tags audit logs
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/auditLogs [get]
+// GetAuditLogs is a function uses to handle get audit log list.
+func (s *AuditLogs) GetAuditLogs(c *gin.Context) {
+	parameters, ok := s.parameters(c)
+	if !ok {
+		return
+	}
+
+	logs, meta, err := s.us.GetAuditLogs(parameters)
+	s.respond(c, logs, meta, err)
+}
+
+// @Summary Get audit logs of record
+// @Description Get changes of record, entity is a name of table of record, e.g. trips.
+// @Tags audit logs
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param entity path string true "Entity type"
+// @Param uuid path string true "Record UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/auditLogs/{entity}/{uuid} [get]
+// GetEntityAuditLogs is a function uses to handle get audit log list of record.
+func (s *AuditLogs) GetEntityAuditLogs(c *gin.Context) {
+	parameters, ok := s.parameters(c)
+	if !ok {
+		return
+	}
+
+	logs, meta, err := s.us.GetEntityAuditLogs(c.Param("entity"), c.Param("uuid"), parameters)
+	s.respond(c, logs, meta, err)
+}
+
+// @Summary Get audit logs of user
+// @Description Get changes of data made by user.
+// @Tags audit logs
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "User UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/users/{uuid}/auditLogs [get]
+// GetUserAuditLogs is a function uses to handle get audit log list of user.
+func (s *AuditLogs) GetUserAuditLogs(c *gin.Context) {
+	parameters, ok := s.parameters(c)
+	if !ok {
+		return
+	}
+
+	logs, meta, err := s.us.GetUserAuditLogs(c.Param("uuid"), parameters)
+	s.respond(c, logs, meta, err)
+}
+
+// parameters will return validated parameters of list, request is aborted when parameters are invalid.
+func (s *AuditLogs) parameters(c *gin.Context) (*repository.Parameters, bool) {
+	var log entity.AuditLog
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(log.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return nil, false
+	}
+	return parameters, true
+}
+
+// respond will write page of audit logs.
+func (s *AuditLogs) respond(c *gin.Context, logs entity.AuditLogs, meta *repository.Meta, err error) {
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, logs.DetailAuditLogs(), success.AuditLogSuccessfullyGetAuditLogList).
+		WithMeta(meta).
+		JSON()
+}
@@ -0,0 +1,178 @@
+package auditLogv1point00
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// auditLog return audit log of changed departure time of trip.
+func auditLog(entityUUID string, userUUID string) *entity.AuditLog {
+	return &entity.AuditLog{
+		UUID:       uuid.New().String(),
+		EntityType: "trips",
+		EntityUUID: entityUUID,
+		Action:     "update",
+		UserUUID:   userUUID,
+		RequestID:  uuid.New().String(),
+		Changes:    `{"departure_time":{"before":"2021-03-01T10:00:00Z","after":"2021-03-01T11:00:00Z"}}`,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// TestGetAuditLogs_Success Test.
+func TestGetAuditLogs_Success(t *testing.T) {
+	var auditLogApp mock.AuditLogAppInterface
+	var logsData []entity.DetailAuditLog
+	auditLogHandler := NewAuditLogs(&auditLogApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/auditLogs", auditLogHandler.GetAuditLogs)
+
+	auditLogApp.GetAuditLogsFn = func(params *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error) {
+		logs := []*entity.AuditLog{auditLog(uuid.New().String(), uuid.New().String())}
+		return logs, repository.NewMeta(params, int64(len(logs))), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/auditLogs?action=update", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &logsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, logsData, 1)
+	assert.Equal(t, "trips", logsData[0].EntityType)
+	assert.Equal(t, "2021-03-01T10:00:00Z", logsData[0].Changes["departure_time"].Before)
+	assert.Equal(t, "2021-03-01T11:00:00Z", logsData[0].Changes["departure_time"].After)
+}
+
+// TestGetAuditLogs_Failed_InternalServerError Test.
+func TestGetAuditLogs_Failed_InternalServerError(t *testing.T) {
+	var auditLogApp mock.AuditLogAppInterface
+	auditLogHandler := NewAuditLogs(&auditLogApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/auditLogs", auditLogHandler.GetAuditLogs)
+
+	auditLogApp.GetAuditLogsFn = func(params *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error) {
+		return nil, nil, errors.New("database is down")
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/auditLogs", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusInternalServerError)
+}
+
+// TestGetEntityAuditLogs_Success Test.
+func TestGetEntityAuditLogs_Success(t *testing.T) {
+	var auditLogApp mock.AuditLogAppInterface
+	var logsData []entity.DetailAuditLog
+	auditLogHandler := NewAuditLogs(&auditLogApp)
+	tripUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/auditLogs/:entity/:uuid", auditLogHandler.GetEntityAuditLogs)
+
+	var requestedType, requestedUUID string
+	auditLogApp.GetEntityAuditLogsFn = func(
+		entityType string,
+		entityUUID string,
+		params *repository.Parameters,
+	) ([]*entity.AuditLog, *repository.Meta, error) {
+		requestedType = entityType
+		requestedUUID = entityUUID
+		logs := []*entity.AuditLog{auditLog(entityUUID, uuid.New().String())}
+		return logs, repository.NewMeta(params, int64(len(logs))), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/auditLogs/trips/"+tripUUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &logsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, "trips", requestedType)
+	assert.Equal(t, tripUUID, requestedUUID)
+	assert.Len(t, logsData, 1)
+	assert.Equal(t, tripUUID, logsData[0].EntityUUID)
+}
+
+// TestGetUserAuditLogs_Success Test.
+func TestGetUserAuditLogs_Success(t *testing.T) {
+	var auditLogApp mock.AuditLogAppInterface
+	var logsData []entity.DetailAuditLog
+	auditLogHandler := NewAuditLogs(&auditLogApp)
+	userUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/users/:uuid/auditLogs", auditLogHandler.GetUserAuditLogs)
+
+	var requested string
+	auditLogApp.GetUserAuditLogsFn = func(
+		userUUID string,
+		params *repository.Parameters,
+	) ([]*entity.AuditLog, *repository.Meta, error) {
+		requested = userUUID
+		logs := []*entity.AuditLog{auditLog(uuid.New().String(), userUUID)}
+		return logs, repository.NewMeta(params, int64(len(logs))), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/users/"+userUUID+"/auditLogs", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &logsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, userUUID, requested)
+	assert.Len(t, logsData, 1)
+	assert.Equal(t, userUUID, logsData[0].UserUUID)
+}
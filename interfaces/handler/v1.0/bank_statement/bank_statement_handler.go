@@ -0,0 +1,229 @@
+package bankStatementv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/bankstatement"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BankStatements is a struct defines the dependencies that will be used.
+type BankStatements struct {
+	us application.BankStatementAppInterface
+}
+
+// NewBankStatements is constructor will initialize bank statement handler.
+func NewBankStatements(us application.BankStatementAppInterface) *BankStatements {
+	return &BankStatements{
+		us: us,
+	}
+}
+
+// @Summary Import bank statement
+// @Description Import CSV or 1CClientBankExchange statement and match its lines to payments by external ID, amount and date.
+// @Tags bank statements
+// @Accept mpfd
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param file formData file true "Statement file"
+// @Param format formData string false "Statement format, detected when empty" Enums(csv, 1c)
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/bankStatements [post]
+// SaveBankStatement is a function uses to handle import bank statement.
+func (s *BankStatements) SaveBankStatement(c *gin.Context) {
+	file, _ := c.FormFile("file")
+	if file == nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextBankStatementFileRequired)
+		return
+	}
+	if file.Size > entity.BankStatementMaxFileSize*1000000 {
+		c.Set("args", fmt.Sprintf("Size:%d", entity.BankStatementMaxFileSize))
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextBankStatementInvalidFileSize)
+		return
+	}
+	reader, err := file.Open()
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextStorageUploadCannotOpenFile)
+		return
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextStorageUploadCannotOpenFile)
+		return
+	}
+
+	statement, err := entity.NewBankStatement(file.Filename, c.PostForm("format"), content)
+	if err != nil {
+		var lineErr *bankstatement.Error
+		switch {
+		case errors.As(err, &lineErr):
+			c.Set("args", fmt.Sprintf("Field:%s;Line:%d", lineErr.Field, lineErr.Line))
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextBankStatementInvalidLine)
+		case errors.Is(err, bankstatement.ErrEmpty):
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextBankStatementEmpty)
+		default:
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextBankStatementFormatUnsupported)
+		}
+		return
+	}
+	if userUUID, exists := c.Get("UUID"); exists {
+		statement.CreatedBy, _ = userUUID.(string)
+	}
+
+	newStatement, err := s.us.SaveBankStatement(statement)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, newStatement.DetailBankStatement(), success.BankStatementSuccessfullyImportBankStatement).
+		JSON()
+}
+
+// @Summary Get bank statements
+// @Description Get list of imported bank statements with number of matched, unmatched and discrepancy lines.
+// @Tags bank statements
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/bankStatements [get]
+// GetBankStatements is a function uses to handle get bank statement list.
+func (s *BankStatements) GetBankStatements(c *gin.Context) {
+	var statement entity.BankStatement
+	var statements entity.BankStatements
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(statement.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	statements, meta, err := s.us.GetBankStatements(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, statements.DetailBankStatements(), success.BankStatementSuccessfullyGetBankStatementList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Get bank statement
+// @Description Get bank statement with matched, unmatched, discrepancy and resolved lines.
+// @Tags bank statements
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Bank statement UUID"
+// @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/bankStatements/{uuid} [get]
+// GetBankStatement is a function uses to handle get bank statement detail by UUID.
+func (s *BankStatements) GetBankStatement(c *gin.Context) {
+	UUID := c.Param("uuid")
+	statement, err := s.us.GetBankStatement(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextBankStatementNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextBankStatementNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, statement.DetailBankStatement(), success.BankStatementSuccessfullyGetBankStatementDetail).
+		WithETag(statement.UpdatedAt).
+		JSON()
+}
+
+// @Summary Resolve bank statement line
+// @Description Match unmatched or discrepancy line to payment by hand or ignore it, e.g. bank fee.
+// @Tags bank statements
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Bank statement line UUID"
+// @Param resolution body entity.BankStatementLineResolveRequest true "Resolution of line"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/bankStatements/lines/{uuid} [put]
+// ResolveBankStatementLine is a function uses to handle resolve bank statement line by UUID.
+func (s *BankStatements) ResolveBankStatementLine(c *gin.Context) {
+	var request entity.BankStatementLineResolveRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+	request.Prepare()
+
+	validateErr := request.ValidateBankStatementLineResolveRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	var resolvedBy string
+	if userUUID, exists := c.Get("UUID"); exists {
+		resolvedBy, _ = userUUID.(string)
+	}
+	line, errDesc, errException := s.us.ResolveBankStatementLine(c.Param("uuid"), &request, resolvedBy)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextBankStatementLineNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	response.NewSuccess(c, line.DetailBankStatementLine(), success.BankStatementSuccessfullyResolveLine).JSON()
+}
@@ -0,0 +1,392 @@
+package bankStatementv1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// statementForm return multipart body of statement import request.
+func statementForm(t *testing.T, content string, format string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if format != "" {
+		_ = writer.WriteField("format", format)
+	}
+	if content != "" {
+		part, err := writer.CreateFormFile("file", "statement.csv")
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		_, _ = part.Write([]byte(content))
+	}
+	_ = writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+// TestSaveBankStatement_Success Test.
+func TestSaveBankStatement_Success(t *testing.T) {
+	var statementData entity.DetailBankStatement
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+	UUID := uuid.New().String()
+	PaymentUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/bankStatements", statementHandler.SaveBankStatement)
+
+	statementApp.SaveBankStatementFn = func(statement *entity.BankStatement) (*entity.BankStatement, error) {
+		statement.UUID = UUID
+		statement.Reconcile(entity.Payments{
+			{UUID: PaymentUUID, Type: entity.PaymentTypeOrder, ExternalUUID: "ext-1", Amount: 1500.5,
+				PaymentDate: statement.Lines[0].Date},
+		}, nil)
+		return statement, nil
+	}
+
+	body, contentType := statementForm(t, "date;amount;reference\n2021-03-01;1500,50;ext-1\n2021-03-02;20;\n", "")
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/bankStatements", body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &statementData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, statementData.UUID, UUID)
+	assert.EqualValues(t, statementData.Format, "csv")
+	assert.EqualValues(t, statementData.LineCount, 2)
+	assert.EqualValues(t, statementData.MatchedCount, 1)
+	assert.EqualValues(t, statementData.UnmatchedCount, 1)
+	assert.EqualValues(t, statementData.TotalAmount, 1520.5)
+	assert.Len(t, statementData.Matched, 1)
+	assert.Len(t, statementData.Unmatched, 1)
+}
+
+// TestSaveBankStatement_InvalidFile Test.
+func TestSaveBankStatement_InvalidFile(t *testing.T) {
+	samples := []struct {
+		content string
+		format  string
+		err     error
+	}{
+		{"", "", exception.ErrorTextBankStatementFileRequired},
+		{"name,value\na,1\n", "", exception.ErrorTextBankStatementFormatUnsupported},
+		{"date,amount\n2021-03-01,10\n", "xml", exception.ErrorTextBankStatementFormatUnsupported},
+		{"date,amount\n", "", exception.ErrorTextBankStatementEmpty},
+		{"date,amount\n2021-03-01,ten\n", "", exception.ErrorTextBankStatementInvalidLine},
+	}
+
+	for _, v := range samples {
+		var statementApp mock.BankStatementAppInterface
+		statementHandler := NewBankStatements(&statementApp)
+		saved := false
+		statementApp.SaveBankStatementFn = func(statement *entity.BankStatement) (*entity.BankStatement, error) {
+			saved = true
+			return statement, nil
+		}
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		var abortErr error
+		r.Use(func(c *gin.Context) {
+			c.Next()
+			if last := c.Errors.Last(); last != nil {
+				abortErr = last.Err
+			}
+		})
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/bankStatements", statementHandler.SaveBankStatement)
+
+		body, contentType := statementForm(t, v.content, v.format)
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/bankStatements", body)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+		assert.False(t, saved)
+		assert.Equal(t, v.err, abortErr)
+	}
+}
+
+// TestGetBankStatements_Success Test.
+func TestGetBankStatements_Success(t *testing.T) {
+	var statementsData []entity.DetailBankStatementList
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/bankStatements", statementHandler.GetBankStatements)
+
+	statementApp.GetBankStatementsFn = func(params *repository.Parameters) ([]*entity.BankStatement, *repository.Meta, error) {
+		return []*entity.BankStatement{
+			{UUID: uuid.New().String(), Format: "1c", LineCount: 3, MatchedCount: 2, UnmatchedCount: 1},
+			{UUID: uuid.New().String(), Format: "csv", LineCount: 1, MatchedCount: 1},
+		}, repository.NewMeta(params, 2), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/bankStatements", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &statementsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, statementsData, 2)
+	assert.EqualValues(t, statementsData[0].UnmatchedCount, 1)
+}
+
+// TestGetBankStatement_Success Test.
+func TestGetBankStatement_Success(t *testing.T) {
+	var statementData entity.DetailBankStatement
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/bankStatements/:uuid", statementHandler.GetBankStatement)
+
+	statementApp.GetBankStatementFn = func(string) (*entity.BankStatement, error) {
+		return &entity.BankStatement{
+			UUID: UUID,
+			Lines: entity.BankStatementLines{
+				{LineNumber: 1, Status: entity.BankStatementLineMatched},
+				{LineNumber: 2, Status: entity.BankStatementLineDiscrepancy, Discrepancy: "amount"},
+				{LineNumber: 3, Status: entity.BankStatementLineUnmatched},
+				{LineNumber: 4, Status: entity.BankStatementLineIgnored},
+			},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/bankStatements/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &statementData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, statementData.UUID, UUID)
+	assert.Len(t, statementData.Matched, 1)
+	assert.Len(t, statementData.Discrepancies, 1)
+	assert.Len(t, statementData.Unmatched, 1)
+	assert.Len(t, statementData.Resolved, 1)
+}
+
+// TestGetBankStatement_NotFound Test.
+func TestGetBankStatement_NotFound(t *testing.T) {
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/bankStatements/:uuid", statementHandler.GetBankStatement)
+
+	statementApp.GetBankStatementFn = func(string) (*entity.BankStatement, error) {
+		return nil, exception.ErrorTextBankStatementNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/bankStatements/"+uuid.New().String(), nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestResolveBankStatementLine_Success Test.
+func TestResolveBankStatementLine_Success(t *testing.T) {
+	var lineData entity.DetailBankStatementLine
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+	UUID := uuid.New().String()
+	PaymentUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.PUT("/bankStatements/lines/:uuid", statementHandler.ResolveBankStatementLine)
+
+	statementApp.ResolveBankStatementLineFn = func(
+		lineUUID string,
+		request *entity.BankStatementLineResolveRequest,
+		resolvedBy string,
+	) (*entity.BankStatementLine, map[string]string, error) {
+		line := &entity.BankStatementLine{UUID: lineUUID, Status: entity.BankStatementLineUnmatched}
+		line.Resolve(request, resolvedBy)
+		return line, nil, nil
+	}
+
+	body := `{"action":"Match","payment_uuid":"` + PaymentUUID + `","comment":"Paid by two transfers"}`
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPut, "/api/v1/external/bankStatements/lines/"+UUID, strings.NewReader(body))
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &lineData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, lineData.UUID, UUID)
+	assert.EqualValues(t, lineData.Status, entity.BankStatementLineResolved)
+	assert.EqualValues(t, lineData.PaymentUUID, PaymentUUID)
+	assert.EqualValues(t, lineData.Comment, "Paid by two transfers")
+	assert.NotNil(t, lineData.ResolvedAt)
+}
+
+// TestResolveBankStatementLine_InvalidData Test.
+func TestResolveBankStatementLine_InvalidData(t *testing.T) {
+	samples := []string{
+		`{"action":""}`,
+		`{"action":"delete"}`,
+		`{"action":"match"}`,
+		`{"action":"match","payment_uuid":"not-uuid"}`,
+		`{"action":"ignore","comment":"` + strings.Repeat("a", 256) + `"}`,
+	}
+
+	for _, v := range samples {
+		var statementApp mock.BankStatementAppInterface
+		statementHandler := NewBankStatements(&statementApp)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.PUT("/bankStatements/lines/:uuid", statementHandler.ResolveBankStatementLine)
+
+		var err error
+		c.Request, err = http.NewRequest(
+			http.MethodPut,
+			"/api/v1/external/bankStatements/lines/"+uuid.New().String(),
+			strings.NewReader(v),
+		)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+// TestResolveBankStatementLine_PaymentReconciled Test.
+func TestResolveBankStatementLine_PaymentReconciled(t *testing.T) {
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.PUT("/bankStatements/lines/:uuid", statementHandler.ResolveBankStatementLine)
+
+	statementApp.ResolveBankStatementLineFn = func(
+		string,
+		*entity.BankStatementLineResolveRequest,
+		string,
+	) (*entity.BankStatementLine, map[string]string, error) {
+		return nil, map[string]string{
+			"payment_uuid": exception.ErrorTextBankStatementPaymentReconciled.Error(),
+		}, exception.ErrorTextUnprocessableEntity
+	}
+
+	body := `{"action":"match","payment_uuid":"` + uuid.New().String() + `"}`
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPut,
+		"/api/v1/external/bankStatements/lines/"+uuid.New().String(),
+		strings.NewReader(body),
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestResolveBankStatementLine_NotFound Test.
+func TestResolveBankStatementLine_NotFound(t *testing.T) {
+	var statementApp mock.BankStatementAppInterface
+	statementHandler := NewBankStatements(&statementApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.PUT("/bankStatements/lines/:uuid", statementHandler.ResolveBankStatementLine)
+
+	statementApp.ResolveBankStatementLineFn = func(
+		string,
+		*entity.BankStatementLineResolveRequest,
+		string,
+	) (*entity.BankStatementLine, map[string]string, error) {
+		return nil, nil, exception.ErrorTextBankStatementLineNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPut,
+		"/api/v1/external/bankStatements/lines/"+uuid.New().String(),
+		strings.NewReader(`{"action":"ignore","comment":"Bank fee"}`),
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
@@ -0,0 +1,132 @@
+package calendarv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/ical"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Calendars is a struct defines the dependencies that will be used.
+type Calendars struct {
+	us       application.CalendarAppInterface
+	location *time.Location
+}
+
+// NewCalendars is constructor will initialize calendar handler, times of events are described in timezone.
+func NewCalendars(us application.CalendarAppInterface, timezone string) *Calendars {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	return &Calendars{
+		us:       us,
+		location: location,
+	}
+}
+
+// @Summary Get calendar subscriptions
+// @Description Get subscription URLs of iCalendar feeds of current user: feed of paid orders
+// @Description and feed of assigned trips when user is a driver. URLs are issued on the first request.
+// @Tags calendars
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/calendars [get]
+// GetCalendarTokens is a function uses to handle get subscription URLs of calendars of current user.
+func (s *Calendars) GetCalendarTokens(c *gin.Context) {
+	UUID, exists := c.Get("UUID")
+	if !exists {
+		_ = c.AbortWithError(http.StatusUnauthorized, exception.ErrorTextUnauthorized)
+		return
+	}
+	tokens, err := s.us.GetCalendarTokens(UUID.(string))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, entity.CalendarTokens(tokens).DetailCalendarTokens(baseURL(c)),
+		success.CalendarSuccessfullyGetCalendarTokens).JSON()
+}
+
+// @Summary Reset calendar subscriptions
+// @Description Revoke subscription URLs of iCalendar feeds of current user and issue new ones.
+// @Tags calendars
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/calendars/reset [post]
+// ResetCalendarTokens is a function uses to handle reset of subscription URLs of calendars of current user.
+func (s *Calendars) ResetCalendarTokens(c *gin.Context) {
+	UUID, exists := c.Get("UUID")
+	if !exists {
+		_ = c.AbortWithError(http.StatusUnauthorized, exception.ErrorTextUnauthorized)
+		return
+	}
+	tokens, err := s.us.ResetCalendarTokens(UUID.(string))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, entity.CalendarTokens(tokens).DetailCalendarTokens(baseURL(c)),
+		success.CalendarSuccessfullyResetCalendarTokens).JSON()
+}
+
+// @Summary Get calendar feed
+// @Description Get iCalendar feed of subscription URL. Driver feed contains assigned trips, passenger feed contains
+// @Description paid orders with seats. Trips departed more than 30 days ago are not included, deleted trips
+// @Description and refunded orders are cancelled events.
+// @Tags calendars
+// @Produce text/calendar
+// @Param token path string true "Token of feed with .ics extension"
+// @Success 200 {file} file
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /calendars/{token} [get]
+// GetCalendarFeed is a function uses to handle get iCalendar feed of token.
+func (s *Calendars) GetCalendarFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), entity.CalendarFeedExtension)
+	feed, err := s.us.GetCalendarFeed(token)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCalendarNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, ical.ContentType, feed.ICS(s.location, time.Now()))
+}
+
+// baseURL return scheme and host of request, scheme of proxy is used when request is forwarded.
+func baseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
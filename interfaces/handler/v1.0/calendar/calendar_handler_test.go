@@ -0,0 +1,222 @@
+package calendarv1point00
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/pkg/ical"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestGetCalendarTokens_Success Test.
+func TestGetCalendarTokens_Success(t *testing.T) {
+	var tokenData []entity.DetailCalendarToken
+	var calendarApp mock.CalendarAppInterface
+	calendarHandler := NewCalendars(&calendarApp, "Europe/Moscow")
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("UUID", UUID)
+	})
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/calendars", calendarHandler.GetCalendarTokens)
+
+	var requested string
+	calendarApp.GetCalendarTokensFn = func(userUUID string) ([]*entity.CalendarToken, error) {
+		requested = userUUID
+		return []*entity.CalendarToken{
+			{UUID: uuid.New().String(), UserUUID: userUUID, Kind: entity.CalendarFeedDriver, Token: "driver-token"},
+			{UUID: uuid.New().String(), UserUUID: userUUID, Kind: entity.CalendarFeedPassenger, Token: "passenger-token"},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/calendars", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	c.Request.Host = "api.example.com"
+	c.Request.Header.Set("X-Forwarded-Proto", "https")
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &tokenData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, UUID, requested)
+	assert.Len(t, tokenData, 2)
+	assert.EqualValues(t, entity.CalendarFeedDriver, tokenData[0].Kind)
+	assert.EqualValues(t, "https://api.example.com/calendars/driver-token.ics", tokenData[0].URL)
+	assert.EqualValues(t, "https://api.example.com/calendars/passenger-token.ics", tokenData[1].URL)
+}
+
+// TestResetCalendarTokens_Success Test.
+func TestResetCalendarTokens_Success(t *testing.T) {
+	var tokenData []entity.DetailCalendarToken
+	var calendarApp mock.CalendarAppInterface
+	calendarHandler := NewCalendars(&calendarApp, "Europe/Moscow")
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("UUID", UUID)
+	})
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/calendars/reset", calendarHandler.ResetCalendarTokens)
+
+	calendarApp.ResetCalendarTokensFn = func(userUUID string) ([]*entity.CalendarToken, error) {
+		return []*entity.CalendarToken{
+			{UUID: uuid.New().String(), UserUUID: userUUID, Kind: entity.CalendarFeedPassenger, Token: "new-token"},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/calendars/reset", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	c.Request.Host = "localhost:8888"
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &tokenData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, tokenData, 1)
+	assert.EqualValues(t, "http://localhost:8888/calendars/new-token.ics", tokenData[0].URL)
+}
+
+// TestGetCalendarTokens_Unauthorized Test.
+func TestGetCalendarTokens_Unauthorized(t *testing.T) {
+	var calendarApp mock.CalendarAppInterface
+	calendarHandler := NewCalendars(&calendarApp, "Europe/Moscow")
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/calendars", calendarHandler.GetCalendarTokens)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/calendars", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnauthorized)
+}
+
+// TestGetCalendarFeed_Success Test.
+func TestGetCalendarFeed_Success(t *testing.T) {
+	var calendarApp mock.CalendarAppInterface
+	calendarHandler := NewCalendars(&calendarApp, "Europe/Moscow")
+	location, _ := time.LoadLocation("Europe/Moscow")
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.GET("/calendars/:token", calendarHandler.GetCalendarFeed)
+
+	updated := time.Date(2021, 2, 20, 10, 0, 0, 0, time.UTC)
+	trip := entity.Trip{
+		UUID: uuid.New().String(),
+		Route: entity.Route{
+			SityFrom: entity.Sity{Name: "Тверь", Region: "Тверская область"},
+			SityTo:   entity.Sity{Name: "Москва"},
+		},
+		Vehicle:       entity.Vehicle{RegCode: "А123ВС69", Model: "ПАЗ"},
+		DepartureTime: time.Date(2021, 3, 1, 23, 0, 0, 0, location),
+		ArravialTive:  time.Date(2021, 3, 2, 2, 30, 0, 0, location),
+		UpdatedAt:     updated,
+	}
+	paid := &entity.Order{UUID: uuid.New().String(), Trip: trip, Seat: "12", UpdatedAt: updated.Add(-time.Hour)}
+	refunded := &entity.Order{UUID: uuid.New().String(), Trip: trip, Seat: "14", UpdatedAt: updated.Add(time.Hour)}
+	deleted := &entity.Order{
+		UUID:      uuid.New().String(),
+		Trip:      trip,
+		Seat:      "15",
+		UpdatedAt: updated.Add(-time.Hour),
+		DeletedAt: gorm.DeletedAt{Time: updated.Add(2 * time.Hour), Valid: true},
+	}
+
+	var requested string
+	calendarApp.GetCalendarFeedFn = func(token string) (*entity.CalendarFeed, error) {
+		requested = token
+		return &entity.CalendarFeed{
+			Kind:     entity.CalendarFeedPassenger,
+			Orders:   []*entity.Order{paid, refunded, deleted},
+			Refunded: map[string]bool{refunded.UUID: true},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/calendars/secret.ics", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	content := w.Body.String()
+	unfolded := strings.ReplaceAll(content, "\r\n ", "")
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, ical.ContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "secret", requested)
+	assert.Contains(t, unfolded, "UID:order-"+paid.UUID+"@cargo-rest-api\r\n")
+	assert.Contains(t, unfolded, "SUMMARY:Тверь — Москва\r\n")
+	assert.Contains(t, unfolded, "DTSTART:20210301T200000Z\r\n")
+	assert.Contains(t, unfolded, "DTEND:20210301T233000Z\r\n")
+	assert.Contains(t, unfolded, "LOCATION:Тверь\\, Тверская область\r\n")
+	assert.Contains(t, unfolded, "Departure: 01.03.2021 23:00 (Europe/Moscow)")
+	assert.Contains(t, unfolded, "Vehicle: А123ВС69 ПАЗ")
+	assert.Contains(t, unfolded, "Seat: 12")
+	assert.Equal(t, 1, strings.Count(unfolded, "STATUS:CONFIRMED"))
+	assert.Equal(t, 2, strings.Count(unfolded, "STATUS:CANCELLED"))
+	assert.Contains(t, unfolded, "SEQUENCE:1613815200\r\n")
+	assert.Contains(t, unfolded, "SEQUENCE:1613818800\r\n")
+	assert.Contains(t, unfolded, "SEQUENCE:1613822400\r\n")
+}
+
+// TestGetCalendarFeed_NotFound Test.
+func TestGetCalendarFeed_NotFound(t *testing.T) {
+	var calendarApp mock.CalendarAppInterface
+	calendarHandler := NewCalendars(&calendarApp, "Europe/Moscow")
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.GET("/calendars/:token", calendarHandler.GetCalendarFeed)
+
+	calendarApp.GetCalendarFeedFn = func(token string) (*entity.CalendarFeed, error) {
+		return nil, exception.ErrorTextCalendarNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/calendars/revoked.ics", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
@@ -0,0 +1,313 @@
+package cargoTariffv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CargoTariffs is a struct defines the dependencies that will be used.
+type CargoTariffs struct {
+	us application.CargoTariffAppInterface
+}
+
+// NewCargoTariffs is constructor will initialize cargo tariff handler.
+func NewCargoTariffs(us application.CargoTariffAppInterface) *CargoTariffs {
+	return &CargoTariffs{
+		us: us,
+	}
+}
+
+// @Summary Create a new cargo tariff
+// @Description Create a new cargo tariff, tariff without route applies to all routes without own tariff.
+// @Tags cargo tariffs
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param cargo_tariff body entity.DetailCargoTariff true "Cargo tariff data"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cargoTariffs [post]
+// SaveCargoTariff is a function uses to handle create a new cargo tariff.
+func (s *CargoTariffs) SaveCargoTariff(c *gin.Context) {
+	var tariffEntity entity.CargoTariff
+	if err := c.ShouldBindJSON(&tariffEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := tariffEntity.ValidateSaveCargoTariff()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	tariffEntity.Prepare()
+
+	newTariff, errDesc, errException := s.us.SaveCargoTariff(&tariffEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, newTariff.DetailCargoTariff(), success.CargoTariffSuccessfullyCreateCargoTariff).
+		JSON()
+}
+
+// @Summary Update cargo tariff
+// @Description Update an existing cargo tariff, weight brackets are replaced.
+// @Tags cargo tariffs
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Cargo tariff UUID"
+// @Param cargo_tariff body entity.DetailCargoTariff true "Cargo tariff data"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cargoTariffs/{uuid} [put]
+// UpdateCargoTariff is a function uses to handle update cargo tariff by UUID.
+func (s *CargoTariffs) UpdateCargoTariff(c *gin.Context) {
+	var tariffEntity entity.CargoTariff
+	if err := c.ShouldBindUri(&tariffEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	if err := c.ShouldBindJSON(&tariffEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := tariffEntity.ValidateUpdateCargoTariff()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	tariffEntity.Prepare()
+
+	UUID := c.Param("uuid")
+	_, err := s.us.GetCargoTariff(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCargoTariffNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextCargoTariffNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	updatedTariff, errDesc, errException := s.us.UpdateCargoTariff(UUID, &tariffEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextCargoTariffNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusOK)
+	response.NewSuccess(c, updatedTariff.DetailCargoTariff(), success.CargoTariffSuccessfullyUpdateCargoTariff).
+		JSON()
+}
+
+// @Summary Delete cargo tariff
+// @Description Delete an existing cargo tariff.
+// @Tags cargo tariffs
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Cargo tariff UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cargoTariffs/{uuid} [delete]
+// DeleteCargoTariff is a function uses to handle delete cargo tariff by UUID.
+func (s *CargoTariffs) DeleteCargoTariff(c *gin.Context) {
+	var tariffEntity entity.CargoTariff
+	if err := c.ShouldBindUri(&tariffEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	err := s.us.DeleteCargoTariff(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCargoTariffNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextCargoTariffNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, nil, success.CargoTariffSuccessfullyDeleteCargoTariff).JSON()
+}
+
+// @Summary Get cargo tariffs
+// @Description Get list of existing cargo tariffs.
+// @Tags cargo tariffs
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cargoTariffs [get]
+// GetCargoTariffs is a function uses to handle get cargo tariff list.
+func (s *CargoTariffs) GetCargoTariffs(c *gin.Context) {
+	var tariff entity.CargoTariff
+	var tariffs entity.CargoTariffs
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(tariff.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	tariffs, meta, err := s.us.GetCargoTariffs(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, tariffs.DetailCargoTariffs(), success.CargoTariffSuccessfullyGetCargoTariffList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Get cargo tariff
+// @Description Get detail of existing cargo tariff with weight brackets.
+// @Tags cargo tariffs
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Cargo tariff UUID"
+// @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cargoTariffs/{uuid} [get]
+// GetCargoTariff is a function uses to handle get cargo tariff detail by UUID.
+func (s *CargoTariffs) GetCargoTariff(c *gin.Context) {
+	var tariffEntity entity.CargoTariff
+	if err := c.ShouldBindUri(&tariffEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	tariff, err := s.us.GetCargoTariff(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCargoTariffNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextCargoTariffNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.NewSuccess(c, tariff.DetailCargoTariff(), success.CargoTariffSuccessfullyGetCargoTariffDetail).
+		WithETag(tariff.UpdatedAt).
+		JSON()
+}
+
+// @Summary Quote shipment
+// @Description Calculate price of shipment by weight, volume, route distance and declared value.
+// @Tags cargo tariffs
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param shipment body entity.DetailShipment true "Shipment data"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cargoTariffs/quote [post]
+// QuoteShipment is a function uses to handle calculate price of shipment.
+func (s *CargoTariffs) QuoteShipment(c *gin.Context) {
+	var shipmentEntity entity.Shipment
+	if err := c.ShouldBindJSON(&shipmentEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := shipmentEntity.ValidateQuoteShipment()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	quote, errDesc, errException := s.us.QuoteShipment(&shipmentEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	response.NewSuccess(c, quote, success.CargoTariffSuccessfullyQuoteShipment).JSON()
+}
@@ -0,0 +1,306 @@
+package cargoTariffv1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// cargoTariffJSON is a request body of cargo tariff.
+const cargoTariffJSON = `{
+	"name": "Default",
+	"price_per_km": 0.5,
+	"insurance_percent": 1,
+	"minimum_charge": 300,
+	"brackets": [
+		{"max_weight": 5, "price": 200},
+		{"max_weight": 20, "price": 400},
+		{"max_weight": 0, "price": 900}
+	]
+}`
+
+// cargoTariff return cargo tariff used to calculate quote.
+func cargoTariff() *entity.CargoTariff {
+	return &entity.CargoTariff{
+		UUID:              uuid.New().String(),
+		Name:              "Default",
+		VolumetricDivisor: entity.DefaultVolumetricDivisor,
+		PricePerKm:        0.5,
+		InsurancePercent:  1,
+		MinimumCharge:     300,
+		Brackets: entity.CargoTariffBrackets{
+			{MaxWeight: 20, Price: 400},
+			{MaxWeight: 5, Price: 200},
+		},
+	}
+}
+
+// TestSaveCargoTariff_Success Test.
+func TestSaveCargoTariff_Success(t *testing.T) {
+	var tariffData entity.DetailCargoTariff
+	var tariffApp mock.CargoTariffAppInterface
+	tariffHandler := NewCargoTariffs(&tariffApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/cargoTariffs", tariffHandler.SaveCargoTariff)
+
+	tariffApp.SaveCargoTariffFn = func(tariff *entity.CargoTariff) (*entity.CargoTariff, map[string]string, error) {
+		tariff.UUID = UUID
+		return tariff, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/cargoTariffs", bytes.NewBufferString(cargoTariffJSON))
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &tariffData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, tariffData.UUID, UUID)
+	assert.EqualValues(t, tariffData.VolumetricDivisor, entity.DefaultVolumetricDivisor)
+	assert.EqualValues(t, 3, len(tariffData.Brackets))
+}
+
+// TestSaveCargoTariff_InvalidData Test.
+func TestSaveCargoTariff_InvalidData(t *testing.T) {
+	var tariffApp mock.CargoTariffAppInterface
+	tariffHandler := NewCargoTariffs(&tariffApp)
+
+	samples := []struct {
+		inputJSON  string
+		statusCode int
+	}{
+		{
+			inputJSON:  `{"name": "Default", "brackets": []}`,
+			statusCode: 422,
+		},
+		{
+			inputJSON:  `{"name": "Default", "insurance_percent": 120, "brackets": [{"max_weight": 5, "price": 200}]}`,
+			statusCode: 422,
+		},
+		{
+			inputJSON:  `{"name": "Default", "brackets": [{"max_weight": -5, "price": 200}]}`,
+			statusCode: 422,
+		},
+	}
+
+	for _, v := range samples {
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/cargoTariffs", tariffHandler.SaveCargoTariff)
+
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/cargoTariffs", bytes.NewBufferString(v.inputJSON))
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, v.statusCode)
+	}
+}
+
+// TestGetCargoTariff_Success Test.
+func TestGetCargoTariff_Success(t *testing.T) {
+	var tariffData entity.DetailCargoTariff
+	var tariffApp mock.CargoTariffAppInterface
+	tariffHandler := NewCargoTariffs(&tariffApp)
+	tariff := cargoTariff()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/cargoTariffs/:uuid", tariffHandler.GetCargoTariff)
+
+	tariffApp.GetCargoTariffFn = func(string) (*entity.CargoTariff, error) {
+		return tariff, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/cargoTariffs/"+tariff.UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &tariffData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, tariffData.UUID, tariff.UUID)
+	assert.EqualValues(t, 2, len(tariffData.Brackets))
+}
+
+// TestGetCargoTariffs_Success Test.
+func TestGetCargoTariffs_Success(t *testing.T) {
+	var tariffApp mock.CargoTariffAppInterface
+	var tariffsData []entity.DetailCargoTariffList
+	var metaData repository.Meta
+	tariffHandler := NewCargoTariffs(&tariffApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/cargoTariffs", tariffHandler.GetCargoTariffs)
+	tariffApp.GetCargoTariffsFn = func(params *repository.Parameters) ([]*entity.CargoTariff, *repository.Meta, error) {
+		tariffs := []*entity.CargoTariff{cargoTariff(), cargoTariff()}
+		meta := repository.NewMeta(params, int64(len(tariffs)))
+		return tariffs, meta, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/cargoTariffs", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+	meta, _ := json.Marshal(response["meta"])
+
+	_ = json.Unmarshal(data, &tariffsData)
+	_ = json.Unmarshal(meta, &metaData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, 2, len(tariffsData))
+	assert.EqualValues(t, 2, metaData.Total)
+}
+
+// TestDeleteCargoTariff_Failed_CargoTariffNotFound Test.
+func TestDeleteCargoTariff_Failed_CargoTariffNotFound(t *testing.T) {
+	var tariffApp mock.CargoTariffAppInterface
+	tariffHandler := NewCargoTariffs(&tariffApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/cargoTariffs/:uuid", tariffHandler.DeleteCargoTariff)
+
+	tariffApp.DeleteCargoTariffFn = func(UUID string) error {
+		return exception.ErrorTextCargoTariffNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/cargoTariffs/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestQuoteShipment_Success Test.
+func TestQuoteShipment_Success(t *testing.T) {
+	var quoteData entity.CargoQuote
+	var tariffApp mock.CargoTariffAppInterface
+	tariffHandler := NewCargoTariffs(&tariffApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/cargoTariffs/quote", tariffHandler.QuoteShipment)
+
+	tariffApp.QuoteShipmentFn = func(shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error) {
+		quote, _ := cargoTariff().Quote(shipment, 600)
+		return quote, nil, nil
+	}
+
+	// Volumetric weight 50*40*30/5000 = 12 kg exceeds actual weight.
+	inputJSON := `{
+		"from_uuid": "` + uuid.New().String() + `",
+		"to_uuid": "` + uuid.New().String() + `",
+		"weight": 4,
+		"length": 50,
+		"width": 40,
+		"height": 30,
+		"declared_value": 10000
+	}`
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/cargoTariffs/quote", bytes.NewBufferString(inputJSON))
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &quoteData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, 12, quoteData.VolumetricWeight)
+	assert.EqualValues(t, 12, quoteData.ChargeableWeight)
+	assert.EqualValues(t, 400, quoteData.WeightCharge)
+	assert.EqualValues(t, 300, quoteData.DistanceCharge)
+	assert.EqualValues(t, 100, quoteData.InsuranceCharge)
+	assert.EqualValues(t, 800, quoteData.Total)
+}
+
+// TestQuoteShipment_WeightNotCovered Test.
+func TestQuoteShipment_WeightNotCovered(t *testing.T) {
+	var tariffApp mock.CargoTariffAppInterface
+	tariffHandler := NewCargoTariffs(&tariffApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/cargoTariffs/quote", tariffHandler.QuoteShipment)
+
+	tariffApp.QuoteShipmentFn = func(shipment *entity.Shipment) (*entity.CargoQuote, map[string]string, error) {
+		if _, covered := cargoTariff().Quote(shipment, 600); !covered {
+			errDesc := map[string]string{"weight": exception.ErrorTextCargoTariffWeightNotCovered.Error()}
+			return nil, errDesc, exception.ErrorTextUnprocessableEntity
+		}
+		return nil, nil, exception.ErrorTextAnErrorOccurred
+	}
+
+	inputJSON := `{
+		"from_uuid": "` + uuid.New().String() + `",
+		"to_uuid": "` + uuid.New().String() + `",
+		"weight": 35
+	}`
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/cargoTariffs/quote", bytes.NewBufferString(inputJSON))
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
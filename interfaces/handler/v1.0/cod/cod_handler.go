@@ -0,0 +1,204 @@
+package codv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CODs is a struct defines the dependencies that will be used.
+type CODs struct {
+	us application.CODAppInterface
+}
+
+// NewCODs is constructor will initialize cash on delivery handler.
+func NewCODs(us application.CODAppInterface) *CODs {
+	return &CODs{
+		us: us,
+	}
+}
+
+// @Summary Get cash on delivery settlement report
+// @Description Get cash on delivery collected for each sender, our fees, paid out and outstanding amounts.
+// @Tags cash on delivery
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param date_from query string false "Collected from date (yyyy-mm-dd)"
+// @Param date_to query string false "Collected to date inclusive (yyyy-mm-dd)"
+// @Param sender_phone query string false "Sender phone"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cod/settlement [get]
+// GetCODSettlements is a function uses to handle get cash on delivery settlement report.
+func (s *CODs) GetCODSettlements(c *gin.Context) {
+	var request entity.CODSettlementRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+	if !s.validateRequest(c, &request) {
+		return
+	}
+
+	settlements, err := s.us.GetCODSettlements(&request)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(
+		c,
+		entity.CODSettlements(settlements).DetailCODSettlements(),
+		success.CODSuccessfullyGetSettlementReport,
+	).JSON()
+}
+
+// @Summary Create cash on delivery payouts
+// @Description Create payout batch for each sender from collected cash on delivery which is not paid out yet.
+// @Tags cash on delivery
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param payout body entity.CODSettlementRequest true "Period of collection and sender"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 409 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cod/payouts [post]
+// SaveCODPayouts is a function uses to handle create cash on delivery payouts.
+func (s *CODs) SaveCODPayouts(c *gin.Context) {
+	var request entity.CODSettlementRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+	if !s.validateRequest(c, &request) {
+		return
+	}
+
+	var createdBy string
+	if userUUID, exists := c.Get("UUID"); exists {
+		createdBy, _ = userUUID.(string)
+	}
+	payouts, err := s.us.SaveCODPayouts(&request, createdBy)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCODPayoutNothingToPay) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, err)
+			return
+		}
+		if errors.Is(err, exception.ErrorTextCODPayoutConflict) {
+			_ = c.AbortWithError(http.StatusConflict, err)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, entity.CODPayouts(payouts).DetailCODPayouts(), success.CODSuccessfullyCreatePayouts).
+		JSON()
+}
+
+// @Summary Get cash on delivery payouts
+// @Description Get list of cash on delivery payouts.
+// @Tags cash on delivery
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param sender_phone query string false "Sender phone"
+// @Param page query integer false "Page number"
+// @Param per_page query integer false "Number of items per page"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cod/payouts [get]
+// GetCODPayouts is a function uses to handle get cash on delivery payout list.
+func (s *CODs) GetCODPayouts(c *gin.Context) {
+	var payout entity.CODPayout
+	var payouts entity.CODPayouts
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(payout.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	payouts, meta, err := s.us.GetCODPayouts(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, payouts.DetailCODPayouts(), success.CODSuccessfullyGetPayoutList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Get cash on delivery payout
+// @Description Get detail of cash on delivery payout with its payments.
+// @Tags cash on delivery
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Payout UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/cod/payouts/{uuid} [get]
+// GetCODPayout is a function uses to handle get cash on delivery payout detail by UUID.
+func (s *CODs) GetCODPayout(c *gin.Context) {
+	UUID := c.Param("uuid")
+	payout, err := s.us.GetCODPayout(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextCODPayoutNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextCODPayoutNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, payout.DetailCODPayout(), success.CODSuccessfullyGetPayoutDetail).JSON()
+}
+
+// validateRequest will prepare and validate settlement request.
+func (s *CODs) validateRequest(c *gin.Context, request *entity.CODSettlementRequest) bool {
+	request.Prepare()
+	validateErr := request.ValidateCODSettlementRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return false
+	}
+	return true
+}
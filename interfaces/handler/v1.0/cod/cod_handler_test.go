@@ -0,0 +1,224 @@
+package codv1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// codSettlement return cash on delivery collected for sender.
+func codSettlement() *entity.CODSettlement {
+	settlement := &entity.CODSettlement{
+		SenderPhone:     "+79001234567",
+		SenderName:      "Sender",
+		ShipmentCount:   2,
+		CollectedAmount: 3000,
+		FeeAmount:       60,
+		PaidOutAmount:   970,
+	}
+	settlement.Calculate()
+	return settlement
+}
+
+// TestGetCODSettlements_Success Test.
+func TestGetCODSettlements_Success(t *testing.T) {
+	var settlementsData []entity.CODSettlement
+	var codApp mock.CODAppInterface
+	codHandler := NewCODs(&codApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/cod/settlement", codHandler.GetCODSettlements)
+
+	codApp.GetCODSettlementsFn = func(request *entity.CODSettlementRequest) ([]*entity.CODSettlement, error) {
+		assert.Equal(t, "2026-01-01", request.DateFrom)
+		assert.Equal(t, "2026-01-31", request.DateTo)
+		return []*entity.CODSettlement{codSettlement()}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodGet,
+		"/api/v1/external/cod/settlement?date_from=2026-01-01&date_to=2026-01-31",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &settlementsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, settlementsData, 1)
+	assert.EqualValues(t, 2940, settlementsData[0].PayoutAmount)
+	assert.EqualValues(t, 1970, settlementsData[0].OutstandingAmount)
+}
+
+// TestGetCODSettlements_InvalidData Test.
+func TestGetCODSettlements_InvalidData(t *testing.T) {
+	var codApp mock.CODAppInterface
+	codHandler := NewCODs(&codApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/cod/settlement", codHandler.GetCODSettlements)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/cod/settlement?date_from=01.01.2026", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestSaveCODPayouts_Success Test.
+func TestSaveCODPayouts_Success(t *testing.T) {
+	var payoutsData []entity.DetailCODPayoutList
+	var codApp mock.CODAppInterface
+	codHandler := NewCODs(&codApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/cod/payouts", codHandler.SaveCODPayouts)
+
+	codApp.SaveCODPayoutsFn = func(request *entity.CODSettlementRequest, createdBy string) ([]*entity.CODPayout, error) {
+		payout := entity.NewCODPayout(codSettlement(), nil)
+		payout.UUID = UUID
+		return []*entity.CODPayout{payout}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/cod/payouts",
+		bytes.NewBufferString(`{"date_to": "2026-01-31"}`),
+	)
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &payoutsData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.Len(t, payoutsData, 1)
+	assert.Equal(t, UUID, payoutsData[0].UUID)
+	assert.EqualValues(t, 2940, payoutsData[0].PayoutAmount)
+}
+
+// TestSaveCODPayouts_Failed_NothingToPay Test.
+func TestSaveCODPayouts_Failed_NothingToPay(t *testing.T) {
+	var codApp mock.CODAppInterface
+	codHandler := NewCODs(&codApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/cod/payouts", codHandler.SaveCODPayouts)
+
+	codApp.SaveCODPayoutsFn = func(request *entity.CODSettlementRequest, createdBy string) ([]*entity.CODPayout, error) {
+		return nil, exception.ErrorTextCODPayoutNothingToPay
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/cod/payouts", bytes.NewBufferString(`{}`))
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestGetCODPayouts_Success Test.
+func TestGetCODPayouts_Success(t *testing.T) {
+	var payoutsData []entity.DetailCODPayoutList
+	var codApp mock.CODAppInterface
+	codHandler := NewCODs(&codApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/cod/payouts", codHandler.GetCODPayouts)
+
+	codApp.GetCODPayoutsFn = func(params *repository.Parameters) ([]*entity.CODPayout, *repository.Meta, error) {
+		payouts := []*entity.CODPayout{
+			entity.NewCODPayout(codSettlement(), nil),
+			entity.NewCODPayout(codSettlement(), nil),
+		}
+		meta := repository.NewMeta(params, int64(len(payouts)))
+		return payouts, meta, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/cod/payouts", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &payoutsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, payoutsData, 2)
+}
+
+// TestGetCODPayout_Failed_PayoutNotFound Test.
+func TestGetCODPayout_Failed_PayoutNotFound(t *testing.T) {
+	var codApp mock.CODAppInterface
+	codHandler := NewCODs(&codApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/cod/payouts/:uuid", codHandler.GetCODPayout)
+
+	codApp.GetCODPayoutFn = func(UUID string) (*entity.CODPayout, error) {
+		return nil, exception.ErrorTextCODPayoutNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/cod/payouts/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
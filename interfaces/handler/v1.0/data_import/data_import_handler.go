@@ -0,0 +1,118 @@
+package dataImportv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/dataimport"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DataImports is a struct defines the dependencies that will be used.
+type DataImports struct {
+	us application.DataImportAppInterface
+}
+
+// NewDataImports is constructor will initialize data import handler.
+func NewDataImports(us application.DataImportAppInterface) *DataImports {
+	return &DataImports{
+		us: us,
+	}
+}
+
+// @Summary Import reference data and schedules
+// @Description Import sities, vehicles, drivers, routes or trips from CSV with header row or JSON array of objects.
+// @Description Every row is validated and creates or updates record found by natural key, all rows are imported
+// @Description in one transaction, nothing is imported when any row is invalid or in dry run.
+// @Tags data imports
+// @Accept mpfd
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param entity path string true "Imported entity" Enums(sities, vehicles, drivers, routes, trips)
+// @Param file formData file true "Import file"
+// @Param format formData string false "Import format, detected when empty" Enums(csv, json)
+// @Param dry_run formData bool false "Validate rows without import"
+// @Success 200 {object} response.successOutput
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/imports/{entity} [post]
+// SaveDataImport is a function uses to handle bulk import of entity rows.
+func (s *DataImports) SaveDataImport(c *gin.Context) {
+	entityName := c.Param("entity")
+	if !entity.IsDataImportEntity(entityName) {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportEntityUnsupported)
+		return
+	}
+	file, _ := c.FormFile("file")
+	if file == nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportFileRequired)
+		return
+	}
+	if file.Size > entity.DataImportMaxFileSize*1000000 {
+		c.Set("args", fmt.Sprintf("Size:%d", entity.DataImportMaxFileSize))
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportInvalidFileSize)
+		return
+	}
+	reader, err := file.Open()
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextStorageUploadCannotOpenFile)
+		return
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextStorageUploadCannotOpenFile)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(c.PostForm("dry_run"))
+	data, err := entity.NewDataImport(entityName, file.Filename, c.PostForm("format"), dryRun, content)
+	if err != nil {
+		var rowErr *dataimport.Error
+		switch {
+		case errors.As(err, &rowErr):
+			c.Set("args", fmt.Sprintf("Row:%d", rowErr.Row))
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportInvalidRow)
+		case errors.Is(err, dataimport.ErrEmpty):
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportEmpty)
+		default:
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportFormatUnsupported)
+		}
+		return
+	}
+
+	result, err := s.us.SaveDataImport(data)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	detail := result.DetailDataImport(func(validation []response.ErrorForm) map[string]string {
+		return response.TranslateErrorForm(c, validation)
+	})
+	if result.Failed() {
+		c.Set("data", detail)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextDataImportRowsInvalid)
+		return
+	}
+	if result.DryRun {
+		response.NewSuccess(c, detail, success.DataImportSuccessfullyDryRun).JSON()
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, detail, success.DataImportSuccessfullyImport).JSON()
+}
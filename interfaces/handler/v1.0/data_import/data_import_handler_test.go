@@ -0,0 +1,201 @@
+package dataImportv1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// importForm return multipart body of data import request.
+func importForm(t *testing.T, fileName string, content string, dryRun bool) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if dryRun {
+		_ = writer.WriteField("dry_run", "true")
+	}
+	if content != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		_, _ = part.Write([]byte(content))
+	}
+	_ = writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+// TestSaveDataImport_Success Test.
+func TestSaveDataImport_Success(t *testing.T) {
+	samples := []struct {
+		dryRun bool
+		code   int
+	}{
+		{false, http.StatusCreated},
+		{true, http.StatusOK},
+	}
+
+	for _, v := range samples {
+		var importData entity.DetailDataImport
+		var importApp mock.DataImportAppInterface
+		importHandler := NewDataImports(&importApp)
+		UUID := uuid.New().String()
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/imports/:entity", importHandler.SaveDataImport)
+
+		importApp.SaveDataImportFn = func(data *entity.DataImport) (*entity.DataImport, error) {
+			data.Rows[0].Sity()
+			data.Rows[0].Action, data.Rows[0].UUID = entity.DataImportActionCreate, UUID
+			data.Rows[1].Sity()
+			data.Rows[1].Action = entity.DataImportActionUpdate
+			data.Committed = !data.DryRun
+			return data, nil
+		}
+
+		body, contentType := importForm(t, "sities.json", `[
+			{"name": "Тверь", "region": "Тверская область"},
+			{"name": "Клин", "region": "Московская область"}
+		]`, v.dryRun)
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/imports/sities", body)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		response := encoder.ResponseDecoder(w.Body)
+		data, _ := json.Marshal(response["data"])
+
+		_ = json.Unmarshal(data, &importData)
+
+		assert.Equal(t, w.Code, v.code)
+		assert.EqualValues(t, importData.Entity, entity.DataImportSities)
+		assert.EqualValues(t, importData.Format, "json")
+		assert.EqualValues(t, importData.DryRun, v.dryRun)
+		assert.EqualValues(t, importData.Committed, !v.dryRun)
+		assert.EqualValues(t, importData.Total, 2)
+		assert.EqualValues(t, importData.Created, 1)
+		assert.EqualValues(t, importData.Updated, 1)
+		assert.EqualValues(t, importData.Rows[0].UUID, UUID)
+		assert.EqualValues(t, importData.Rows[0].Key, "Тверь, Тверская область")
+	}
+}
+
+// TestSaveDataImport_RowsInvalid Test.
+func TestSaveDataImport_RowsInvalid(t *testing.T) {
+	var importApp mock.DataImportAppInterface
+	importHandler := NewDataImports(&importApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	var abortErr error
+	var abortData interface{}
+	r.Use(func(c *gin.Context) {
+		c.Next()
+		if last := c.Errors.Last(); last != nil {
+			abortErr = last.Err
+		}
+		abortData, _ = c.Get("data")
+	})
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/imports/:entity", importHandler.SaveDataImport)
+
+	importApp.SaveDataImportFn = func(data *entity.DataImport) (*entity.DataImport, error) {
+		for _, row := range data.Rows {
+			row.Vehicle()
+		}
+		data.Rows[0].Action = entity.DataImportActionCreate
+		return data, nil
+	}
+
+	body, contentType := importForm(t, "vehicles.csv", "reg_code;model;number_of_seats\n"+
+		"А123ВС77;ПАЗ;25\n"+
+		"В456ОР99;ЛиАЗ;many\n", false)
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/imports/vehicles", body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	importData, _ := abortData.(*entity.DetailDataImport)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	assert.Equal(t, exception.ErrorTextDataImportRowsInvalid, abortErr)
+	assert.NotNil(t, importData)
+	assert.EqualValues(t, importData.Committed, false)
+	assert.EqualValues(t, importData.Failed, 1)
+	assert.EqualValues(t, importData.Rows[0].Action, entity.DataImportActionCreate)
+	assert.EqualValues(t, importData.Rows[1].Row, 2)
+	assert.EqualValues(t, importData.Rows[1].Action, entity.DataImportActionFailed)
+	assert.Contains(t, importData.Rows[1].Errors, "number_of_seats")
+}
+
+// TestSaveDataImport_InvalidFile Test.
+func TestSaveDataImport_InvalidFile(t *testing.T) {
+	samples := []struct {
+		entity   string
+		fileName string
+		content  string
+		err      error
+	}{
+		{"passengers", "passengers.csv", "name\nИванов\n", exception.ErrorTextDataImportEntityUnsupported},
+		{"sities", "sities.csv", "", exception.ErrorTextDataImportFileRequired},
+		{"sities", "sities.csv", "name,region\n", exception.ErrorTextDataImportEmpty},
+		{"sities", "sities.json", `{"name": "Тверь"}`, exception.ErrorTextDataImportFormatUnsupported},
+		{"sities", "sities.json", `[{"name": {"ru": "Тверь"}}]`, exception.ErrorTextDataImportInvalidRow},
+	}
+
+	for _, v := range samples {
+		var importApp mock.DataImportAppInterface
+		importHandler := NewDataImports(&importApp)
+		saved := false
+		importApp.SaveDataImportFn = func(data *entity.DataImport) (*entity.DataImport, error) {
+			saved = true
+			return data, nil
+		}
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		var abortErr error
+		r.Use(func(c *gin.Context) {
+			c.Next()
+			if last := c.Errors.Last(); last != nil {
+				abortErr = last.Err
+			}
+		})
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/imports/:entity", importHandler.SaveDataImport)
+
+		body, contentType := importForm(t, v.fileName, v.content, false)
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/imports/"+v.entity, body)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+		assert.False(t, saved)
+		assert.Equal(t, v.err, abortErr)
+	}
+}
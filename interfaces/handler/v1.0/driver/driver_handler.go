@@ -79,6 +79,7 @@ func (s *Drivers) SaveDriver(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param driver body entity.DetailDriver true "Driver driver"
@@ -87,6 +88,7 @@ func (s *Drivers) SaveDriver(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/driver/uuid [put]
 // UpdateDriver is a function uses to handle update driver by UUID.
@@ -138,6 +140,7 @@ func (s *Drivers) UpdateDriver(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Driver UUID"
@@ -146,6 +149,7 @@ func (s *Drivers) UpdateDriver(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/driver/{uuid} [delete]
 // DeleteDriver is a function uses to handle delete driver by UUID.
@@ -218,6 +222,7 @@ func (s *Drivers) GetDrivers(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Driver UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -244,6 +249,7 @@ func (s *Drivers) GetDriver(c *gin.Context) {
 	}
 
 	response.NewSuccess(c, driver.DetailDriver(), success.DriverSuccessfullyGetDriverDetail).
+		WithETag(driver.UpdatedAt).
 		JSON()
 }
 
@@ -354,3 +360,124 @@ func (s *Drivers) DeleteDriverVehicle(c *gin.Context) {
 	c.Status(http.StatusOK)
 	response.NewSuccess(c, driver.DetailDriver(), success.DriverSuccessfullyDeleteDriverVehicle).JSON()
 }
+
+// @Summary Get driver working time rules
+// @Description Get driving and rest limits checked when trip is assigned to driver, durations are in minutes.
+// @Tags drivers
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/driver/workRules [get]
+// GetDriverWorkRule is a function uses to handle get driver working time rules.
+func (s *Drivers) GetDriverWorkRule(c *gin.Context) {
+	rule, err := s.us.GetDriverWorkRule()
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, rule.DetailDriverWorkRule(), success.DriverSuccessfullyGetWorkRule).JSON()
+}
+
+// @Summary Update driver working time rules
+// @Description Update driving and rest limits, durations are in minutes and zero disables the rule.
+// @Description Enforcement "reject" rejects violating trip assignment, "warn" saves it and returns warnings.
+// @Tags drivers
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param rule body entity.DetailDriverWorkRule true "Working time rules"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/driver/workRules [put]
+// UpdateDriverWorkRule is a function uses to handle update driver working time rules.
+func (s *Drivers) UpdateDriverWorkRule(c *gin.Context) {
+	var ruleEntity entity.DriverWorkRule
+	if err := c.ShouldBindJSON(&ruleEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	ruleEntity.Prepare()
+
+	validateErr := ruleEntity.ValidateUpdateDriverWorkRule()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	rule, err := s.us.UpdateDriverWorkRule(&ruleEntity)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	response.NewSuccess(c, rule.DetailDriverWorkRule(), success.DriverSuccessfullyUpdateWorkRule).JSON()
+}
+
+// @Summary Get driver timesheet
+// @Description Get driving of driver by days with violations of working time rules, current week by default.
+// @Tags drivers
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Driver UUID"
+// @Param date_from query string false "Date from (YYYY-MM-DD)"
+// @Param date_to query string false "Date to (YYYY-MM-DD)"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/driver/{uuid}/timesheet [get]
+// GetDriverTimesheet is a function uses to handle get timesheet of driver.
+func (s *Drivers) GetDriverTimesheet(c *gin.Context) {
+	var request entity.DriverTimesheetRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	request.Prepare()
+
+	validateErr := request.ValidateDriverTimesheetRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	timesheet, errDesc, errException := s.us.GetDriverTimesheet(UUID, &request)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextDriverNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	response.NewSuccess(c, timesheet, success.DriverSuccessfullyGetTimesheet).JSON()
+}
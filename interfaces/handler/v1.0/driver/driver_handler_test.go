@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -415,3 +416,225 @@ func TestDrivers_DeleteDriverVehicle(t *testing.T) {
 	assert.EqualValues(t, driverData.UUID, UUID)
 
 }
+
+// TestGetDriverWorkRule_Success Test.
+func TestGetDriverWorkRule_Success(t *testing.T) {
+	var ruleData entity.DetailDriverWorkRule
+	var driverApp mock.DriverAppInterface
+	driverHandler := NewDrivers(&driverApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/driver/workRules", driverHandler.GetDriverWorkRule)
+
+	driverApp.GetDriverWorkRuleFn = func() (*entity.DriverWorkRule, error) {
+		return entity.DefaultDriverWorkRule(), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/driver/workRules", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &ruleData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, ruleData.Enforcement, entity.DriverWorkRuleReject)
+	assert.EqualValues(t, ruleData.MaxDailyDriving, 540)
+	assert.EqualValues(t, ruleData.MinDailyRest, 660)
+}
+
+// TestUpdateDriverWorkRule_Success Test.
+func TestUpdateDriverWorkRule_Success(t *testing.T) {
+	var ruleData entity.DetailDriverWorkRule
+	var driverApp mock.DriverAppInterface
+	driverHandler := NewDrivers(&driverApp)
+
+	ruleJSON := `{
+		"enforcement": "Warn",
+		"max_continuous_driving": 270,
+		"min_break": 45,
+		"max_daily_driving": 540,
+		"extended_daily_driving": 0,
+		"extended_days_per_week": 0,
+		"min_daily_rest": 660,
+		"max_weekly_driving": 3360,
+		"min_weekly_rest": 2700
+	}`
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.PUT("/driver/workRules", driverHandler.UpdateDriverWorkRule)
+
+	driverApp.UpdateDriverWorkRuleFn = func(rule *entity.DriverWorkRule) (*entity.DriverWorkRule, error) {
+		return rule, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPut, "/api/v1/external/driver/workRules", bytes.NewBufferString(ruleJSON))
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &ruleData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, ruleData.Enforcement, entity.DriverWorkRuleWarn)
+	assert.EqualValues(t, ruleData.ExtendedDailyDriving, 0)
+}
+
+// TestUpdateDriverWorkRule_InvalidData Test.
+func TestUpdateDriverWorkRule_InvalidData(t *testing.T) {
+	samples := []string{
+		`{"enforcement": "ignore", "max_daily_driving": 540}`,
+		`{"enforcement": "reject", "max_daily_driving": -1}`,
+		`{"enforcement": "reject", "extended_days_per_week": 8}`,
+		`{"enforcement": "reject", "min_daily_rest": "11h"}`,
+	}
+
+	for _, v := range samples {
+		var driverApp mock.DriverAppInterface
+		driverHandler := NewDrivers(&driverApp)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.PUT("/driver/workRules", driverHandler.UpdateDriverWorkRule)
+
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPut, "/api/v1/external/driver/workRules", bytes.NewBufferString(v))
+		c.Request.Header.Add("Content-Type", "application/json")
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+// TestGetDriverTimesheet_Success Test.
+func TestGetDriverTimesheet_Success(t *testing.T) {
+	var timesheetData entity.DriverTimesheet
+	var driverApp mock.DriverAppInterface
+	driverHandler := NewDrivers(&driverApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/driver/:uuid/timesheet", driverHandler.GetDriverTimesheet)
+
+	driverApp.GetDriverTimesheetFn = func(
+		driverUUID string,
+		request *entity.DriverTimesheetRequest,
+	) (*entity.DriverTimesheet, map[string]string, error) {
+		first := &entity.Trip{
+			UUID:          uuid.New().String(),
+			DepartureTime: time.Date(2026, time.October, 19, 6, 0, 0, 0, time.Local),
+			ArravialTive:  time.Date(2026, time.October, 19, 16, 0, 0, 0, time.Local),
+		}
+		second := &entity.Trip{
+			UUID:          uuid.New().String(),
+			DepartureTime: time.Date(2026, time.October, 19, 18, 0, 0, 0, time.Local),
+			ArravialTive:  time.Date(2026, time.October, 20, 4, 0, 0, 0, time.Local),
+		}
+		from, to := request.Period()
+		timesheet := entity.NewDriverTimesheet(
+			&entity.Driver{UUID: driverUUID, Name: "Ivan Petrov"},
+			entity.DefaultDriverWorkRule(),
+			entity.Trips{first, second},
+			from,
+			to,
+		)
+		return timesheet, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodGet,
+		"/api/v1/external/driver/"+UUID+"/timesheet?date_from=2026-10-19&date_to=2026-10-25",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &timesheetData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, timesheetData.DriverUUID, UUID)
+	assert.EqualValues(t, timesheetData.DateTo, "2026-10-25")
+	assert.EqualValues(t, timesheetData.TripCount, 2)
+	assert.EqualValues(t, timesheetData.DrivingMinutes, 20*60)
+	assert.Len(t, timesheetData.Days, 2)
+	assert.EqualValues(t, timesheetData.Days[0].DrivingMinutes, 16*60)
+	assert.NotEmpty(t, timesheetData.Violations)
+}
+
+// TestGetDriverTimesheet_InvalidData Test.
+func TestGetDriverTimesheet_InvalidData(t *testing.T) {
+	var driverApp mock.DriverAppInterface
+	driverHandler := NewDrivers(&driverApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/driver/:uuid/timesheet", driverHandler.GetDriverTimesheet)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/driver/"+UUID+"/timesheet?date_from=19.10.2026", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestGetDriverTimesheet_Failed_DriverNotFound Test.
+func TestGetDriverTimesheet_Failed_DriverNotFound(t *testing.T) {
+	var driverApp mock.DriverAppInterface
+	driverHandler := NewDrivers(&driverApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/driver/:uuid/timesheet", driverHandler.GetDriverTimesheet)
+
+	driverApp.GetDriverTimesheetFn = func(string, *entity.DriverTimesheetRequest) (*entity.DriverTimesheet, map[string]string, error) {
+		return nil, map[string]string{}, exception.ErrorTextDriverNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/driver/"+UUID+"/timesheet", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
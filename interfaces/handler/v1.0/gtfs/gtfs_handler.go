@@ -0,0 +1,84 @@
+package gtfsv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GTFS is a struct defines the dependencies that will be used.
+type GTFS struct {
+	us     application.GTFSAppInterface
+	agency entity.GTFSAgency
+}
+
+// NewGTFS is constructor will initialize GTFS handler.
+func NewGTFS(us application.GTFSAppInterface, agency entity.GTFSAgency) *GTFS {
+	return &GTFS{
+		us:     us,
+		agency: agency,
+	}
+}
+
+// @Summary Get GTFS feed
+// @Description Get static GTFS feed of trips for trip planners and map applications. Sities are stops,
+// @Description trips of route departing at the same time of day are one trip running on dates of trips.
+// @Description Trips departing from today are exported by default, period is limited to 366 days.
+// @Tags gtfs
+// @Produce application/zip
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param from query string false "The first date of departure (2006-01-02)"
+// @Param to query string false "The last date of departure (2006-01-02)"
+// @Success 200 {file} file
+// @Failure 400 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 429 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /gtfs.zip [get]
+// GetGTFSFeed is a function uses to handle export of trips as GTFS feed.
+func (s *GTFS) GetGTFSFeed(c *gin.Context) {
+	var request entity.GTFSRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+	validateErr := request.ValidateGTFSRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	export, err := entity.NewGTFSExport(s.agency, &request, time.Now())
+	if errors.Is(err, entity.ErrGTFSInvalidPeriod) {
+		c.Set("args", fmt.Sprintf("Days:%d", entity.GTFSExportMaxPeriod))
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextGTFSInvalidPeriod)
+		return
+	}
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextAnErrorOccurred)
+		return
+	}
+	export, err = s.us.GetGTFSExport(export)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextGTFSEmpty) {
+			_ = c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", entity.GTFSFileName))
+	c.Data(http.StatusOK, "application/zip", export.Content)
+}
@@ -0,0 +1,135 @@
+package gtfsv1point00
+
+import (
+	"archive/zip"
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/tests/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// agency is an agency of exported feed.
+var agency = entity.GTFSAgency{Name: "Перевозчик", URL: "https://example.com", Timezone: "Europe/Moscow", Lang: "ru"}
+
+// TestGetGTFSFeed_Success Test.
+func TestGetGTFSFeed_Success(t *testing.T) {
+	var gtfsApp mock.GTFSAppInterface
+	gtfsHandler := NewGTFS(&gtfsApp, agency)
+	location, _ := time.LoadLocation(agency.Timezone)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.GET("/gtfs.zip", gtfsHandler.GetGTFSFeed)
+
+	var requested *entity.GTFSExport
+	gtfsApp.GetGTFSExportFn = func(export *entity.GTFSExport) (*entity.GTFSExport, error) {
+		requested = export
+		departure := time.Date(2021, 3, 1, 23, 0, 0, 0, location)
+		from := entity.Sity{UUID: uuid.New().String(), Name: "Тверь", Latitude: "56.858721", Longitude: "35.917597"}
+		to := entity.Sity{UUID: uuid.New().String(), Name: "Москва", Latitude: "55.755826", Longitude: "37.6173"}
+		route := entity.Route{UUID: uuid.New().String(), SityFrom: from, SityTo: to}
+		var trips []*entity.Trip
+		for day := 0; day < 7; day++ {
+			trips = append(trips, &entity.Trip{
+				UUID:          uuid.New().String(),
+				RouteUUID:     route.UUID,
+				Route:         route,
+				DepartureTime: departure.AddDate(0, 0, day),
+				ArravialTive:  departure.AddDate(0, 0, day).Add(3*time.Hour + 30*time.Minute),
+			})
+		}
+		return export, export.GTFS(trips)
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/gtfs.zip?from=2021-03-01&to=2021-03-07", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), entity.GTFSFileName)
+	assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, location), requested.From)
+	assert.Equal(t, time.Date(2021, 3, 8, 0, 0, 0, 0, location), requested.To)
+	assert.EqualValues(t, 1, requested.TripCount)
+	assert.EqualValues(t, 1, requested.ServiceCount)
+
+	archive, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	assert.NoError(t, err)
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.Contains(t, names, "stop_times.txt")
+	assert.Contains(t, names, "calendar.txt")
+	assert.NotContains(t, names, "calendar_dates.txt")
+}
+
+// TestGetGTFSFeed_InvalidData Test.
+func TestGetGTFSFeed_InvalidData(t *testing.T) {
+	samples := []string{
+		"from=01.03.2021",
+		"to=2021-03-32",
+		"from=2021-03-02&to=2021-03-01",
+		"from=2021-03-01&to=2022-03-02",
+	}
+
+	for _, v := range samples {
+		var gtfsApp mock.GTFSAppInterface
+		gtfsHandler := NewGTFS(&gtfsApp, agency)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		r.GET("/gtfs.zip", gtfsHandler.GetGTFSFeed)
+
+		var err error
+		c.Request, err = http.NewRequest(http.MethodGet, "/gtfs.zip?"+v, nil)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+// TestGetGTFSFeed_Empty Test.
+func TestGetGTFSFeed_Empty(t *testing.T) {
+	var gtfsApp mock.GTFSAppInterface
+	gtfsHandler := NewGTFS(&gtfsApp, agency)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.GET("/gtfs.zip", gtfsHandler.GetGTFSFeed)
+
+	var requested *entity.GTFSExport
+	gtfsApp.GetGTFSExportFn = func(export *entity.GTFSExport) (*entity.GTFSExport, error) {
+		requested = export
+		return nil, exception.ErrorTextGTFSEmpty
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/gtfs.zip", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	now := time.Now().In(requested.From.Location())
+	assert.Equal(t, w.Code, http.StatusNotFound)
+	assert.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()), requested.From)
+	assert.Equal(t, requested.From.AddDate(0, 0, entity.GTFSExportMaxPeriod), requested.To)
+}
@@ -0,0 +1,110 @@
+package gtfsv1point00
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/gtfs"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Import GTFS feed
+// @Description Import routes and trips of partner GTFS feed. Stops are matched to sities by name or by the nearest
+// @Description sity within radius, unmatched stops are created as sities when create_sities is set. Trips are created
+// @Description for every date of service from the first date up to the last date in timezone of agency of feed,
+// @Description trips of unmatched stops are not imported and stops are listed in unmatched report.
+// @Tags gtfs
+// @Accept mpfd
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param file formData file true "GTFS archive"
+// @Param from formData string false "The first date of imported trips (2006-01-02), today by default"
+// @Param to formData string false "The last date of imported trips (2006-01-02), 30 days by default"
+// @Param radius formData number false "Radius of match of stop to the nearest sity in kilometers" default(10)
+// @Param create_sities formData bool false "Create sities of unmatched stops"
+// @Param dry_run formData bool false "Report matches without import"
+// @Success 200 {object} response.successOutput
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/gtfsImports [post]
+// SaveGTFSImport is a function uses to handle import of GTFS feed.
+func (s *GTFS) SaveGTFSImport(c *gin.Context) {
+	var request entity.GTFSImportRequest
+	if err := c.ShouldBind(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+	validateErr := request.ValidateGTFSImportRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	file, _ := c.FormFile("file")
+	if file == nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextGTFSImportFileRequired)
+		return
+	}
+	if file.Size > entity.GTFSImportMaxFileSize*1000000 {
+		c.Set("args", fmt.Sprintf("Size:%d", entity.GTFSImportMaxFileSize))
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextGTFSImportInvalidFileSize)
+		return
+	}
+	reader, err := file.Open()
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextStorageUploadCannotOpenFile)
+		return
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextStorageUploadCannotOpenFile)
+		return
+	}
+
+	data, err := entity.NewGTFSImport(&request, content, time.Now())
+	if err != nil {
+		var feedErr *gtfs.Error
+		switch {
+		case errors.As(err, &feedErr):
+			c.Set("args", fmt.Sprintf("File:%s", feedErr.File))
+			c.Set("data", map[string]string{feedErr.File: feedErr.Error()})
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextGTFSImportInvalidFeed)
+		case errors.Is(err, entity.ErrGTFSImportInvalidHorizon):
+			c.Set("args", fmt.Sprintf("Days:%d", entity.GTFSImportMaxHorizon))
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextGTFSImportInvalidHorizon)
+		default:
+			c.Set("args", "File:gtfs.zip")
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextGTFSImportInvalidFeed)
+		}
+		return
+	}
+
+	result, err := s.us.SaveGTFSImport(data)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if result.DryRun {
+		response.NewSuccess(c, result.DetailGTFSImport(), success.GTFSImportSuccessfullyDryRun).JSON()
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, result.DetailGTFSImport(), success.GTFSImportSuccessfullyImport).JSON()
+}
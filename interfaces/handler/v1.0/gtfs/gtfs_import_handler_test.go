@@ -0,0 +1,239 @@
+package gtfsv1point00
+
+import (
+	"archive/zip"
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// partnerFeed return files of feed with trip from Tver to Moscow via Klin running on weekdays of March 2021.
+func partnerFeed() map[string]string {
+	return map[string]string{
+		"agency.txt": "agency_id,agency_name,agency_url,agency_timezone\n" +
+			"a,Партнер,https://partner.example,Europe/Moscow\n",
+		"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\n" +
+			"tver,Тверь,56.8587,35.9176\n" +
+			"klin,Клин,56.3318,36.7286\n" +
+			"msk,Москва,55.7558,37.6173\n",
+		"routes.txt": "route_id,agency_id,route_short_name,route_type\nr1,a,101,3\n",
+		"trips.txt":  "route_id,service_id,trip_id\nr1,weekdays,t1\n",
+		"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\n" +
+			"t1,07:00:00,07:00:00,tver,1\n" +
+			"t1,08:30:00,08:35:00,klin,2\n" +
+			"t1,10:30:00,10:30:00,msk,3\n",
+		"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"weekdays,1,1,1,1,1,0,0,20210301,20210331\n",
+	}
+}
+
+// gtfsImportForm return multipart body of GTFS import request with archive of files.
+func gtfsImportForm(t *testing.T, files map[string]string, fields map[string]string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for name, value := range fields {
+		_ = writer.WriteField(name, value)
+	}
+	if files != nil {
+		var archive bytes.Buffer
+		zipWriter := zip.NewWriter(&archive)
+		for name, content := range files {
+			file, err := zipWriter.Create(name)
+			if err != nil {
+				t.Errorf("this is the error: %v\n", err)
+			}
+			_, _ = file.Write([]byte(content))
+		}
+		_ = zipWriter.Close()
+		part, err := writer.CreateFormFile("file", "partner.zip")
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		_, _ = part.Write(archive.Bytes())
+	}
+	_ = writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+// TestSaveGTFSImport_Success Test.
+func TestSaveGTFSImport_Success(t *testing.T) {
+	samples := []struct {
+		dryRun string
+		code   int
+	}{
+		{"false", http.StatusCreated},
+		{"true", http.StatusOK},
+	}
+
+	for _, v := range samples {
+		var importData entity.DetailGTFSImport
+		var gtfsApp mock.GTFSAppInterface
+		gtfsHandler := NewGTFS(&gtfsApp, agency)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/gtfsImports", gtfsHandler.SaveGTFSImport)
+
+		sities := []*entity.Sity{
+			{UUID: uuid.New().String(), Name: "Тверь", Latitude: "56.858721", Longitude: "35.917597"},
+			{UUID: uuid.New().String(), Name: "Москва", Latitude: "55.755826", Longitude: "37.6173"},
+		}
+		gtfsApp.SaveGTFSImportFn = func(data *entity.GTFSImport) (*entity.GTFSImport, error) {
+			for _, stop := range data.Stops {
+				stop.MatchSity(sities, data.Radius)
+			}
+			data.RouteCreated = 1
+			data.TripCreated = len(data.Trips)
+			data.Committed = !data.DryRun
+			return data, nil
+		}
+
+		body, contentType := gtfsImportForm(t, partnerFeed(), map[string]string{
+			"from":    "2021-03-01",
+			"to":      "2021-03-07",
+			"radius":  "5",
+			"dry_run": v.dryRun,
+		})
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/gtfsImports", body)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		response := encoder.ResponseDecoder(w.Body)
+		data, _ := json.Marshal(response["data"])
+
+		_ = json.Unmarshal(data, &importData)
+
+		assert.Equal(t, w.Code, v.code)
+		assert.EqualValues(t, "2021-03-01", importData.From)
+		assert.EqualValues(t, "2021-03-07", importData.To)
+		assert.EqualValues(t, 2, importData.StopCount)
+		assert.EqualValues(t, 2, importData.MatchedStops)
+		assert.EqualValues(t, 5, importData.TripCreated)
+		assert.EqualValues(t, v.dryRun == "false", importData.Committed)
+		assert.Empty(t, importData.UnmatchedReport)
+	}
+}
+
+// TestSaveGTFSImport_Unmatched Test.
+func TestSaveGTFSImport_Unmatched(t *testing.T) {
+	var importData entity.DetailGTFSImport
+	var gtfsApp mock.GTFSAppInterface
+	gtfsHandler := NewGTFS(&gtfsApp, agency)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/gtfsImports", gtfsHandler.SaveGTFSImport)
+
+	sities := []*entity.Sity{
+		{UUID: uuid.New().String(), Name: "Москва", Latitude: "55.755826", Longitude: "37.6173"},
+	}
+	gtfsApp.SaveGTFSImportFn = func(data *entity.GTFSImport) (*entity.GTFSImport, error) {
+		for _, stop := range data.Stops {
+			stop.MatchSity(sities, data.Radius)
+		}
+		data.TripUnmatched = len(data.Trips)
+		data.Committed = true
+		return data, nil
+	}
+
+	body, contentType := gtfsImportForm(t, partnerFeed(), map[string]string{"from": "2021-03-01", "to": "2021-03-07"})
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/gtfsImports", body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &importData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, 1, importData.UnmatchedStops)
+	assert.EqualValues(t, 5, importData.TripUnmatched)
+	if assert.Len(t, importData.UnmatchedReport, 1) {
+		assert.EqualValues(t, "tver", importData.UnmatchedReport[0].StopID)
+		assert.EqualValues(t, entity.GTFSImportMatchNone, importData.UnmatchedReport[0].Match)
+		assert.EqualValues(t, "Москва", importData.UnmatchedReport[0].SityName)
+		assert.EqualValues(t, 5, importData.UnmatchedReport[0].TripCount)
+	}
+}
+
+// TestSaveGTFSImport_InvalidData Test.
+func TestSaveGTFSImport_InvalidData(t *testing.T) {
+	withoutStopTimes := partnerFeed()
+	delete(withoutStopTimes, "stop_times.txt")
+	samples := []struct {
+		files  map[string]string
+		fields map[string]string
+		err    error
+		data   string
+	}{
+		{partnerFeed(), map[string]string{"from": "01.03.2021"}, exception.ErrorTextUnprocessableEntity, "from"},
+		{partnerFeed(), map[string]string{"radius": "near"}, exception.ErrorTextUnprocessableEntity, "radius"},
+		{nil, map[string]string{}, exception.ErrorTextGTFSImportFileRequired, ""},
+		{withoutStopTimes, map[string]string{}, exception.ErrorTextGTFSImportInvalidFeed, "stop_times.txt"},
+		{
+			partnerFeed(),
+			map[string]string{"from": "2021-03-07", "to": "2021-03-01"},
+			exception.ErrorTextGTFSImportInvalidHorizon,
+			"",
+		},
+	}
+
+	for _, v := range samples {
+		var gtfsApp mock.GTFSAppInterface
+		gtfsHandler := NewGTFS(&gtfsApp, agency)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		var abortErr error
+		var abortData interface{}
+		r.Use(func(c *gin.Context) {
+			c.Next()
+			if last := c.Errors.Last(); last != nil {
+				abortErr = last.Err
+			}
+			abortData, _ = c.Get("data")
+		})
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/gtfsImports", gtfsHandler.SaveGTFSImport)
+
+		body, contentType := gtfsImportForm(t, v.files, v.fields)
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/gtfsImports", body)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+		assert.ErrorIs(t, abortErr, v.err)
+		if v.data != "" {
+			assert.Contains(t, abortData, v.data)
+		}
+	}
+}
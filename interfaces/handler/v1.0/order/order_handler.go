@@ -32,6 +32,7 @@ func NewOrders(us application.OrderAppInterface) *Orders {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param Idempotency-Key header string false "Key of request, repeated request returns stored response"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param order body entity.DetailOrder true "Order order"
@@ -40,6 +41,8 @@ func NewOrders(us application.OrderAppInterface) *Orders {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 409 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/order [post]
 // SaveOrder is a function order to handle create a new order.
@@ -79,6 +82,7 @@ func (s *Orders) SaveOrder(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param order body entity.DetailOrder true "Order order"
@@ -87,6 +91,7 @@ func (s *Orders) SaveOrder(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/order/uuid [put]
 // UpdateOrder is a function uses to handle update order by UUID.
@@ -138,6 +143,7 @@ func (s *Orders) UpdateOrder(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Order UUID"
@@ -146,6 +152,7 @@ func (s *Orders) UpdateOrder(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/order/{uuid} [delete]
 // DeleteOrder is a function uses to handle delete order by UUID.
@@ -218,6 +225,7 @@ func (s *Orders) GetOrders(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Order UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -244,5 +252,6 @@ func (s *Orders) GetOrder(c *gin.Context) {
 	}
 
 	response.NewSuccess(c, order.DetailOrder(), success.OrderSuccessfullyGetOrderDetail).
+		WithETag(order.UpdatedAt).
 		JSON()
 }
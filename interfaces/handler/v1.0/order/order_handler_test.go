@@ -137,6 +137,48 @@ func TestSaveOrder_InvalidData(t *testing.T) {
 	}
 }
 
+// TestSaveOrder_PassengerTypeMismatch Test.
+func TestSaveOrder_PassengerTypeMismatch(t *testing.T) {
+	var orderApp mock.OrderAppInterface
+	orderHandler := NewOrders(&orderApp)
+	passengerUUID := uuid.New().String()
+	field := "passengers[0].passenger_type_uuid"
+
+	orderJSON := `{
+    "trip_uuid":"` + uuid.New().String() + `",
+    "seat":"2s",
+    "passengers":[{"uuid":"` + passengerUUID + `","passenger_type_uuid":"` + uuid.New().String() + `"}]
+	}`
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/order", orderHandler.SaveOrder)
+
+	orderApp.SaveOrderFn = func(order *entity.Order) (*entity.Order, map[string]string, error) {
+		return nil, map[string]string{
+			field: exception.ErrorTextPassengerTypeAgeMismatch.Error(),
+		}, exception.ErrorTextUnprocessableEntity
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/order", bytes.NewBufferString(orderJSON))
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	validationErr := make(map[string]string)
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	err = json.Unmarshal(data, &validationErr)
+	if err != nil {
+		t.Errorf("error unmarshalling error %s\n", err)
+	}
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
 // TestUpdateOrder_Success Test.
 func TestUpdateOrder_Success(t *testing.T) {
 	var orderData entity.Order
@@ -78,6 +78,7 @@ func (s *Passengers) SavePassenger(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Passenger UUID"
@@ -90,6 +91,7 @@ func (s *Passengers) SavePassenger(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/passenger/uuid [put]
 // UpdatePassenger is a function uses to handle update passenger by UUID.
@@ -140,6 +142,7 @@ func (s *Passengers) UpdatePassenger(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Passenger UUID"
@@ -148,6 +151,7 @@ func (s *Passengers) UpdatePassenger(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/passenger/{uuid} [delete]
 // DeletePassenger is a function uses to handle delete passenger by UUID.
@@ -220,6 +224,7 @@ func (s *Passengers) GetPassengers(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Passenger UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -245,5 +250,7 @@ func (s *Passengers) GetPassenger(c *gin.Context) {
 		return
 	}
 
-	response.NewSuccess(c, passenger.DetailPassenger(), success.PassengerSuccessfullyGetPassengerDetail).JSON()
+	response.NewSuccess(c, passenger.DetailPassenger(), success.PassengerSuccessfullyGetPassengerDetail).
+		WithETag(passenger.UpdatedAt).
+		JSON()
 }
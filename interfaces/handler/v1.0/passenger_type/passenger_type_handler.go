@@ -78,6 +78,7 @@ func (s *PassengerTypes) SavePassengerType(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "PassengerType UUID"
@@ -90,6 +91,7 @@ func (s *PassengerTypes) SavePassengerType(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/passengerTypes/uuid [put]
 // UpdatePassengerType is a function uses to handle update passengerType by UUID.
@@ -141,6 +143,7 @@ func (s *PassengerTypes) UpdatePassengerType(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "PassengerType UUID"
@@ -149,6 +152,7 @@ func (s *PassengerTypes) UpdatePassengerType(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/passengerTypes/{uuid} [delete]
 // DeletePassengerType is a function uses to handle delete passengerType by UUID.
@@ -221,6 +225,7 @@ func (s *PassengerTypes) GetPassengerTypes(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "PassengerType UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -246,5 +251,7 @@ func (s *PassengerTypes) GetPassengerType(c *gin.Context) {
 		return
 	}
 
-	response.NewSuccess(c, passengerType.DetailPassengerType(), success.PassengerTypeSuccessfullyGetPassengerTypeDetail).JSON()
+	response.NewSuccess(c, passengerType.DetailPassengerType(), success.PassengerTypeSuccessfullyGetPassengerTypeDetail).
+		WithETag(passengerType.UpdatedAt).
+		JSON()
 }
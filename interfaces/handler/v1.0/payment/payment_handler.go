@@ -32,6 +32,7 @@ func NewPayments(us application.PaymentAppInterface) *Payments {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param Idempotency-Key header string false "Key of request, repeated request returns stored response"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param payment body entity.DetailPayment true "Payment payment"
@@ -40,6 +41,8 @@ func NewPayments(us application.PaymentAppInterface) *Payments {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 409 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/payment [post]
 // SavePayment is a function payment to handle create a new payment.
@@ -79,6 +82,7 @@ func (s *Payments) SavePayment(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param payment body entity.DetailPayment true "Payment payment"
@@ -87,6 +91,7 @@ func (s *Payments) SavePayment(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/payment/uuid [put]
 // UpdatePayment is a function uses to handle update payment by UUID.
@@ -138,6 +143,7 @@ func (s *Payments) UpdatePayment(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Payment UUID"
@@ -146,6 +152,7 @@ func (s *Payments) UpdatePayment(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/payment/{uuid} [delete]
 // DeletePayment is a function uses to handle delete payment by UUID.
@@ -218,6 +225,7 @@ func (s *Payments) GetPayments(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Payment UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -244,6 +252,7 @@ func (s *Payments) GetPayment(c *gin.Context) {
 	}
 
 	response.NewSuccess(c, payment.DetailPayment(), success.PaymentSuccessfullyGetPaymentDetail).
+		WithETag(payment.UpdatedAt).
 		JSON()
 }
 
@@ -265,6 +265,56 @@ func TestGetPayment_Success(t *testing.T) {
 	assert.EqualValues(t, paymentData.ExternalUUID, externalUUID)
 }
 
+// TestGetPayment_Receipt Test.
+func TestGetPayment_Receipt(t *testing.T) {
+	var paymentData entity.DetailPayment
+	var receiptData entity.DetailPaymentReceipt
+	var paymentApp mock.PaymentAppInterface
+	paymentHandler := NewPayments(&paymentApp)
+	UUID := uuid.New().String()
+	fiscalizedAt := time.Date(2021, 3, 1, 10, 5, 0, 0, time.UTC)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/payment/:uuid", paymentHandler.GetPayment)
+
+	paymentApp.GetPaymentFn = func(string) (*entity.Payment, error) {
+		return &entity.Payment{
+			UUID:                 UUID,
+			Type:                 entity.PaymentTypeRefund,
+			Amount:               750,
+			FiscalStatus:         entity.PaymentFiscalStatusDone,
+			FiscalUUID:           "atol-uuid",
+			FiscalDocumentNumber: "1843",
+			FiscalSign:           "3326126016",
+			FiscalAttempts:       2,
+			FiscalizedAt:         &fiscalizedAt,
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/payment/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+	_ = json.Unmarshal(data, &paymentData)
+	receipt, _ := json.Marshal(paymentData.Receipt)
+	_ = json.Unmarshal(receipt, &receiptData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, entity.PaymentFiscalStatusDone, receiptData.Status)
+	assert.EqualValues(t, "1843", receiptData.DocumentNumber)
+	assert.EqualValues(t, "3326126016", receiptData.Sign)
+	assert.EqualValues(t, 2, receiptData.Attempts)
+	assert.True(t, fiscalizedAt.Equal(*receiptData.FiscalizedAt))
+}
+
 // TestGetPayments_Success Test.
 func TestGetPayments_Success(t *testing.T) {
 	var paymentApp mock.PaymentAppInterface
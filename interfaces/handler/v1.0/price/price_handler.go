@@ -78,6 +78,7 @@ func (s *Prices) SavePrice(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Price UUID"
@@ -90,6 +91,7 @@ func (s *Prices) SavePrice(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/price/uuid [put]
 // UpdatePrice is a function uses to handle update price by UUID.
@@ -140,6 +142,7 @@ func (s *Prices) UpdatePrice(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Price UUID"
@@ -148,6 +151,7 @@ func (s *Prices) UpdatePrice(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/price/{uuid} [delete]
 // DeletePrice is a function uses to handle delete price by UUID.
@@ -218,6 +222,7 @@ func (s *Prices) GetPrices(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Price UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -243,5 +248,7 @@ func (s *Prices) GetPrice(c *gin.Context) {
 		return
 	}
 
-	response.NewSuccess(c, price.DetailPrice(), success.PriceSuccessfullyGetPriceDetail).JSON()
+	response.NewSuccess(c, price.DetailPrice(), success.PriceSuccessfullyGetPriceDetail).
+		WithETag(price.UpdatedAt).
+		JSON()
 }
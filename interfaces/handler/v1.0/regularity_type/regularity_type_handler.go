@@ -79,6 +79,7 @@ func (s *RegularityTypes) SaveRegularityType(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "RegularityType UUID"
@@ -91,6 +92,7 @@ func (s *RegularityTypes) SaveRegularityType(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/regularityType/uuid [put]
 // UpdateRegularityType is a function uses to handle update regularityType by UUID.
@@ -142,6 +144,7 @@ func (s *RegularityTypes) UpdateRegularityType(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "RegularityType UUID"
@@ -150,6 +153,7 @@ func (s *RegularityTypes) UpdateRegularityType(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/regularityType/{uuid} [delete]
 // DeleteRegularityType is a function uses to handle delete regularityType by UUID.
@@ -222,6 +226,7 @@ func (s *RegularityTypes) GetRegularityTypes(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "RegularityType UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -248,5 +253,6 @@ func (s *RegularityTypes) GetRegularityType(c *gin.Context) {
 	}
 
 	response.NewSuccess(c, regularityType.DetailRegularityType(), success.RegularityTypeSuccessfullyGetRegularityTypeDetail).
+		WithETag(regularityType.UpdatedAt).
 		JSON()
 }
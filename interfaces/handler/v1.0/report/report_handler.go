@@ -0,0 +1,228 @@
+package reportv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/pkg/response"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Reports is a struct defines the dependencies that will be used.
+type Reports struct {
+	us application.ReportAppInterface
+}
+
+// NewReports is constructor will initialize report handler.
+func NewReports(us application.ReportAppInterface) *Reports {
+	return &Reports{
+		us: us,
+	}
+}
+
+// @Summary Get revenue report
+// @Description Get order payments, refunds and net revenue by route, day, week or month.
+// @Tags reports
+// @Produce json,text/csv
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param date_from query string false "Paid from date (yyyy-mm-dd)"
+// @Param date_to query string false "Paid to date inclusive (yyyy-mm-dd)"
+// @Param route_uuid query string false "Route UUID"
+// @Param group_by query string false "Grouping" Enums(route, day, week, month) default(route)
+// @Param format query string false "Report format" Enums(json, csv) default(json)
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/reports/revenue [get]
+// GetRevenueReport is a function uses to handle get revenue report.
+func (s *Reports) GetRevenueReport(c *gin.Context) {
+	request, ok := s.request(
+		c,
+		entity.ReportGroupRoute,
+		entity.ReportGroupDay,
+		entity.ReportGroupWeek,
+		entity.ReportGroupMonth,
+	)
+	if !ok {
+		return
+	}
+
+	revenues, err := s.us.GetRevenueReport(request)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if request.IsCSV() {
+		s.csv(c, revenues.CSV(request.GroupBy), "revenue")
+		return
+	}
+	response.NewSuccess(c, revenues.DetailReport(), success.ReportSuccessfullyGetRevenueReport).JSON()
+}
+
+// @Summary Get load factor report
+// @Description Get seats sold to passengers against seats of vehicle for every trip or route.
+// @Tags reports
+// @Produce json,text/csv
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param date_from query string false "Departure from date (yyyy-mm-dd)"
+// @Param date_to query string false "Departure to date inclusive (yyyy-mm-dd)"
+// @Param route_uuid query string false "Route UUID"
+// @Param group_by query string false "Grouping" Enums(trip, route) default(trip)
+// @Param format query string false "Report format" Enums(json, csv) default(json)
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/reports/loadFactor [get]
+// GetLoadFactorReport is a function uses to handle get load factor report.
+func (s *Reports) GetLoadFactorReport(c *gin.Context) {
+	request, ok := s.request(c, entity.ReportGroupTrip, entity.ReportGroupRoute)
+	if !ok {
+		return
+	}
+
+	loadFactors, err := s.us.GetLoadFactorReport(request)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if request.IsCSV() {
+		s.csv(c, loadFactors.CSV(request.GroupBy), "load-factor")
+		return
+	}
+	response.NewSuccess(c, loadFactors.DetailReport(), success.ReportSuccessfullyGetLoadFactorReport).JSON()
+}
+
+// @Summary Get average fare report
+// @Description Get number of tickets and average fare by passenger type, fare is share of ticket in order payments
+// @Description by route price of passenger type less refunds.
+// @Tags reports
+// @Produce json,text/csv
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param date_from query string false "Departure from date (yyyy-mm-dd)"
+// @Param date_to query string false "Departure to date inclusive (yyyy-mm-dd)"
+// @Param route_uuid query string false "Route UUID"
+// @Param format query string false "Report format" Enums(json, csv) default(json)
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/reports/averageFare [get]
+// GetAverageFareReport is a function uses to handle get average fare report.
+func (s *Reports) GetAverageFareReport(c *gin.Context) {
+	request, ok := s.request(c)
+	if !ok {
+		return
+	}
+
+	fares, err := s.us.GetAverageFareReport(request)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if request.IsCSV() {
+		s.csv(c, fares.CSV(), "average-fare")
+		return
+	}
+	response.NewSuccess(c, fares.DetailReport(), success.ReportSuccessfullyGetAverageFareReport).JSON()
+}
+
+// @Summary Get cancellation report
+// @Description Get canceled orders, cancellation rate and refunds by route, day, week or month.
+// @Tags reports
+// @Produce json,text/csv
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param date_from query string false "Ordered or refunded from date (yyyy-mm-dd)"
+// @Param date_to query string false "Ordered or refunded to date inclusive (yyyy-mm-dd)"
+// @Param route_uuid query string false "Route UUID"
+// @Param group_by query string false "Grouping" Enums(route, day, week, month) default(route)
+// @Param format query string false "Report format" Enums(json, csv) default(json)
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/reports/cancellations [get]
+// GetCancellationReport is a function uses to handle get cancellation report.
+func (s *Reports) GetCancellationReport(c *gin.Context) {
+	request, ok := s.request(
+		c,
+		entity.ReportGroupRoute,
+		entity.ReportGroupDay,
+		entity.ReportGroupWeek,
+		entity.ReportGroupMonth,
+	)
+	if !ok {
+		return
+	}
+
+	cancellations, err := s.us.GetCancellationReport(request)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if request.IsCSV() {
+		s.csv(c, cancellations.CSV(request.GroupBy), "cancellations")
+		return
+	}
+	response.NewSuccess(c, cancellations.DetailReport(), success.ReportSuccessfullyGetCancellationReport).JSON()
+}
+
+// request will bind, prepare and validate report request, first of groups is used by default.
+func (s *Reports) request(c *gin.Context, groups ...string) (*entity.ReportRequest, bool) {
+	var request entity.ReportRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return nil, false
+	}
+	var groupBy string
+	if len(groups) > 0 {
+		groupBy = groups[0]
+	}
+	request.Prepare(groupBy)
+
+	validateErr := request.ValidateReportRequest(groups...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return nil, false
+	}
+	return &request, true
+}
+
+// csv will send rows of report as csv attachment.
+func (s *Reports) csv(c *gin.Context, rows [][]string, fileName string) {
+	content, err := encoder.CSV(rows)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "report-"+fileName+".csv"))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", content)
+}
@@ -0,0 +1,334 @@
+package reportv1point00
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	reportRouteUUID      = uuid.New().String()
+	reportOtherRouteUUID = uuid.New().String()
+)
+
+// reportPayments return order payments and refunds of two routes within two days.
+func reportPayments() []*entity.ReportPayment {
+	return []*entity.ReportPayment{
+		{
+			PaymentDate: time.Date(2026, time.October, 5, 9, 0, 0, 0, time.Local),
+			Type:        entity.PaymentTypeOrder,
+			Amount:      1500.50,
+			RouteUUID:   reportRouteUUID,
+			FromName:    "Москва",
+			ToName:      "Тверь",
+		},
+		{
+			PaymentDate: time.Date(2026, time.October, 5, 12, 0, 0, 0, time.Local),
+			Type:        entity.PaymentTypeOrder,
+			Amount:      700,
+			RouteUUID:   reportOtherRouteUUID,
+			FromName:    "Казань",
+			ToName:      "Уфа",
+		},
+		{
+			PaymentDate: time.Date(2026, time.October, 6, 10, 0, 0, 0, time.Local),
+			Type:        entity.PaymentTypeRefund,
+			Amount:      500.25,
+			RouteUUID:   reportRouteUUID,
+			FromName:    "Москва",
+			ToName:      "Тверь",
+		},
+	}
+}
+
+// TestGetRevenueReport_Success Test.
+func TestGetRevenueReport_Success(t *testing.T) {
+	var revenuesData []entity.ReportRevenue
+	var reportApp mock.ReportAppInterface
+	reportHandler := NewReports(&reportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/reports/revenue", reportHandler.GetRevenueReport)
+
+	reportApp.GetRevenueReportFn = func(request *entity.ReportRequest) (entity.ReportRevenues, error) {
+		assert.Equal(t, entity.ReportGroupRoute, request.GroupBy)
+		assert.Equal(t, reportRouteUUID, request.RouteUUID)
+		return entity.NewReportRevenues(reportPayments(), request.GroupBy), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodGet,
+		"/api/v1/external/reports/revenue?date_from=2026-10-01&date_to=2026-10-31&route_uuid="+reportRouteUUID,
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &revenuesData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, revenuesData, 2)
+	assert.Equal(t, "Казань - Уфа", revenuesData[0].RouteName)
+	assert.Equal(t, "Москва - Тверь", revenuesData[1].RouteName)
+	assert.EqualValues(t, 1, revenuesData[1].PaymentCount)
+	assert.EqualValues(t, 1500.50, revenuesData[1].Revenue)
+	assert.EqualValues(t, 500.25, revenuesData[1].RefundAmount)
+	assert.EqualValues(t, 1000.25, revenuesData[1].NetRevenue)
+}
+
+// TestGetRevenueReport_CSV Test.
+func TestGetRevenueReport_CSV(t *testing.T) {
+	var reportApp mock.ReportAppInterface
+	reportHandler := NewReports(&reportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/reports/revenue", reportHandler.GetRevenueReport)
+
+	reportApp.GetRevenueReportFn = func(request *entity.ReportRequest) (entity.ReportRevenues, error) {
+		return entity.NewReportRevenues(reportPayments(), request.GroupBy), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/reports/revenue?group_by=day&format=CSV", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	rows, errCSV := csv.NewReader(strings.NewReader(strings.TrimPrefix(w.Body.String(), "\xEF\xBB\xBF"))).ReadAll()
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "report-revenue.csv")
+	assert.NoError(t, errCSV)
+	assert.Equal(t, [][]string{
+		{"period", "payment_count", "revenue", "refund_count", "refund_amount", "net_revenue"},
+		{"2026-10-05", "2", "2200.50", "0", "0.00", "2200.50"},
+		{"2026-10-06", "0", "0.00", "1", "500.25", "-500.25"},
+	}, rows)
+}
+
+// TestGetRevenueReport_InvalidData Test.
+func TestGetRevenueReport_InvalidData(t *testing.T) {
+	samples := []string{
+		"date_from=01.10.2026",
+		"date_to=2026-13-01",
+		"route_uuid=route",
+		"group_by=trip",
+		"format=xlsx",
+	}
+
+	for _, v := range samples {
+		var reportApp mock.ReportAppInterface
+		reportHandler := NewReports(&reportApp)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.GET("/reports/revenue", reportHandler.GetRevenueReport)
+
+		var err error
+		c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/reports/revenue?"+v, nil)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity, v)
+	}
+}
+
+// TestGetLoadFactorReport_Success Test.
+func TestGetLoadFactorReport_Success(t *testing.T) {
+	var loadFactorsData []entity.ReportLoadFactor
+	var reportApp mock.ReportAppInterface
+	reportHandler := NewReports(&reportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/reports/loadFactor", reportHandler.GetLoadFactorReport)
+
+	reportApp.GetLoadFactorReportFn = func(request *entity.ReportRequest) (entity.ReportLoadFactors, error) {
+		assert.Equal(t, entity.ReportGroupRoute, request.GroupBy)
+		trips := []*entity.ReportTripSeats{
+			{
+				TripUUID:      uuid.New().String(),
+				DepartureTime: time.Date(2026, time.October, 5, 9, 0, 0, 0, time.Local),
+				Seats:         13,
+				SoldSeats:     10,
+				RouteUUID:     reportRouteUUID,
+				FromName:      "Москва",
+				ToName:        "Тверь",
+			},
+			{
+				TripUUID:      uuid.New().String(),
+				DepartureTime: time.Date(2026, time.October, 6, 9, 0, 0, 0, time.Local),
+				Seats:         7,
+				SoldSeats:     5,
+				RouteUUID:     reportRouteUUID,
+				FromName:      "Москва",
+				ToName:        "Тверь",
+			},
+		}
+		return entity.NewReportLoadFactors(trips, request.GroupBy), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/reports/loadFactor?group_by=route", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &loadFactorsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, loadFactorsData, 1)
+	assert.EqualValues(t, 2, loadFactorsData[0].TripCount)
+	assert.EqualValues(t, 20, loadFactorsData[0].Seats)
+	assert.EqualValues(t, 15, loadFactorsData[0].SoldSeats)
+	assert.EqualValues(t, 0.75, loadFactorsData[0].LoadFactor)
+	assert.Nil(t, loadFactorsData[0].DepartureTime)
+}
+
+// TestGetAverageFareReport_Success Test.
+func TestGetAverageFareReport_Success(t *testing.T) {
+	var faresData []entity.ReportAverageFare
+	var reportApp mock.ReportAppInterface
+	reportHandler := NewReports(&reportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/reports/averageFare", reportHandler.GetAverageFareReport)
+
+	reportApp.GetAverageFareReportFn = func(request *entity.ReportRequest) (entity.ReportAverageFares, error) {
+		fare := &entity.ReportAverageFare{
+			PassengerTypeUUID: uuid.New().String(),
+			PassengerType:     "Взрослый",
+			TicketCount:       3,
+			TotalFare:         1250,
+			AverageFare:       416.666666,
+		}
+		fare.Calculate()
+		return entity.ReportAverageFares{fare}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/reports/averageFare", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &faresData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, faresData, 1)
+	assert.EqualValues(t, 3, faresData[0].TicketCount)
+	assert.EqualValues(t, 416.67, faresData[0].AverageFare)
+}
+
+// TestGetAverageFareReport_InvalidData Test.
+func TestGetAverageFareReport_InvalidData(t *testing.T) {
+	var reportApp mock.ReportAppInterface
+	reportHandler := NewReports(&reportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/reports/averageFare", reportHandler.GetAverageFareReport)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/reports/averageFare?format=pdf", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestGetCancellationReport_Success Test.
+func TestGetCancellationReport_Success(t *testing.T) {
+	var cancellationsData []entity.ReportCancellation
+	var reportApp mock.ReportAppInterface
+	reportHandler := NewReports(&reportApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/reports/cancellations", reportHandler.GetCancellationReport)
+
+	reportApp.GetCancellationReportFn = func(request *entity.ReportRequest) (entity.ReportCancellations, error) {
+		orders := []*entity.ReportOrder{
+			{
+				OrderDate: time.Date(2026, time.October, 5, 9, 0, 0, 0, time.Local),
+				Canceled:  true,
+				Seats:     2,
+			},
+			{OrderDate: time.Date(2026, time.October, 13, 9, 0, 0, 0, time.Local), Seats: 1},
+			{OrderDate: time.Date(2026, time.October, 11, 9, 0, 0, 0, time.Local), Seats: 1},
+			{OrderDate: time.Date(2026, time.October, 7, 9, 0, 0, 0, time.Local), Seats: 1},
+		}
+		return entity.NewReportCancellations(orders, reportPayments()[2:], request.GroupBy), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/reports/cancellations?group_by=week", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &cancellationsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, cancellationsData, 2)
+	assert.Equal(t, "2026-10-05", cancellationsData[0].Period)
+	assert.EqualValues(t, 3, cancellationsData[0].OrderCount)
+	assert.EqualValues(t, 1, cancellationsData[0].CanceledCount)
+	assert.EqualValues(t, 2, cancellationsData[0].CanceledSeats)
+	assert.EqualValues(t, 0.3333, cancellationsData[0].CancellationRate)
+	assert.EqualValues(t, 1, cancellationsData[0].RefundCount)
+	assert.EqualValues(t, 500.25, cancellationsData[0].RefundAmount)
+	assert.Equal(t, "2026-10-12", cancellationsData[1].Period)
+}
@@ -163,5 +163,7 @@ func (s *Roles) GetRole(c *gin.Context) {
 		_ = c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	response.NewSuccess(c, role.DetailRole(), success.RoleSuccessfullyGetRoleDetail).JSON()
+	response.NewSuccess(c, role.DetailRole(), success.RoleSuccessfullyGetRoleDetail).
+		WithETag(role.UpdatedAt).
+		JSON()
 }
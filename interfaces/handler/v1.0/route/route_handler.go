@@ -79,6 +79,7 @@ func (s *Routes) SaveRoute(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param route body entity.DetailRoute true "Route route"
@@ -87,6 +88,7 @@ func (s *Routes) SaveRoute(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/route/uuid [put]
 // UpdateRoute is a function uses to handle update route by UUID.
@@ -138,6 +140,7 @@ func (s *Routes) UpdateRoute(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Route UUID"
@@ -146,6 +149,7 @@ func (s *Routes) UpdateRoute(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/route/{uuid} [delete]
 // DeleteRoute is a function uses to handle delete route by UUID.
@@ -218,6 +222,7 @@ func (s *Routes) GetRoutes(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Route UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -244,6 +249,7 @@ func (s *Routes) GetRoute(c *gin.Context) {
 	}
 
 	response.NewSuccess(c, route.DetailRoute(), success.RouteSuccessfullyGetRouteDetail).
+		WithETag(route.UpdatedAt).
 		JSON()
 }
 
@@ -0,0 +1,725 @@
+package shipmentv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/label"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/infrastructure/notify/notification"
+	"cargo-rest-api/infrastructure/storage"
+	"cargo-rest-api/pkg/response"
+	"cargo-rest-api/pkg/translation"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Shipments is a struct defines the dependencies that will be used.
+type Shipments struct {
+	us application.ShipmentAppInterface
+	ni application.NotifyAppInterface
+	ss application.StorageAppInterface
+}
+
+// NewShipments is constructor will initialize shipment handler.
+func NewShipments(
+	us application.ShipmentAppInterface,
+	ni application.NotifyAppInterface,
+	ss application.StorageAppInterface,
+) *Shipments {
+	return &Shipments{
+		us: us,
+		ni: ni,
+		ss: ss,
+	}
+}
+
+// @Summary Create a new shipment
+// @Description Create a new shipment.
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param shipment body entity.DetailShipment true "Shipment data"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment [post]
+// SaveShipment is a function shipment to handle create a new shipment.
+func (s *Shipments) SaveShipment(c *gin.Context) {
+	var shipmentEntity entity.Shipment
+	if err := c.ShouldBindJSON(&shipmentEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := shipmentEntity.ValidateSaveShipment()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	newShipment, errDesc, errException := s.us.SaveShipment(&shipmentEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	if len(newShipment.Events) > 0 {
+		s.notifyShipmentEvent(c, newShipment.UUID, newShipment.Events[len(newShipment.Events)-1].UUID)
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, newShipment.DetailShipment(), success.ShipmentSuccessfullyCreateShipment).
+		JSON()
+}
+
+// @Summary Update shipment
+// @Description Update an existing shipment.
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param shipment body entity.DetailShipment true "Shipment data"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid} [put]
+// UpdateShipment is a function uses to handle update shipment by UUID.
+func (s *Shipments) UpdateShipment(c *gin.Context) {
+	var shipmentEntity entity.Shipment
+	if err := c.ShouldBindUri(&shipmentEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	if err := c.ShouldBindJSON(&shipmentEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	_, err := s.us.GetShipment(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	updatedShipment, errDesc, errException := s.us.UpdateShipment(UUID, &shipmentEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusOK)
+	response.NewSuccess(c, updatedShipment.DetailShipment(), success.ShipmentSuccessfullyUpdateShipment).
+		JSON()
+}
+
+// @Summary Delete shipment
+// @Description Delete an existing shipment.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid} [delete]
+// DeleteShipment is a function uses to handle delete shipment by UUID.
+func (s *Shipments) DeleteShipment(c *gin.Context) {
+	var shipmentEntity entity.Shipment
+	if err := c.ShouldBindUri(&shipmentEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	err := s.us.DeleteShipment(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, nil, success.ShipmentSuccessfullyDeleteShipment).JSON()
+}
+
+// @Summary Get shipments
+// @Description Get list of existing shipments.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipments [get]
+// GetShipments is a function uses to handle get shipment list.
+func (s *Shipments) GetShipments(c *gin.Context) {
+	var shipment entity.Shipment
+	var shipments entity.Shipments
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(shipment.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	shipments, meta, err := s.us.GetShipments(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, shipments.DetailShipments(), success.ShipmentSuccessfullyGetShipmentList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Get shipment
+// @Description Get detail of existing shipment.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid} [get]
+// GetShipment is a function uses to handle get shipment detail by UUID.
+func (s *Shipments) GetShipment(c *gin.Context) {
+	var shipmentEntity entity.Shipment
+	if err := c.ShouldBindUri(&shipmentEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	shipment, err := s.us.GetShipment(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.NewSuccess(c, shipment.DetailShipment(), success.ShipmentSuccessfullyGetShipmentDetail).
+		WithETag(shipment.UpdatedAt).
+		JSON()
+}
+
+// @Summary Add shipment event
+// @Description Add a new event to shipment timeline and update shipment status.
+// @Tags shipments
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Param event body entity.DetailShipmentEvent true "Shipment event data"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid}/event [post]
+// SaveShipmentEvent is a function uses to handle add a new event to shipment timeline.
+func (s *Shipments) SaveShipmentEvent(c *gin.Context) {
+	var eventEntity entity.ShipmentEvent
+	if err := c.ShouldBindJSON(&eventEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := eventEntity.ValidateSaveShipmentEvent()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	eventEntity.Prepare()
+
+	UUID := c.Param("uuid")
+	newEvent, errDesc, errException := s.us.SaveShipmentEvent(UUID, &eventEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	s.notifyShipmentEvent(c, UUID, newEvent.UUID)
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, newEvent.DetailShipmentEvent(), success.ShipmentSuccessfullyCreateShipmentEvent).
+		JSON()
+}
+
+// @Summary Get shipment events
+// @Description Get timeline of existing shipment.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid}/events [get]
+// GetShipmentEvents is a function uses to handle get shipment timeline by UUID.
+func (s *Shipments) GetShipmentEvents(c *gin.Context) {
+	UUID := c.Param("uuid")
+	events, err := s.us.GetShipmentEvents(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.NewSuccess(c, entity.ShipmentEvents(events).DetailShipmentEvents(), success.ShipmentSuccessfullyGetShipmentEvents).
+		JSON()
+}
+
+// @Summary Track shipment
+// @Description Get public timeline of shipment by tracking number. Personal data of sender and recipient is hidden.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param tracking_number path string true "Shipment tracking number"
+// @Success 200 {object} response.successOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 429 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/track/{tracking_number} [get]
+// TrackShipment is a function uses to handle get public shipment timeline by tracking number.
+func (s *Shipments) TrackShipment(c *gin.Context) {
+	trackingNumber := c.Param("tracking_number")
+	shipment, err := s.us.GetShipmentByTrackingNumber(trackingNumber)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.NewSuccess(c, shipment.DetailShipmentTracking(), success.ShipmentSuccessfullyTrackShipment).
+		JSON()
+}
+
+// @Summary Issue delivery confirmation code
+// @Description Generate a new delivery confirmation code and send it to recipient, previous code becomes invalid.
+// @Description Code is sent by email and by sms when sms gateway is configured, 422 is returned when recipient can not be reached.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid}/deliveryCode [post]
+// IssueDeliveryCode is a function uses to handle send delivery confirmation code to recipient.
+func (s *Shipments) IssueDeliveryCode(c *gin.Context) {
+	UUID := c.Param("uuid")
+	shipment, errDesc, errException := s.us.IssueShipmentDeliveryCode(UUID)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	if err := notification.NewShipmentDeliveryCode(shipment, s.ni, translation.GetLanguage(c)).Send(); err != nil {
+		if errors.Is(err, notification.ErrDeliveryCodeNoChannel) {
+			c.Set("data", map[string]string{"code": exception.ErrorTextShipmentDeliveryCodeNotSent.Error()})
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextShipmentDeliveryCodeNotSent)
+		return
+	}
+	response.NewSuccess(c, nil, success.ShipmentSuccessfullyIssueDeliveryCode).JSON()
+}
+
+// @Summary Confirm shipment delivery
+// @Description Confirm hand over of shipment to recipient by confirmation code with photo and/or signature.
+// @Description Proof of delivery is stored and shipment is closed.
+// @Description Cash on delivery must be collected in full, it is recorded as payment of type cod.
+// @Description Code is invalidated after 5 wrong attempts, a new code must be issued then.
+// @Tags shipments
+// @Accept mpfd
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Param recipient_name formData string true "Name of person who received shipment"
+// @Param code formData string true "Delivery confirmation code"
+// @Param cod_amount formData number false "Collected cash on delivery"
+// @Param photo formData file false "Photo of handed over shipment"
+// @Param signature formData file false "Signature image of recipient"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid}/delivery [post]
+// ConfirmDelivery is a function uses to handle confirm shipment delivery.
+func (s *Shipments) ConfirmDelivery(c *gin.Context) {
+	var deliveryEntity entity.ShipmentDelivery
+	if err := c.ShouldBind(&deliveryEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	deliveryEntity.Prepare()
+
+	photo, _ := c.FormFile("photo")
+	signature, _ := c.FormFile("signature")
+	validateErr := deliveryEntity.ValidateSaveShipmentDelivery(photo != nil, signature != nil)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	// Code is checked before evidence is uploaded, so wrong attempts do not leave orphan files.
+	UUID := c.Param("uuid")
+	errDesc, errException := s.us.VerifyShipmentDelivery(UUID, &deliveryEntity)
+	if errException != nil {
+		s.abortDelivery(c, errDesc, errException)
+		return
+	}
+
+	var ok bool
+	if deliveryEntity.PhotoUUID, ok = s.uploadEvidence(c, photo); !ok {
+		return
+	}
+	if deliveryEntity.SignatureUUID, ok = s.uploadEvidence(c, signature); !ok {
+		return
+	}
+	if userUUID, exists := c.Get("UUID"); exists {
+		deliveryEntity.DeliveredBy, _ = userUUID.(string)
+	}
+
+	delivery, errDesc, errException := s.us.SaveShipmentDelivery(UUID, &deliveryEntity)
+	if errException != nil {
+		s.abortDelivery(c, errDesc, errException)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, s.detailDelivery(delivery), success.ShipmentSuccessfullyConfirmDelivery).JSON()
+}
+
+// @Summary Get proof of delivery
+// @Description Get proof of shipment delivery with links to download photo and signature.
+// @Tags shipments
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid}/delivery [get]
+// GetDelivery is a function uses to handle get proof of shipment delivery.
+func (s *Shipments) GetDelivery(c *gin.Context) {
+	UUID := c.Param("uuid")
+	delivery, err := s.us.GetShipmentDelivery(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentDeliveryNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentDeliveryNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, s.detailDelivery(delivery), success.ShipmentSuccessfullyGetDelivery).JSON()
+}
+
+// @Summary Print shipping label
+// @Description Print shipping label of shipment with tracking number as Code128 barcode and QR code.
+// @Tags shipments
+// @Produce application/pdf,application/zpl,json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Shipment UUID"
+// @Param format query string false "Label format" Enums(pdf, zpl) default(pdf)
+// @Success 200 {file} file
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/shipment/{uuid}/label [get]
+// GetShipmentLabel is a function uses to handle print shipping label of shipment.
+func (s *Shipments) GetShipmentLabel(c *gin.Context) {
+	printer, ok := s.labelPrinter(c)
+	if !ok {
+		return
+	}
+
+	UUID := c.Param("uuid")
+	shipment, err := s.us.GetShipment(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextShipmentNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	s.printLabels(c, printer, []*entity.Shipment{shipment}, "label-"+shipment.TrackingNumber)
+}
+
+// @Summary Print shipping labels of trip
+// @Description Print shipping labels of all shipments assigned to trip in one document.
+// @Tags shipments
+// @Produce application/pdf,application/zpl,json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Trip UUID"
+// @Param format query string false "Label format" Enums(pdf, zpl) default(pdf)
+// @Success 200 {file} file
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trip/{uuid}/labels [get]
+// GetTripShipmentLabels is a function uses to handle print shipping labels of all trip shipments.
+func (s *Shipments) GetTripShipmentLabels(c *gin.Context) {
+	printer, ok := s.labelPrinter(c)
+	if !ok {
+		return
+	}
+
+	UUID := c.Param("uuid")
+	shipments, err := s.us.GetTripShipments(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextTripNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextTripNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if len(shipments) == 0 {
+		_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextShipmentLabelNoShipments)
+		return
+	}
+
+	s.printLabels(c, printer, shipments, "labels-"+UUID)
+}
+
+// labelPrinter will validate requested label format and return its printer.
+func (s *Shipments) labelPrinter(c *gin.Context) (label.PrinterInterface, bool) {
+	var request entity.ShipmentLabelRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return nil, false
+	}
+	request.Prepare()
+
+	validateErr := request.ValidateShipmentLabelRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return nil, false
+	}
+
+	printer, err := label.NewPrinter(request.Format)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+	return printer, true
+}
+
+// printLabels will print labels of shipments and send them as attachment.
+func (s *Shipments) printLabels(
+	c *gin.Context,
+	printer label.PrinterInterface,
+	shipments []*entity.Shipment,
+	fileName string,
+) {
+	content, err := printer.Print(label.NewLabels(shipments))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName+"."+printer.Extension()))
+	c.Data(http.StatusOK, printer.ContentType(), content)
+}
+
+// uploadEvidence will upload photo or signature of delivery, missing file is skipped.
+func (s *Shipments) uploadEvidence(c *gin.Context, file *multipart.FileHeader) (string, bool) {
+	if file == nil {
+		return "", true
+	}
+	fileUUID, _, errException, errArgs := s.ss.UploadFile(file, storage.CategoryDelivery)
+	if errException != nil {
+		if errors.Is(errException, exception.ErrorTextStorageUploadInvalidSize) ||
+			errors.Is(errException, exception.ErrorTextStorageUploadInvalidFileType) {
+			c.Set("args", errArgs)
+		}
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+		return "", false
+	}
+	return fileUUID, true
+}
+
+// detailDelivery will return proof of delivery with signed URLs of evidence.
+func (s *Shipments) detailDelivery(delivery *entity.ShipmentDelivery) interface{} {
+	var photoURL, signatureURL interface{}
+	if delivery.PhotoUUID != "" {
+		photoURL, _ = s.ss.GetFile(delivery.PhotoUUID)
+	}
+	if delivery.SignatureUUID != "" {
+		signatureURL, _ = s.ss.GetFile(delivery.SignatureUUID)
+	}
+	return delivery.DetailShipmentDelivery(photoURL, signatureURL)
+}
+
+// abortDelivery will abort delivery confirmation request with status of given error.
+func (s *Shipments) abortDelivery(c *gin.Context, errDesc map[string]string, errException error) {
+	c.Set("data", errDesc)
+	if errors.Is(errException, exception.ErrorTextShipmentNotFound) {
+		_ = c.AbortWithError(http.StatusNotFound, errException)
+		return
+	}
+	if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+		return
+	}
+	_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+}
+
+// notifyShipmentEvent will notify recipient about shipment event when recipient subscribed to notifications.
+// Notification is optional, so its failure does not fail the request.
+func (s *Shipments) notifyShipmentEvent(c *gin.Context, shipmentUUID string, eventUUID string) {
+	if s.ni == nil {
+		return
+	}
+	shipment, err := s.us.GetShipment(shipmentUUID)
+	if err != nil || (!shipment.NotifyEmail && !shipment.NotifySMS) {
+		return
+	}
+	for _, event := range shipment.Events {
+		if event.UUID == eventUUID {
+			_ = notification.NewShipmentEvent(shipment, event, s.ni, translation.GetLanguage(c)).Send()
+			return
+		}
+	}
+}
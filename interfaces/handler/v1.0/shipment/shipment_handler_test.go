@@ -0,0 +1,905 @@
+package shipmentv1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/notify"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// shipmentJSON return request body of shipment.
+func shipmentJSON(fromUUID string, toUUID string, tripUUID string) string {
+	return `{
+		"sender_name": "Ivan Petrov",
+		"sender_phone": "+79001234567",
+		"recipient_name": "Petr Ivanov",
+		"recipient_phone": "+79007654321",
+		"from_uuid": "` + fromUUID + `",
+		"to_uuid": "` + toUUID + `",
+		"weight": 12.5,
+		"length": 40,
+		"width": 30,
+		"height": 20,
+		"declared_value": 5000,
+		"trip_uuid": "` + tripUUID + `"
+	}`
+}
+
+// TestSaveShipment_Success Test.
+func TestSaveShipment_Success(t *testing.T) {
+	var shipmentData entity.DetailShipment
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+	FromUUID := uuid.New().String()
+	ToUUID := uuid.New().String()
+	TripUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment", shipmentHandler.SaveShipment)
+
+	shipmentApp.SaveShipmentFn = func(shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+		shipment.UUID = UUID
+		shipment.TrackingNumber = "CRG0000000001"
+		return shipment, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/shipment",
+		bytes.NewBufferString(shipmentJSON(FromUUID, ToUUID, TripUUID)),
+	)
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &shipmentData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, shipmentData.UUID, UUID)
+	assert.EqualValues(t, shipmentData.TrackingNumber, "CRG0000000001")
+	assert.EqualValues(t, shipmentData.FromUUID, FromUUID)
+	assert.EqualValues(t, shipmentData.ToUUID, ToUUID)
+	assert.EqualValues(t, shipmentData.TripUUID, TripUUID)
+	assert.EqualValues(t, shipmentData.Weight, 12.5)
+	assert.InDelta(t, shipmentData.Volume, 0.024, 0.000001)
+}
+
+// TestSaveShipment_LuggageCapacityExceeded Test.
+func TestSaveShipment_LuggageCapacityExceeded(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment", shipmentHandler.SaveShipment)
+
+	shipmentApp.SaveShipmentFn = func(shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+		errDesc := map[string]string{
+			"trip_uuid": exception.ErrorTextShipmentLuggageCapacityExceeded.Error(),
+		}
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/shipment",
+		bytes.NewBufferString(shipmentJSON(uuid.New().String(), uuid.New().String(), uuid.New().String())),
+	)
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+func TestSaveShipment_InvalidData(t *testing.T) {
+	samples := []struct {
+		inputJSON  string
+		statusCode int
+	}{
+		{
+			inputJSON:  `{"uuid":33, "": ""}`,
+			statusCode: 422,
+		},
+		{
+			inputJSON:  `{"sender_name": "", "weight": -1}`,
+			statusCode: 422,
+		},
+	}
+
+	for _, v := range samples {
+		var shipmentApp mock.ShipmentAppInterface
+		shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/shipment", shipmentHandler.SaveShipment)
+
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/shipment", bytes.NewBufferString(v.inputJSON))
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, v.statusCode)
+	}
+}
+
+// TestUpdateShipment_Success Test.
+func TestUpdateShipment_Success(t *testing.T) {
+	var shipmentData entity.DetailShipment
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+	TripUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.PUT("/shipment/:uuid", shipmentHandler.UpdateShipment)
+
+	shipmentApp.GetShipmentFn = func(string) (*entity.Shipment, error) {
+		return &entity.Shipment{UUID: UUID}, nil
+	}
+	shipmentApp.UpdateShipmentFn = func(UUID string, shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+		shipment.UUID = UUID
+		return shipment, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPut,
+		"/api/v1/external/shipment/"+UUID,
+		bytes.NewBufferString(shipmentJSON(uuid.New().String(), uuid.New().String(), TripUUID)),
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &shipmentData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, shipmentData.UUID, UUID)
+	assert.EqualValues(t, shipmentData.TripUUID, TripUUID)
+}
+
+// TestGetShipment_Success Test.
+func TestGetShipment_Success(t *testing.T) {
+	var shipmentData entity.DetailShipment
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/shipment/:uuid", shipmentHandler.GetShipment)
+
+	shipmentApp.GetShipmentFn = func(string) (*entity.Shipment, error) {
+		return &entity.Shipment{
+			UUID:           UUID,
+			TrackingNumber: "CRG0000000002",
+			Weight:         3,
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/shipment/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &shipmentData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, shipmentData.UUID, UUID)
+	assert.EqualValues(t, shipmentData.TrackingNumber, "CRG0000000002")
+	assert.EqualValues(t, shipmentData.Weight, 3)
+}
+
+// TestGetShipments_Success Test.
+func TestGetShipments_Success(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	var shipmentsData []entity.DetailShipmentList
+	var metaData repository.Meta
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/shipments", shipmentHandler.GetShipments)
+	shipmentApp.GetShipmentsFn = func(params *repository.Parameters) ([]*entity.Shipment, *repository.Meta, error) {
+		shipments := []*entity.Shipment{
+			{UUID: uuid.New().String(), TripUUID: uuid.New().String()},
+			{UUID: uuid.New().String(), TripUUID: uuid.New().String()},
+		}
+		meta := repository.NewMeta(params, int64(len(shipments)))
+		return shipments, meta, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/shipments", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+	meta, _ := json.Marshal(response["meta"])
+
+	_ = json.Unmarshal(data, &shipmentsData)
+	_ = json.Unmarshal(meta, &metaData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, 2, len(shipmentsData))
+	assert.EqualValues(t, 1, metaData.Page)
+	assert.EqualValues(t, 5, metaData.PerPage)
+	assert.EqualValues(t, 2, metaData.Total)
+}
+
+// TestDeleteShipment_Success Test.
+func TestDeleteShipment_Success(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/shipment/:uuid", shipmentHandler.DeleteShipment)
+
+	shipmentApp.DeleteShipmentFn = func(UUID string) error {
+		return nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/shipment/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+}
+
+// TestDeleteShipment_Failed_ShipmentNotFound Test.
+func TestDeleteShipment_Failed_ShipmentNotFound(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/shipment/:uuid", shipmentHandler.DeleteShipment)
+
+	shipmentApp.DeleteShipmentFn = func(UUID string) error {
+		return exception.ErrorTextShipmentNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/shipment/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestSaveShipmentEvent_Success Test.
+func TestSaveShipmentEvent_Success(t *testing.T) {
+	var eventData entity.DetailShipmentEvent
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+	SityUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment/:uuid/event", shipmentHandler.SaveShipmentEvent)
+
+	shipmentApp.SaveShipmentEventFn = func(
+		shipmentUUID string,
+		event *entity.ShipmentEvent,
+	) (*entity.ShipmentEvent, map[string]string, error) {
+		event.UUID = uuid.New().String()
+		event.ShipmentUUID = shipmentUUID
+		return event, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/shipment/"+UUID+"/event",
+		bytes.NewBufferString(`{"type": "arrived", "sity_uuid": "`+SityUUID+`"}`),
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &eventData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, eventData.ShipmentUUID, UUID)
+	assert.EqualValues(t, eventData.Type, entity.ShipmentEventArrived)
+	assert.EqualValues(t, eventData.SityUUID, SityUUID)
+	assert.False(t, eventData.EventTime.IsZero())
+}
+
+// TestSaveShipmentEvent_InvalidData Test.
+func TestSaveShipmentEvent_InvalidData(t *testing.T) {
+	samples := []string{
+		`{"type": "lost"}`,
+		`{"type": "arrived"}`,
+	}
+
+	for _, inputJSON := range samples {
+		var shipmentApp mock.ShipmentAppInterface
+		shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/shipment/:uuid/event", shipmentHandler.SaveShipmentEvent)
+
+		var err error
+		c.Request, err = http.NewRequest(
+			http.MethodPost,
+			"/api/v1/external/shipment/"+uuid.New().String()+"/event",
+			bytes.NewBufferString(inputJSON),
+		)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+// TestGetShipmentEvents_Success Test.
+func TestGetShipmentEvents_Success(t *testing.T) {
+	var eventsData []entity.DetailShipmentEvent
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/shipment/:uuid/events", shipmentHandler.GetShipmentEvents)
+
+	shipmentApp.GetShipmentEventsFn = func(string) ([]*entity.ShipmentEvent, error) {
+		return []*entity.ShipmentEvent{
+			{UUID: uuid.New().String(), ShipmentUUID: UUID, Type: entity.ShipmentEventAccepted},
+			{UUID: uuid.New().String(), ShipmentUUID: UUID, Type: entity.ShipmentEventLoaded},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/shipment/"+UUID+"/events", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &eventsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, 2, len(eventsData))
+	assert.EqualValues(t, entity.ShipmentEventLoaded, eventsData[1].Type)
+}
+
+// TestTrackShipment_Success Test.
+func TestTrackShipment_Success(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	TripUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/track/:tracking_number", shipmentHandler.TrackShipment)
+
+	shipmentApp.GetShipmentByTrackingNumberFn = func(trackingNumber string) (*entity.Shipment, error) {
+		return &entity.Shipment{
+			UUID:           uuid.New().String(),
+			TrackingNumber: trackingNumber,
+			SenderName:     "Ivan Petrov",
+			SenderPhone:    "+79001234567",
+			RecipientName:  "Petr Ivanov",
+			RecipientPhone: "+79007654321",
+			Status:         entity.ShipmentEventLoaded,
+			SityFrom:       entity.Sity{Name: "Moscow"},
+			SityTo:         entity.Sity{Name: "Kazan"},
+			Events: entity.ShipmentEvents{
+				{Type: entity.ShipmentEventAccepted, Sity: entity.Sity{Name: "Moscow"}},
+				{Type: entity.ShipmentEventLoaded, TripUUID: TripUUID},
+			},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/track/CRG0000000003", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	body := w.Body.String()
+	var trackingData entity.ShipmentTracking
+	response := encoder.ResponseDecoder(bytes.NewBufferString(body))
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &trackingData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, "CRG0000000003", trackingData.TrackingNumber)
+	assert.EqualValues(t, entity.ShipmentEventLoaded, trackingData.Status)
+	assert.EqualValues(t, "Moscow", trackingData.From)
+	assert.EqualValues(t, "Kazan", trackingData.To)
+	assert.EqualValues(t, 2, len(trackingData.Events))
+	assert.EqualValues(t, TripUUID, trackingData.Events[1].TripUUID)
+	assert.NotContains(t, body, "Petrov")
+	assert.NotContains(t, body, "+7900")
+}
+
+// TestTrackShipment_Failed_ShipmentNotFound Test.
+func TestTrackShipment_Failed_ShipmentNotFound(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/track/:tracking_number", shipmentHandler.TrackShipment)
+
+	shipmentApp.GetShipmentByTrackingNumberFn = func(string) (*entity.Shipment, error) {
+		return nil, exception.ErrorTextShipmentNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/track/CRG0000000004", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// deliveryForm return multipart request body of delivery confirmation with optional photo.
+func deliveryForm(t *testing.T, code string, withPhoto bool) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("recipient_name", "Petr Ivanov")
+	_ = writer.WriteField("code", code)
+	if withPhoto {
+		part, err := writer.CreateFormFile("photo", "photo.jpg")
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		_, _ = part.Write([]byte("photo"))
+	}
+	_ = writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+// TestIssueDeliveryCode_Success Test.
+func TestIssueDeliveryCode_Success(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	var receivers []string
+	gateway := &mock.SMSGatewayInterface{SendFn: func(receiver []string, message string) error {
+		receivers = append(receivers, receiver...)
+		return nil
+	}}
+	notification := &notify.Notification{SMSNotification: &notify.SMSChannel{Gateway: gateway}}
+	shipmentHandler := NewShipments(&shipmentApp, notification, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment/:uuid/deliveryCode", shipmentHandler.IssueDeliveryCode)
+
+	shipmentApp.IssueShipmentDeliveryCodeFn = func(string) (*entity.Shipment, map[string]string, error) {
+		return &entity.Shipment{UUID: UUID, DeliveryCode: "123456", RecipientPhone: "+79007654321"}, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/shipment/"+UUID+"/deliveryCode", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, []string{"+79007654321"}, receivers)
+}
+
+// TestIssueDeliveryCode_Failed_NoChannel Test.
+func TestIssueDeliveryCode_Failed_NoChannel(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	notification := &notify.Notification{SMSNotification: &notify.SMSChannel{}}
+	shipmentHandler := NewShipments(&shipmentApp, notification, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment/:uuid/deliveryCode", shipmentHandler.IssueDeliveryCode)
+
+	shipmentApp.IssueShipmentDeliveryCodeFn = func(string) (*entity.Shipment, map[string]string, error) {
+		return &entity.Shipment{UUID: UUID, DeliveryCode: "123456", RecipientPhone: "+79007654321"}, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/shipment/"+UUID+"/deliveryCode", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestConfirmDelivery_Success Test.
+func TestConfirmDelivery_Success(t *testing.T) {
+	var deliveryData entity.DetailShipmentDelivery
+	var shipmentApp mock.ShipmentAppInterface
+	var storageApp mock.StorageAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, &storageApp)
+	UUID := uuid.New().String()
+	PhotoUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment/:uuid/delivery", shipmentHandler.ConfirmDelivery)
+
+	shipmentApp.VerifyShipmentDeliveryFn = func(string, *entity.ShipmentDelivery) (map[string]string, error) {
+		return nil, nil
+	}
+	shipmentApp.SaveShipmentDeliveryFn = func(
+		shipmentUUID string,
+		delivery *entity.ShipmentDelivery,
+	) (*entity.ShipmentDelivery, map[string]string, error) {
+		delivery.UUID = uuid.New().String()
+		delivery.ShipmentUUID = shipmentUUID
+		return delivery, nil, nil
+	}
+	storageApp.UploadFileFn = func(*multipart.FileHeader, string) (string, map[string]string, error, interface{}) {
+		return PhotoUUID, nil, nil, nil
+	}
+	storageApp.GetFileFn = func(string) (interface{}, error) {
+		return "https://storage.local/" + PhotoUUID, nil
+	}
+
+	body, contentType := deliveryForm(t, "123456", true)
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/shipment/"+UUID+"/delivery", body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &deliveryData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, deliveryData.ShipmentUUID, UUID)
+	assert.EqualValues(t, deliveryData.RecipientName, "Petr Ivanov")
+	assert.EqualValues(t, deliveryData.PhotoUUID, PhotoUUID)
+	assert.EqualValues(t, deliveryData.PhotoURL, "https://storage.local/"+PhotoUUID)
+}
+
+// TestConfirmDelivery_InvalidCode Test.
+func TestConfirmDelivery_InvalidCode(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	var storageApp mock.StorageAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, &storageApp)
+	UUID := uuid.New().String()
+	uploaded := false
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/shipment/:uuid/delivery", shipmentHandler.ConfirmDelivery)
+
+	shipmentApp.VerifyShipmentDeliveryFn = func(string, *entity.ShipmentDelivery) (map[string]string, error) {
+		return map[string]string{
+			"code": exception.ErrorTextShipmentDeliveryCodeInvalid.Error(),
+		}, exception.ErrorTextUnprocessableEntity
+	}
+	storageApp.UploadFileFn = func(*multipart.FileHeader, string) (string, map[string]string, error, interface{}) {
+		uploaded = true
+		return uuid.New().String(), nil, nil, nil
+	}
+
+	body, contentType := deliveryForm(t, "000000", true)
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/shipment/"+UUID+"/delivery", body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	assert.False(t, uploaded)
+}
+
+// TestConfirmDelivery_InvalidData Test.
+func TestConfirmDelivery_InvalidData(t *testing.T) {
+	samples := []struct {
+		code      string
+		withPhoto bool
+	}{
+		{code: "123456", withPhoto: false},
+		{code: "123", withPhoto: true},
+	}
+
+	for _, v := range samples {
+		var shipmentApp mock.ShipmentAppInterface
+		var storageApp mock.StorageAppInterface
+		shipmentHandler := NewShipments(&shipmentApp, nil, &storageApp)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/shipment/:uuid/delivery", shipmentHandler.ConfirmDelivery)
+
+		body, contentType := deliveryForm(t, v.code, v.withPhoto)
+		var err error
+		c.Request, err = http.NewRequest(
+			http.MethodPost,
+			"/api/v1/external/shipment/"+uuid.New().String()+"/delivery",
+			body,
+		)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+// TestGetDelivery_Failed_DeliveryNotFound Test.
+func TestGetDelivery_Failed_DeliveryNotFound(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/shipment/:uuid/delivery", shipmentHandler.GetDelivery)
+
+	shipmentApp.GetShipmentDeliveryFn = func(string) (*entity.ShipmentDelivery, error) {
+		return nil, exception.ErrorTextShipmentDeliveryNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/shipment/"+uuid.New().String()+"/delivery", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// labelShipment return shipment with preloaded cities and trip to print label.
+func labelShipment(trackingNumber string) *entity.Shipment {
+	return &entity.Shipment{
+		UUID:           uuid.New().String(),
+		TrackingNumber: trackingNumber,
+		SenderName:     "Ivan Petrov",
+		RecipientName:  "Петр Иванов",
+		RecipientPhone: "+79007654321",
+		SityFrom:       entity.Sity{Name: "Москва"},
+		SityTo:         entity.Sity{Name: "Казань"},
+		Weight:         12.5,
+		TripUUID:       uuid.New().String(),
+		Trip: entity.Trip{
+			DepartureTime: time.Date(2022, 5, 1, 9, 30, 0, 0, time.UTC),
+			Vehicle:       entity.Vehicle{RegCode: "A123BC77"},
+		},
+	}
+}
+
+// TestGetShipmentLabel_Success Test.
+func TestGetShipmentLabel_Success(t *testing.T) {
+	samples := []struct {
+		format      string
+		contentType string
+		contains    string
+	}{
+		{format: "", contentType: "application/pdf", contains: "%PDF"},
+		{format: "pdf", contentType: "application/pdf", contains: "%PDF"},
+		{format: "zpl", contentType: "application/zpl", contains: "^BCN,180,Y,N,N^FDCRG0000000001^FS"},
+	}
+
+	for _, v := range samples {
+		var shipmentApp mock.ShipmentAppInterface
+		shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.GET("/shipment/:uuid/label", shipmentHandler.GetShipmentLabel)
+
+		shipmentApp.GetShipmentFn = func(string) (*entity.Shipment, error) {
+			return labelShipment("CRG0000000001"), nil
+		}
+
+		var err error
+		c.Request, err = http.NewRequest(
+			http.MethodGet,
+			"/api/v1/external/shipment/"+uuid.New().String()+"/label?format="+v.format,
+			nil,
+		)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusOK)
+		assert.Equal(t, w.Header().Get("Content-Type"), v.contentType)
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "label-CRG0000000001")
+		assert.Contains(t, w.Body.String(), v.contains)
+	}
+}
+
+// TestGetShipmentLabel_InvalidFormat Test.
+func TestGetShipmentLabel_InvalidFormat(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/shipment/:uuid/label", shipmentHandler.GetShipmentLabel)
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodGet,
+		"/api/v1/external/shipment/"+uuid.New().String()+"/label?format=png",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestGetTripShipmentLabels_Success Test.
+func TestGetTripShipmentLabels_Success(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+	TripUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trip/:uuid/labels", shipmentHandler.GetTripShipmentLabels)
+
+	shipmentApp.GetTripShipmentsFn = func(string) ([]*entity.Shipment, error) {
+		return []*entity.Shipment{
+			labelShipment("CRG0000000001"),
+			labelShipment("CRG0000000002"),
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trip/"+TripUUID+"/labels?format=zpl", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "labels-"+TripUUID+".zpl")
+	assert.Equal(t, strings.Count(w.Body.String(), "^XA"), 2)
+	assert.Contains(t, w.Body.String(), "^FDMA,CRG0000000002^FS")
+}
+
+// TestGetTripShipmentLabels_Failed_NoShipments Test.
+func TestGetTripShipmentLabels_Failed_NoShipments(t *testing.T) {
+	var shipmentApp mock.ShipmentAppInterface
+	shipmentHandler := NewShipments(&shipmentApp, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trip/:uuid/labels", shipmentHandler.GetTripShipmentLabels)
+
+	shipmentApp.GetTripShipmentsFn = func(string) ([]*entity.Shipment, error) {
+		return []*entity.Shipment{}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trip/"+uuid.New().String()+"/labels", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
@@ -79,6 +79,7 @@ func (s *Sities) SaveSity(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param passenger body entity.DetailSity true "Sity sity"
@@ -87,6 +88,7 @@ func (s *Sities) SaveSity(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/sity/uuid [put]
 // UpdateSity is a function uses to handle update sity by UUID.
@@ -137,6 +139,7 @@ func (s *Sities) UpdateSity(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Sity UUID"
@@ -145,6 +148,7 @@ func (s *Sities) UpdateSity(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/sity/{uuid} [delete]
 // DeleteSity is a function uses to handle delete sity by UUID.
@@ -208,6 +212,7 @@ func (s *Sities) GetSities(c *gin.Context) {
 // @Param Set-Request-Id header string false "Request id"
 // @Param uuid path string true "Sity UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/sity/{uuid} [get]
@@ -230,5 +235,7 @@ func (s *Sities) GetSity(c *gin.Context) {
 		return
 	}
 
-	response.NewSuccess(c, sity.DetailSity(), success.SitySuccessfullyGetSityDetail).JSON()
+	response.NewSuccess(c, sity.DetailSity(), success.SitySuccessfullyGetSityDetail).
+		WithETag(sity.UpdatedAt).
+		JSON()
 }
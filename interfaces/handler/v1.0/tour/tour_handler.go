@@ -128,5 +128,7 @@ func (s *Tours) GetTour(c *gin.Context) {
 		_ = c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	response.NewSuccess(c, tour.DetailTour(), success.RoleSuccessfullyGetRoleDetail).JSON()
+	response.NewSuccess(c, tour.DetailTour(), success.RoleSuccessfullyGetRoleDetail).
+		WithETag(tour.UpdatedAt).
+		JSON()
 }
@@ -0,0 +1,166 @@
+package trashv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Trash is a struct defines the dependencies that will be used.
+type Trash struct {
+	us application.TrashAppInterface
+}
+
+// NewTrash is constructor will initialize trash handler.
+func NewTrash(us application.TrashAppInterface) *Trash {
+	return &Trash{
+		us: us,
+	}
+}
+
+// @Summary Get deleted records
+// @Description Get soft-deleted records of resource, the latest deleted first. Resource is a name of table:
+// @Description sities, vehicles, drivers, routes, trips, orders, payments, passengers, passenger_types, prices,
+// @Description document_types, regularity_types, order_status_types, shipments or cargo_tariffs.
+// @Tags trash
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param resource path string true "Resource"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trash/{resource} [get]
+// GetTrash is a function uses to handle get deleted record list of resource.
+func (s *Trash) GetTrash(c *gin.Context) {
+	resource, ok := s.resource(c)
+	if !ok {
+		return
+	}
+
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(resource.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	records, meta, err := s.us.GetTrash(resource, parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, records, success.TrashSuccessfullyGetTrashList).WithMeta(meta).JSON()
+}
+
+// @Summary Restore deleted record
+// @Description Restore soft-deleted record of resource. Record is not restored when record it references,
+// @Description e.g. route of trip, is deleted or does not exist.
+// @Tags trash
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param resource path string true "Resource"
+// @Param uuid path string true "Record UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trash/{resource}/{uuid}/restore [post]
+// RestoreTrash is a function uses to handle restore deleted record of resource by UUID.
+func (s *Trash) RestoreTrash(c *gin.Context) {
+	resource, ok := s.resource(c)
+	if !ok {
+		return
+	}
+
+	UUID := c.Param("uuid")
+	record, errDesc, errException := s.us.RestoreTrash(resource, UUID)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextTrashNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	response.NewSuccess(c, record, success.TrashSuccessfullyRestore).JSON()
+}
+
+// @Summary Purge deleted record
+// @Description Permanently delete soft-deleted record of resource, purged record can not be restored.
+// @Description Payment with fiscal receipt, payout or bank statement line is not purged.
+// @Tags trash
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param resource path string true "Resource"
+// @Param uuid path string true "Record UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 409 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trash/{resource}/{uuid} [delete]
+// PurgeTrash is a function uses to handle purge deleted record of resource by UUID.
+func (s *Trash) PurgeTrash(c *gin.Context) {
+	resource, ok := s.resource(c)
+	if !ok {
+		return
+	}
+
+	UUID := c.Param("uuid")
+	err := s.us.PurgeTrash(resource, UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextTrashNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextTrashNotFound)
+			return
+		}
+		if errors.Is(err, exception.ErrorTextTrashRetained) {
+			_ = c.AbortWithError(http.StatusConflict, err)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, nil, success.TrashSuccessfullyPurge).JSON()
+}
+
+// resource will return resource of request, request is aborted when resource is not in trash.
+func (s *Trash) resource(c *gin.Context) (*entity.TrashResource, bool) {
+	resource, ok := entity.GetTrashResource(c.Param("resource"))
+	if !ok {
+		_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextTrashResourceNotFound)
+		return nil, false
+	}
+	return resource, true
+}
@@ -0,0 +1,268 @@
+package trashv1point00
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetTrash_Success Test.
+func TestGetTrash_Success(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	var routesData []entity.Route
+	trashHandler := NewTrash(&trashApp)
+	routeUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trash/:resource", trashHandler.GetTrash)
+
+	var requested string
+	trashApp.GetTrashFn = func(
+		resource *entity.TrashResource,
+		params *repository.Parameters,
+	) ([]interface{}, *repository.Meta, error) {
+		requested = resource.Name
+		records := []interface{}{&entity.Route{UUID: routeUUID}}
+		return records, repository.NewMeta(params, int64(len(records))), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trash/routes", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &routesData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, "routes", requested)
+	assert.Len(t, routesData, 1)
+	assert.Equal(t, routeUUID, routesData[0].UUID)
+}
+
+// TestGetTrash_Failed_ResourceNotFound Test.
+func TestGetTrash_Failed_ResourceNotFound(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	trashHandler := NewTrash(&trashApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trash/:resource", trashHandler.GetTrash)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trash/audit_logs", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestRestoreTrash_Success Test.
+func TestRestoreTrash_Success(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	var tripData entity.Trip
+	trashHandler := NewTrash(&trashApp)
+	tripUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trash/:resource/:uuid/restore", trashHandler.RestoreTrash)
+
+	trashApp.RestoreTrashFn = func(
+		resource *entity.TrashResource,
+		UUID string,
+	) (interface{}, map[string]string, error) {
+		return &entity.Trip{UUID: UUID}, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/trash/trips/"+tripUUID+"/restore", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &tripData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, tripUUID, tripData.UUID)
+}
+
+// TestRestoreTrash_Failed_InvalidReference Test.
+func TestRestoreTrash_Failed_InvalidReference(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	var abortData interface{}
+	trashHandler := NewTrash(&trashApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Next()
+		abortData, _ = c.Get("data")
+	})
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trash/:resource/:uuid/restore", trashHandler.RestoreTrash)
+
+	var restored *entity.TrashResource
+	trashApp.RestoreTrashFn = func(
+		resource *entity.TrashResource,
+		UUID string,
+	) (interface{}, map[string]string, error) {
+		restored = resource
+		errDesc := map[string]string{"route_uuid": exception.ErrorTextTrashInvalidReference.Error()}
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/trash/trips/"+uuid.New().String()+"/restore",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	assert.Contains(t, restored.References, entity.TrashReference{Column: "route_uuid", Table: "routes"})
+	assert.Equal(t, map[string]string{"route_uuid": exception.ErrorTextTrashInvalidReference.Error()}, abortData)
+}
+
+// TestRestoreTrash_Failed_NotFound Test.
+func TestRestoreTrash_Failed_NotFound(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	trashHandler := NewTrash(&trashApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trash/:resource/:uuid/restore", trashHandler.RestoreTrash)
+
+	trashApp.RestoreTrashFn = func(
+		resource *entity.TrashResource,
+		UUID string,
+	) (interface{}, map[string]string, error) {
+		return nil, map[string]string{}, exception.ErrorTextTrashNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/trash/routes/"+uuid.New().String()+"/restore",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestPurgeTrash_Success Test.
+func TestPurgeTrash_Success(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	trashHandler := NewTrash(&trashApp)
+	routeUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/trash/:resource/:uuid", trashHandler.PurgeTrash)
+
+	var purged string
+	trashApp.PurgeTrashFn = func(resource *entity.TrashResource, UUID string) error {
+		purged = resource.Name + "/" + UUID
+		return nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/trash/routes/"+routeUUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, "routes/"+routeUUID, purged)
+}
+
+// TestPurgeTrash_Failed_NotFound Test.
+func TestPurgeTrash_Failed_NotFound(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	trashHandler := NewTrash(&trashApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/trash/:resource/:uuid", trashHandler.PurgeTrash)
+
+	trashApp.PurgeTrashFn = func(resource *entity.TrashResource, UUID string) error {
+		return exception.ErrorTextTrashNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/trash/routes/"+uuid.New().String(), nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestPurgeTrash_Failed_Retained Test.
+func TestPurgeTrash_Failed_Retained(t *testing.T) {
+	var trashApp mock.TrashAppInterface
+	trashHandler := NewTrash(&trashApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/trash/:resource/:uuid", trashHandler.PurgeTrash)
+
+	trashApp.PurgeTrashFn = func(resource *entity.TrashResource, UUID string) error {
+		return exception.ErrorTextTrashRetained
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/trash/payments/"+uuid.New().String(), nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusConflict)
+}
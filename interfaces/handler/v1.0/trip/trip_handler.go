@@ -79,6 +79,7 @@ func (s *Trips) SaveTrip(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param trip body entity.DetailTrip true "Trip trip"
@@ -87,6 +88,7 @@ func (s *Trips) SaveTrip(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Tripr /api/v1/external/trip/uuid [put]
 // UpdateTrip is a function uses to handle update trip by UUID.
@@ -138,6 +140,7 @@ func (s *Trips) UpdateTrip(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Trip UUID"
@@ -146,6 +149,7 @@ func (s *Trips) UpdateTrip(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Tripr /api/v1/external/trip/{uuid} [delete]
 // DeleteTrip is a function uses to handle delete trip by UUID.
@@ -218,6 +222,7 @@ func (s *Trips) GetTrips(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Trip UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -244,5 +249,179 @@ func (s *Trips) GetTrip(c *gin.Context) {
 	}
 
 	response.NewSuccess(c, trip.DetailTrip(), success.TripSuccessfullyGetTripDetail).
+		WithETag(trip.UpdatedAt).
+		JSON()
+}
+
+// @Summary Get trip load plan
+// @Description Get luggage load of trip by shipments and passenger baggage.
+// @Description Alternative trips of the same route are suggested when requested load does not fit.
+// @Tags trips
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Trip UUID"
+// @Param weight query number false "Requested additional weight, kg"
+// @Param volume query number false "Requested additional volume, m³"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trip/{uuid}/loadPlan [get]
+// GetTripLoadPlan is a function uses to handle get trip load plan by UUID.
+func (s *Trips) GetTripLoadPlan(c *gin.Context) {
+	var request entity.TripLoadPlan
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	validateErr := request.ValidateTripLoadPlan()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	plan, err := s.us.GetTripLoadPlan(UUID, request.RequestedWeight, request.RequestedVolume)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextTripNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextTripNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.NewSuccess(c, plan, success.TripSuccessfullyGetLoadPlan).JSON()
+}
+
+// @Summary Optimize trip route
+// @Description Order drop-off stops of trip shipments starting from depot to minimize distance.
+// @Description ETAs are derived from departure time of trip and average speed, previous route is replaced.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Trip UUID"
+// @Param route body entity.DetailTripRoute true "Depot coordinates, average speed (km/h) and stop duration (minutes)"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trip/{uuid}/route [post]
+// SaveTripRoute is a function uses to handle optimize route of trip by UUID.
+func (s *Trips) SaveTripRoute(c *gin.Context) {
+	var routeEntity entity.TripRoute
+	if err := c.ShouldBindJSON(&routeEntity); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	routeEntity.Prepare()
+	validateErr := routeEntity.ValidateSaveTripRoute()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	route, errDesc, errException := s.us.SaveTripRoute(UUID, &routeEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextTripNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, route.DetailTripRoute(), success.TripSuccessfullyOptimizeRoute).JSON()
+}
+
+// @Summary Get trip route
+// @Description Get optimized sequence of drop-off stops of trip with ETAs.
+// @Tags trips
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Trip UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/trip/{uuid}/route [get]
+// GetTripRoute is a function uses to handle get route of trip by UUID.
+func (s *Trips) GetTripRoute(c *gin.Context) {
+	UUID := c.Param("uuid")
+	route, err := s.us.GetTripRoute(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextTripRouteNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextTripRouteNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, route.DetailTripRoute(), success.TripSuccessfullyGetRoute).JSON()
+}
+
+// @Summary Get routes of current driver
+// @Description Get routes of not finished trips assigned to driver of authenticated user.
+// @Tags trips
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/driver/routes [get]
+// GetDriverTripRoutes is a function uses to handle get routes of driver of authenticated user.
+func (s *Trips) GetDriverTripRoutes(c *gin.Context) {
+	UUID, exists := c.Get("UUID")
+	if !exists {
+		_ = c.AbortWithError(http.StatusUnauthorized, exception.ErrorTextUnauthorized)
+		return
+	}
+	userUUID, _ := UUID.(string)
+	routes, err := s.us.GetDriverTripRoutes(userUUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextDriverNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextDriverNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, entity.TripRoutes(routes).DetailTripRoutes(), success.TripSuccessfullyGetDriverRoutes).
 		JSON()
 }
@@ -6,6 +6,7 @@ import (
 	"cargo-rest-api/domain/repository"
 	"cargo-rest-api/infrastructure/message/exception"
 	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/pkg/etag"
 	"cargo-rest-api/pkg/util"
 	"cargo-rest-api/tests/mock"
 	"encoding/json"
@@ -86,6 +87,102 @@ func TestSaveTrip_Success(t *testing.T) {
 	assert.EqualValues(t, tripData.DriverUUID, DriverUUID)
 }
 
+// TestSaveTrip_VehicleNotCompliant Test.
+func TestSaveTrip_VehicleNotCompliant(t *testing.T) {
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	VehicleUUID := uuid.New().String()
+
+	tripJSON := `{
+		"route_uuid": "` + uuid.New().String() + `",
+		"vehicle_uuid": "` + VehicleUUID + `",
+		"departure_time": "2022-04-22T11:00:00Z",
+		"arravial_tive": "2022-04-22T19:30:00Z",
+		"driver_uuid": "` + uuid.New().String() + `"
+	}`
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trip", tripHandler.SaveTrip)
+
+	tripApp.SaveTripFn = func(trip *entity.Trip) (*entity.Trip, map[string]string, error) {
+		return nil, map[string]string{
+			"vehicle_uuid": exception.ErrorTextVehicleMaintenanceScheduled.Error(),
+		}, exception.ErrorTextUnprocessableEntity
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/trip", bytes.NewBufferString(tripJSON))
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestSaveTrip_WorkTimeWarnings Test.
+func TestSaveTrip_WorkTimeWarnings(t *testing.T) {
+	var tripData entity.DetailTrip
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	UUID := uuid.New().String()
+	DriverUUID := uuid.New().String()
+
+	tripJSON := `{
+		"route_uuid": "` + uuid.New().String() + `",
+		"vehicle_uuid": "` + uuid.New().String() + `",
+		"departure_time": "2022-04-22T06:00:00Z",
+		"arravial_tive": "2022-04-22T17:00:00Z",
+		"driver_uuid": "` + DriverUUID + `"
+	}`
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trip", tripHandler.SaveTrip)
+
+	tripApp.SaveTripFn = func(trip *entity.Trip) (*entity.Trip, map[string]string, error) {
+		return &entity.Trip{
+			UUID:          UUID,
+			DriverUUID:    DriverUUID,
+			DepartureTime: time.Date(2022, time.April, 22, 6, 0, 0, 0, time.UTC),
+			ArravialTive:  time.Date(2022, time.April, 22, 17, 0, 0, 0, time.UTC),
+			Warnings: []entity.DriverWorkViolation{
+				{
+					Rule:      "daily_driving",
+					Start:     time.Date(2022, time.April, 22, 0, 0, 0, 0, time.UTC),
+					End:       time.Date(2022, time.April, 23, 0, 0, 0, 0, time.UTC),
+					Actual:    660,
+					Limit:     600,
+					TripUUIDs: []string{UUID},
+				},
+			},
+		}, nil, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/trip", bytes.NewBufferString(tripJSON))
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &tripData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, tripData.UUID, UUID)
+	assert.Len(t, tripData.Warnings, 1)
+	assert.EqualValues(t, tripData.Warnings[0].Rule, "daily_driving")
+	assert.EqualValues(t, tripData.Warnings[0].TripUUIDs, []string{UUID})
+}
+
 func TestSaveTrip_InvalidData(t *testing.T) {
 	samples := []struct {
 		inputJSON  string
@@ -239,6 +336,7 @@ func TestGetTrip_Success(t *testing.T) {
 			ArravialTive:       time.Date(2022, time.April, 22, 19, 30, 0, 0, time.UTC),
 			RegularityTypeUUID: RegularityTypeUUID,
 			DriverUUID:         DriverUUID,
+			UpdatedAt:          time.Date(2022, time.April, 20, 9, 0, 0, 0, time.UTC),
 		}, nil
 	}
 
@@ -255,6 +353,7 @@ func TestGetTrip_Success(t *testing.T) {
 	_ = json.Unmarshal(data, &tripData)
 
 	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, etag.New(time.Date(2022, time.April, 20, 9, 0, 0, 0, time.UTC)), w.Header().Get("ETag"))
 	assert.EqualValues(t, tripData.UUID, UUID)
 	assert.EqualValues(t, tripData.RouteUUID, RouteUUID)
 	assert.EqualValues(t, tripData.VehicleUUID, VehicleUUID)
@@ -374,3 +473,266 @@ func TestDeleteTrip_Failed_TripNotFound(t *testing.T) {
 
 	assert.Equal(t, w.Code, http.StatusNotFound)
 }
+
+// TestGetTripLoadPlan_Success Test.
+func TestGetTripLoadPlan_Success(t *testing.T) {
+	var planData entity.TripLoadPlan
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	UUID := uuid.New().String()
+	AlternativeUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trip/:uuid/loadPlan", tripHandler.GetTripLoadPlan)
+
+	tripApp.GetTripLoadPlanFn = func(UUID string, weight float64, volume float64) (*entity.TripLoadPlan, error) {
+		vehicle := entity.Vehicle{LuggageWeight: 100, LuggageVolume: 2}
+		plan := entity.NewTripLoadPlan(
+			&entity.Trip{UUID: UUID, Vehicle: vehicle},
+			entity.Shipments{{Weight: 40, Length: 100, Width: 50, Height: 40}},
+			entity.Orders{{BaggageWeight: 20, BaggageVolume: 0.1}, {BaggageWeight: 15, BaggageVolume: 0.1}},
+		)
+		plan.RequestedWeight = weight
+		plan.RequestedVolume = volume
+		plan.Fits = plan.HasRoom(weight, volume)
+		plan.Alternatives = []*entity.TripLoadPlan{
+			entity.NewTripLoadPlan(&entity.Trip{UUID: AlternativeUUID, Vehicle: vehicle}, nil, nil),
+		}
+		return plan, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trip/"+UUID+"/loadPlan?weight=30&volume=0.1", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &planData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, planData.TripUUID, UUID)
+	assert.EqualValues(t, 40, planData.ShipmentWeight)
+	assert.EqualValues(t, 0.2, planData.ShipmentVolume)
+	assert.EqualValues(t, 35, planData.BaggageWeight)
+	assert.EqualValues(t, 75, planData.TotalWeight)
+	assert.EqualValues(t, 25, *planData.RemainingWeight)
+	assert.False(t, planData.Overloaded)
+	assert.False(t, planData.Fits)
+	assert.EqualValues(t, 1, len(planData.Alternatives))
+	assert.EqualValues(t, AlternativeUUID, planData.Alternatives[0].TripUUID)
+}
+
+// TestGetTripLoadPlan_InvalidData Test.
+func TestGetTripLoadPlan_InvalidData(t *testing.T) {
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trip/:uuid/loadPlan", tripHandler.GetTripLoadPlan)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trip/"+uuid.New().String()+"/loadPlan?weight=-1", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestGetTripLoadPlan_Failed_TripNotFound Test.
+func TestGetTripLoadPlan_Failed_TripNotFound(t *testing.T) {
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trip/:uuid/loadPlan", tripHandler.GetTripLoadPlan)
+
+	tripApp.GetTripLoadPlanFn = func(UUID string, weight float64, volume float64) (*entity.TripLoadPlan, error) {
+		return nil, exception.ErrorTextTripNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trip/"+uuid.New().String()+"/loadPlan", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// routeShipments return shipments of trip to deliver within a city.
+func routeShipments() entity.Shipments {
+	return entity.Shipments{
+		{UUID: uuid.New().String(), TrackingNumber: "CRG0000000001", DestinationLatitude: 55.75, DestinationLongitude: 37.64},
+		{UUID: uuid.New().String(), TrackingNumber: "CRG0000000002", DestinationLatitude: 55.75, DestinationLongitude: 37.61},
+		{UUID: uuid.New().String(), TrackingNumber: "CRG0000000003"},
+		{UUID: uuid.New().String(), TrackingNumber: "CRG0000000004", DestinationLatitude: 55.75, DestinationLongitude: 37.62},
+	}
+}
+
+// TestSaveTripRoute_Success Test.
+func TestSaveTripRoute_Success(t *testing.T) {
+	var routeData entity.DetailTripRoute
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	UUID := uuid.New().String()
+	departure := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trip/:uuid/route", tripHandler.SaveTripRoute)
+
+	tripApp.SaveTripRouteFn = func(UUID string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error) {
+		route.UUID = uuid.New().String()
+		route.TripUUID = UUID
+		route.StartTime = departure
+		route.Plan(routeShipments())
+		return route, nil, nil
+	}
+
+	routeJSON := `{"depot_latitude": 55.75, "depot_longitude": 37.60, "stop_duration": 5}`
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/trip/"+UUID+"/route",
+		bytes.NewBufferString(routeJSON),
+	)
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &routeData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.Equal(t, UUID, routeData.TripUUID)
+	assert.EqualValues(t, entity.DefaultTripRouteAverageSpeed, routeData.AverageSpeed)
+	assert.Len(t, routeData.Stops, 3)
+	assert.Len(t, routeData.SkippedShipments, 1)
+	assert.Equal(t, "CRG0000000002", routeData.Stops[0].TrackingNumber)
+	assert.Equal(t, "CRG0000000004", routeData.Stops[1].TrackingNumber)
+	assert.Equal(t, "CRG0000000001", routeData.Stops[2].TrackingNumber)
+	assert.True(t, routeData.Stops[0].ETA.After(departure))
+	assert.True(t, routeData.Stops[2].ETA.After(routeData.Stops[1].ETA.Add(5*time.Minute)))
+	assert.Equal(t, routeData.Stops[2].CumulativeDistance, routeData.TotalDistance)
+}
+
+// TestSaveTripRoute_InvalidData Test.
+func TestSaveTripRoute_InvalidData(t *testing.T) {
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/trip/:uuid/route", tripHandler.SaveTripRoute)
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/trip/"+UUID+"/route",
+		bytes.NewBufferString(`{"depot_latitude": 95, "average_speed": -10}`),
+	)
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
+
+// TestGetTripRoute_Failed_RouteNotFound Test.
+func TestGetTripRoute_Failed_RouteNotFound(t *testing.T) {
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/trip/:uuid/route", tripHandler.GetTripRoute)
+
+	tripApp.GetTripRouteFn = func(UUID string) (*entity.TripRoute, error) {
+		return nil, exception.ErrorTextTripRouteNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/trip/"+UUID+"/route", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestGetDriverTripRoutes_Success Test.
+func TestGetDriverTripRoutes_Success(t *testing.T) {
+	var routesData []entity.DetailTripRoute
+	var tripApp mock.TripAppInterface
+	tripHandler := NewTrips(&tripApp)
+	UserUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/driver/routes", func(c *gin.Context) {
+		c.Set("UUID", UserUUID)
+	}, tripHandler.GetDriverTripRoutes)
+
+	tripApp.GetDriverTripRoutesFn = func(userUUID string) ([]*entity.TripRoute, error) {
+		assert.Equal(t, UserUUID, userUUID)
+		route := &entity.TripRoute{
+			UUID:           uuid.New().String(),
+			TripUUID:       uuid.New().String(),
+			DepotLatitude:  55.75,
+			DepotLongitude: 37.60,
+			AverageSpeed:   entity.DefaultTripRouteAverageSpeed,
+			StartTime:      time.Now(),
+		}
+		route.Plan(routeShipments())
+		return []*entity.TripRoute{route}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/driver/routes", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &routesData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Len(t, routesData, 1)
+	assert.Len(t, routesData[0].Stops, 3)
+}
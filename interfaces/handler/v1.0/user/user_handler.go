@@ -121,6 +121,7 @@ func (s *Users) SaveUser(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "User UUID"
@@ -133,6 +134,7 @@ func (s *Users) SaveUser(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/users/uuid [put]
 // UpdateUser is a function uses to handle update user by UUID.
@@ -227,6 +229,7 @@ func (s *Users) UpdateUser(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "User UUID"
@@ -235,6 +238,7 @@ func (s *Users) UpdateUser(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/users/{uuid} [delete]
 // DeleteUser is a function uses to handle delete user by UUID.
@@ -306,6 +310,7 @@ func (s *Users) GetUsers(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "User UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -336,7 +341,9 @@ func (s *Users) GetUser(c *gin.Context) {
 		_ = c.AbortWithError(http.StatusInternalServerError, errAvatar)
 		return
 	}
-	response.NewSuccess(c, user.DetailUserAvatar(avatarURL), success.UserSuccessfullyGetUserDetail).JSON()
+	response.NewSuccess(c, user.DetailUserAvatar(avatarURL), success.UserSuccessfullyGetUserDetail).
+		WithETag(user.UpdatedAt).
+		JSON()
 }
 
 // @Summary Update user avatar
@@ -78,6 +78,7 @@ func (s *Vehicles) SaveVehicle(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Vehicle UUID"
@@ -90,6 +91,7 @@ func (s *Vehicles) SaveVehicle(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/vehicles/uuid [put]
 // UpdateVehicle is a function uses to handle update vehicle by UUID.
@@ -140,6 +142,7 @@ func (s *Vehicles) UpdateVehicle(c *gin.Context) {
 // @Produce json
 // @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
 // @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
 // @Security BasicAuth
 // @Security JWTAuth
 // @Param uuid path string true "Vehicle UUID"
@@ -148,6 +151,7 @@ func (s *Vehicles) UpdateVehicle(c *gin.Context) {
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
 // @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
 // @Failure 500 {object} response.errorOutput
 // @Router /api/v1/external/vehicles/{uuid} [delete]
 // DeleteVehicle is a function uses to handle delete vehicle by UUID.
@@ -218,6 +222,7 @@ func (s *Vehicles) GetVehicles(c *gin.Context) {
 // @Security JWTAuth
 // @Param uuid path string true "Vehicle UUID"
 // @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
 // @Failure 400 {object} response.errorOutput
 // @Failure 401 {object} response.errorOutput
 // @Failure 403 {object} response.errorOutput
@@ -243,5 +248,7 @@ func (s *Vehicles) GetVehicle(c *gin.Context) {
 		return
 	}
 
-	response.NewSuccess(c, vehicle.DetailVehicle(), success.VehicleSuccessfullyGetVehicleDetail).JSON()
+	response.NewSuccess(c, vehicle.DetailVehicle(), success.VehicleSuccessfullyGetVehicleDetail).
+		WithETag(vehicle.UpdatedAt).
+		JSON()
 }
@@ -0,0 +1,345 @@
+package vehicleCompliancev1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/infrastructure/storage"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VehicleCompliances is a struct defines the dependencies that will be used.
+type VehicleCompliances struct {
+	us application.VehicleComplianceAppInterface
+	ss application.StorageAppInterface
+}
+
+// NewVehicleCompliances is constructor will initialize vehicle compliance handler.
+func NewVehicleCompliances(
+	us application.VehicleComplianceAppInterface,
+	ss application.StorageAppInterface,
+) *VehicleCompliances {
+	return &VehicleCompliances{
+		us: us,
+		ss: ss,
+	}
+}
+
+// @Summary Create a new vehicle compliance record
+// @Description Create insurance, inspection, tachograph calibration or maintenance window of vehicle with optional scan.
+// @Tags vehicle compliances
+// @Accept mpfd
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param vehicle_uuid formData string true "Vehicle UUID"
+// @Param type formData string true "Record type" Enums(insurance, inspection, tachograph, maintenance)
+// @Param number formData string false "Document number"
+// @Param valid_from formData string false "Valid from date (YYYY-MM-DD), required for maintenance"
+// @Param valid_until formData string true "Valid until date (YYYY-MM-DD)"
+// @Param note formData string false "Note"
+// @Param file formData file false "Scan of document"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/vehicleCompliances [post]
+// SaveVehicleCompliance is a function uses to handle create a new vehicle compliance record.
+func (s *VehicleCompliances) SaveVehicleCompliance(c *gin.Context) {
+	var recordEntity entity.VehicleCompliance
+	if err := c.ShouldBind(&recordEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	recordEntity.Prepare()
+
+	validateErr := recordEntity.ValidateSaveVehicleCompliance()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	var ok bool
+	if recordEntity.FileUUID, ok = s.uploadScan(c); !ok {
+		return
+	}
+
+	newRecord, errDesc, errException := s.us.SaveVehicleCompliance(&recordEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, s.detailRecord(newRecord), success.VehicleComplianceSuccessfullyCreateVehicleCompliance).
+		JSON()
+}
+
+// @Summary Update vehicle compliance record
+// @Description Update an existing vehicle compliance record, scan is replaced only when a new file is uploaded.
+// @Tags vehicle compliances
+// @Accept mpfd
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Vehicle compliance UUID"
+// @Param vehicle_uuid formData string true "Vehicle UUID"
+// @Param type formData string true "Record type" Enums(insurance, inspection, tachograph, maintenance)
+// @Param number formData string false "Document number"
+// @Param valid_from formData string false "Valid from date (YYYY-MM-DD), required for maintenance"
+// @Param valid_until formData string true "Valid until date (YYYY-MM-DD)"
+// @Param note formData string false "Note"
+// @Param file formData file false "Scan of document"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/vehicleCompliances/{uuid} [put]
+// UpdateVehicleCompliance is a function uses to handle update vehicle compliance record by UUID.
+func (s *VehicleCompliances) UpdateVehicleCompliance(c *gin.Context) {
+	var recordEntity entity.VehicleCompliance
+	if err := c.ShouldBind(&recordEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	recordEntity.Prepare()
+
+	validateErr := recordEntity.ValidateUpdateVehicleCompliance()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	_, err := s.us.GetVehicleCompliance(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextVehicleComplianceNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextVehicleComplianceNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	var ok bool
+	if recordEntity.FileUUID, ok = s.uploadScan(c); !ok {
+		return
+	}
+
+	updatedRecord, errDesc, errException := s.us.UpdateVehicleCompliance(UUID, &recordEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextVehicleComplianceNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusOK)
+	response.NewSuccess(c, s.detailRecord(updatedRecord), success.VehicleComplianceSuccessfullyUpdateVehicleCompliance).
+		JSON()
+}
+
+// @Summary Delete vehicle compliance record
+// @Description Delete an existing vehicle compliance record.
+// @Tags vehicle compliances
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Vehicle compliance UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/vehicleCompliances/{uuid} [delete]
+// DeleteVehicleCompliance is a function uses to handle delete vehicle compliance record by UUID.
+func (s *VehicleCompliances) DeleteVehicleCompliance(c *gin.Context) {
+	UUID := c.Param("uuid")
+	err := s.us.DeleteVehicleCompliance(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextVehicleComplianceNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextVehicleComplianceNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, nil, success.VehicleComplianceSuccessfullyDeleteVehicleCompliance).JSON()
+}
+
+// @Summary Get vehicle compliance records
+// @Description Get list of vehicle compliance records ordered by expiry date.
+// @Tags vehicle compliances
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/vehicleCompliances [get]
+// GetVehicleCompliances is a function uses to handle get vehicle compliance record list.
+func (s *VehicleCompliances) GetVehicleCompliances(c *gin.Context) {
+	var record entity.VehicleCompliance
+	var records entity.VehicleCompliances
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(record.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	records, meta, err := s.us.GetVehicleCompliances(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, records.DetailVehicleCompliances(), success.VehicleComplianceSuccessfullyGetVehicleComplianceList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Get vehicle compliance record
+// @Description Get detail of vehicle compliance record with link to download scan.
+// @Tags vehicle compliances
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Vehicle compliance UUID"
+// @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/vehicleCompliances/{uuid} [get]
+// GetVehicleCompliance is a function uses to handle get vehicle compliance record detail by UUID.
+func (s *VehicleCompliances) GetVehicleCompliance(c *gin.Context) {
+	UUID := c.Param("uuid")
+	record, err := s.us.GetVehicleCompliance(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextVehicleComplianceNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextVehicleComplianceNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, s.detailRecord(record), success.VehicleComplianceSuccessfullyGetVehicleComplianceDetail).
+		WithETag(record.UpdatedAt).
+		JSON()
+}
+
+// @Summary Get expiring vehicle documents
+// @Description Get vehicle documents which expire within given number of days and are not renewed yet.
+// @Tags vehicle compliances
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param days query int false "Number of days" default(30)
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/vehicleCompliances/expiring [get]
+// GetExpiringVehicleCompliances is a function uses to handle get vehicle documents expiring soon.
+func (s *VehicleCompliances) GetExpiringVehicleCompliances(c *gin.Context) {
+	request := entity.VehicleComplianceExpiringRequest{Days: entity.DefaultVehicleComplianceReminderDays}
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := request.ValidateVehicleComplianceExpiringRequest()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	records, err := s.us.GetExpiringVehicleCompliances(request.Days)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, entity.VehicleCompliances(records).DetailVehicleCompliances(), success.VehicleComplianceSuccessfullyGetExpiring).
+		JSON()
+}
+
+// uploadScan will upload scan of document when it is submitted, missing file is skipped.
+func (s *VehicleCompliances) uploadScan(c *gin.Context) (string, bool) {
+	file, _ := c.FormFile("file")
+	if file == nil {
+		return "", true
+	}
+	fileUUID, _, errException, errArgs := s.ss.UploadFile(file, storage.CategoryCompliance)
+	if errException != nil {
+		if errors.Is(errException, exception.ErrorTextStorageUploadInvalidSize) ||
+			errors.Is(errException, exception.ErrorTextStorageUploadInvalidFileType) {
+			c.Set("args", errArgs)
+		}
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+		return "", false
+	}
+	return fileUUID, true
+}
+
+// detailRecord will return compliance record with signed URL of scan.
+func (s *VehicleCompliances) detailRecord(record *entity.VehicleCompliance) interface{} {
+	var fileURL interface{}
+	if record.FileUUID != "" {
+		fileURL, _ = s.ss.GetFile(record.FileUUID)
+	}
+	return record.DetailVehicleCompliance(fileURL)
+}
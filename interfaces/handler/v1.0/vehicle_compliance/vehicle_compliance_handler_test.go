@@ -0,0 +1,301 @@
+package vehicleCompliancev1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// complianceForm return multipart body of compliance record request.
+func complianceForm(t *testing.T, fields map[string]string, withFile bool) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		_ = writer.WriteField(key, value)
+	}
+	if withFile {
+		part, err := writer.CreateFormFile("file", "insurance.pdf")
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		_, _ = part.Write([]byte("%PDF-1.4"))
+	}
+	_ = writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+// TestSaveVehicleCompliance_Success Test.
+func TestSaveVehicleCompliance_Success(t *testing.T) {
+	var recordData entity.DetailVehicleCompliance
+	var complianceApp mock.VehicleComplianceAppInterface
+	var storageApp mock.StorageAppInterface
+	complianceHandler := NewVehicleCompliances(&complianceApp, &storageApp)
+	UUID := uuid.New().String()
+	VehicleUUID := uuid.New().String()
+	FileUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/vehicleCompliances", complianceHandler.SaveVehicleCompliance)
+
+	complianceApp.SaveVehicleComplianceFn = func(
+		record *entity.VehicleCompliance,
+	) (*entity.VehicleCompliance, map[string]string, error) {
+		record.UUID = UUID
+		return record, nil, nil
+	}
+	storageApp.UploadFileFn = func(*multipart.FileHeader, string) (string, map[string]string, error, interface{}) {
+		return FileUUID, nil, nil, nil
+	}
+	storageApp.GetFileFn = func(string) (interface{}, error) {
+		return "https://storage.local/" + FileUUID, nil
+	}
+
+	body, contentType := complianceForm(t, map[string]string{
+		"vehicle_uuid": VehicleUUID,
+		"type":         "Insurance",
+		"number":       "XXX 0123456789",
+		"valid_until":  "2027-03-31",
+	}, true)
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/vehicleCompliances", body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &recordData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.EqualValues(t, recordData.UUID, UUID)
+	assert.EqualValues(t, recordData.Type, entity.VehicleComplianceInsurance)
+	assert.EqualValues(t, recordData.ValidUntil.Format(entity.VehicleComplianceDateLayout), "2027-03-31")
+	assert.EqualValues(t, recordData.FileUUID, FileUUID)
+	assert.EqualValues(t, recordData.FileURL, "https://storage.local/"+FileUUID)
+}
+
+// TestSaveVehicleCompliance_InvalidData Test.
+func TestSaveVehicleCompliance_InvalidData(t *testing.T) {
+	samples := []map[string]string{
+		{"vehicle_uuid": uuid.New().String(), "type": "insurance"},
+		{"vehicle_uuid": uuid.New().String(), "type": "unknown", "valid_until": "2027-03-31"},
+		{"vehicle_uuid": uuid.New().String(), "type": "maintenance", "valid_until": "2027-03-31"},
+		{"vehicle_uuid": "not-uuid", "type": "inspection", "valid_until": "2027-03-31"},
+		{"vehicle_uuid": uuid.New().String(), "type": "inspection", "valid_until": "31.03.2027"},
+	}
+
+	for _, v := range samples {
+		var complianceApp mock.VehicleComplianceAppInterface
+		var storageApp mock.StorageAppInterface
+		complianceHandler := NewVehicleCompliances(&complianceApp, &storageApp)
+		uploaded := false
+		storageApp.UploadFileFn = func(*multipart.FileHeader, string) (string, map[string]string, error, interface{}) {
+			uploaded = true
+			return uuid.New().String(), nil, nil, nil
+		}
+
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/vehicleCompliances", complianceHandler.SaveVehicleCompliance)
+
+		body, contentType := complianceForm(t, v, true)
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/vehicleCompliances", body)
+		c.Request.Header.Add("Content-Type", contentType)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+		assert.False(t, uploaded)
+	}
+}
+
+// TestUpdateVehicleCompliance_Success Test.
+func TestUpdateVehicleCompliance_Success(t *testing.T) {
+	var recordData entity.DetailVehicleCompliance
+	var complianceApp mock.VehicleComplianceAppInterface
+	var storageApp mock.StorageAppInterface
+	complianceHandler := NewVehicleCompliances(&complianceApp, &storageApp)
+	UUID := uuid.New().String()
+	VehicleUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.PUT("/vehicleCompliances/:uuid", complianceHandler.UpdateVehicleCompliance)
+
+	complianceApp.GetVehicleComplianceFn = func(string) (*entity.VehicleCompliance, error) {
+		return &entity.VehicleCompliance{UUID: UUID}, nil
+	}
+	complianceApp.UpdateVehicleComplianceFn = func(
+		recordUUID string,
+		record *entity.VehicleCompliance,
+	) (*entity.VehicleCompliance, map[string]string, error) {
+		record.UUID = recordUUID
+		return record, nil, nil
+	}
+
+	body, contentType := complianceForm(t, map[string]string{
+		"vehicle_uuid": VehicleUUID,
+		"type":         "maintenance",
+		"valid_from":   "2026-11-02",
+		"valid_until":  "2026-11-04",
+	}, false)
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPut, "/api/v1/external/vehicleCompliances/"+UUID, body)
+	c.Request.Header.Add("Content-Type", contentType)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &recordData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, recordData.UUID, UUID)
+	assert.EqualValues(t, recordData.Type, entity.VehicleComplianceMaintenance)
+	assert.EqualValues(t, recordData.ValidFrom.Format(entity.VehicleComplianceDateLayout), "2026-11-02")
+	assert.Empty(t, recordData.FileUUID)
+}
+
+// TestGetVehicleCompliance_Failed_NotFound Test.
+func TestGetVehicleCompliance_Failed_NotFound(t *testing.T) {
+	var complianceApp mock.VehicleComplianceAppInterface
+	complianceHandler := NewVehicleCompliances(&complianceApp, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/vehicleCompliances/:uuid", complianceHandler.GetVehicleCompliance)
+
+	complianceApp.GetVehicleComplianceFn = func(string) (*entity.VehicleCompliance, error) {
+		return nil, exception.ErrorTextVehicleComplianceNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/vehicleCompliances/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestDeleteVehicleCompliance_Success Test.
+func TestDeleteVehicleCompliance_Success(t *testing.T) {
+	var complianceApp mock.VehicleComplianceAppInterface
+	complianceHandler := NewVehicleCompliances(&complianceApp, nil)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/vehicleCompliances/:uuid", complianceHandler.DeleteVehicleCompliance)
+
+	complianceApp.DeleteVehicleComplianceFn = func(string) error {
+		return nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/vehicleCompliances/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+}
+
+// TestGetExpiringVehicleCompliances_Success Test.
+func TestGetExpiringVehicleCompliances_Success(t *testing.T) {
+	var recordsData []entity.DetailVehicleComplianceList
+	var complianceApp mock.VehicleComplianceAppInterface
+	complianceHandler := NewVehicleCompliances(&complianceApp, nil)
+	requestedDays := 0
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/vehicleCompliances/expiring", complianceHandler.GetExpiringVehicleCompliances)
+
+	complianceApp.GetExpiringVehicleCompliancesFn = func(days int) ([]*entity.VehicleCompliance, error) {
+		requestedDays = days
+		return []*entity.VehicleCompliance{
+			{
+				UUID:       uuid.New().String(),
+				Type:       entity.VehicleComplianceTachograph,
+				ValidUntil: time.Now().AddDate(0, 0, 5),
+				Vehicle:    entity.Vehicle{RegCode: "A123BC77"},
+			},
+		}, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/vehicleCompliances/expiring?days=14", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &recordsData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, 14, requestedDays)
+	assert.Len(t, recordsData, 1)
+	assert.EqualValues(t, recordsData[0].RegCode, "A123BC77")
+}
+
+// TestGetExpiringVehicleCompliances_InvalidDays Test.
+func TestGetExpiringVehicleCompliances_InvalidDays(t *testing.T) {
+	var complianceApp mock.VehicleComplianceAppInterface
+	complianceHandler := NewVehicleCompliances(&complianceApp, nil)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/vehicleCompliances/expiring", complianceHandler.GetExpiringVehicleCompliances)
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/vehicleCompliances/expiring?days=1000", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+}
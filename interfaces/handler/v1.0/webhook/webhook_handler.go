@@ -0,0 +1,356 @@
+package webhookv1point00
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/infrastructure/message/success"
+	"cargo-rest-api/pkg/response"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Webhooks is a struct defines the dependencies that will be used.
+type Webhooks struct {
+	us application.WebhookAppInterface
+}
+
+// NewWebhooks is constructor will initialize webhook handler.
+func NewWebhooks(us application.WebhookAppInterface) *Webhooks {
+	return &Webhooks{
+		us: us,
+	}
+}
+
+// @Summary Create a new webhook subscription
+// @Description Subscribe URL of application to events: order.created, order.status_changed, payment.succeeded,
+// @Description trip.delayed or * for every event. Every delivery is a POST request signed by HMAC-SHA256 of
+// @Description "timestamp.body" with secret of subscription in X-Webhook-Signature header "t=<unix>,v1=<hex>".
+// @Description X-Webhook-Id is an ID of event, it is the same in retries and replays of event.
+// @Description Secret is returned only in response of this request.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param webhook body entity.WebhookSubscriptionFieldsForDetail true "Webhook subscription data"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhooks [post]
+// SaveWebhookSubscription is a function uses to handle create a new webhook subscription.
+func (s *Webhooks) SaveWebhookSubscription(c *gin.Context) {
+	var subscriptionEntity entity.WebhookSubscription
+	if err := c.ShouldBindJSON(&subscriptionEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := subscriptionEntity.ValidateSaveWebhookSubscription()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	subscriptionEntity.Prepare()
+
+	newSubscription, errDesc, errException := s.us.SaveWebhookSubscription(&subscriptionEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, newSubscription.DetailWebhookSubscriptionSecret(), success.WebhookSuccessfullyCreateWebhook).
+		JSON()
+}
+
+// @Summary Update webhook subscription
+// @Description Update an existing webhook subscription, secret is kept. Disabled subscription receives no events.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Webhook subscription UUID"
+// @Param webhook body entity.WebhookSubscriptionFieldsForDetail true "Webhook subscription data"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhooks/{uuid} [put]
+// UpdateWebhookSubscription is a function uses to handle update webhook subscription by UUID.
+func (s *Webhooks) UpdateWebhookSubscription(c *gin.Context) {
+	var subscriptionEntity entity.WebhookSubscription
+	if err := c.ShouldBindUri(&subscriptionEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	if err := c.ShouldBindJSON(&subscriptionEntity); err != nil {
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	validateErr := subscriptionEntity.ValidateUpdateWebhookSubscription()
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+	subscriptionEntity.Prepare()
+
+	UUID := c.Param("uuid")
+	_, err := s.us.GetWebhookSubscription(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextWebhookNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextWebhookNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	updatedSubscription, errDesc, errException := s.us.UpdateWebhookSubscription(UUID, &subscriptionEntity)
+	if errException != nil {
+		c.Set("data", errDesc)
+		if errors.Is(errException, exception.ErrorTextWebhookNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, errException)
+			return
+		}
+		if errors.Is(errException, exception.ErrorTextUnprocessableEntity) {
+			_ = c.AbortWithError(http.StatusUnprocessableEntity, errException)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextInternalServerError)
+		return
+	}
+	c.Status(http.StatusOK)
+	response.NewSuccess(c, updatedSubscription.DetailWebhookSubscription(), success.WebhookSuccessfullyUpdateWebhook).
+		JSON()
+}
+
+// @Summary Delete webhook subscription
+// @Description Delete an existing webhook subscription, pending deliveries are not sent.
+// @Tags webhooks
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Param If-Match header string false "ETag of record, change is rejected when record was changed since"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Webhook subscription UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 412 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhooks/{uuid} [delete]
+// DeleteWebhookSubscription is a function uses to handle delete webhook subscription by UUID.
+func (s *Webhooks) DeleteWebhookSubscription(c *gin.Context) {
+	var subscriptionEntity entity.WebhookSubscription
+	if err := c.ShouldBindUri(&subscriptionEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	err := s.us.DeleteWebhookSubscription(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextWebhookNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextWebhookNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, nil, success.WebhookSuccessfullyDeleteWebhook).JSON()
+}
+
+// @Summary Get webhook subscriptions
+// @Description Get list of existing webhook subscriptions.
+// @Tags webhooks
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhooks [get]
+// GetWebhookSubscriptions is a function uses to handle get webhook subscription list.
+func (s *Webhooks) GetWebhookSubscriptions(c *gin.Context) {
+	var subscription entity.WebhookSubscription
+	var subscriptions entity.WebhookSubscriptions
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(subscription.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	subscriptions, meta, err := s.us.GetWebhookSubscriptions(parameters)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, subscriptions.DetailWebhookSubscriptions(), success.WebhookSuccessfullyGetWebhookList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Get webhook subscription
+// @Description Get detail of existing webhook subscription.
+// @Tags webhooks
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Webhook subscription UUID"
+// @Success 200 {object} response.successOutput
+// @Header 200 {string} ETag "Version of record, sent in If-Match header of update and delete"
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhooks/{uuid} [get]
+// GetWebhookSubscription is a function uses to handle get webhook subscription detail by UUID.
+func (s *Webhooks) GetWebhookSubscription(c *gin.Context) {
+	var subscriptionEntity entity.WebhookSubscription
+	if err := c.ShouldBindUri(&subscriptionEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	subscription, err := s.us.GetWebhookSubscription(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextWebhookNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextWebhookNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.NewSuccess(c, subscription.DetailWebhookSubscription(), success.WebhookSuccessfullyGetWebhookDetail).
+		WithETag(subscription.UpdatedAt).
+		JSON()
+}
+
+// @Summary Get webhook deliveries
+// @Description Get delivery log of webhook subscription, the latest first. Failed delivery has error and response
+// @Description of the last attempt, pending delivery has time of the next attempt.
+// @Tags webhooks
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Webhook subscription UUID"
+// @Success 200 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 422 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhooks/{uuid}/deliveries [get]
+// GetWebhookDeliveries is a function uses to handle get delivery list of webhook subscription.
+func (s *Webhooks) GetWebhookDeliveries(c *gin.Context) {
+	var delivery entity.WebhookDelivery
+	var deliveries entity.WebhookDeliveries
+	var err error
+	parameters := repository.NewGinParameters(c)
+	validateErr := parameters.ValidateParameter(delivery.FilterableFields()...)
+	if len(validateErr) > 0 {
+		exceptionData := response.TranslateErrorForm(c, validateErr)
+		c.Set("data", exceptionData)
+		_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextUnprocessableEntity)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	deliveries, meta, err := s.us.GetWebhookDeliveries(UUID, parameters)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextWebhookNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextWebhookNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	response.NewSuccess(c, deliveries.DetailWebhookDeliveries(), success.WebhookSuccessfullyGetWebhookDeliveryList).
+		WithMeta(meta).
+		JSON()
+}
+
+// @Summary Replay webhook delivery
+// @Description Queue a new delivery of event of delivery, subscriber recognizes repeated event by X-Webhook-Id.
+// @Tags webhooks
+// @Produce json
+// @Param Accept-Language header string false "Language code" Enums(en, ru) default(en)
+// @Param Set-Request-Id header string false "Request id"
+// @Security BasicAuth
+// @Security JWTAuth
+// @Param uuid path string true "Webhook delivery UUID"
+// @Success 201 {object} response.successOutput
+// @Failure 400 {object} response.errorOutput
+// @Failure 401 {object} response.errorOutput
+// @Failure 403 {object} response.errorOutput
+// @Failure 404 {object} response.errorOutput
+// @Failure 500 {object} response.errorOutput
+// @Router /api/v1/external/webhookDeliveries/{uuid}/replay [post]
+// ReplayWebhookDelivery is a function uses to handle replay of webhook delivery by UUID.
+func (s *Webhooks) ReplayWebhookDelivery(c *gin.Context) {
+	var deliveryEntity entity.WebhookDelivery
+	if err := c.ShouldBindUri(&deliveryEntity.UUID); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+		return
+	}
+
+	UUID := c.Param("uuid")
+	replay, err := s.us.ReplayWebhookDelivery(UUID)
+	if err != nil {
+		if errors.Is(err, exception.ErrorTextWebhookDeliveryNotFound) {
+			_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextWebhookDeliveryNotFound)
+			return
+		}
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusCreated)
+	response.NewSuccess(c, replay.DetailWebhookDelivery(), success.WebhookSuccessfullyReplayWebhookDelivery).JSON()
+}
@@ -0,0 +1,391 @@
+package webhookv1point00
+
+import (
+	"bytes"
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/encoder"
+	"cargo-rest-api/tests/mock"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// webhookSubscription return webhook subscription of order events.
+func webhookSubscription() *entity.WebhookSubscription {
+	return &entity.WebhookSubscription{
+		UUID:            uuid.New().String(),
+		ApplicationUUID: uuid.New().String(),
+		URL:             "https://partner.example.com/hooks",
+		Events:          []string{entity.WebhookEventOrderCreated, entity.WebhookEventOrderStatusChanged},
+		Secret:          "whsec_secret",
+	}
+}
+
+// TestSaveWebhookSubscription_Success Test.
+func TestSaveWebhookSubscription_Success(t *testing.T) {
+	var subscriptionData entity.DetailWebhookSubscriptionSecret
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+	applicationUUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/webhooks", webhookHandler.SaveWebhookSubscription)
+
+	var saved *entity.WebhookSubscription
+	webhookApp.SaveWebhookSubscriptionFn = func(
+		subscription *entity.WebhookSubscription,
+	) (*entity.WebhookSubscription, map[string]string, error) {
+		saved = subscription
+		subscription.UUID = uuid.New().String()
+		subscription.Secret = "whsec_secret"
+		return subscription, nil, nil
+	}
+
+	inputJSON := `{
+		"application_uuid": "` + applicationUUID + `",
+		"url": "https://partner.example.com/hooks",
+		"events": ["order.created", "payment.succeeded"]
+	}`
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/webhooks", bytes.NewBufferString(inputJSON))
+	c.Request.Header.Add("Content-Type", "application/json")
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &subscriptionData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.Equal(t, "order.created,payment.succeeded", saved.EventTypes)
+	assert.EqualValues(t, applicationUUID, subscriptionData.ApplicationUUID)
+	assert.EqualValues(t, []string{"order.created", "payment.succeeded"}, subscriptionData.Events)
+	assert.EqualValues(t, "whsec_secret", subscriptionData.Secret)
+}
+
+// TestSaveWebhookSubscription_InvalidData Test.
+func TestSaveWebhookSubscription_InvalidData(t *testing.T) {
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+	applicationUUID := uuid.New().String()
+
+	samples := []struct {
+		inputJSON  string
+		statusCode int
+	}{
+		{
+			inputJSON:  `{"url": "https://partner.example.com/hooks", "events": ["order.created"]}`,
+			statusCode: 422,
+		},
+		{
+			inputJSON:  `{"application_uuid": "` + applicationUUID + `", "url": "partner", "events": ["order.created"]}`,
+			statusCode: 422,
+		},
+		{
+			inputJSON:  `{"application_uuid": "` + applicationUUID + `", "url": "https://partner.example.com/hooks"}`,
+			statusCode: 422,
+		},
+		{
+			inputJSON:  `{"application_uuid": "` + applicationUUID + `", "url": "https://partner.example.com/hooks", "events": ["order.deleted"]}`,
+			statusCode: 422,
+		},
+	}
+
+	for _, v := range samples {
+		gin.SetMode(gin.TestMode)
+		w := httptest.NewRecorder()
+		c, r := gin.CreateTestContext(w)
+		v1 := r.Group("/api/v1/external/")
+		v1.POST("/webhooks", webhookHandler.SaveWebhookSubscription)
+
+		var err error
+		c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/webhooks", bytes.NewBufferString(v.inputJSON))
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, c.Request)
+
+		assert.Equal(t, w.Code, v.statusCode)
+	}
+}
+
+// TestSaveWebhookSubscription_InvalidApplication Test.
+func TestSaveWebhookSubscription_InvalidApplication(t *testing.T) {
+	var abortData interface{}
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Next()
+		abortData, _ = c.Get("data")
+	})
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/webhooks", webhookHandler.SaveWebhookSubscription)
+
+	webhookApp.SaveWebhookSubscriptionFn = func(
+		*entity.WebhookSubscription,
+	) (*entity.WebhookSubscription, map[string]string, error) {
+		errDesc := map[string]string{"application_uuid": exception.ErrorTextWebhookInvalidApplication.Error()}
+		return nil, errDesc, exception.ErrorTextUnprocessableEntity
+	}
+
+	inputJSON := `{
+		"application_uuid": "` + uuid.New().String() + `",
+		"url": "https://partner.example.com/hooks",
+		"events": ["*"]
+	}`
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/webhooks", bytes.NewBufferString(inputJSON))
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusUnprocessableEntity)
+	assert.Equal(t, map[string]string{"application_uuid": "api.msg.error.webhook.invalid_application"}, abortData)
+}
+
+// TestGetWebhookSubscriptions_Success Test.
+func TestGetWebhookSubscriptions_Success(t *testing.T) {
+	var webhookApp mock.WebhookAppInterface
+	var subscriptionsData []map[string]interface{}
+	var metaData repository.Meta
+	webhookHandler := NewWebhooks(&webhookApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/webhooks", webhookHandler.GetWebhookSubscriptions)
+	webhookApp.GetWebhookSubscriptionsFn = func(
+		params *repository.Parameters,
+	) ([]*entity.WebhookSubscription, *repository.Meta, error) {
+		subscriptions := []*entity.WebhookSubscription{webhookSubscription(), webhookSubscription()}
+		meta := repository.NewMeta(params, int64(len(subscriptions)))
+		return subscriptions, meta, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/webhooks", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+	meta, _ := json.Marshal(response["meta"])
+
+	_ = json.Unmarshal(data, &subscriptionsData)
+	_ = json.Unmarshal(meta, &metaData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.EqualValues(t, 2, len(subscriptionsData))
+	assert.EqualValues(t, 2, metaData.Total)
+	assert.NotContains(t, subscriptionsData[0], "secret")
+}
+
+// TestDeleteWebhookSubscription_Failed_WebhookNotFound Test.
+func TestDeleteWebhookSubscription_Failed_WebhookNotFound(t *testing.T) {
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+	UUID := uuid.New().String()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.DELETE("/webhooks/:uuid", webhookHandler.DeleteWebhookSubscription)
+
+	webhookApp.DeleteWebhookSubscriptionFn = func(UUID string) error {
+		return exception.ErrorTextWebhookNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodDelete, "/api/v1/external/webhooks/"+UUID, nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestGetWebhookDeliveries_Success Test.
+func TestGetWebhookDeliveries_Success(t *testing.T) {
+	var webhookApp mock.WebhookAppInterface
+	var deliveriesData []entity.DetailWebhookDelivery
+	webhookHandler := NewWebhooks(&webhookApp)
+	subscription := webhookSubscription()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/webhooks/:uuid/deliveries", webhookHandler.GetWebhookDeliveries)
+
+	var requested string
+	webhookApp.GetWebhookDeliveriesFn = func(
+		subscriptionUUID string,
+		params *repository.Parameters,
+	) ([]*entity.WebhookDelivery, *repository.Meta, error) {
+		requested = subscriptionUUID
+		deliveries := []*entity.WebhookDelivery{
+			{
+				UUID:             uuid.New().String(),
+				SubscriptionUUID: subscriptionUUID,
+				EventID:          uuid.New().String(),
+				Event:            entity.WebhookEventOrderCreated,
+				Status:           entity.WebhookDeliveryStatusFailed,
+				Attempts:         entity.DefaultWebhookMaxAttempts,
+				ResponseStatus:   http.StatusServiceUnavailable,
+			},
+		}
+		return deliveries, repository.NewMeta(params, int64(len(deliveries))), nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodGet,
+		"/api/v1/external/webhooks/"+subscription.UUID+"/deliveries?status=failed",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &deliveriesData)
+
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, subscription.UUID, requested)
+	assert.Len(t, deliveriesData, 1)
+	assert.EqualValues(t, entity.WebhookDeliveryStatusFailed, deliveriesData[0].Status)
+	assert.EqualValues(t, http.StatusServiceUnavailable, deliveriesData[0].ResponseStatus)
+}
+
+// TestGetWebhookDeliveries_Failed_WebhookNotFound Test.
+func TestGetWebhookDeliveries_Failed_WebhookNotFound(t *testing.T) {
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.GET("/webhooks/:uuid/deliveries", webhookHandler.GetWebhookDeliveries)
+
+	webhookApp.GetWebhookDeliveriesFn = func(
+		string,
+		*repository.Parameters,
+	) ([]*entity.WebhookDelivery, *repository.Meta, error) {
+		return nil, nil, exception.ErrorTextWebhookNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodGet, "/api/v1/external/webhooks/"+uuid.New().String()+"/deliveries", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
+
+// TestReplayWebhookDelivery_Success Test.
+func TestReplayWebhookDelivery_Success(t *testing.T) {
+	var deliveryData entity.DetailWebhookDelivery
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+	delivery := &entity.WebhookDelivery{
+		UUID:             uuid.New().String(),
+		SubscriptionUUID: uuid.New().String(),
+		EventID:          uuid.New().String(),
+		Event:            entity.WebhookEventTripDelayed,
+		Payload:          `{"id":"event"}`,
+		Status:           entity.WebhookDeliveryStatusFailed,
+		Attempts:         entity.DefaultWebhookMaxAttempts,
+	}
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/webhookDeliveries/:uuid/replay", webhookHandler.ReplayWebhookDelivery)
+
+	webhookApp.ReplayWebhookDeliveryFn = func(UUID string) (*entity.WebhookDelivery, error) {
+		replay := delivery.Replay()
+		replay.UUID = uuid.New().String()
+		return replay, nil
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPost, "/api/v1/external/webhookDeliveries/"+delivery.UUID+"/replay", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	response := encoder.ResponseDecoder(w.Body)
+	data, _ := json.Marshal(response["data"])
+
+	_ = json.Unmarshal(data, &deliveryData)
+
+	assert.Equal(t, w.Code, http.StatusCreated)
+	assert.NotEqual(t, delivery.UUID, deliveryData.UUID)
+	assert.Equal(t, delivery.UUID, deliveryData.ReplayOf)
+	assert.Equal(t, delivery.EventID, deliveryData.EventID)
+	assert.Equal(t, delivery.Payload, deliveryData.Payload)
+	assert.Equal(t, entity.WebhookDeliveryStatusPending, deliveryData.Status)
+	assert.Equal(t, 0, deliveryData.Attempts)
+}
+
+// TestReplayWebhookDelivery_Failed_DeliveryNotFound Test.
+func TestReplayWebhookDelivery_Failed_DeliveryNotFound(t *testing.T) {
+	var webhookApp mock.WebhookAppInterface
+	webhookHandler := NewWebhooks(&webhookApp)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	v1 := r.Group("/api/v1/external/")
+	v1.POST("/webhookDeliveries/:uuid/replay", webhookHandler.ReplayWebhookDelivery)
+
+	webhookApp.ReplayWebhookDeliveryFn = func(UUID string) (*entity.WebhookDelivery, error) {
+		return nil, exception.ErrorTextWebhookDeliveryNotFound
+	}
+
+	var err error
+	c.Request, err = http.NewRequest(
+		http.MethodPost,
+		"/api/v1/external/webhookDeliveries/"+uuid.New().String()+"/replay",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, w.Code, http.StatusNotFound)
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"cargo-rest-api/pkg/audit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyApplicationInterface is an interface of storage which returns application and UUID of API key.
+type APIKeyApplicationInterface interface {
+	GetAPIKeyApplication(apiKey string) (applicationUUID string, keyUUID string, err error)
+}
+
+// Audit is a middleware function uses to bind actor of request to context of request, changes of data made
+// with the context are recorded with the actor. Application of API key is resolved once per request,
+// user is read when change is written, so user authenticated by route guard is recorded.
+func Audit(keys APIKeyApplicationInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request := audit.Actor{APIKey: c.Request.Header.Get("api_key")}
+		if request.APIKey != "" && keys != nil {
+			applicationUUID, keyUUID, err := keys.GetAPIKeyApplication(request.APIKey)
+			if err == nil {
+				request.ApplicationUUID = applicationUUID
+				request.APIKeyUUID = keyUUID
+			}
+		}
+
+		ctx := audit.WithActorFunc(c.Request.Context(), func() *audit.Actor {
+			actor := request
+			actor.RequestID = c.Writer.Header().Get("X-Request-Id")
+			if UUID, exists := c.Get("UUID"); exists {
+				actor.UserUUID, _ = UUID.(string)
+			}
+			return &actor
+		})
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
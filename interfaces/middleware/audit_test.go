@@ -0,0 +1,62 @@
+package middleware_test
+
+import (
+	"cargo-rest-api/interfaces/middleware"
+	"cargo-rest-api/pkg/audit"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// apiKeys is a storage of API keys counting lookups.
+type apiKeys struct {
+	lookups int
+}
+
+func (k *apiKeys) GetAPIKeyApplication(apiKey string) (string, string, error) {
+	k.lookups++
+	if apiKey != "key-1" {
+		return "", "", errors.New("not found")
+	}
+	return "application-1", "key-uuid-1", nil
+}
+
+func TestAudit(t *testing.T) {
+	var actor *audit.Actor
+	keys := &apiKeys{}
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, r := gin.CreateTestContext(w)
+	r.Use(middleware.SetRequestID(middleware.RequestIDOptions{AllowSetting: true}))
+	r.Use(middleware.Audit(keys))
+	r.PUT("/test", func(c *gin.Context) {
+		c.Set("UUID", "user-1")
+		actor = audit.ActorFromContext(c.Request.Context())
+		_ = audit.ActorFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	var err error
+	c.Request, err = http.NewRequest(http.MethodPut, "/test", nil)
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	c.Request.Header.Set("Set-Request-Id", "request-1")
+	c.Request.Header.Set("api_key", "key-1")
+	r.ServeHTTP(w, c.Request)
+
+	assert.Equal(t, &audit.Actor{
+		UserUUID:        "user-1",
+		ApplicationUUID: "application-1",
+		APIKey:          "key-1",
+		APIKeyUUID:      "key-uuid-1",
+		RequestID:       "request-1",
+	}, actor)
+	assert.Equal(t, 1, keys.lookups)
+	assert.Nil(t, audit.ActorFromContext(c.Request.Context()))
+}
@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"bytes"
+	"cargo-rest-api/infrastructure/message/exception"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// HeaderIdempotencyKey is a name of header containing key of request generated by client.
+	HeaderIdempotencyKey = "Idempotency-Key"
+
+	// HeaderIdempotentReplayed is a name of header set on stored response returned for repeated request.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotentResponse represent response stored by idempotency key, response has no status
+// while the first request is processed.
+type IdempotentResponse struct {
+	Fingerprint string `json:"fingerprint"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Completed return true when response of request is stored.
+func (r *IdempotentResponse) Completed() bool {
+	return r.Status != 0
+}
+
+// IdempotencyStoreInterface is an interface of storage of responses by idempotency key.
+// Reserve stores pending response when key is not used yet, otherwise it returns stored response.
+type IdempotencyStoreInterface interface {
+	Reserve(key string, pending *IdempotentResponse, ttl time.Duration) (*IdempotentResponse, error)
+	Save(key string, response *IdempotentResponse, ttl time.Duration) error
+	Release(key string) error
+}
+
+// IdempotencyOptions is a struct to store option of Idempotency.
+// TTL is a lifetime of stored response, LockTTL is a lifetime of pending response of request
+// which was interrupted before response was stored.
+type IdempotencyOptions struct {
+	Store   IdempotencyStoreInterface
+	TTL     time.Duration
+	LockTTL time.Duration
+}
+
+// RedisIdempotencyStore is a storage of responses by idempotency key backed by redis.
+type RedisIdempotencyStore struct {
+	client *redis.Client
+}
+
+// NewRedisIdempotencyStore will initialize redis idempotency store.
+func NewRedisIdempotencyStore(client *redis.Client) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{client: client}
+}
+
+// Reserve will store pending response when key is not used yet, stored response is returned otherwise.
+func (s *RedisIdempotencyStore) Reserve(
+	key string,
+	pending *IdempotentResponse,
+	ttl time.Duration,
+) (*IdempotentResponse, error) {
+	ctx := context.Background()
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; attempt < 2; attempt++ {
+		reserved, err := s.client.SetNX(ctx, key, data, ttl).Result()
+		if err != nil {
+			return nil, err
+		}
+		if reserved {
+			return nil, nil
+		}
+		stored, err := s.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var response IdempotentResponse
+		if err := json.Unmarshal(stored, &response); err != nil {
+			return nil, err
+		}
+		return &response, nil
+	}
+	return nil, fmt.Errorf("idempotency key %s is not reserved", key)
+}
+
+// Save will store response by key.
+func (s *RedisIdempotencyStore) Save(key string, response *IdempotentResponse, ttl time.Duration) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(context.Background(), key, data, ttl).Err()
+}
+
+// Release will remove response by key, so request can be repeated with the same key.
+func (s *RedisIdempotencyStore) Release(key string) error {
+	return s.client.Del(context.Background(), key).Err()
+}
+
+// Idempotency is a middleware function uses to return stored response of request repeated with the same
+// Idempotency-Key header, keys are scoped by authenticated user.
+// Request repeated while the first one is processed is rejected with 409 and key reused for another request
+// is rejected with 422. Failed responses are not stored, so request can be retried with the same key.
+// Requests are handled as usual when store is not available.
+func Idempotency(options IdempotencyOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderIdempotencyKey)
+		if key == "" || options.Store == nil {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+			return
+		}
+
+		fingerprint, err := requestFingerprint(c)
+		if err != nil {
+			_ = c.AbortWithError(http.StatusBadRequest, exception.ErrorTextBadRequest)
+			return
+		}
+
+		storeKey := fmt.Sprintf("idempotency:%s:%s", idempotencyScope(c), key)
+		stored, err := options.Store.Reserve(storeKey, &IdempotentResponse{Fingerprint: fingerprint}, options.LockTTL)
+		if err != nil {
+			c.Next()
+			return
+		}
+		if stored != nil {
+			switch {
+			case stored.Fingerprint != fingerprint:
+				_ = c.AbortWithError(http.StatusUnprocessableEntity, exception.ErrorTextIdempotencyKeyReused)
+			case !stored.Completed():
+				_ = c.AbortWithError(http.StatusConflict, exception.ErrorTextIdempotencyKeyInProgress)
+			default:
+				c.Header(HeaderIdempotentReplayed, "true")
+				c.Data(stored.Status, stored.ContentType, stored.Body)
+				c.Abort()
+			}
+			return
+		}
+
+		w := &responseBodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+
+		if len(c.Errors) > 0 || w.Status() >= http.StatusInternalServerError {
+			_ = options.Store.Release(storeKey)
+			return
+		}
+		_ = options.Store.Save(storeKey, &IdempotentResponse{
+			Fingerprint: fingerprint,
+			Status:      w.Status(),
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		}, options.TTL)
+	}
+}
+
+// idempotencyScope return authenticated user of request, client IP address is used for anonymous request.
+func idempotencyScope(c *gin.Context) string {
+	if UUID, exists := c.Get("UUID"); exists {
+		if user, ok := UUID.(string); ok && user != "" {
+			return "user:" + user
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// requestFingerprint return hash of method, path and body of request, body is restored for handler.
+func requestFingerprint(c *gin.Context) (string, error) {
+	var body []byte
+	if c.Request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			return "", err
+		}
+		c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	}
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
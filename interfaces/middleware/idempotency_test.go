@@ -0,0 +1,128 @@
+package middleware_test
+
+import (
+	"cargo-rest-api/interfaces/middleware"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryIdempotencyStore struct {
+	responses map[string]*middleware.IdempotentResponse
+}
+
+func (s *memoryIdempotencyStore) Reserve(
+	key string,
+	pending *middleware.IdempotentResponse,
+	ttl time.Duration,
+) (*middleware.IdempotentResponse, error) {
+	if stored, ok := s.responses[key]; ok {
+		return stored, nil
+	}
+	s.responses[key] = pending
+	return nil, nil
+}
+
+func (s *memoryIdempotencyStore) Save(key string, response *middleware.IdempotentResponse, ttl time.Duration) error {
+	s.responses[key] = response
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(key string) error {
+	delete(s.responses, key)
+	return nil
+}
+
+func newIdempotencyRouter(store *memoryIdempotencyStore, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/order", func(c *gin.Context) {
+		c.Set("UUID", c.GetHeader("X-User"))
+	}, middleware.Idempotency(middleware.IdempotencyOptions{
+		Store:   store,
+		TTL:     time.Hour,
+		LockTTL: time.Minute,
+	}), handler)
+	return r
+}
+
+func sendIdempotentRequest(t *testing.T, r *gin.Engine, user string, key string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, "/order", strings.NewReader(body))
+	if err != nil {
+		t.Errorf("this is the error: %v\n", err)
+	}
+	req.Header.Set("X-User", user)
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotency_Replay(t *testing.T) {
+	store := &memoryIdempotencyStore{responses: map[string]*middleware.IdempotentResponse{}}
+	calls := 0
+	r := newIdempotencyRouter(store, func(c *gin.Context) {
+		calls++
+		body, _ := ioutil.ReadAll(c.Request.Body)
+		c.Data(http.StatusCreated, "application/json", append([]byte(`{"call":`), append(body, '}')...))
+	})
+
+	first := sendIdempotentRequest(t, r, "user-1", "key-1", `1`)
+	second := sendIdempotentRequest(t, r, "user-1", "key-1", `1`)
+	otherUser := sendIdempotentRequest(t, r, "user-2", "key-1", `1`)
+	withoutKey := sendIdempotentRequest(t, r, "user-1", "", `1`)
+
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, `{"call":1}`, second.Body.String())
+	assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, "", first.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, http.StatusCreated, otherUser.Code)
+	assert.Equal(t, http.StatusCreated, withoutKey.Code)
+}
+
+func TestIdempotency_ConflictAndReuse(t *testing.T) {
+	store := &memoryIdempotencyStore{responses: map[string]*middleware.IdempotentResponse{}}
+	var r *gin.Engine
+	var concurrent *httptest.ResponseRecorder
+	r = newIdempotencyRouter(store, func(c *gin.Context) {
+		concurrent = sendIdempotentRequest(t, r, "user-1", "key-1", `1`)
+		c.Status(http.StatusCreated)
+	})
+
+	first := sendIdempotentRequest(t, r, "user-1", "key-1", `1`)
+	reused := sendIdempotentRequest(t, r, "user-1", "key-1", `2`)
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusConflict, concurrent.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+}
+
+func TestIdempotency_FailedResponseIsNotStored(t *testing.T) {
+	store := &memoryIdempotencyStore{responses: map[string]*middleware.IdempotentResponse{}}
+	calls := 0
+	r := newIdempotencyRouter(store, func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusCreated)
+	})
+
+	first := sendIdempotentRequest(t, r, "user-1", "key-1", `1`)
+	retry := sendIdempotentRequest(t, r, "user-1", "key-1", `1`)
+
+	assert.Equal(t, http.StatusInternalServerError, first.Code)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, 2, calls)
+}
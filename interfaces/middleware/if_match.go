@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/pkg/etag"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// conditionWriter is a response writer replacing status of change rejected by condition of If-Match with 412.
+type conditionWriter struct {
+	gin.ResponseWriter
+	condition *etag.Condition
+	rejected  bool
+}
+
+// WriteHeader will write 412 instead of status of change which did not match condition or record not found.
+func (w *conditionWriter) WriteHeader(code int) {
+	if w.condition.Failed || (!w.condition.Checked && code == http.StatusNotFound) {
+		w.rejected = true
+		code = http.StatusPreconditionFailed
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// IfMatch is a middleware function uses to reject change of record which was changed after client has read it.
+// Condition of If-Match header is passed to storage in context of request and checked in the statement which
+// changes record of model. Requests without header are handled as usual, change of record which does not match
+// header or does not exist is rejected with 412.
+func IfMatch(model interface{}) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(etag.HeaderIfMatch)
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		condition := etag.NewCondition(model, header)
+		if !condition.Matchable() {
+			_ = c.AbortWithError(http.StatusPreconditionFailed, exception.ErrorTextPreconditionFailed)
+			return
+		}
+		w := &conditionWriter{ResponseWriter: c.Writer, condition: condition}
+		c.Writer = w
+		c.Request = c.Request.WithContext(etag.WithCondition(c.Request.Context(), condition))
+		c.Next()
+
+		if w.rejected {
+			c.Errors = c.Errors[:0]
+			_ = c.Error(exception.ErrorTextPreconditionFailed)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package middleware_test
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/infrastructure/message/exception"
+	"cargo-rest-api/interfaces/middleware"
+	"cargo-rest-api/pkg/etag"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryTrips struct {
+	versions map[string]time.Time
+}
+
+// UpdateTrip will change trip as storage does: only trip matching condition of request is changed.
+func (s *memoryTrips) UpdateTrip(c *gin.Context) {
+	updatedAt, ok := s.versions[c.Param("uuid")]
+	if !ok {
+		_ = c.AbortWithError(http.StatusNotFound, exception.ErrorTextTripNotFound)
+		return
+	}
+	condition := etag.ConditionFromContext(c.Request.Context())
+	if condition != nil {
+		matched := condition.Any
+		for _, version := range condition.Versions {
+			matched = matched || version.Equal(updatedAt)
+		}
+		if matched {
+			condition.Resolve(1)
+		} else {
+			condition.Resolve(0)
+			_ = c.AbortWithError(http.StatusInternalServerError, exception.ErrorTextAnErrorOccurred)
+			return
+		}
+	}
+	s.versions[c.Param("uuid")] = updatedAt.Add(time.Second)
+	c.Status(http.StatusOK)
+}
+
+func TestIfMatch(t *testing.T) {
+	updatedAt := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		uuid    string
+		ifMatch string
+		code    int
+	}{
+		{uuid: "trip-1", ifMatch: "", code: http.StatusOK},
+		{uuid: "trip-1", ifMatch: etag.New(updatedAt), code: http.StatusOK},
+		{uuid: "trip-1", ifMatch: "*", code: http.StatusOK},
+		{uuid: "trip-1", ifMatch: etag.New(updatedAt.Add(-time.Second)), code: http.StatusPreconditionFailed},
+		{uuid: "trip-1", ifMatch: "W/" + etag.New(updatedAt), code: http.StatusPreconditionFailed},
+		{uuid: "trip-2", ifMatch: etag.New(updatedAt), code: http.StatusPreconditionFailed},
+		{uuid: "trip-2", ifMatch: "", code: http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		trips := &memoryTrips{versions: map[string]time.Time{"trip-1": updatedAt}}
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.PUT("/trip/:uuid", middleware.IfMatch(&entity.Trip{}), trips.UpdateTrip)
+
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPut, "/trip/"+tc.uuid, nil)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		if tc.ifMatch != "" {
+			req.Header.Set("If-Match", tc.ifMatch)
+		}
+		r.ServeHTTP(w, req)
+		assert.Equal(t, tc.code, w.Code, tc.ifMatch)
+		if tc.code == http.StatusPreconditionFailed && tc.uuid == "trip-1" {
+			assert.Equal(t, updatedAt, trips.versions["trip-1"])
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"cargo-rest-api/infrastructure/message/exception"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+)
+
+// RateLimiterInterface is an interface of storage which counts requests in a time window.
+type RateLimiterInterface interface {
+	Allow(key string, limit int, window time.Duration) (bool, error)
+}
+
+// RateLimitOptions is a struct to store option of RateLimit.
+type RateLimitOptions struct {
+	Limiter RateLimiterInterface
+	Prefix  string
+	Limit   int
+	Window  time.Duration
+}
+
+// RedisRateLimiter is a fixed window rate limiter backed by redis.
+type RedisRateLimiter struct {
+	client *redis.Client
+}
+
+// NewRedisRateLimiter will initialize redis rate limiter.
+func NewRedisRateLimiter(client *redis.Client) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client}
+}
+
+// Allow will count request for the key and return false when limit of the window is exceeded.
+// Counter is created with expiration and incremented in one transaction, so it never stays without expiration.
+func (l *RedisRateLimiter) Allow(key string, limit int, window time.Duration) (bool, error) {
+	ctx := context.Background()
+	var count *redis.IntCmd
+	_, err := l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetNX(ctx, key, 0, window)
+		count = pipe.Incr(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return count.Val() <= int64(limit), nil
+}
+
+// RateLimit is a middleware function uses to limit amount of requests per client IP address.
+// Requests are not limited when limiter is not available, so an outage of the limiter does not break the API.
+func RateLimit(options RateLimitOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if options.Limiter == nil || options.Limit <= 0 {
+			c.Next()
+			return
+		}
+
+		key := fmt.Sprintf("rate_limit:%s:%s", options.Prefix, c.ClientIP())
+		allowed, err := options.Limiter.Allow(key, options.Limit, options.Window)
+		if err != nil {
+			c.Next()
+			return
+		}
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(options.Window.Seconds())))
+			_ = c.AbortWithError(http.StatusTooManyRequests, exception.ErrorTextTooManyRequests)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,67 @@
+package middleware_test
+
+import (
+	"cargo-rest-api/interfaces/middleware"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryRateLimiter struct {
+	counts map[string]int
+}
+
+func (l *memoryRateLimiter) Allow(key string, limit int, window time.Duration) (bool, error) {
+	l.counts[key]++
+	return l.counts[key] <= limit, nil
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := &memoryRateLimiter{counts: map[string]int{}}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.RateLimit(middleware.RateLimitOptions{
+		Limiter: limiter,
+		Prefix:  "test",
+		Limit:   2,
+		Window:  time.Minute,
+	}))
+	r.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	expected := []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}
+	for _, code := range expected {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/test", nil)
+		if err != nil {
+			t.Errorf("this is the error: %v\n", err)
+		}
+		r.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code)
+		if code == http.StatusTooManyRequests {
+			assert.Equal(t, "60", w.Header().Get("Retry-After"))
+		}
+	}
+}
+
+func TestRateLimit_WithoutLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.RateLimit(middleware.RateLimitOptions{Limit: 1, Window: time.Minute}))
+	r.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
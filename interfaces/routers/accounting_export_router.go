@@ -0,0 +1,30 @@
+package routers
+
+import (
+	AccountingExportV1Point00 "cargo-rest-api/interfaces/handler/v1.0/accounting_export"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func accountingExportRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	AccountingExportV1 := func(c *gin.Context) *AccountingExportV1Point00.AccountingExports {
+		return AccountingExportV1Point00.NewAccountingExports(r.scoped(c).AccountingExport)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/accountingExports",
+		guard.Authenticate(),
+		guard.Authorize("accounting_export_read"),
+		func(c *gin.Context) { AccountingExportV1(c).GetAccountingExports(c) },
+	)
+	v1.POST(
+		"/accountingExports/commerceml",
+		guard.Authenticate(),
+		guard.Authorize("accounting_export_create"),
+		func(c *gin.Context) { AccountingExportV1(c).SaveCommerceMLExport(c) },
+	)
+}
@@ -0,0 +1,36 @@
+package routers
+
+import (
+	AuditLogV1Point00 "cargo-rest-api/interfaces/handler/v1.0/audit_log"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func auditLogRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	AuditLogV1 := func(c *gin.Context) *AuditLogV1Point00.AuditLogs {
+		return AuditLogV1Point00.NewAuditLogs(r.scoped(c).AuditLog)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/auditLogs",
+		guard.Authenticate(),
+		guard.Authorize("audit_read"),
+		func(c *gin.Context) { AuditLogV1(c).GetAuditLogs(c) },
+	)
+	v1.GET(
+		"/auditLogs/:entity/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("audit_read"),
+		func(c *gin.Context) { AuditLogV1(c).GetEntityAuditLogs(c) },
+	)
+	v1.GET(
+		"/users/:uuid/auditLogs",
+		guard.Authenticate(),
+		guard.Authorize("audit_read"),
+		func(c *gin.Context) { AuditLogV1(c).GetUserAuditLogs(c) },
+	)
+}
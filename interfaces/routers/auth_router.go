@@ -9,18 +9,21 @@ import (
 )
 
 func authRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	authenticate := handler.NewAuthenticate(
-		service.NewAuthService(r.dbService.User, r.dbService.UserForgotPassword),
-		r.redisService.Auth,
-		rg.authToken,
-		r.notificationService.Notification)
+	authenticate := func(c *gin.Context) *handler.Authenticate {
+		db := r.scoped(c)
+		return handler.NewAuthenticate(
+			service.NewAuthService(db.User, db.UserForgotPassword),
+			r.redisService.Auth,
+			rg.authToken,
+			r.notificationService.Notification)
+	}
 
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/profile", middleware.Auth(rg.authGateway), authenticate.Profile)
-	v1.POST("/login", authenticate.Login)
-	v1.POST("/logout", middleware.Auth(rg.authGateway), authenticate.Logout)
-	v1.POST("/refresh", authenticate.Refresh)
-	v1.POST("/password/forgot", authenticate.ForgotPassword)
-	v1.POST("/password/reset/:token", authenticate.ResetPassword)
+	v1.GET("/profile", middleware.Auth(rg.authGateway), func(c *gin.Context) { authenticate(c).Profile(c) })
+	v1.POST("/login", func(c *gin.Context) { authenticate(c).Login(c) })
+	v1.POST("/logout", middleware.Auth(rg.authGateway), func(c *gin.Context) { authenticate(c).Logout(c) })
+	v1.POST("/refresh", func(c *gin.Context) { authenticate(c).Refresh(c) })
+	v1.POST("/password/forgot", func(c *gin.Context) { authenticate(c).ForgotPassword(c) })
+	v1.POST("/password/reset/:token", func(c *gin.Context) { authenticate(c).ResetPassword(c) })
 }
@@ -0,0 +1,42 @@
+package routers
+
+import (
+	BankStatementV1Point00 "cargo-rest-api/interfaces/handler/v1.0/bank_statement"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func bankStatementRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	BankStatementV1 := func(c *gin.Context) *BankStatementV1Point00.BankStatements {
+		return BankStatementV1Point00.NewBankStatements(r.scoped(c).BankStatement)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/bankStatements",
+		guard.Authenticate(),
+		guard.Authorize("bank_statement_read"),
+		func(c *gin.Context) { BankStatementV1(c).GetBankStatements(c) },
+	)
+	v1.POST(
+		"/bankStatements",
+		guard.Authenticate(),
+		guard.Authorize("bank_statement_create"),
+		func(c *gin.Context) { BankStatementV1(c).SaveBankStatement(c) },
+	)
+	v1.GET(
+		"/bankStatements/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("bank_statement_detail"),
+		func(c *gin.Context) { BankStatementV1(c).GetBankStatement(c) },
+	)
+	v1.PUT(
+		"/bankStatements/lines/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("bank_statement_update"),
+		func(c *gin.Context) { BankStatementV1(c).ResolveBankStatementLine(c) },
+	)
+}
@@ -0,0 +1,23 @@
+package routers
+
+import (
+	CalendarV1Point00 "cargo-rest-api/interfaces/handler/v1.0/calendar"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func calendarRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	CalendarV1 := func(c *gin.Context) *CalendarV1Point00.Calendars {
+		return CalendarV1Point00.NewCalendars(r.scoped(c).Calendar, r.conf.AppTimezone)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET("/calendars", guard.Authenticate(), func(c *gin.Context) { CalendarV1(c).GetCalendarTokens(c) })
+	v1.POST("/calendars/reset", guard.Authenticate(), func(c *gin.Context) { CalendarV1(c).ResetCalendarTokens(c) })
+
+	// Calendar applications subscribe without credentials, token of URL is a secret of feed.
+	e.GET("/calendars/:token", func(c *gin.Context) { CalendarV1(c).GetCalendarFeed(c) })
+}
@@ -0,0 +1,58 @@
+package routers
+
+import (
+	"cargo-rest-api/domain/entity"
+	CargoTariffV1Point00 "cargo-rest-api/interfaces/handler/v1.0/cargo_tariff"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func cargoTariffRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	CargoTariffV1 := func(c *gin.Context) *CargoTariffV1Point00.CargoTariffs {
+		return CargoTariffV1Point00.NewCargoTariffs(r.scoped(c).CargoTariff)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.CargoTariff{})
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/cargoTariffs",
+		guard.Authenticate(),
+		guard.Authorize("cargo_tariff_read"),
+		func(c *gin.Context) { CargoTariffV1(c).GetCargoTariffs(c) },
+	)
+	v1.POST(
+		"/cargoTariffs",
+		guard.Authenticate(),
+		guard.Authorize("cargo_tariff_create"),
+		func(c *gin.Context) { CargoTariffV1(c).SaveCargoTariff(c) },
+	)
+	v1.POST(
+		"/cargoTariffs/quote",
+		guard.Authenticate(),
+		guard.Authorize("cargo_tariff_read"),
+		func(c *gin.Context) { CargoTariffV1(c).QuoteShipment(c) },
+	)
+	v1.GET(
+		"/cargoTariffs/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("cargo_tariff_detail"),
+		func(c *gin.Context) { CargoTariffV1(c).GetCargoTariff(c) },
+	)
+	v1.PUT(
+		"/cargoTariffs/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("cargo_tariff_update"),
+		ifMatch,
+		func(c *gin.Context) { CargoTariffV1(c).UpdateCargoTariff(c) },
+	)
+	v1.DELETE(
+		"/cargoTariffs/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("cargo_tariff_delete"),
+		ifMatch,
+		func(c *gin.Context) { CargoTariffV1(c).DeleteCargoTariff(c) },
+	)
+}
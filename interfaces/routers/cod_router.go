@@ -0,0 +1,42 @@
+package routers
+
+import (
+	CODV1Point00 "cargo-rest-api/interfaces/handler/v1.0/cod"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func codRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	CODV1 := func(c *gin.Context) *CODV1Point00.CODs {
+		return CODV1Point00.NewCODs(r.scoped(c).COD)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/cod/settlement",
+		guard.Authenticate(),
+		guard.Authorize("cod_read"),
+		func(c *gin.Context) { CODV1(c).GetCODSettlements(c) },
+	)
+	v1.GET(
+		"/cod/payouts",
+		guard.Authenticate(),
+		guard.Authorize("cod_read"),
+		func(c *gin.Context) { CODV1(c).GetCODPayouts(c) },
+	)
+	v1.POST(
+		"/cod/payouts",
+		guard.Authenticate(),
+		guard.Authorize("cod_create"),
+		func(c *gin.Context) { CODV1(c).SaveCODPayouts(c) },
+	)
+	v1.GET(
+		"/cod/payouts/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("cod_detail"),
+		func(c *gin.Context) { CODV1(c).GetCODPayout(c) },
+	)
+}
@@ -0,0 +1,24 @@
+package routers
+
+import (
+	DataImportV1Point00 "cargo-rest-api/interfaces/handler/v1.0/data_import"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func dataImportRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	DataImportV1 := func(c *gin.Context) *DataImportV1Point00.DataImports {
+		return DataImportV1Point00.NewDataImports(r.scoped(c).DataImport)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.POST(
+		"/imports/:entity",
+		guard.Authenticate(),
+		guard.Authorize("data_import_create"),
+		func(c *gin.Context) { DataImportV1(c).SaveDataImport(c) },
+	)
+}
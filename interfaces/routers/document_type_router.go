@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	DocumentTypeV1Point00 "cargo-rest-api/interfaces/handler/v1.0/document_type"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,27 @@ import (
 )
 
 func documentTypeRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	DocumentTypeV1 := DocumentTypeV1Point00.NewDocumentTypes(r.dbService.DocumentType)
+	DocumentTypeV1 := func(c *gin.Context) *DocumentTypeV1Point00.DocumentTypes {
+		return DocumentTypeV1Point00.NewDocumentTypes(r.scoped(c).DocumentType)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.DocumentType{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/documentTypes", guard.Authenticate(), DocumentTypeV1.GetDocumentTypes)
-	v1.POST("/documentTypes", guard.Authenticate(), DocumentTypeV1.SaveDocumentType)
-	v1.GET("/documentTypes/:uuid", guard.Authenticate(), DocumentTypeV1.GetDocumentType)
-	v1.PUT("/documentTypes/:uuid", guard.Authenticate(), DocumentTypeV1.UpdateDocumentType)
-	v1.DELETE("/documentTypes/:uuid", guard.Authenticate(), DocumentTypeV1.DeleteDocumentType)
+	v1.GET("/documentTypes", guard.Authenticate(), func(c *gin.Context) { DocumentTypeV1(c).GetDocumentTypes(c) })
+	v1.POST("/documentTypes", guard.Authenticate(), func(c *gin.Context) { DocumentTypeV1(c).SaveDocumentType(c) })
+	v1.GET("/documentTypes/:uuid", guard.Authenticate(), func(c *gin.Context) { DocumentTypeV1(c).GetDocumentType(c) })
+	v1.PUT(
+		"/documentTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { DocumentTypeV1(c).UpdateDocumentType(c) },
+	)
+	v1.DELETE(
+		"/documentTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { DocumentTypeV1(c).DeleteDocumentType(c) },
+	)
 }
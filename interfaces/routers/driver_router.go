@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	DriverV1Point00 "cargo-rest-api/interfaces/handler/v1.0/driver"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,17 +9,34 @@ import (
 )
 
 func driverRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	DriverV1 := DriverV1Point00.NewDrivers(r.dbService.Driver)
+	DriverV1 := func(c *gin.Context) *DriverV1Point00.Drivers {
+		return DriverV1Point00.NewDrivers(r.scoped(c).Driver)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Driver{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/drivers", guard.Authenticate(), DriverV1.GetDrivers)
-	v1.POST("/driver", guard.Authenticate(), DriverV1.SaveDriver)
-	v1.GET("/driver/:uuid", guard.Authenticate(), DriverV1.GetDriver)
-	v1.PUT("/driver/:uuid", guard.Authenticate(), DriverV1.UpdateDriver)
-	v1.DELETE("/driver/:uuid", guard.Authenticate(), DriverV1.DeleteDriver)
+	v1.GET("/drivers", guard.Authenticate(), func(c *gin.Context) { DriverV1(c).GetDrivers(c) })
+	v1.POST("/driver", guard.Authenticate(), func(c *gin.Context) { DriverV1(c).SaveDriver(c) })
+	v1.GET("/driver/:uuid", guard.Authenticate(), func(c *gin.Context) { DriverV1(c).GetDriver(c) })
+	v1.PUT("/driver/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { DriverV1(c).UpdateDriver(c) })
+	v1.DELETE("/driver/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { DriverV1(c).DeleteDriver(c) })
 
-	v1.POST("/driver/vehicle_add", guard.Authenticate(), DriverV1.AddDriverVehicle)
-	v1.POST("/driver/vehicle_del", guard.Authenticate(), DriverV1.DeleteDriverVehicle)
+	v1.POST("/driver/vehicle_add", guard.Authenticate(), func(c *gin.Context) { DriverV1(c).AddDriverVehicle(c) })
+	v1.POST("/driver/vehicle_del", guard.Authenticate(), func(c *gin.Context) { DriverV1(c).DeleteDriverVehicle(c) })
+
+	v1.GET("/driver/workRules", guard.Authenticate(), func(c *gin.Context) { DriverV1(c).GetDriverWorkRule(c) })
+	v1.PUT(
+		"/driver/workRules",
+		guard.Authenticate(),
+		guard.Authorize("driver_work_rule"),
+		func(c *gin.Context) { DriverV1(c).UpdateDriverWorkRule(c) },
+	)
+	v1.GET(
+		"/driver/:uuid/timesheet",
+		guard.Authenticate(),
+		guard.Authorize("driver_timesheet"),
+		func(c *gin.Context) { DriverV1(c).GetDriverTimesheet(c) },
+	)
 }
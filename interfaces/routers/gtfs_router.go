@@ -0,0 +1,41 @@
+package routers
+
+import (
+	"cargo-rest-api/domain/entity"
+	GTFSV1Point00 "cargo-rest-api/interfaces/handler/v1.0/gtfs"
+	"cargo-rest-api/interfaces/middleware"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func gtfsRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	GTFSV1 := func(c *gin.Context) *GTFSV1Point00.GTFS {
+		return GTFSV1Point00.NewGTFS(r.scoped(c).GTFS, entity.GTFSAgency{
+			Name:     r.conf.GTFSConfig.AgencyName,
+			URL:      r.conf.GTFSConfig.AgencyURL,
+			Timezone: r.conf.AppTimezone,
+			Lang:     r.conf.GTFSConfig.AgencyLang,
+			Phone:    r.conf.GTFSConfig.AgencyPhone,
+		})
+	}
+
+	// Feed is public, trip planners download it without credentials.
+	feedRateLimit := middleware.RateLimit(middleware.RateLimitOptions{
+		Limiter: middleware.NewRedisRateLimiter(r.redisService.Client),
+		Prefix:  "gtfs",
+		Limit:   r.conf.RateLimitConfig.GTFSLimit,
+		Window:  time.Duration(r.conf.RateLimitConfig.GTFSWindow) * time.Second,
+	})
+	e.GET("/gtfs.zip", feedRateLimit, func(c *gin.Context) { GTFSV1(c).GetGTFSFeed(c) })
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.POST(
+		"/gtfsImports",
+		guard.Authenticate(),
+		guard.Authorize("gtfs_import_create"),
+		func(c *gin.Context) { GTFSV1(c).SaveGTFSImport(c) },
+	)
+}
@@ -73,11 +73,14 @@ func oauthServerRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
 
 	oauthServer.SetUserAuthorizationHandler(userAuthorizeHandler)
 
-	oauth := handler.NewOauth(
-		service.NewAuthService(r.dbService.User, r.dbService.UserForgotPassword),
-		r.redisService.Auth,
-		rg.authToken,
-		r.notificationService.Notification)
+	oauth := func(c *gin.Context) *handler.Oauth {
+		db := r.scoped(c)
+		return handler.NewOauth(
+			service.NewAuthService(db.User, db.UserForgotPassword),
+			r.redisService.Auth,
+			rg.authToken,
+			r.notificationService.Notification)
+	}
 
 	sessionStore := cookie.NewStore([]byte(config.KeyConfig.AppPrivateKey))
 	e.Use(sessions.Sessions("cargo-rest-api-sessions", sessionStore))
@@ -99,8 +102,8 @@ func oauthServerRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
 
 		loginHandler(c)
 	})
-	o.POST("/login", oauth.Login)
-	o.GET("/auth", oauth.Auth)
+	o.POST("/login", func(c *gin.Context) { oauth(c).Login(c) })
+	o.GET("/auth", func(c *gin.Context) { oauth(c).Auth(c) })
 	o.GET("/authorize", func(c *gin.Context) {
 		authorizeHandler(c, oauthServer)
 	})
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	OrderV1Point00 "cargo-rest-api/interfaces/handler/v1.0/order"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,17 @@ import (
 )
 
 func orderRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	OrderV1 := OrderV1Point00.NewOrders(r.dbService.Order)
+	OrderV1 := func(c *gin.Context) *OrderV1Point00.Orders {
+		return OrderV1Point00.NewOrders(r.scoped(c).Order)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Order{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/orders", guard.Authenticate(), OrderV1.GetOrders)
-	v1.POST("/order", guard.Authenticate(), OrderV1.SaveOrder)
-	v1.GET("/order/:uuid", guard.Authenticate(), OrderV1.GetOrder)
-	v1.PUT("/order/:uuid", guard.Authenticate(), OrderV1.UpdateOrder)
-	v1.DELETE("/order/:uuid", guard.Authenticate(), OrderV1.DeleteOrder)
+	v1.GET("/orders", guard.Authenticate(), func(c *gin.Context) { OrderV1(c).GetOrders(c) })
+	v1.POST("/order", guard.Authenticate(), r.idempotency(), func(c *gin.Context) { OrderV1(c).SaveOrder(c) })
+	v1.GET("/order/:uuid", guard.Authenticate(), func(c *gin.Context) { OrderV1(c).GetOrder(c) })
+	v1.PUT("/order/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { OrderV1(c).UpdateOrder(c) })
+	v1.DELETE("/order/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { OrderV1(c).DeleteOrder(c) })
 }
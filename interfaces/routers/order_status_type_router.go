@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	OrderStatusTypeV1Point00 "cargo-rest-api/interfaces/handler/v1.0/order_status_type"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,39 @@ import (
 )
 
 func orderStatusTypeRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	OrderStatusTypeV1 := OrderStatusTypeV1Point00.NewOrderStatusTypes(r.dbService.OrderStatusType)
+	OrderStatusTypeV1 := func(c *gin.Context) *OrderStatusTypeV1Point00.OrderStatusTypes {
+		return OrderStatusTypeV1Point00.NewOrderStatusTypes(r.scoped(c).OrderStatusType)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.OrderStatusType{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/orderStatusTypes", guard.Authenticate(), OrderStatusTypeV1.GetOrderStatusTypes)
-	v1.POST("/orderStatusTypes", guard.Authenticate(), OrderStatusTypeV1.SaveOrderStatusType)
-	v1.GET("/orderStatusTypes/:uuid", guard.Authenticate(), OrderStatusTypeV1.GetOrderStatusType)
-	v1.PUT("/orderStatusTypes/:uuid", guard.Authenticate(), OrderStatusTypeV1.UpdateOrderStatusType)
-	v1.DELETE("/orderStatusTypes/:uuid", guard.Authenticate(), OrderStatusTypeV1.DeleteOrderStatusType)
+	v1.GET(
+		"/orderStatusTypes",
+		guard.Authenticate(),
+		func(c *gin.Context) { OrderStatusTypeV1(c).GetOrderStatusTypes(c) },
+	)
+	v1.POST(
+		"/orderStatusTypes",
+		guard.Authenticate(),
+		func(c *gin.Context) { OrderStatusTypeV1(c).SaveOrderStatusType(c) },
+	)
+	v1.GET(
+		"/orderStatusTypes/:uuid",
+		guard.Authenticate(),
+		func(c *gin.Context) { OrderStatusTypeV1(c).GetOrderStatusType(c) },
+	)
+	v1.PUT(
+		"/orderStatusTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { OrderStatusTypeV1(c).UpdateOrderStatusType(c) },
+	)
+	v1.DELETE(
+		"/orderStatusTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { OrderStatusTypeV1(c).DeleteOrderStatusType(c) },
+	)
 }
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	PassengerV1Point00 "cargo-rest-api/interfaces/handler/v1.0/passenger"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,27 @@ import (
 )
 
 func passengerRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	PassengerV1 := PassengerV1Point00.NewPassengers(r.dbService.Passenger)
+	PassengerV1 := func(c *gin.Context) *PassengerV1Point00.Passengers {
+		return PassengerV1Point00.NewPassengers(r.scoped(c).Passenger)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Passenger{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/passengers", guard.Authenticate(), PassengerV1.GetPassengers)
-	v1.POST("/passenger", guard.Authenticate(), PassengerV1.SavePassenger)
-	v1.GET("/passenger/:uuid", guard.Authenticate(), PassengerV1.GetPassenger)
-	v1.PUT("/passenger/:uuid", guard.Authenticate(), PassengerV1.UpdatePassenger)
-	v1.DELETE("/passenger/:uuid", guard.Authenticate(), PassengerV1.DeletePassenger)
+	v1.GET("/passengers", guard.Authenticate(), func(c *gin.Context) { PassengerV1(c).GetPassengers(c) })
+	v1.POST("/passenger", guard.Authenticate(), func(c *gin.Context) { PassengerV1(c).SavePassenger(c) })
+	v1.GET("/passenger/:uuid", guard.Authenticate(), func(c *gin.Context) { PassengerV1(c).GetPassenger(c) })
+	v1.PUT(
+		"/passenger/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { PassengerV1(c).UpdatePassenger(c) },
+	)
+	v1.DELETE(
+		"/passenger/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { PassengerV1(c).DeletePassenger(c) },
+	)
 }
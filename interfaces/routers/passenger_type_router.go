@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	PassengerTypeV1Point00 "cargo-rest-api/interfaces/handler/v1.0/passenger_type"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,31 @@ import (
 )
 
 func passengerTypeRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	PassengerTypeV1 := PassengerTypeV1Point00.NewPassengerTypes(r.dbService.PassengerType)
+	PassengerTypeV1 := func(c *gin.Context) *PassengerTypeV1Point00.PassengerTypes {
+		return PassengerTypeV1Point00.NewPassengerTypes(r.scoped(c).PassengerType)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.PassengerType{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/passengerTypes", guard.Authenticate(), PassengerTypeV1.GetPassengerTypes)
-	v1.POST("/passengerTypes", guard.Authenticate(), PassengerTypeV1.SavePassengerType)
-	v1.GET("/passengerTypes/:uuid", guard.Authenticate(), PassengerTypeV1.GetPassengerType)
-	v1.PUT("/passengerTypes/:uuid", guard.Authenticate(), PassengerTypeV1.UpdatePassengerType)
-	v1.DELETE("/passengerTypes/:uuid", guard.Authenticate(), PassengerTypeV1.DeletePassengerType)
+	v1.GET("/passengerTypes", guard.Authenticate(), func(c *gin.Context) { PassengerTypeV1(c).GetPassengerTypes(c) })
+	v1.POST("/passengerTypes", guard.Authenticate(), func(c *gin.Context) { PassengerTypeV1(c).SavePassengerType(c) })
+	v1.GET(
+		"/passengerTypes/:uuid",
+		guard.Authenticate(),
+		func(c *gin.Context) { PassengerTypeV1(c).GetPassengerType(c) },
+	)
+	v1.PUT(
+		"/passengerTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { PassengerTypeV1(c).UpdatePassengerType(c) },
+	)
+	v1.DELETE(
+		"/passengerTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { PassengerTypeV1(c).DeletePassengerType(c) },
+	)
 }
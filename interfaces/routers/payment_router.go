@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	PaymentV1Point00 "cargo-rest-api/interfaces/handler/v1.0/payment"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,17 +9,20 @@ import (
 )
 
 func paymentRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	PaymentV1 := PaymentV1Point00.NewPayments(r.dbService.Payment)
+	PaymentV1 := func(c *gin.Context) *PaymentV1Point00.Payments {
+		return PaymentV1Point00.NewPayments(r.scoped(c).Payment)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Payment{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/payments", guard.Authenticate(), PaymentV1.GetPayments)
-	v1.POST("/payment", guard.Authenticate(), PaymentV1.SavePayment)
-	v1.GET("/payment/:uuid", guard.Authenticate(), PaymentV1.GetPayment)
-	v1.PUT("/payment/:uuid", guard.Authenticate(), PaymentV1.UpdatePayment)
-	v1.DELETE("/payment/:uuid", guard.Authenticate(), PaymentV1.DeletePayment)
+	v1.GET("/payments", guard.Authenticate(), func(c *gin.Context) { PaymentV1(c).GetPayments(c) })
+	v1.POST("/payment", guard.Authenticate(), r.idempotency(), func(c *gin.Context) { PaymentV1(c).SavePayment(c) })
+	v1.GET("/payment/:uuid", guard.Authenticate(), func(c *gin.Context) { PaymentV1(c).GetPayment(c) })
+	v1.PUT("/payment/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { PaymentV1(c).UpdatePayment(c) })
+	v1.DELETE("/payment/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { PaymentV1(c).DeletePayment(c) })
 
-	v1.POST("/payment/price_add", guard.Authenticate(), PaymentV1.AddOrderPayment)
-	v1.POST("/payment/price_del", guard.Authenticate(), PaymentV1.DeleteOrderPayment)
+	v1.POST("/payment/price_add", guard.Authenticate(), func(c *gin.Context) { PaymentV1(c).AddOrderPayment(c) })
+	v1.POST("/payment/price_del", guard.Authenticate(), func(c *gin.Context) { PaymentV1(c).DeleteOrderPayment(c) })
 }
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	PriceV1Point00 "cargo-rest-api/interfaces/handler/v1.0/price"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,17 @@ import (
 )
 
 func priceRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	PriceV1 := PriceV1Point00.NewPrices(r.dbService.Price)
+	PriceV1 := func(c *gin.Context) *PriceV1Point00.Prices {
+		return PriceV1Point00.NewPrices(r.scoped(c).Price)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Price{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/prices", guard.Authenticate(), PriceV1.GetPrices)
-	v1.POST("/price", guard.Authenticate(), PriceV1.SavePrice)
-	v1.GET("/price/:uuid", guard.Authenticate(), PriceV1.GetPrice)
-	v1.PUT("/price/:uuid", guard.Authenticate(), PriceV1.UpdatePrice)
-	v1.DELETE("/price/:uuid", guard.Authenticate(), PriceV1.DeletePrice)
+	v1.GET("/prices", guard.Authenticate(), func(c *gin.Context) { PriceV1(c).GetPrices(c) })
+	v1.POST("/price", guard.Authenticate(), func(c *gin.Context) { PriceV1(c).SavePrice(c) })
+	v1.GET("/price/:uuid", guard.Authenticate(), func(c *gin.Context) { PriceV1(c).GetPrice(c) })
+	v1.PUT("/price/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { PriceV1(c).UpdatePrice(c) })
+	v1.DELETE("/price/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { PriceV1(c).DeletePrice(c) })
 }
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	RegularityTypeV1Point00 "cargo-rest-api/interfaces/handler/v1.0/regularity_type"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,35 @@ import (
 )
 
 func regularityTypeRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	RegularityTypeV1 := RegularityTypeV1Point00.NewRegularityTypes(r.dbService.RegularityType)
+	RegularityTypeV1 := func(c *gin.Context) *RegularityTypeV1Point00.RegularityTypes {
+		return RegularityTypeV1Point00.NewRegularityTypes(r.scoped(c).RegularityType)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.RegularityType{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/regularityTypes", guard.Authenticate(), RegularityTypeV1.GetRegularityTypes)
-	v1.POST("/regularityTypes", guard.Authenticate(), RegularityTypeV1.SaveRegularityType)
-	v1.GET("/regularityTypes/:uuid", guard.Authenticate(), RegularityTypeV1.GetRegularityType)
-	v1.PUT("/regularityTypes/:uuid", guard.Authenticate(), RegularityTypeV1.UpdateRegularityType)
-	v1.DELETE("/regularityTypes/:uuid", guard.Authenticate(), RegularityTypeV1.DeleteRegularityType)
+	v1.GET("/regularityTypes", guard.Authenticate(), func(c *gin.Context) { RegularityTypeV1(c).GetRegularityTypes(c) })
+	v1.POST(
+		"/regularityTypes",
+		guard.Authenticate(),
+		func(c *gin.Context) { RegularityTypeV1(c).SaveRegularityType(c) },
+	)
+	v1.GET(
+		"/regularityTypes/:uuid",
+		guard.Authenticate(),
+		func(c *gin.Context) { RegularityTypeV1(c).GetRegularityType(c) },
+	)
+	v1.PUT(
+		"/regularityTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { RegularityTypeV1(c).UpdateRegularityType(c) },
+	)
+	v1.DELETE(
+		"/regularityTypes/:uuid",
+		guard.Authenticate(),
+		ifMatch,
+		func(c *gin.Context) { RegularityTypeV1(c).DeleteRegularityType(c) },
+	)
 }
@@ -0,0 +1,42 @@
+package routers
+
+import (
+	ReportV1Point00 "cargo-rest-api/interfaces/handler/v1.0/report"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func reportRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	ReportV1 := func(c *gin.Context) *ReportV1Point00.Reports {
+		return ReportV1Point00.NewReports(r.scoped(c).Report)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/reports/revenue",
+		guard.Authenticate(),
+		guard.Authorize("report_revenue"),
+		func(c *gin.Context) { ReportV1(c).GetRevenueReport(c) },
+	)
+	v1.GET(
+		"/reports/loadFactor",
+		guard.Authenticate(),
+		guard.Authorize("report_load_factor"),
+		func(c *gin.Context) { ReportV1(c).GetLoadFactorReport(c) },
+	)
+	v1.GET(
+		"/reports/averageFare",
+		guard.Authenticate(),
+		guard.Authorize("report_average_fare"),
+		func(c *gin.Context) { ReportV1(c).GetAverageFareReport(c) },
+	)
+	v1.GET(
+		"/reports/cancellations",
+		guard.Authenticate(),
+		guard.Authorize("report_cancellation"),
+		func(c *gin.Context) { ReportV1(c).GetCancellationReport(c) },
+	)
+}
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	"cargo-rest-api/interfaces/middleware"
 
 	roleV1Point00 "cargo-rest-api/interfaces/handler/v1.0/role"
@@ -9,15 +10,40 @@ import (
 )
 
 func roleRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	roleV1 := roleV1Point00.NewRoles(r.dbService.Role, r.redisService.Auth, rg.authToken)
+	roleV1 := func(c *gin.Context) *roleV1Point00.Roles {
+		return roleV1Point00.NewRoles(r.scoped(c).Role, r.redisService.Auth, rg.authToken)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Role{})
 
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/roles", guard.Authenticate(), guard.Authorize("role_read"), roleV1.GetRoles)
-	v1.POST("/roles", guard.Authenticate(), guard.Authorize("role_create"), roleV1.SaveRole)
-	v1.GET("/roles/:uuid", guard.Authenticate(), guard.Authorize("role_detail"), roleV1.GetRole)
-	v1.PUT("/roles/:uuid", guard.Authenticate(), guard.Authorize("role_update"), roleV1.UpdateRole)
-	v1.DELETE("/roles/:uuid", guard.Authenticate(), guard.Authorize("role_delete"), roleV1.DeleteRole)
+	v1.GET("/roles", guard.Authenticate(), guard.Authorize("role_read"), func(c *gin.Context) { roleV1(c).GetRoles(c) })
+	v1.POST(
+		"/roles",
+		guard.Authenticate(),
+		guard.Authorize("role_create"),
+		func(c *gin.Context) { roleV1(c).SaveRole(c) },
+	)
+	v1.GET(
+		"/roles/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("role_detail"),
+		func(c *gin.Context) { roleV1(c).GetRole(c) },
+	)
+	v1.PUT(
+		"/roles/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("role_update"),
+		ifMatch,
+		func(c *gin.Context) { roleV1(c).UpdateRole(c) },
+	)
+	v1.DELETE(
+		"/roles/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("role_delete"),
+		ifMatch,
+		func(c *gin.Context) { roleV1(c).DeleteRole(c) },
+	)
 }
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	RouteV1Point00 "cargo-rest-api/interfaces/handler/v1.0/route"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,17 +9,20 @@ import (
 )
 
 func routeRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	RouteV1 := RouteV1Point00.NewRoutes(r.dbService.Route)
+	RouteV1 := func(c *gin.Context) *RouteV1Point00.Routes {
+		return RouteV1Point00.NewRoutes(r.scoped(c).Route)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Route{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/routes", guard.Authenticate(), RouteV1.GetRoutes)
-	v1.POST("/route", guard.Authenticate(), RouteV1.SaveRoute)
-	v1.GET("/route/:uuid", guard.Authenticate(), RouteV1.GetRoute)
-	v1.PUT("/route/:uuid", guard.Authenticate(), RouteV1.UpdateRoute)
-	v1.DELETE("/route/:uuid", guard.Authenticate(), RouteV1.DeleteRoute)
+	v1.GET("/routes", guard.Authenticate(), func(c *gin.Context) { RouteV1(c).GetRoutes(c) })
+	v1.POST("/route", guard.Authenticate(), func(c *gin.Context) { RouteV1(c).SaveRoute(c) })
+	v1.GET("/route/:uuid", guard.Authenticate(), func(c *gin.Context) { RouteV1(c).GetRoute(c) })
+	v1.PUT("/route/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { RouteV1(c).UpdateRoute(c) })
+	v1.DELETE("/route/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { RouteV1(c).DeleteRoute(c) })
 
-	v1.POST("/route/price_add", guard.Authenticate(), RouteV1.AddRoutePrice)
-	v1.POST("/route/price_del", guard.Authenticate(), RouteV1.DeleteRoutePrice)
+	v1.POST("/route/price_add", guard.Authenticate(), func(c *gin.Context) { RouteV1(c).AddRoutePrice(c) })
+	v1.POST("/route/price_del", guard.Authenticate(), func(c *gin.Context) { RouteV1(c).DeleteRoutePrice(c) })
 }
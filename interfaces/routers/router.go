@@ -6,6 +6,7 @@ import (
 	"cargo-rest-api/infrastructure/persistence"
 	"cargo-rest-api/interfaces/middleware"
 	"os"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -58,6 +59,12 @@ func NewRouterAuthGateway(
 	}
 }
 
+// scoped return repositories bound to context of request, so changes made while request is handled
+// are audited with actor of request.
+func (r *Router) scoped(c *gin.Context) *persistence.Repositories {
+	return r.dbService.WithContext(c.Request.Context())
+}
+
 // MainRouter is a method to initialize gin engine.
 func (r *Router) Init() *gin.Engine {
 	// Logging
@@ -94,6 +101,7 @@ func (r *Router) Init() *gin.Engine {
 	e := gin.Default()
 	e.Use(middleware.NewResponse(optResponse).Handler())
 	e.Use(middleware.SetRequestID(middleware.RequestIDOptions{AllowSetting: r.conf.EnableRequestID}))
+	e.Use(middleware.Audit(r.dbService.AuditLog))
 	e.Use(middleware.CORS(middleware.CORSOptions{AllowSetting: r.conf.EnableCors}))
 	e.Use(middleware.SetLogger(middleware.LoggerOptions{AllowSetting: r.conf.EnableLogger}))
 	e.Use(middleware.APIVersion())
@@ -122,9 +130,31 @@ func (r *Router) Init() *gin.Engine {
 	driverRoutes(e, r, rg)
 	routeRoutes(e, r, rg)
 	tripRoutes(e, r, rg)
+	shipmentRoutes(e, r, rg)
+	cargoTariffRoutes(e, r, rg)
+	codRoutes(e, r, rg)
+	vehicleComplianceRoutes(e, r, rg)
+	reportRoutes(e, r, rg)
+	bankStatementRoutes(e, r, rg)
+	accountingExportRoutes(e, r, rg)
+	dataImportRoutes(e, r, rg)
+	gtfsRoutes(e, r, rg)
+	calendarRoutes(e, r, rg)
+	webhookRoutes(e, r, rg)
+	auditLogRoutes(e, r, rg)
+	trashRoutes(e, r, rg)
 	orderRoutes(e, r, rg)
 	paymentRoutes(e, r, rg)
 
 	return e
 
 }
+
+// idempotency return middleware returning stored response of request repeated with the same Idempotency-Key.
+func (r *Router) idempotency() gin.HandlerFunc {
+	return middleware.Idempotency(middleware.IdempotencyOptions{
+		Store:   middleware.NewRedisIdempotencyStore(r.redisService.Client),
+		TTL:     time.Duration(r.conf.IdempotencyConfig.TTL) * time.Second,
+		LockTTL: time.Duration(r.conf.IdempotencyConfig.LockTTL) * time.Second,
+	})
+}
@@ -0,0 +1,112 @@
+package routers
+
+import (
+	"cargo-rest-api/application"
+	"cargo-rest-api/domain/entity"
+	ShipmentV1Point00 "cargo-rest-api/interfaces/handler/v1.0/shipment"
+	"cargo-rest-api/interfaces/middleware"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func shipmentRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	// Notification service is not available when SMTP is not configured.
+	var notification application.NotifyAppInterface
+	if r.notificationService != nil && r.notificationService.Notification != nil {
+		notification = r.notificationService.Notification
+	}
+	ShipmentV1 := func(c *gin.Context) *ShipmentV1Point00.Shipments {
+		return ShipmentV1Point00.NewShipments(r.scoped(c).Shipment, notification, r.storageService.Storage)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Shipment{})
+	trackingRateLimit := middleware.RateLimit(middleware.RateLimitOptions{
+		Limiter: middleware.NewRedisRateLimiter(r.redisService.Client),
+		Prefix:  "track",
+		Limit:   r.conf.RateLimitConfig.TrackingLimit,
+		Window:  time.Duration(r.conf.RateLimitConfig.TrackingWindow) * time.Second,
+	})
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/shipments",
+		guard.Authenticate(),
+		guard.Authorize("shipment_read"),
+		func(c *gin.Context) { ShipmentV1(c).GetShipments(c) },
+	)
+	v1.POST(
+		"/shipment",
+		guard.Authenticate(),
+		guard.Authorize("shipment_create"),
+		func(c *gin.Context) { ShipmentV1(c).SaveShipment(c) },
+	)
+	v1.GET(
+		"/shipment/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("shipment_detail"),
+		func(c *gin.Context) { ShipmentV1(c).GetShipment(c) },
+	)
+	v1.PUT(
+		"/shipment/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("shipment_update"),
+		ifMatch,
+		func(c *gin.Context) { ShipmentV1(c).UpdateShipment(c) },
+	)
+	v1.DELETE(
+		"/shipment/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("shipment_delete"),
+		ifMatch,
+		func(c *gin.Context) { ShipmentV1(c).DeleteShipment(c) },
+	)
+
+	v1.GET(
+		"/shipment/:uuid/events",
+		guard.Authenticate(),
+		guard.Authorize("shipment_detail"),
+		func(c *gin.Context) { ShipmentV1(c).GetShipmentEvents(c) },
+	)
+	v1.POST(
+		"/shipment/:uuid/event",
+		guard.Authenticate(),
+		guard.Authorize("shipment_update"),
+		func(c *gin.Context) { ShipmentV1(c).SaveShipmentEvent(c) },
+	)
+
+	v1.POST(
+		"/shipment/:uuid/deliveryCode",
+		guard.Authenticate(),
+		guard.Authorize("shipment_update"),
+		func(c *gin.Context) { ShipmentV1(c).IssueDeliveryCode(c) },
+	)
+	v1.POST(
+		"/shipment/:uuid/delivery",
+		guard.Authenticate(),
+		guard.Authorize("shipment_update"),
+		func(c *gin.Context) { ShipmentV1(c).ConfirmDelivery(c) },
+	)
+	v1.GET(
+		"/shipment/:uuid/delivery",
+		guard.Authenticate(),
+		guard.Authorize("shipment_detail"),
+		func(c *gin.Context) { ShipmentV1(c).GetDelivery(c) },
+	)
+
+	v1.GET(
+		"/shipment/:uuid/label",
+		guard.Authenticate(),
+		guard.Authorize("shipment_detail"),
+		func(c *gin.Context) { ShipmentV1(c).GetShipmentLabel(c) },
+	)
+	v1.GET(
+		"/trip/:uuid/labels",
+		guard.Authenticate(),
+		guard.Authorize("shipment_read"),
+		func(c *gin.Context) { ShipmentV1(c).GetTripShipmentLabels(c) },
+	)
+
+	v1.GET("/track/:tracking_number", trackingRateLimit, func(c *gin.Context) { ShipmentV1(c).TrackShipment(c) })
+}
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	SityV1Point00 "cargo-rest-api/interfaces/handler/v1.0/sity"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,34 @@ import (
 )
 
 func sityRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	sityV1 := SityV1Point00.NewSities(r.dbService.Sity)
+	sityV1 := func(c *gin.Context) *SityV1Point00.Sities {
+		return SityV1Point00.NewSities(r.scoped(c).Sity)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Sity{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/sities", sityV1.GetSities)
-	v1.POST("/sity", sityV1.SaveSity)
-	v1.GET("/sity/:uuid", guard.Authenticate(), guard.Authorize("sity_detail"), sityV1.GetSity)
-	v1.PUT("/sity/:uuid", guard.Authenticate(), guard.Authorize("sity_update"), sityV1.UpdateSity)
-	v1.DELETE("/sity/:uuid", guard.Authenticate(), guard.Authorize("sity_delete"), sityV1.DeleteSity)
+	v1.GET("/sities", func(c *gin.Context) { sityV1(c).GetSities(c) })
+	v1.POST("/sity", func(c *gin.Context) { sityV1(c).SaveSity(c) })
+	v1.GET(
+		"/sity/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("sity_detail"),
+		func(c *gin.Context) { sityV1(c).GetSity(c) },
+	)
+	v1.PUT(
+		"/sity/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("sity_update"),
+		ifMatch,
+		func(c *gin.Context) { sityV1(c).UpdateSity(c) },
+	)
+	v1.DELETE(
+		"/sity/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("sity_delete"),
+		ifMatch,
+		func(c *gin.Context) { sityV1(c).DeleteSity(c) },
+	)
 }
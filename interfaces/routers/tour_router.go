@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	"cargo-rest-api/interfaces/middleware"
 
 	TourV1Point00 "cargo-rest-api/interfaces/handler/v1.0/tour"
@@ -9,15 +10,40 @@ import (
 )
 
 func tourRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	TourV1 := TourV1Point00.NewTours(r.dbService.Tour, r.redisService.Auth, rg.authToken)
+	TourV1 := func(c *gin.Context) *TourV1Point00.Tours {
+		return TourV1Point00.NewTours(r.scoped(c).Tour, r.redisService.Auth, rg.authToken)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Tour{})
 
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/tours", guard.Authenticate(), guard.Authorize("tour_read"), TourV1.GetTours)
-	v1.POST("/tours", guard.Authenticate(), guard.Authorize("tour_create"), TourV1.SaveTour)
-	v1.GET("/tours/:uuid", guard.Authenticate(), guard.Authorize("tour_detail"), TourV1.GetTour)
-	v1.PUT("/tours/:uuid", guard.Authenticate(), guard.Authorize("tour_update"), TourV1.UpdateTour)
-	v1.DELETE("/tours/:uuid", guard.Authenticate(), guard.Authorize("tour_delete"), TourV1.DeleteTour)
+	v1.GET("/tours", guard.Authenticate(), guard.Authorize("tour_read"), func(c *gin.Context) { TourV1(c).GetTours(c) })
+	v1.POST(
+		"/tours",
+		guard.Authenticate(),
+		guard.Authorize("tour_create"),
+		func(c *gin.Context) { TourV1(c).SaveTour(c) },
+	)
+	v1.GET(
+		"/tours/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("tour_detail"),
+		func(c *gin.Context) { TourV1(c).GetTour(c) },
+	)
+	v1.PUT(
+		"/tours/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("tour_update"),
+		ifMatch,
+		func(c *gin.Context) { TourV1(c).UpdateTour(c) },
+	)
+	v1.DELETE(
+		"/tours/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("tour_delete"),
+		ifMatch,
+		func(c *gin.Context) { TourV1(c).DeleteTour(c) },
+	)
 }
@@ -0,0 +1,36 @@
+package routers
+
+import (
+	TrashV1Point00 "cargo-rest-api/interfaces/handler/v1.0/trash"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func trashRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	TrashV1 := func(c *gin.Context) *TrashV1Point00.Trash {
+		return TrashV1Point00.NewTrash(r.scoped(c).Trash)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/trash/:resource",
+		guard.Authenticate(),
+		guard.Authorize("trash_read"),
+		func(c *gin.Context) { TrashV1(c).GetTrash(c) },
+	)
+	v1.POST(
+		"/trash/:resource/:uuid/restore",
+		guard.Authenticate(),
+		guard.Authorize("trash_restore"),
+		func(c *gin.Context) { TrashV1(c).RestoreTrash(c) },
+	)
+	v1.DELETE(
+		"/trash/:resource/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("trash_purge"),
+		func(c *gin.Context) { TrashV1(c).PurgeTrash(c) },
+	)
+}
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	TripV1Point00 "cargo-rest-api/interfaces/handler/v1.0/trip"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,22 @@ import (
 )
 
 func tripRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	TripV1 := TripV1Point00.NewTrips(r.dbService.Trip)
+	TripV1 := func(c *gin.Context) *TripV1Point00.Trips {
+		return TripV1Point00.NewTrips(r.scoped(c).Trip)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Trip{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/trips", guard.Authenticate(), TripV1.GetTrips)
-	v1.POST("/trip", guard.Authenticate(), TripV1.SaveTrip)
-	v1.GET("/trip/:uuid", guard.Authenticate(), TripV1.GetTrip)
-	v1.PUT("/trip/:uuid", guard.Authenticate(), TripV1.UpdateTrip)
-	v1.DELETE("/trip/:uuid", guard.Authenticate(), TripV1.DeleteTrip)
+	v1.GET("/trips", guard.Authenticate(), func(c *gin.Context) { TripV1(c).GetTrips(c) })
+	v1.POST("/trip", guard.Authenticate(), func(c *gin.Context) { TripV1(c).SaveTrip(c) })
+	v1.GET("/trip/:uuid", guard.Authenticate(), func(c *gin.Context) { TripV1(c).GetTrip(c) })
+	v1.PUT("/trip/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { TripV1(c).UpdateTrip(c) })
+	v1.DELETE("/trip/:uuid", guard.Authenticate(), ifMatch, func(c *gin.Context) { TripV1(c).DeleteTrip(c) })
+
+	v1.GET("/trip/:uuid/loadPlan", guard.Authenticate(), func(c *gin.Context) { TripV1(c).GetTripLoadPlan(c) })
+	v1.POST("/trip/:uuid/route", guard.Authenticate(), func(c *gin.Context) { TripV1(c).SaveTripRoute(c) })
+	v1.GET("/trip/:uuid/route", guard.Authenticate(), func(c *gin.Context) { TripV1(c).GetTripRoute(c) })
+	v1.GET("/driver/routes", guard.Authenticate(), func(c *gin.Context) { TripV1(c).GetDriverTripRoutes(c) })
 }
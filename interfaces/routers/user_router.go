@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	"cargo-rest-api/interfaces/handler"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -10,22 +11,54 @@ import (
 )
 
 func userRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	userV1 := userV1Point00.NewUsers(r.dbService.User, r.storageService.Storage)
-	userPreference := handler.NewPreference(r.dbService.UserPreference, r.redisService.Auth, rg.authToken)
+	userV1 := func(c *gin.Context) *userV1Point00.Users {
+		return userV1Point00.NewUsers(r.scoped(c).User, r.storageService.Storage)
+	}
+	userPreference := func(c *gin.Context) *handler.Preference {
+		return handler.NewPreference(r.scoped(c).UserPreference, r.redisService.Auth, rg.authToken)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.User{})
 
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/users", guard.Authenticate(), guard.Authorize("user_read"), userV1.GetUsers)
-	v1.POST("/users", guard.Authenticate(), guard.Authorize("user_create"), userV1.SaveUser)
-	v1.GET("/users/:uuid", guard.Authenticate(), guard.Authorize("user_detail"), userV1.GetUser)
-	v1.PUT("/users/:uuid", guard.Authenticate(), guard.Authorize("user_update"), userV1.UpdateUser)
-	v1.PUT("/users/:uuid/avatar", guard.Authenticate(), guard.Authorize("user_update"), userV1.UpdateAvatar)
-	v1.DELETE("/users/:uuid", guard.Authenticate(), guard.Authorize("user_delete"), userV1.DeleteUser)
+	v1.GET("/users", guard.Authenticate(), guard.Authorize("user_read"), func(c *gin.Context) { userV1(c).GetUsers(c) })
+	v1.POST(
+		"/users",
+		guard.Authenticate(),
+		guard.Authorize("user_create"),
+		func(c *gin.Context) { userV1(c).SaveUser(c) },
+	)
+	v1.GET(
+		"/users/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("user_detail"),
+		func(c *gin.Context) { userV1(c).GetUser(c) },
+	)
+	v1.PUT(
+		"/users/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("user_update"),
+		ifMatch,
+		func(c *gin.Context) { userV1(c).UpdateUser(c) },
+	)
+	v1.PUT(
+		"/users/:uuid/avatar",
+		guard.Authenticate(),
+		guard.Authorize("user_update"),
+		func(c *gin.Context) { userV1(c).UpdateAvatar(c) },
+	)
+	v1.DELETE(
+		"/users/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("user_delete"),
+		ifMatch,
+		func(c *gin.Context) { userV1(c).DeleteUser(c) },
+	)
 
-	v1.GET("/preference", guard.Authenticate(), userPreference.GerPreference)
-	v1.PUT("/preference", guard.Authenticate(), userPreference.UpdatePreference)
-	v1.POST("/preference/reset", guard.Authenticate(), userPreference.ResetPreference)
+	v1.GET("/preference", guard.Authenticate(), func(c *gin.Context) { userPreference(c).GerPreference(c) })
+	v1.PUT("/preference", guard.Authenticate(), func(c *gin.Context) { userPreference(c).UpdatePreference(c) })
+	v1.POST("/preference/reset", guard.Authenticate(), func(c *gin.Context) { userPreference(c).ResetPreference(c) })
 
 }
@@ -0,0 +1,61 @@
+package routers
+
+import (
+	"cargo-rest-api/domain/entity"
+	VehicleComplianceV1Point00 "cargo-rest-api/interfaces/handler/v1.0/vehicle_compliance"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func vehicleComplianceRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	VehicleComplianceV1 := func(c *gin.Context) *VehicleComplianceV1Point00.VehicleCompliances {
+		return VehicleComplianceV1Point00.NewVehicleCompliances(
+			r.scoped(c).VehicleCompliance,
+			r.storageService.Storage,
+		)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.VehicleCompliance{})
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/vehicleCompliances",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_compliance_read"),
+		func(c *gin.Context) { VehicleComplianceV1(c).GetVehicleCompliances(c) },
+	)
+	v1.POST(
+		"/vehicleCompliances",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_compliance_create"),
+		func(c *gin.Context) { VehicleComplianceV1(c).SaveVehicleCompliance(c) },
+	)
+	v1.GET(
+		"/vehicleCompliances/expiring",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_compliance_read"),
+		func(c *gin.Context) { VehicleComplianceV1(c).GetExpiringVehicleCompliances(c) },
+	)
+	v1.GET(
+		"/vehicleCompliances/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_compliance_detail"),
+		func(c *gin.Context) { VehicleComplianceV1(c).GetVehicleCompliance(c) },
+	)
+	v1.PUT(
+		"/vehicleCompliances/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_compliance_update"),
+		ifMatch,
+		func(c *gin.Context) { VehicleComplianceV1(c).UpdateVehicleCompliance(c) },
+	)
+	v1.DELETE(
+		"/vehicleCompliances/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_compliance_delete"),
+		ifMatch,
+		func(c *gin.Context) { VehicleComplianceV1(c).DeleteVehicleCompliance(c) },
+	)
+}
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"cargo-rest-api/domain/entity"
 	VehicleV1Point00 "cargo-rest-api/interfaces/handler/v1.0/vehicle"
 	"cargo-rest-api/interfaces/middleware"
 
@@ -8,14 +9,44 @@ import (
 )
 
 func vehicleRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
-	vehicleV1 := VehicleV1Point00.NewVehicles(r.dbService.Vehicle)
+	vehicleV1 := func(c *gin.Context) *VehicleV1Point00.Vehicles {
+		return VehicleV1Point00.NewVehicles(r.scoped(c).Vehicle)
+	}
 
 	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.Vehicle{})
 	v1 := e.Group("/api/v1/external")
 
-	v1.GET("/vehicles", guard.Authenticate(), guard.Authorize("vehicle_read"), vehicleV1.GetVehicles)
-	v1.POST("/vehicles", guard.Authenticate(), guard.Authorize("vehicle_create"), vehicleV1.SaveVehicle)
-	v1.GET("/vehicles/:uuid", guard.Authenticate(), guard.Authorize("vehicle_detail"), vehicleV1.GetVehicle)
-	v1.PUT("/vehicles/:uuid", guard.Authenticate(), guard.Authorize("vehicle_update"), vehicleV1.UpdateVehicle)
-	v1.DELETE("/vehicles/:uuid", guard.Authenticate(), guard.Authorize("vehicle_delete"), vehicleV1.DeleteVehicle)
+	v1.GET(
+		"/vehicles",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_read"),
+		func(c *gin.Context) { vehicleV1(c).GetVehicles(c) },
+	)
+	v1.POST(
+		"/vehicles",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_create"),
+		func(c *gin.Context) { vehicleV1(c).SaveVehicle(c) },
+	)
+	v1.GET(
+		"/vehicles/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_detail"),
+		func(c *gin.Context) { vehicleV1(c).GetVehicle(c) },
+	)
+	v1.PUT(
+		"/vehicles/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_update"),
+		ifMatch,
+		func(c *gin.Context) { vehicleV1(c).UpdateVehicle(c) },
+	)
+	v1.DELETE(
+		"/vehicles/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("vehicle_delete"),
+		ifMatch,
+		func(c *gin.Context) { vehicleV1(c).DeleteVehicle(c) },
+	)
 }
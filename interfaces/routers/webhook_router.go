@@ -0,0 +1,64 @@
+package routers
+
+import (
+	"cargo-rest-api/domain/entity"
+	WebhookV1Point00 "cargo-rest-api/interfaces/handler/v1.0/webhook"
+	"cargo-rest-api/interfaces/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func webhookRoutes(e *gin.Engine, r *Router, rg *RouterAuthGateway) {
+	WebhookV1 := func(c *gin.Context) *WebhookV1Point00.Webhooks {
+		return WebhookV1Point00.NewWebhooks(r.scoped(c).Webhook)
+	}
+
+	guard := middleware.Guard(rg.authGateway)
+	ifMatch := middleware.IfMatch(&entity.WebhookSubscription{})
+	v1 := e.Group("/api/v1/external")
+
+	v1.GET(
+		"/webhooks",
+		guard.Authenticate(),
+		guard.Authorize("webhook_read"),
+		func(c *gin.Context) { WebhookV1(c).GetWebhookSubscriptions(c) },
+	)
+	v1.POST(
+		"/webhooks",
+		guard.Authenticate(),
+		guard.Authorize("webhook_create"),
+		func(c *gin.Context) { WebhookV1(c).SaveWebhookSubscription(c) },
+	)
+	v1.GET(
+		"/webhooks/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("webhook_detail"),
+		func(c *gin.Context) { WebhookV1(c).GetWebhookSubscription(c) },
+	)
+	v1.PUT(
+		"/webhooks/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("webhook_update"),
+		ifMatch,
+		func(c *gin.Context) { WebhookV1(c).UpdateWebhookSubscription(c) },
+	)
+	v1.DELETE(
+		"/webhooks/:uuid",
+		guard.Authenticate(),
+		guard.Authorize("webhook_delete"),
+		ifMatch,
+		func(c *gin.Context) { WebhookV1(c).DeleteWebhookSubscription(c) },
+	)
+	v1.GET(
+		"/webhooks/:uuid/deliveries",
+		guard.Authenticate(),
+		guard.Authorize("webhook_read"),
+		func(c *gin.Context) { WebhookV1(c).GetWebhookDeliveries(c) },
+	)
+	v1.POST(
+		"/webhookDeliveries/:uuid/replay",
+		guard.Authenticate(),
+		guard.Authorize("webhook_update"),
+		func(c *gin.Context) { WebhookV1(c).ReplayWebhookDelivery(c) },
+	)
+}
@@ -6,8 +6,13 @@ import (
 	"cargo-rest-api/infrastructure/persistence"
 	"cargo-rest-api/interfaces/cmd"
 	"cargo-rest-api/interfaces/routers"
+	"context"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -87,6 +92,24 @@ func main() {
 	// Init notification services
 	notificationService, _ := persistence.NewNotificationService(conf)
 
+	// Init fiscal services
+	fiscalService, errFiscal := persistence.NewFiscalService(conf, dbService.Payment)
+	if errFiscal != nil {
+		log.Println(errFiscal)
+	} else if fiscalService == nil {
+		log.Println("fiscal provider is not configured, receipts are left pending")
+	}
+
+	// Init webhook services
+	webhookService := persistence.NewWebhookService(conf, dbService.Webhook)
+
+	// Init outbox relay
+	outboxService := persistence.NewOutboxService(
+		conf,
+		dbService.Outbox,
+		persistence.NewOutboxSinks(conf, dbService.Webhook, redisService.Client)...,
+	)
+
 	// Init rollbar services
 	rollbar.SetToken(conf.RollbarConfig.Token)
 	rollbar.SetEnvironment(conf.RollbarConfig.Environment)
@@ -97,6 +120,28 @@ func main() {
 		// Init Router
 		router := routers.NewRouter(conf, dbService, redisService, storageService, notificationService).Init()
 
+		// Background workers finish current batch and return when stop is closed on shutdown
+		stop := make(chan struct{})
+		var workers sync.WaitGroup
+		runWorker := func(run func(stop <-chan struct{})) {
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				run(stop)
+			}()
+		}
+
+		// Send fiscal receipts in background
+		if fiscalService != nil {
+			runWorker(fiscalService.Run)
+		}
+
+		// Send webhook deliveries in background
+		runWorker(webhookService.Run)
+
+		// Publish outbox events in background
+		runWorker(outboxService.Run)
+
 		// Inject swagger handler on dev environment
 		if conf.AppEnvironment != "production" {
 			router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -107,12 +152,29 @@ func main() {
 		if appPort == "" {
 			appPort = "8888"
 		}
-		log.Println(router.Run(":" + appPort))
+		server := &http.Server{Addr: ":" + appPort, Handler: router}
+		go func() {
+			quit := make(chan os.Signal, 1)
+			signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+			<-quit
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := server.Shutdown(ctx); err != nil {
+				log.Println(err)
+			}
+		}()
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Println(err)
+		}
+
+		// Stop background workers
+		close(stop)
+		workers.Wait()
 		return nil
 	}
 
 	// Init Cli
-	cliCommands := cmd.NewCommand(dbService)
+	cliCommands := cmd.NewCommand(dbService, notificationService, fiscalService, conf)
 	app.Commands = cliCommands
 	err := app.Run(os.Args)
 	if err != nil {
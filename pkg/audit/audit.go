@@ -0,0 +1,136 @@
+// Package audit keeps actor of data changes and computes field-level diff of changed records.
+//
+// Actor is passed by context of database statement: request context carries actor by WithActorFunc
+// and actor is read by ActorFromContext when record is written.
+package audit
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"sort"
+	"time"
+)
+
+const (
+	// ActionCreate is an action of created record.
+	ActionCreate = "create"
+
+	// ActionUpdate is an action of updated record.
+	ActionUpdate = "update"
+
+	// ActionDelete is an action of deleted record.
+	ActionDelete = "delete"
+)
+
+// Actor represent who changed data: authenticated user, application of API key and request.
+type Actor struct {
+	UserUUID        string
+	ApplicationUUID string
+	APIKey          string
+	APIKeyUUID      string
+	RequestID       string
+}
+
+// ActorFunc return actor of the current request, actor is read when change is written
+// so user authenticated after request context is created is recorded.
+type ActorFunc func() *Actor
+
+// Change represent values of field before and after change, value of created field has no before
+// and value of deleted field has no after.
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type actorKey struct{}
+
+// WithActor will return context carrying actor.
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return WithActorFunc(ctx, func() *Actor {
+		return actor
+	})
+}
+
+// WithActorFunc will return context carrying actor read when change is written.
+func WithActorFunc(ctx context.Context, actor ActorFunc) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext return actor of context or nil.
+func ActorFromContext(ctx context.Context) *Actor {
+	if ctx == nil {
+		return nil
+	}
+	actor, ok := ctx.Value(actorKey{}).(ActorFunc)
+	if !ok {
+		return nil
+	}
+	return actor()
+}
+
+// Diff will return changed fields of record, before is nil for created record and after is nil for deleted one.
+// Ignored fields are not compared.
+func Diff(before map[string]interface{}, after map[string]interface{}, ignored ...string) map[string]Change {
+	changes := map[string]Change{}
+	skip := map[string]bool{}
+	for _, field := range ignored {
+		skip[field] = true
+	}
+	for _, field := range fields(before, after) {
+		if skip[field] {
+			continue
+		}
+		oldValue := normalize(before[field])
+		newValue := normalize(after[field])
+		if oldValue == nil && newValue == nil {
+			continue
+		}
+		if before != nil && after != nil && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes[field] = Change{Before: oldValue, After: newValue}
+	}
+	return changes
+}
+
+// fields return sorted names of fields of both records.
+func fields(records ...map[string]interface{}) []string {
+	names := map[string]bool{}
+	for _, record := range records {
+		for name := range record {
+			names[name] = true
+		}
+	}
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// normalize will convert values returned by database drivers and values of models to comparable values.
+func normalize(value interface{}) interface{} {
+	if reflected := reflect.ValueOf(value); reflected.Kind() == reflect.Ptr && reflected.IsNil() {
+		return nil
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC()
+	case driver.Valuer:
+		stored, err := v.Value()
+		if err != nil {
+			return value
+		}
+		return normalize(stored)
+	}
+	if reflected := reflect.ValueOf(value); reflected.Kind() == reflect.Ptr {
+		return normalize(reflected.Elem().Interface())
+	}
+	return value
+}
@@ -0,0 +1,78 @@
+package audit_test
+
+import (
+	"cargo-rest-api/pkg/audit"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorFromContext(t *testing.T) {
+	user := ""
+	ctx := audit.WithActorFunc(context.Background(), func() *audit.Actor {
+		return &audit.Actor{UserUUID: user, RequestID: "request-1"}
+	})
+	user = "user-1"
+
+	actor := audit.ActorFromContext(ctx)
+	assert.Equal(t, "user-1", actor.UserUUID)
+	assert.Equal(t, "request-1", actor.RequestID)
+
+	ctx = audit.WithActor(context.Background(), &audit.Actor{UserUUID: "context"})
+	assert.Equal(t, "context", audit.ActorFromContext(ctx).UserUUID)
+	assert.Nil(t, audit.ActorFromContext(context.Background()))
+}
+
+func TestDiff_Update(t *testing.T) {
+	departure := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	before := map[string]interface{}{
+		"uuid":           "trip-1",
+		"departure_time": departure,
+		"vehicle_uuid":   "vehicle-1",
+		"updated_at":     departure,
+		"note":           []byte("old"),
+	}
+	after := map[string]interface{}{
+		"uuid":           "trip-1",
+		"departure_time": departure.Add(time.Hour),
+		"vehicle_uuid":   "vehicle-1",
+		"updated_at":     departure.Add(time.Minute),
+		"note":           []byte("old"),
+	}
+
+	changes := audit.Diff(before, after, "updated_at")
+
+	assert.Equal(t, map[string]audit.Change{
+		"departure_time": {Before: departure, After: departure.Add(time.Hour)},
+	}, changes)
+}
+
+func TestDiff_CreateAndDelete(t *testing.T) {
+	record := map[string]interface{}{"uuid": "sity-1", "name": "Moscow", "deleted_at": nil}
+
+	created := audit.Diff(nil, record)
+	deleted := audit.Diff(record, nil)
+
+	assert.Equal(t, map[string]audit.Change{
+		"uuid": {After: "sity-1"},
+		"name": {After: "Moscow"},
+	}, created)
+	assert.Equal(t, map[string]audit.Change{
+		"uuid": {Before: "sity-1"},
+		"name": {Before: "Moscow"},
+	}, deleted)
+}
+
+func TestDiff_ModelValues(t *testing.T) {
+	name := "Moscow"
+	var note *string
+	record := map[string]interface{}{"uuid": "sity-1", "name": &name, "note": note, "deleted_at": sql.NullTime{}}
+
+	assert.Equal(t, map[string]audit.Change{
+		"uuid": {After: "sity-1"},
+		"name": {After: "Moscow"},
+	}, audit.Diff(nil, record))
+}
@@ -0,0 +1,168 @@
+// Package bankstatement reads bank statements and matches their lines to payments.
+// Statements are read from CSV with header row or from 1CClientBankExchange text format,
+// UTF-8, Windows-1251 and DOS (CP866) encodings are recognized.
+// Incoming lines have positive amount, outgoing lines have negative amount.
+package bankstatement
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	// FormatCSV is a format of statement exported as CSV with header row.
+	FormatCSV = "csv"
+
+	// Format1C is a format of statement exported as 1CClientBankExchange text.
+	Format1C = "1c"
+
+	exchangeHeader = "1CClientBankExchange"
+	utf8BOM        = "\xEF\xBB\xBF"
+)
+
+var (
+	// ErrUnknownFormat is returned when format of statement is not supported or can not be detected.
+	ErrUnknownFormat = errors.New("bankstatement: unknown format")
+
+	// ErrEmpty is returned when statement has no lines.
+	ErrEmpty = errors.New("bankstatement: statement has no lines")
+
+	referencePattern = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	dateLayouts      = []string{"2006-01-02", "02.01.2006", "02.01.06", time.RFC3339}
+)
+
+// Error represent invalid value of statement at line of file.
+type Error struct {
+	Line  int
+	Field string
+}
+
+// Error return description of invalid value.
+func (e *Error) Error() string {
+	return fmt.Sprintf("bankstatement: invalid %s at line %d", e.Field, e.Line)
+}
+
+// Statement represent account statement.
+type Statement struct {
+	Format   string
+	Account  string
+	DateFrom time.Time
+	DateTo   time.Time
+	Lines    []Line
+}
+
+// Line represent money transfer of statement, Number is position of line in statement.
+type Line struct {
+	Number         int
+	Date           time.Time
+	Amount         float64
+	Reference      string
+	DocumentNumber string
+	Counterparty   string
+	Purpose        string
+}
+
+// Detect return format of statement content.
+func Detect(content []byte) string {
+	content = bytes.TrimPrefix(content, []byte(utf8BOM))
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte(exchangeHeader)) {
+		return Format1C
+	}
+	if len(bytes.TrimSpace(content)) > 0 {
+		return FormatCSV
+	}
+	return ""
+}
+
+// Parse will read statement of given format, format is detected when it is empty.
+// Dates without time zone are read in given location.
+func Parse(content []byte, format string, location *time.Location) (*Statement, error) {
+	if format == "" {
+		format = Detect(content)
+	}
+	text, err := decode(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var statement *Statement
+	switch format {
+	case FormatCSV:
+		statement, err = parseCSV(text, location)
+	case Format1C:
+		statement, err = parseExchange(text, location)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(statement.Lines) == 0 {
+		return nil, ErrEmpty
+	}
+	for i := range statement.Lines {
+		statement.Lines[i].Number = i + 1
+	}
+	return statement, nil
+}
+
+// decode return content as UTF-8 text, content which is not valid UTF-8 is read as Windows-1251 or DOS.
+func decode(content []byte) (string, error) {
+	content = bytes.TrimPrefix(content, []byte(utf8BOM))
+	if utf8.Valid(content) {
+		return string(content), nil
+	}
+	decoder := charmap.Windows1251.NewDecoder()
+	if bytes.Contains(content, []byte("=DOS")) {
+		decoder = charmap.CodePage866.NewDecoder()
+	}
+	decoded, err := decoder.Bytes(content)
+	if err != nil {
+		return "", ErrUnknownFormat
+	}
+	return string(decoded), nil
+}
+
+// parseAmount will read amount with dot or comma as decimal separator and spaces between digits.
+func parseAmount(value string) (float64, bool) {
+	value = strings.NewReplacer(" ", "", " ", "", "'", "").Replace(strings.TrimSpace(value))
+	if strings.Contains(value, ",") {
+		if strings.Contains(value, ".") {
+			value = strings.ReplaceAll(value, ",", "")
+		} else {
+			value = strings.ReplaceAll(value, ",", ".")
+		}
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return amount, true
+}
+
+// parseDate will read date in one of known layouts.
+func parseDate(value string, location *time.Location) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if date, err := time.ParseInLocation(layout, value, location); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// reference return payment reference of line, explicit reference is preferred to UUID found in purpose.
+func reference(explicit string, purpose string) string {
+	if explicit = strings.TrimSpace(explicit); explicit != "" {
+		return explicit
+	}
+	return referencePattern.FindString(purpose)
+}
@@ -0,0 +1,114 @@
+package bankstatement
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+)
+
+// csvColumns are accepted names of header columns, lowercase.
+var csvColumns = map[string][]string{
+	"date":         {"date", "payment_date", "дата", "дата операции", "дата платежа"},
+	"amount":       {"amount", "sum", "сумма", "сумма операции"},
+	"reference":    {"reference", "external_uuid", "external_id", "референс", "идентификатор"},
+	"counterparty": {"counterparty", "payer", "контрагент", "плательщик"},
+	"purpose":      {"purpose", "description", "назначение", "назначение платежа"},
+	"number":       {"number", "document_number", "номер", "номер документа"},
+}
+
+// parseCSV will read statement from CSV with header row, semicolon or comma is used as delimiter.
+func parseCSV(text string, location *time.Location) (*Statement, error) {
+	header := text
+	if i := strings.IndexAny(text, "\r\n"); i >= 0 {
+		header = text[:i]
+	}
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.Comma = ','
+	if strings.Contains(header, ";") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	record, err := reader.Read()
+	if err != nil {
+		return nil, ErrUnknownFormat
+	}
+	columns := csvHeader(record)
+	if _, ok := columns["date"]; !ok {
+		return nil, ErrUnknownFormat
+	}
+	if _, ok := columns["amount"]; !ok {
+		return nil, ErrUnknownFormat
+	}
+
+	statement := &Statement{Format: FormatCSV}
+	for row := 2; ; row++ {
+		record, err = reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, &Error{Line: row, Field: "row"}
+		}
+		value := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		date, ok := parseDate(value("date"), location)
+		if !ok {
+			return nil, &Error{Line: row, Field: "date"}
+		}
+		amount, ok := parseAmount(value("amount"))
+		if !ok {
+			return nil, &Error{Line: row, Field: "amount"}
+		}
+		line := Line{
+			Date:           date,
+			Amount:         amount,
+			DocumentNumber: value("number"),
+			Counterparty:   value("counterparty"),
+			Purpose:        value("purpose"),
+		}
+		line.Reference = reference(value("reference"), line.Purpose)
+		statement.Lines = append(statement.Lines, line)
+		statement.expand(date)
+	}
+	return statement, nil
+}
+
+// csvHeader return position of known columns of header.
+func csvHeader(record []string) map[string]int {
+	columns := make(map[string]int)
+	for i, name := range record {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range csvColumns {
+			if _, ok := columns[column]; ok {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					columns[column] = i
+				}
+			}
+		}
+	}
+	return columns
+}
+
+// expand will extend period of statement to include date.
+func (s *Statement) expand(date time.Time) {
+	if s.DateFrom.IsZero() || date.Before(s.DateFrom) {
+		s.DateFrom = date
+	}
+	if s.DateTo.IsZero() || date.After(s.DateTo) {
+		s.DateTo = date
+	}
+}
@@ -0,0 +1,59 @@
+package bankstatement_test
+
+import (
+	"cargo-rest-api/pkg/bankstatement"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestParse_CSV(t *testing.T) {
+	content := "\xEF\xBB\xBFDate,Amount,Reference,Payer,Purpose\n" +
+		"2021-03-01,1500.50,ext-1,Acquirer,Payout\n" +
+		"2021-03-02,-200,,Acquirer,Refund 3fa85f64-5717-4562-b3fc-2c963f66afa6\n"
+	statement, err := bankstatement.Parse([]byte(content), "", time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, bankstatement.FormatCSV, statement.Format)
+	assert.Len(t, statement.Lines, 2)
+	assert.Equal(t, 1, statement.Lines[0].Number)
+	assert.Equal(t, 1500.5, statement.Lines[0].Amount)
+	assert.Equal(t, "ext-1", statement.Lines[0].Reference)
+	assert.Equal(t, "Acquirer", statement.Lines[0].Counterparty)
+	assert.Equal(t, -200.0, statement.Lines[1].Amount)
+	assert.Equal(t, "3fa85f64-5717-4562-b3fc-2c963f66afa6", statement.Lines[1].Reference)
+	assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), statement.DateFrom)
+	assert.Equal(t, time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC), statement.DateTo)
+}
+
+func TestParse_CSVWindows1251(t *testing.T) {
+	content, _ := charmap.Windows1251.NewEncoder().String(
+		"Дата;Сумма;Плательщик;Назначение\n01.03.2021;1 500,50;Банк;Возмещение\n",
+	)
+	statement, err := bankstatement.Parse([]byte(content), bankstatement.FormatCSV, time.UTC)
+	assert.NoError(t, err)
+	assert.Len(t, statement.Lines, 1)
+	assert.Equal(t, 1500.5, statement.Lines[0].Amount)
+	assert.Equal(t, "Банк", statement.Lines[0].Counterparty)
+	assert.Equal(t, "Возмещение", statement.Lines[0].Purpose)
+}
+
+func TestParse_CSVInvalidAmount(t *testing.T) {
+	content := "date,amount\n2021-03-01,10\n2021-03-02,ten\n"
+	_, err := bankstatement.Parse([]byte(content), bankstatement.FormatCSV, time.UTC)
+	lineErr, ok := err.(*bankstatement.Error)
+	assert.True(t, ok)
+	assert.Equal(t, 3, lineErr.Line)
+	assert.Equal(t, "amount", lineErr.Field)
+}
+
+func TestParse_CSVWithoutColumns(t *testing.T) {
+	_, err := bankstatement.Parse([]byte("name,value\na,1\n"), bankstatement.FormatCSV, time.UTC)
+	assert.Equal(t, bankstatement.ErrUnknownFormat, err)
+}
+
+func TestParse_Empty(t *testing.T) {
+	_, err := bankstatement.Parse([]byte("date,amount\n"), "", time.UTC)
+	assert.Equal(t, bankstatement.ErrEmpty, err)
+}
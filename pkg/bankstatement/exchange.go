@@ -0,0 +1,95 @@
+package bankstatement
+
+import (
+	"strings"
+	"time"
+)
+
+// parseExchange will read statement from 1CClientBankExchange text, documents are read
+// between СекцияДокумент and КонецДокумента, direction of document is defined by account of statement.
+func parseExchange(text string, location *time.Location) (*Statement, error) {
+	statement := &Statement{Format: Format1C}
+	var document map[string]string
+	var start int
+
+	for i, row := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		row = strings.TrimSpace(row)
+		key, value := row, ""
+		if j := strings.Index(row, "="); j >= 0 {
+			key, value = strings.TrimSpace(row[:j]), strings.TrimSpace(row[j+1:])
+		}
+
+		switch {
+		case key == "СекцияДокумент":
+			document, start = map[string]string{}, i+1
+		case key == "КонецДокумента":
+			if document == nil {
+				return nil, &Error{Line: i + 1, Field: "document"}
+			}
+			line, err := exchangeLine(document, statement.Account, start, location)
+			if err != nil {
+				return nil, err
+			}
+			statement.Lines = append(statement.Lines, line)
+			statement.expand(line.Date)
+			document = nil
+		case document != nil:
+			document[key] = value
+		case key == "РасчСчет":
+			statement.Account = value
+		case key == "ДатаНачала":
+			statement.DateFrom, _ = parseDate(value, location)
+		case key == "ДатаКонца":
+			statement.DateTo, _ = parseDate(value, location)
+		}
+	}
+	if document != nil {
+		return nil, &Error{Line: start, Field: "document"}
+	}
+	return statement, nil
+}
+
+// exchangeLine will convert document of 1CClientBankExchange to statement line.
+func exchangeLine(document map[string]string, account string, start int, location *time.Location) (Line, error) {
+	date, ok := parseDate(document["Дата"], location)
+	if !ok {
+		return Line{}, &Error{Line: start, Field: "date"}
+	}
+	amount, ok := parseAmount(document["Сумма"])
+	if !ok {
+		return Line{}, &Error{Line: start, Field: "amount"}
+	}
+
+	outgoing := document["ДатаСписано"] != "" && document["ДатаПоступило"] == ""
+	if account != "" {
+		outgoing = document["ПлательщикСчет"] == account && document["ПолучательСчет"] != account
+	}
+	counterparty := exchangeValue(document, "Плательщик1", "Плательщик")
+	if outgoing {
+		amount = -amount
+		counterparty = exchangeValue(document, "Получатель1", "Получатель")
+	}
+	if received, ok := parseDate(document["ДатаПоступило"], location); ok && !outgoing {
+		date = received
+	}
+
+	purpose := document["НазначениеПлатежа"]
+	return Line{
+		Date:           date,
+		Amount:         amount,
+		Reference:      reference("", purpose),
+		DocumentNumber: document["Номер"],
+		Counterparty:   counterparty,
+		Purpose:        purpose,
+	}, nil
+}
+
+// exchangeValue return first not empty value of keys.
+func exchangeValue(document map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value := document[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
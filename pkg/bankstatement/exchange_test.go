@@ -0,0 +1,89 @@
+package bankstatement_test
+
+import (
+	"cargo-rest-api/pkg/bankstatement"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/charmap"
+)
+
+const exchangeStatement = `1CClientBankExchange
+ВерсияФормата=1.03
+Кодировка=Windows
+ДатаНачала=01.03.2021
+ДатаКонца=31.03.2021
+РасчСчет=40702810000000000001
+СекцияДокумент=Платежное поручение
+Номер=15
+Дата=01.03.2021
+Сумма=1500.50
+ПлательщикСчет=40702810000000000099
+Плательщик1=ООО Эквайер
+ПолучательСчет=40702810000000000001
+Получатель1=ООО Карго
+ДатаПоступило=02.03.2021
+НазначениеПлатежа=Возмещение по операции 3fa85f64-5717-4562-b3fc-2c963f66afa6
+КонецДокумента
+СекцияДокумент=Платежное поручение
+Номер=16
+Дата=03.03.2021
+Сумма=200.00
+ПлательщикСчет=40702810000000000001
+Плательщик1=ООО Карго
+ПолучательСчет=40702810000000000099
+Получатель1=ООО Эквайер
+ДатаСписано=03.03.2021
+НазначениеПлатежа=Возврат
+КонецДокумента
+КонецФайла
+`
+
+func TestParse_1C(t *testing.T) {
+	content, _ := charmap.Windows1251.NewEncoder().String(exchangeStatement)
+	statement, err := bankstatement.Parse([]byte(content), "", time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, bankstatement.Format1C, statement.Format)
+	assert.Equal(t, "40702810000000000001", statement.Account)
+	assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), statement.DateFrom)
+	assert.Equal(t, time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC), statement.DateTo)
+	assert.Len(t, statement.Lines, 2)
+
+	incoming := statement.Lines[0]
+	assert.Equal(t, "15", incoming.DocumentNumber)
+	assert.Equal(t, 1500.5, incoming.Amount)
+	assert.Equal(t, time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC), incoming.Date)
+	assert.Equal(t, "ООО Эквайер", incoming.Counterparty)
+	assert.Equal(t, "3fa85f64-5717-4562-b3fc-2c963f66afa6", incoming.Reference)
+
+	outgoing := statement.Lines[1]
+	assert.Equal(t, -200.0, outgoing.Amount)
+	assert.Equal(t, "ООО Эквайер", outgoing.Counterparty)
+	assert.Equal(t, "", outgoing.Reference)
+}
+
+func TestParse_1CDOS(t *testing.T) {
+	content, _ := charmap.CodePage866.NewEncoder().String(
+		"1CClientBankExchange\nКодировка=DOS\nСекцияДокумент=Платежное поручение\n" +
+			"Дата=01.03.2021\nСумма=10\nДатаПоступило=01.03.2021\nПлательщик=Банк\nКонецДокумента\n",
+	)
+	statement, err := bankstatement.Parse([]byte(content), "", time.UTC)
+	assert.NoError(t, err)
+	assert.Len(t, statement.Lines, 1)
+	assert.Equal(t, 10.0, statement.Lines[0].Amount)
+	assert.Equal(t, "Банк", statement.Lines[0].Counterparty)
+}
+
+func TestParse_1CUnterminatedDocument(t *testing.T) {
+	content := "1CClientBankExchange\nСекцияДокумент=Платежное поручение\nДата=01.03.2021\nСумма=10\n"
+	_, err := bankstatement.Parse([]byte(content), bankstatement.Format1C, time.UTC)
+	lineErr, ok := err.(*bankstatement.Error)
+	assert.True(t, ok)
+	assert.Equal(t, 2, lineErr.Line)
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	_, err := bankstatement.Parse([]byte("date,amount\n"), "xml", time.UTC)
+	assert.Equal(t, bankstatement.ErrUnknownFormat, err)
+}
@@ -0,0 +1,121 @@
+package bankstatement
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	// StatusMatched is status of line matched to payment.
+	StatusMatched = "matched"
+
+	// StatusUnmatched is status of line without payment.
+	StatusUnmatched = "unmatched"
+
+	// StatusDiscrepancy is status of line with payment which differs from line.
+	StatusDiscrepancy = "discrepancy"
+
+	// DiscrepancyAmount is discrepancy of line with amount other than amount of payment.
+	DiscrepancyAmount = "amount"
+
+	// DiscrepancyDate is discrepancy of line with date out of window of payment date.
+	DiscrepancyDate = "date"
+
+	// DiscrepancyDuplicate is discrepancy of line with payment which is already reconciled.
+	DiscrepancyDuplicate = "duplicate"
+
+	amountTolerance = 0.005
+)
+
+// Payment represent payment which can be matched to line, refund has negative amount.
+type Payment struct {
+	ID         string
+	Reference  string
+	Amount     float64
+	Date       time.Time
+	Reconciled bool
+}
+
+// Match represent result of matching line to payment.
+type Match struct {
+	Line        Line
+	Status      string
+	Discrepancy string
+	PaymentID   string
+}
+
+// Reconcile will match lines to payments.
+// Line is matched by reference first, line with reference of payment gets discrepancy when amount differs,
+// date is out of window or payment is already reconciled. Line without reference is matched to the only
+// payment with equal amount and date in window which is not reconciled.
+func Reconcile(lines []Line, payments []Payment, window time.Duration) []Match {
+	matches := make([]Match, len(lines))
+	used := make(map[string]bool)
+	byReference := make(map[string]*Payment)
+	for i := range payments {
+		if payments[i].Reconciled {
+			used[payments[i].ID] = true
+		}
+		if reference := strings.ToLower(payments[i].Reference); reference != "" {
+			byReference[reference] = &payments[i]
+		}
+	}
+
+	for i, line := range lines {
+		matches[i] = Match{Line: line, Status: StatusUnmatched}
+		payment, ok := byReference[strings.ToLower(line.Reference)]
+		if line.Reference == "" || !ok {
+			continue
+		}
+		matches[i].PaymentID = payment.ID
+		switch {
+		case used[payment.ID]:
+			matches[i].Status, matches[i].Discrepancy = StatusDiscrepancy, DiscrepancyDuplicate
+		case !sameAmount(line.Amount, payment.Amount):
+			matches[i].Status, matches[i].Discrepancy = StatusDiscrepancy, DiscrepancyAmount
+		case !inWindow(line.Date, payment.Date, window):
+			matches[i].Status, matches[i].Discrepancy = StatusDiscrepancy, DiscrepancyDate
+		default:
+			matches[i].Status = StatusMatched
+		}
+		used[payment.ID] = true
+	}
+
+	for i, line := range lines {
+		if matches[i].PaymentID != "" {
+			continue
+		}
+		var found *Payment
+		for j := range payments {
+			payment := &payments[j]
+			if used[payment.ID] || !sameAmount(line.Amount, payment.Amount) || !inWindow(line.Date, payment.Date, window) {
+				continue
+			}
+			if found != nil {
+				found = nil
+				break
+			}
+			found = payment
+		}
+		if found != nil {
+			matches[i].Status, matches[i].PaymentID = StatusMatched, found.ID
+			used[found.ID] = true
+		}
+	}
+	return matches
+}
+
+// sameAmount compares amounts up to half of a cent.
+func sameAmount(a float64, b float64) bool {
+	return math.Abs(a-b) < amountTolerance
+}
+
+// inWindow checks date of line is not farther from date of payment than window.
+func inWindow(date time.Time, paid time.Time, window time.Duration) bool {
+	diff := date.Sub(paid)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= window
+}
@@ -0,0 +1,60 @@
+package bankstatement_test
+
+import (
+	"cargo-rest-api/pkg/bankstatement"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2021, 3, d, 0, 0, 0, 0, time.UTC) }
+	window := 72 * time.Hour
+	payments := []bankstatement.Payment{
+		{ID: "p1", Reference: "EXT-1", Amount: 100, Date: day(1)},
+		{ID: "p2", Reference: "ext-2", Amount: 200, Date: day(1)},
+		{ID: "p3", Reference: "ext-3", Amount: 300, Date: day(1)},
+		{ID: "p4", Reference: "ext-4", Amount: 400, Date: day(1), Reconciled: true},
+		{ID: "p5", Amount: 500, Date: day(2)},
+		{ID: "p6", Amount: 600, Date: day(2)},
+		{ID: "p7", Amount: 600, Date: day(3)},
+		{ID: "p8", Amount: -50, Date: day(4)},
+	}
+	lines := []bankstatement.Line{
+		{Number: 1, Reference: "ext-1", Amount: 100, Date: day(2)},
+		{Number: 2, Reference: "ext-2", Amount: 190, Date: day(1)},
+		{Number: 3, Reference: "ext-3", Amount: 300, Date: day(10)},
+		{Number: 4, Reference: "ext-4", Amount: 400, Date: day(1)},
+		{Number: 5, Amount: 500, Date: day(4)},
+		{Number: 6, Amount: 600, Date: day(3)},
+		{Number: 7, Reference: "unknown", Amount: 700, Date: day(3)},
+		{Number: 8, Amount: -50, Date: day(5)},
+		{Number: 9, Reference: "ext-1", Amount: 100, Date: day(2)},
+	}
+
+	matches := bankstatement.Reconcile(lines, payments, window)
+	assert.Len(t, matches, len(lines))
+
+	expected := []struct {
+		status      string
+		discrepancy string
+		paymentID   string
+	}{
+		{bankstatement.StatusMatched, "", "p1"},
+		{bankstatement.StatusDiscrepancy, bankstatement.DiscrepancyAmount, "p2"},
+		{bankstatement.StatusDiscrepancy, bankstatement.DiscrepancyDate, "p3"},
+		{bankstatement.StatusDiscrepancy, bankstatement.DiscrepancyDuplicate, "p4"},
+		{bankstatement.StatusMatched, "", "p5"},
+		{bankstatement.StatusUnmatched, "", ""},
+		{bankstatement.StatusUnmatched, "", ""},
+		{bankstatement.StatusMatched, "", "p8"},
+		{bankstatement.StatusDiscrepancy, bankstatement.DiscrepancyDuplicate, "p1"},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.status, matches[i].Status, "line %d", i+1)
+		assert.Equal(t, e.discrepancy, matches[i].Discrepancy, "line %d", i+1)
+		assert.Equal(t, e.paymentID, matches[i].PaymentID, "line %d", i+1)
+		assert.Equal(t, lines[i].Number, matches[i].Line.Number)
+	}
+}
@@ -0,0 +1,184 @@
+// Package commerceml writes data exchange files in CommerceML 2 format used by 1C.
+// Reference data is written as classifier and catalog, sales are written as documents.
+package commerceml
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+const (
+	// SchemaVersion is a version of CommerceML schema of written files.
+	SchemaVersion = "2.10"
+
+	// RoleSeller is a role of exporter in documents.
+	RoleSeller = "Продавец"
+
+	// OperationOrder is a business operation of order.
+	OperationOrder = "Заказ товара"
+
+	// OperationPayment is a business operation of payment received by bank transfer.
+	OperationPayment = "Выплата безналичных денег"
+
+	// OperationRefund is a business operation of money returned by bank transfer.
+	OperationRefund = "Возврат безналичных денег"
+
+	dateLayout     = "2006-01-02"
+	timeLayout     = "15:04:05"
+	dateTimeLayout = "2006-01-02T15:04:05"
+)
+
+// Information represent root element of CommerceML file.
+type Information struct {
+	XMLName    xml.Name    `xml:"КоммерческаяИнформация"`
+	Version    string      `xml:"ВерсияСхемы,attr"`
+	CreatedAt  string      `xml:"ДатаФормирования,attr"`
+	Classifier *Classifier `xml:"Классификатор,omitempty"`
+	Catalog    *Catalog    `xml:"Каталог,omitempty"`
+	Documents  []Document  `xml:"Документ"`
+}
+
+// Classifier represent classifier with properties, property values are reference data.
+type Classifier struct {
+	ID         string     `xml:"Ид"`
+	Name       string     `xml:"Наименование"`
+	Properties []Property `xml:"Свойства>Свойство,omitempty"`
+}
+
+// Property represent property of classifier with dictionary of values.
+type Property struct {
+	ID        string            `xml:"Ид"`
+	Name      string            `xml:"Наименование"`
+	ValueType string            `xml:"ТипЗначений"`
+	Values    []DictionaryValue `xml:"ВариантыЗначений>Справочник,omitempty"`
+}
+
+// DictionaryValue represent value of dictionary property.
+type DictionaryValue struct {
+	ID    string `xml:"ИдЗначения"`
+	Value string `xml:"Значение"`
+}
+
+// Catalog represent catalog of products.
+type Catalog struct {
+	ID           string    `xml:"Ид"`
+	ClassifierID string    `xml:"ИдКлассификатора"`
+	Name         string    `xml:"Наименование"`
+	OnlyChanges  bool      `xml:"СодержитТолькоИзменения,attr"`
+	Products     []Product `xml:"Товары>Товар"`
+}
+
+// Product represent product or service of catalog.
+type Product struct {
+	ID          string          `xml:"Ид"`
+	Deleted     bool            `xml:"ПометкаУдаления,omitempty"`
+	Name        string          `xml:"Наименование"`
+	BaseUnit    BaseUnit        `xml:"БазоваяЕдиница"`
+	Properties  []PropertyValue `xml:"ЗначенияСвойств>ЗначенияСвойства,omitempty"`
+	Requisites  []Requisite     `xml:"ЗначенияРеквизитов>ЗначениеРеквизита,omitempty"`
+	Description string          `xml:"Описание,omitempty"`
+}
+
+// BaseUnit represent unit of measure by OKEI code.
+type BaseUnit struct {
+	Code     string `xml:"Код,attr"`
+	FullName string `xml:"НаименованиеПолное,attr"`
+	Name     string `xml:",chardata"`
+}
+
+// PropertyValue represent value of classifier property, value is ID of dictionary value.
+type PropertyValue struct {
+	ID    string `xml:"Ид"`
+	Value string `xml:"Значение"`
+}
+
+// Requisite represent named value of product or document.
+type Requisite struct {
+	Name  string `xml:"Наименование"`
+	Value string `xml:"Значение"`
+}
+
+// Document represent business document.
+type Document struct {
+	ID           string         `xml:"Ид"`
+	Deleted      bool           `xml:"ПометкаУдаления,omitempty"`
+	Number       string         `xml:"Номер"`
+	Date         string         `xml:"Дата"`
+	Operation    string         `xml:"ХозОперация"`
+	Role         string         `xml:"Роль"`
+	Currency     string         `xml:"Валюта"`
+	Rate         string         `xml:"Курс"`
+	Amount       string         `xml:"Сумма"`
+	Counterparty []Counterparty `xml:"Контрагенты>Контрагент,omitempty"`
+	Time         string         `xml:"Время"`
+	Comment      string         `xml:"Комментарий,omitempty"`
+	Products     []LineItem     `xml:"Товары>Товар,omitempty"`
+	Requisites   []Requisite    `xml:"ЗначенияРеквизитов>ЗначениеРеквизита,omitempty"`
+}
+
+// Counterparty represent counterparty of document.
+type Counterparty struct {
+	ID       string `xml:"Ид"`
+	Name     string `xml:"Наименование"`
+	Role     string `xml:"Роль"`
+	FullName string `xml:"ПолноеНаименование,omitempty"`
+}
+
+// LineItem represent product of document.
+type LineItem struct {
+	ID         string      `xml:"Ид"`
+	Name       string      `xml:"Наименование"`
+	BaseUnit   BaseUnit    `xml:"БазоваяЕдиница"`
+	UnitPrice  string      `xml:"ЦенаЗаЕдиницу"`
+	Quantity   string      `xml:"Количество"`
+	Amount     string      `xml:"Сумма"`
+	Requisites []Requisite `xml:"ЗначенияРеквизитов>ЗначениеРеквизита,omitempty"`
+}
+
+// New return empty CommerceML file created at given time.
+func New(createdAt time.Time) *Information {
+	return &Information{
+		Version:   SchemaVersion,
+		CreatedAt: DateTime(createdAt),
+	}
+}
+
+// Marshal will encode CommerceML file as indented UTF-8 XML.
+func Marshal(information *Information) ([]byte, error) {
+	content, err := xml.MarshalIndent(information, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(`<?xml version="1.0" encoding="UTF-8"?>`+"\n"), content...), nil
+}
+
+// Piece return base unit of services counted in pieces.
+func Piece() BaseUnit {
+	return BaseUnit{Code: "796", FullName: "Штука", Name: "шт"}
+}
+
+// Date return date in CommerceML format.
+func Date(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+// Time return time of day in CommerceML format.
+func Time(t time.Time) string {
+	return t.Format(timeLayout)
+}
+
+// DateTime return date and time in CommerceML format.
+func DateTime(t time.Time) string {
+	return t.Format(dateTimeLayout)
+}
+
+// Money return amount with two decimal places.
+func Money(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// Quantity return quantity without trailing zeros.
+func Quantity(quantity float64) string {
+	return strconv.FormatFloat(quantity, 'f', -1, 64)
+}
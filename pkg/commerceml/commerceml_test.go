@@ -0,0 +1,79 @@
+package commerceml_test
+
+import (
+	"cargo-rest-api/pkg/commerceml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshal(t *testing.T) {
+	createdAt := time.Date(2021, 3, 1, 10, 30, 0, 0, time.UTC)
+	information := commerceml.New(createdAt)
+	information.Classifier = &commerceml.Classifier{
+		ID:   "classifier",
+		Name: "Классификатор",
+		Properties: []commerceml.Property{
+			{
+				ID:        "sity",
+				Name:      "Город",
+				ValueType: "Справочник",
+				Values:    []commerceml.DictionaryValue{{ID: "s1", Value: "Тверь"}},
+			},
+		},
+	}
+	information.Catalog = &commerceml.Catalog{
+		ID:           "catalog",
+		ClassifierID: "classifier",
+		Name:         "Каталог",
+		OnlyChanges:  true,
+		Products: []commerceml.Product{
+			{ID: "r1", Name: "Проезд Москва - Тверь", BaseUnit: commerceml.Piece(), Deleted: true},
+		},
+	}
+	information.Documents = []commerceml.Document{
+		{
+			ID:        "o1",
+			Number:    "1",
+			Date:      commerceml.Date(createdAt),
+			Time:      commerceml.Time(createdAt),
+			Operation: commerceml.OperationOrder,
+			Role:      commerceml.RoleSeller,
+			Currency:  "RUB",
+			Rate:      "1",
+			Amount:    commerceml.Money(1500.5),
+			Products: []commerceml.LineItem{
+				{
+					ID:        "r1",
+					Name:      "Проезд Москва - Тверь",
+					BaseUnit:  commerceml.Piece(),
+					UnitPrice: commerceml.Money(750.25),
+					Quantity:  commerceml.Quantity(2),
+					Amount:    commerceml.Money(1500.5),
+				},
+			},
+		},
+	}
+
+	content, err := commerceml.Marshal(information)
+	text := string(content)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(text, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(t, text, `<КоммерческаяИнформация ВерсияСхемы="2.10" ДатаФормирования="2021-03-01T10:30:00">`)
+	assert.Contains(t, text, `<ВариантыЗначений>`)
+	assert.Contains(t, text, `<Каталог СодержитТолькоИзменения="true">`)
+	assert.Contains(t, text, `<ПометкаУдаления>true</ПометкаУдаления>`)
+	assert.Contains(t, text, `<БазоваяЕдиница Код="796" НаименованиеПолное="Штука">шт</БазоваяЕдиница>`)
+	assert.Contains(t, text, `<ХозОперация>Заказ товара</ХозОперация>`)
+	assert.Contains(t, text, `<Сумма>1500.50</Сумма>`)
+	assert.Contains(t, text, `<Количество>2</Количество>`)
+	assert.Equal(t, 1, strings.Count(text, "<ПометкаУдаления>"))
+}
+
+func TestMoney(t *testing.T) {
+	assert.Equal(t, "0.00", commerceml.Money(0))
+	assert.Equal(t, "10.10", commerceml.Money(10.1))
+	assert.Equal(t, "-200.00", commerceml.Money(-200))
+}
@@ -0,0 +1,192 @@
+// Package dataimport reads rows of bulk import from CSV file with header row or from JSON array of objects.
+// Every row is returned as field values by lowercase column name, so rows of both formats are handled the same way.
+package dataimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	// FormatCSV is a format of CSV file with header row.
+	FormatCSV = "csv"
+
+	// FormatJSON is a format of JSON array of objects.
+	FormatJSON = "json"
+
+	utf8BOM = "\xef\xbb\xbf"
+)
+
+var (
+	// ErrUnknownFormat is returned when content is neither CSV nor JSON array.
+	ErrUnknownFormat = errors.New("unknown import format")
+
+	// ErrEmpty is returned when content has no rows.
+	ErrEmpty = errors.New("import has no rows")
+)
+
+// Error is returned when row can not be read.
+type Error struct {
+	Row int
+}
+
+// Error return description of error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid row %d", e.Row)
+}
+
+// Row represent fields of row by lowercase column name, number of row starts from 1 for the first data row.
+type Row struct {
+	Number int
+	Fields map[string]string
+}
+
+// Value return trimmed value of field, empty string is returned for missing field.
+func (r Row) Value(name string) string {
+	return strings.TrimSpace(r.Fields[name])
+}
+
+// Detect return format by file extension or by content when extension is unknown.
+func Detect(fileName string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv", ".txt":
+		return FormatCSV
+	case ".json":
+		return FormatJSON
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(bytes.TrimPrefix(content, []byte(utf8BOM))), []byte("[")) {
+		return FormatJSON
+	}
+	return FormatCSV
+}
+
+// Read will read rows of content in format, empty rows are skipped.
+func Read(content []byte, format string) ([]Row, error) {
+	text, err := decode(content)
+	if err != nil {
+		return nil, err
+	}
+	var rows []Row
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(text)
+	case FormatJSON:
+		rows, err = readJSON(text)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrEmpty
+	}
+	return rows, nil
+}
+
+// readCSV will read rows of CSV with header row, semicolon or comma is used as delimiter.
+func readCSV(text string) ([]Row, error) {
+	header := text
+	if i := strings.IndexAny(text, "\r\n"); i >= 0 {
+		header = text[:i]
+	}
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.Comma = ','
+	if strings.Contains(header, ";") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	columns, err := reader.Read()
+	if err != nil {
+		return nil, ErrUnknownFormat
+	}
+	for i, column := range columns {
+		columns[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+
+	var rows []Row
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, &Error{Row: number}
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		row := Row{Number: number, Fields: map[string]string{}}
+		for i, value := range record {
+			if i < len(columns) && columns[i] != "" {
+				row.Fields[columns[i]] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readJSON will read rows of JSON array of objects, numbers and booleans are kept as written.
+func readJSON(text string) ([]Row, error) {
+	var objects []map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	if err := decoder.Decode(&objects); err != nil {
+		return nil, ErrUnknownFormat
+	}
+
+	var rows []Row
+	for i, object := range objects {
+		row := Row{Number: i + 1, Fields: map[string]string{}}
+		for key, value := range object {
+			switch value := value.(type) {
+			case nil:
+				row.Fields[strings.ToLower(key)] = ""
+			case string:
+				row.Fields[strings.ToLower(key)] = value
+			case json.Number:
+				row.Fields[strings.ToLower(key)] = value.String()
+			case bool:
+				row.Fields[strings.ToLower(key)] = strconv.FormatBool(value)
+			case []interface{}:
+				values := make([]string, len(value))
+				for j, item := range value {
+					values[j] = fmt.Sprint(item)
+				}
+				row.Fields[strings.ToLower(key)] = strings.Join(values, ",")
+			default:
+				return nil, &Error{Row: row.Number}
+			}
+		}
+		if len(row.Fields) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// decode will return content as UTF-8, content which is not UTF-8 is read as Windows-1251.
+func decode(content []byte) (string, error) {
+	content = bytes.TrimPrefix(content, []byte(utf8BOM))
+	if utf8.Valid(content) {
+		return string(content), nil
+	}
+	decoded, err := charmap.Windows1251.NewDecoder().Bytes(content)
+	if err != nil {
+		return "", ErrUnknownFormat
+	}
+	return string(decoded), nil
+}
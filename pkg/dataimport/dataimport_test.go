@@ -0,0 +1,82 @@
+package dataimport_test
+
+import (
+	"cargo-rest-api/pkg/dataimport"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestRead_CSV(t *testing.T) {
+	content := "\xef\xbb\xbfReg_Code;Model;Number_Of_Seats\n" +
+		"А123ВС77;\"ПАЗ; 3205\";25\n" +
+		";;\n" +
+		"В456ОР99;ЛиАЗ\n"
+
+	rows, err := dataimport.Read([]byte(content), dataimport.FormatCSV)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, 1, rows[0].Number)
+	assert.Equal(t, "А123ВС77", rows[0].Value("reg_code"))
+	assert.Equal(t, "ПАЗ; 3205", rows[0].Value("model"))
+	assert.Equal(t, "25", rows[0].Value("number_of_seats"))
+	assert.Equal(t, 3, rows[1].Number)
+	assert.Equal(t, "", rows[1].Value("number_of_seats"))
+}
+
+func TestRead_CSVWindows1251(t *testing.T) {
+	content, _ := charmap.Windows1251.NewEncoder().Bytes([]byte("name,region\nТверь,Тверская область\n"))
+
+	rows, err := dataimport.Read(content, dataimport.FormatCSV)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Тверь", rows[0].Value("name"))
+	assert.Equal(t, "Тверская область", rows[0].Value("region"))
+}
+
+func TestRead_JSON(t *testing.T) {
+	content := `[
+		{"Name": "Иванов Иван", "vehicles": ["А123ВС77", "В456ОР99"], "user_email": null},
+		{},
+		{"name": "Петров", "distance": 180, "active": true}
+	]`
+
+	rows, err := dataimport.Read([]byte(content), dataimport.FormatJSON)
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "Иванов Иван", rows[0].Value("name"))
+	assert.Equal(t, "А123ВС77,В456ОР99", rows[0].Value("vehicles"))
+	assert.Equal(t, "", rows[0].Value("user_email"))
+	assert.Equal(t, 3, rows[1].Number)
+	assert.Equal(t, "180", rows[1].Value("distance"))
+	assert.Equal(t, "true", rows[1].Value("active"))
+}
+
+func TestRead_Errors(t *testing.T) {
+	_, err := dataimport.Read([]byte(`{"name": "not array"}`), dataimport.FormatJSON)
+	assert.ErrorIs(t, err, dataimport.ErrUnknownFormat)
+
+	_, err = dataimport.Read([]byte(`[{"name": {"nested": true}}]`), dataimport.FormatJSON)
+	var rowErr *dataimport.Error
+	assert.ErrorAs(t, err, &rowErr)
+	assert.Equal(t, 1, rowErr.Row)
+
+	_, err = dataimport.Read([]byte("name,region\n"), dataimport.FormatCSV)
+	assert.ErrorIs(t, err, dataimport.ErrEmpty)
+
+	_, err = dataimport.Read([]byte("name\n\"unclosed\n"), dataimport.FormatCSV)
+	assert.ErrorAs(t, err, &rowErr)
+
+	_, err = dataimport.Read([]byte("name"), "xml")
+	assert.ErrorIs(t, err, dataimport.ErrUnknownFormat)
+}
+
+func TestDetect(t *testing.T) {
+	assert.Equal(t, dataimport.FormatJSON, dataimport.Detect("routes.JSON", nil))
+	assert.Equal(t, dataimport.FormatCSV, dataimport.Detect("routes.csv", []byte("[")))
+	assert.Equal(t, dataimport.FormatJSON, dataimport.Detect("routes", []byte(" \n[{}]")))
+	assert.Equal(t, dataimport.FormatCSV, dataimport.Detect("", []byte("name\nТверь")))
+}
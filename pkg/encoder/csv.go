@@ -0,0 +1,20 @@
+package encoder
+
+import (
+	"bytes"
+	"encoding/csv"
+)
+
+// utf8BOM lets spreadsheet applications detect encoding of non-latin text.
+const utf8BOM = "\xEF\xBB\xBF"
+
+// CSV formats the given rows and return csv content with UTF-8 byte order mark.
+func CSV(rows [][]string) ([]byte, error) {
+	buffer := bytes.NewBufferString(utf8BOM)
+	writer := csv.NewWriter(buffer)
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
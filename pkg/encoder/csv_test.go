@@ -0,0 +1,20 @@
+package encoder_test
+
+import (
+	"cargo-rest-api/pkg/encoder"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSV(t *testing.T) {
+	rows := [][]string{
+		{"route_name", "revenue"},
+		{"Москва - Тверь", "1500.5"},
+		{"Tver, \"Central\"", "0"},
+	}
+	content, err := encoder.CSV(rows)
+	expectedCSV := "\xEF\xBB\xBFroute_name,revenue\nМосква - Тверь,1500.5\n\"Tver, \"\"Central\"\"\",0\n"
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCSV, string(content))
+}
@@ -0,0 +1,95 @@
+// Package etag builds entity tags of record versions and parses If-Match header into condition of change.
+//
+// Version of record is a time of its last update in nanoseconds, so tag changes on every update of record.
+// Tags are strong: weak tags of If-Match never match, as required for conditional change of record.
+// Condition is passed to storage in context of request and checked in the same statement which changes
+// record, so record changed by concurrent request is never overwritten.
+package etag
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderETag is a name of header containing tag of record.
+	HeaderETag = "ETag"
+
+	// HeaderIfMatch is a name of header containing tags of record client is going to change.
+	HeaderIfMatch = "If-Match"
+
+	// Any is a tag of If-Match matching any existing record.
+	Any = "*"
+
+	weakPrefix = "W/"
+)
+
+// Version return version of record updated at updatedAt.
+func Version(updatedAt time.Time) string {
+	return strconv.FormatInt(updatedAt.UnixNano(), 10)
+}
+
+// New return quoted tag of record updated at updatedAt.
+func New(updatedAt time.Time) string {
+	return strconv.Quote(Version(updatedAt))
+}
+
+// Condition is a condition of If-Match header for change of record of model. Storage checks condition
+// on the first change of record of model and records whether record matched it.
+type Condition struct {
+	Model    interface{}
+	Versions []time.Time
+	Any      bool
+	Checked  bool
+	Failed   bool
+}
+
+type conditionKey struct{}
+
+// NewCondition return condition of comma separated tags of If-Match header, tags are accepted quoted and bare.
+func NewCondition(model interface{}, header string) *Condition {
+	condition := &Condition{Model: model}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == Any {
+			condition.Any = true
+			continue
+		}
+		if strings.HasPrefix(tag, weakPrefix) {
+			continue
+		}
+		nanoseconds, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 64)
+		if err != nil {
+			continue
+		}
+		condition.Versions = append(condition.Versions, time.Unix(0, nanoseconds))
+	}
+	return condition
+}
+
+// Matchable return false when no record can match condition, so change is rejected without storage.
+func (c *Condition) Matchable() bool {
+	return c.Any || len(c.Versions) > 0
+}
+
+// Resolve will record result of conditional change, change failed when it affected no record.
+func (c *Condition) Resolve(rowsAffected int64) {
+	c.Checked = true
+	c.Failed = rowsAffected == 0
+}
+
+// WithCondition return copy of ctx carrying condition of change.
+func WithCondition(ctx context.Context, condition *Condition) context.Context {
+	return context.WithValue(ctx, conditionKey{}, condition)
+}
+
+// ConditionFromContext return condition of change carried by ctx, condition is nil when change is not conditional.
+func ConditionFromContext(ctx context.Context) *Condition {
+	if ctx == nil {
+		return nil
+	}
+	condition, _ := ctx.Value(conditionKey{}).(*Condition)
+	return condition
+}
@@ -0,0 +1,45 @@
+package etag_test
+
+import (
+	"cargo-rest-api/pkg/etag"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	updatedAt := time.Date(2021, 3, 1, 10, 0, 0, 5, time.UTC)
+
+	assert.Equal(t, "1614592800000000005", etag.Version(updatedAt))
+	assert.Equal(t, `"1614592800000000005"`, etag.New(updatedAt))
+	assert.Equal(t, etag.New(updatedAt), etag.New(updatedAt.In(time.FixedZone("MSK", 3*60*60))))
+}
+
+func TestNewCondition(t *testing.T) {
+	updatedAt := time.Date(2021, 3, 1, 10, 0, 0, 5, time.UTC)
+	changedAt := updatedAt.Add(time.Millisecond)
+
+	condition := etag.NewCondition(&struct{}{}, `"1", `+etag.New(updatedAt)+", "+etag.Version(changedAt))
+	assert.False(t, condition.Any)
+	assert.True(t, condition.Matchable())
+	if assert.Len(t, condition.Versions, 3) {
+		assert.True(t, condition.Versions[1].Equal(updatedAt))
+		assert.True(t, condition.Versions[2].Equal(changedAt))
+	}
+
+	assert.True(t, etag.NewCondition(nil, "*").Any)
+	assert.False(t, etag.NewCondition(nil, "W/"+etag.New(updatedAt)).Matchable())
+	assert.False(t, etag.NewCondition(nil, `"abc"`).Matchable())
+}
+
+func TestConditionFromContext(t *testing.T) {
+	condition := etag.NewCondition(nil, "*")
+	assert.Equal(t, condition, etag.ConditionFromContext(etag.WithCondition(context.Background(), condition)))
+	assert.Nil(t, etag.ConditionFromContext(context.Background()))
+
+	condition.Resolve(0)
+	assert.True(t, condition.Checked)
+	assert.True(t, condition.Failed)
+}
@@ -0,0 +1,307 @@
+package fiscal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// AtolURL is an address of ATOL Online API version 4.
+	AtolURL = "https://online.atol.ru/possystem/v4"
+
+	atolTimestampLayout = "02.01.2006 15:04:05"
+	atolTokenLifetime   = 23 * time.Hour
+	atolPaymentCashless = 1
+)
+
+// AtolConfig represent credentials of ATOL Online.
+type AtolConfig struct {
+	URL       string
+	Login     string
+	Password  string
+	GroupCode string
+	Company   Company
+	Timeout   time.Duration
+}
+
+// Atol is a provider of ATOL Online JSON API.
+type Atol struct {
+	config AtolConfig
+	client *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// Atol implements the Provider interface.
+var _ Provider = &Atol{}
+
+type atolError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+type atolTokenRequest struct {
+	Login string `json:"login"`
+	Pass  string `json:"pass"`
+}
+
+type atolTokenResponse struct {
+	Token string     `json:"token"`
+	Error *atolError `json:"error"`
+}
+
+type atolVAT struct {
+	Type string  `json:"type"`
+	Sum  float64 `json:"sum"`
+}
+
+type atolItem struct {
+	Name          string  `json:"name"`
+	Price         float64 `json:"price"`
+	Quantity      float64 `json:"quantity"`
+	Sum           float64 `json:"sum"`
+	PaymentMethod string  `json:"payment_method"`
+	PaymentObject string  `json:"payment_object"`
+	VAT           atolVAT `json:"vat"`
+}
+
+type atolPayment struct {
+	Type int     `json:"type"`
+	Sum  float64 `json:"sum"`
+}
+
+type atolClient struct {
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+type atolCompany struct {
+	Email          string `json:"email"`
+	SNO            string `json:"sno,omitempty"`
+	INN            string `json:"inn"`
+	PaymentAddress string `json:"payment_address"`
+}
+
+type atolReceipt struct {
+	Client   atolClient    `json:"client"`
+	Company  atolCompany   `json:"company"`
+	Items    []atolItem    `json:"items"`
+	Payments []atolPayment `json:"payments"`
+	VATs     []atolVAT     `json:"vats"`
+	Total    float64       `json:"total"`
+}
+
+type atolRegisterRequest struct {
+	ExternalID string      `json:"external_id"`
+	Receipt    atolReceipt `json:"receipt"`
+	Timestamp  string      `json:"timestamp"`
+}
+
+type atolPayload struct {
+	FiscalDocumentNumber    json.Number `json:"fiscal_document_number"`
+	FiscalDocumentAttribute json.Number `json:"fiscal_document_attribute"`
+	FNNumber                string      `json:"fn_number"`
+	ReceiptDatetime         string      `json:"receipt_datetime"`
+}
+
+type atolResponse struct {
+	UUID    string       `json:"uuid"`
+	Status  string       `json:"status"`
+	Error   *atolError   `json:"error"`
+	Payload *atolPayload `json:"payload"`
+}
+
+// NewAtol will initialize ATOL Online provider.
+func NewAtol(config AtolConfig) (*Atol, error) {
+	if config.Login == "" || config.Password == "" || config.GroupCode == "" || config.Company.INN == "" {
+		return nil, ErrProviderNotConfigured
+	}
+	if config.URL == "" {
+		config.URL = AtolURL
+	}
+	config.URL = strings.TrimRight(config.URL, "/")
+	if config.Timeout == 0 {
+		config.Timeout = 30 * time.Second
+	}
+	return &Atol{config: config, client: &http.Client{Timeout: config.Timeout}}, nil
+}
+
+// Register will send receipt to operation endpoint of group.
+func (a *Atol) Register(receipt *Receipt) (*Result, error) {
+	if len(receipt.Items) == 0 {
+		return nil, ErrEmptyReceipt
+	}
+	request := atolRegisterRequest{
+		ExternalID: receipt.ExternalID,
+		Receipt:    a.receipt(receipt),
+		Timestamp:  receipt.Timestamp.Format(atolTimestampLayout),
+	}
+	var response atolResponse
+	path := fmt.Sprintf("/%s/%s", a.config.GroupCode, receipt.Operation)
+	if err := a.call(http.MethodPost, path, request, &response); err != nil {
+		return nil, err
+	}
+	return response.result()
+}
+
+// Report will return state of receipt registered by uuid.
+func (a *Atol) Report(uuid string) (*Result, error) {
+	var response atolResponse
+	path := fmt.Sprintf("/%s/report/%s", a.config.GroupCode, uuid)
+	if err := a.call(http.MethodGet, path, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.result()
+}
+
+// receipt will return receipt in format of API, VAT is summed for every tag.
+func (a *Atol) receipt(receipt *Receipt) atolReceipt {
+	result := atolReceipt{
+		Client: atolClient{Email: receipt.Client.Email, Phone: receipt.Client.Phone},
+		Company: atolCompany{
+			Email:          a.config.Company.Email,
+			SNO:            a.config.Company.SNO,
+			INN:            a.config.Company.INN,
+			PaymentAddress: a.config.Company.PaymentAddress,
+		},
+		Payments: []atolPayment{{Type: atolPaymentCashless, Sum: receipt.Total}},
+		Total:    receipt.Total,
+	}
+	vats := map[string]float64{}
+	var tags []string
+	for _, item := range receipt.Items {
+		vat := item.VAT
+		if vat == "" {
+			vat = VATNone
+		}
+		if _, ok := vats[vat]; !ok {
+			tags = append(tags, vat)
+		}
+		vats[vat] += item.Sum
+		result.Items = append(result.Items, atolItem{
+			Name:          item.Name,
+			Price:         item.Price,
+			Quantity:      item.Quantity,
+			Sum:           item.Sum,
+			PaymentMethod: "full_payment",
+			PaymentObject: "service",
+			VAT:           atolVAT{Type: vat, Sum: VATSum(vat, item.Sum)},
+		})
+	}
+	for _, tag := range tags {
+		result.VATs = append(result.VATs, atolVAT{Type: tag, Sum: VATSum(tag, vats[tag])})
+	}
+	return result
+}
+
+// call will send request with token, token is requested again once when expired.
+func (a *Atol) call(method string, path string, request interface{}, response *atolResponse) error {
+	for attempt := 0; ; attempt++ {
+		token, err := a.authenticate(attempt > 0)
+		if err != nil {
+			return err
+		}
+		status, err := a.send(method, path, token, request, response)
+		if err != nil {
+			return err
+		}
+		if status == http.StatusUnauthorized && attempt == 0 {
+			continue
+		}
+		if status >= http.StatusInternalServerError || status == http.StatusUnauthorized {
+			return fmt.Errorf("atol responded with status %d", status)
+		}
+		if status >= http.StatusBadRequest && response.Error == nil {
+			return fmt.Errorf("atol responded with status %d", status)
+		}
+		return nil
+	}
+}
+
+// authenticate return cached token or request a new one.
+func (a *Atol) authenticate(renew bool) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !renew && a.token != "" && time.Now().Before(a.expires) {
+		return a.token, nil
+	}
+
+	body, err := json.Marshal(atolTokenRequest{Login: a.config.Login, Pass: a.config.Password})
+	if err != nil {
+		return "", err
+	}
+	resp, err := a.client.Post(a.config.URL+"/getToken", "application/json; charset=utf-8", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var token atolTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("atol token: %w", err)
+	}
+	if token.Error != nil {
+		return "", fmt.Errorf("atol token: %s", token.Error.Text)
+	}
+	if token.Token == "" {
+		return "", fmt.Errorf("atol token: responded with status %d", resp.StatusCode)
+	}
+	a.token, a.expires = token.Token, time.Now().Add(atolTokenLifetime)
+	return a.token, nil
+}
+
+// send will send request and decode response, status of response is returned.
+func (a *Atol) send(method string, path string, token string, request interface{}, response *atolResponse) (int, error) {
+	var body bytes.Buffer
+	if request != nil {
+		if err := json.NewEncoder(&body).Encode(request); err != nil {
+			return 0, err
+		}
+	}
+	req, err := http.NewRequest(method, a.config.URL+path, &body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Token", token)
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode >= http.StatusInternalServerError {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return resp.StatusCode, fmt.Errorf("atol response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// result will convert response to result, rejected receipt is returned with fail status.
+func (r *atolResponse) result() (*Result, error) {
+	result := &Result{UUID: r.UUID, Status: r.Status}
+	if r.Error != nil {
+		result.Error = fmt.Sprintf("%d: %s", r.Error.Code, r.Error.Text)
+		if r.Status == "" {
+			result.Status = StatusFail
+		}
+		if r.Error.Type == "system" && r.Status != StatusFail {
+			return nil, fmt.Errorf("atol: %s", result.Error)
+		}
+	}
+	if r.Payload != nil {
+		result.DocumentNumber = r.Payload.FiscalDocumentNumber.String()
+		result.Sign = r.Payload.FiscalDocumentAttribute.String()
+		result.StorageNumber = r.Payload.FNNumber
+		result.RegisteredAt, _ = time.ParseInLocation(atolTimestampLayout, r.Payload.ReceiptDatetime, time.Local)
+	}
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package fiscal_test
+
+import (
+	"cargo-rest-api/pkg/fiscal"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAtolServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*fiscal.Atol, *int) {
+	tokens := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/getToken", func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		assert.Equal(t, "login", request["login"])
+		assert.Equal(t, "secret", request["pass"])
+		tokens++
+		_, _ = w.Write([]byte(`{"token":"token-` + string(rune('0'+tokens)) + `","error":null}`))
+	})
+	mux.HandleFunc("/", handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	atol, err := fiscal.NewAtol(fiscal.AtolConfig{
+		URL:       server.URL + "/",
+		Login:     "login",
+		Password:  "secret",
+		GroupCode: "group",
+		Company:   fiscal.Company{INN: "7700000000", Email: "shop@example.com", SNO: "osn", PaymentAddress: "example.com"},
+	})
+	assert.NoError(t, err)
+	return atol, &tokens
+}
+
+func TestNewAtol_NotConfigured(t *testing.T) {
+	_, err := fiscal.NewAtol(fiscal.AtolConfig{Login: "login"})
+	assert.ErrorIs(t, err, fiscal.ErrProviderNotConfigured)
+}
+
+func TestAtolRegister(t *testing.T) {
+	var body map[string]interface{}
+	atol, _ := newAtolServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/group/sell_refund", r.URL.Path)
+		assert.Equal(t, "token-1", r.Header.Get("Token"))
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"uuid":"atol-uuid","status":"wait","error":null}`))
+	})
+
+	result, err := atol.Register(&fiscal.Receipt{
+		ExternalID: "payment-1",
+		Operation:  fiscal.OperationSellRefund,
+		Timestamp:  time.Date(2021, 3, 1, 10, 5, 0, 0, time.UTC),
+		Client:     fiscal.Client{Email: "client@example.com"},
+		Items: []fiscal.Item{
+			{Name: "ticket", Price: 600, Quantity: 2, Sum: 1200, VAT: fiscal.VAT20},
+			{Name: "luggage", Price: 100, Quantity: 1, Sum: 100},
+		},
+		Total: 1300,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "atol-uuid", result.UUID)
+	assert.Equal(t, fiscal.StatusWait, result.Status)
+	assert.Equal(t, "payment-1", body["external_id"])
+	assert.Equal(t, "01.03.2021 10:05:00", body["timestamp"])
+
+	receipt := body["receipt"].(map[string]interface{})
+	assert.Equal(t, 1300.0, receipt["total"])
+	assert.Equal(t, "7700000000", receipt["company"].(map[string]interface{})["inn"])
+	assert.Equal(t, "client@example.com", receipt["client"].(map[string]interface{})["email"])
+	items := receipt["items"].([]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "vat20", "sum": 200.0}, items[0].(map[string]interface{})["vat"])
+	assert.Equal(t, map[string]interface{}{"type": "none", "sum": 0.0}, items[1].(map[string]interface{})["vat"])
+	assert.Len(t, receipt["vats"], 2)
+}
+
+func TestAtolRegister_Rejected(t *testing.T) {
+	atol, _ := newAtolServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"uuid":"","status":"fail","error":{"code":32,"text":"validation","type":"system"}}`))
+	})
+
+	result, err := atol.Register(&fiscal.Receipt{
+		Operation: fiscal.OperationSell,
+		Items:     []fiscal.Item{{Name: "ticket", Price: 100, Quantity: 1, Sum: 100}},
+		Total:     100,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, fiscal.StatusFail, result.Status)
+	assert.Equal(t, "32: validation", result.Error)
+}
+
+func TestAtolRegister_Unavailable(t *testing.T) {
+	atol, _ := newAtolServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := atol.Register(&fiscal.Receipt{
+		Operation: fiscal.OperationSell,
+		Items:     []fiscal.Item{{Name: "ticket", Price: 100, Quantity: 1, Sum: 100}},
+		Total:     100,
+	})
+
+	assert.Error(t, err)
+}
+
+func TestAtolReport_ExpiredToken(t *testing.T) {
+	atol, tokens := newAtolServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/group/report/atol-uuid", r.URL.Path)
+		if r.Header.Get("Token") == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"uuid":"atol-uuid","status":"done","error":null,"payload":{
+			"fiscal_document_number":1843,"fiscal_document_attribute":3326126016,
+			"fn_number":"9999078900004312","receipt_datetime":"01.03.2021 10:05:00"}}`))
+	})
+
+	result, err := atol.Report("atol-uuid")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *tokens)
+	assert.Equal(t, fiscal.StatusDone, result.Status)
+	assert.Equal(t, "1843", result.DocumentNumber)
+	assert.Equal(t, "3326126016", result.Sign)
+	assert.Equal(t, "9999078900004312", result.StorageNumber)
+	assert.Equal(t, 2021, result.RegisteredAt.Year())
+}
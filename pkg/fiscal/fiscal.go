@@ -0,0 +1,154 @@
+// Package fiscal registers receipts of online cash register (54-FZ) at fiscal data provider.
+// Receipts are registered asynchronously, provider returns uuid of registration which is used to get report
+// with fiscal document number and fiscal sign when receipt is printed by cash register.
+package fiscal
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	// OperationSell is an operation of receipt of income.
+	OperationSell = "sell"
+
+	// OperationSellRefund is an operation of receipt of income return.
+	OperationSellRefund = "sell_refund"
+
+	// StatusWait is a status of receipt registered and waiting for cash register.
+	StatusWait = "wait"
+
+	// StatusDone is a status of receipt printed by cash register.
+	StatusDone = "done"
+
+	// StatusFail is a status of receipt rejected by provider or cash register.
+	StatusFail = "fail"
+
+	// VATNone is a tag of item without VAT.
+	VATNone = "none"
+
+	// VAT0 is a tag of item with VAT 0%.
+	VAT0 = "vat0"
+
+	// VAT10 is a tag of item with VAT 10%.
+	VAT10 = "vat10"
+
+	// VAT20 is a tag of item with VAT 20%.
+	VAT20 = "vat20"
+
+	// VAT110 is a tag of item with VAT 10/110.
+	VAT110 = "vat110"
+
+	// VAT120 is a tag of item with VAT 20/120.
+	VAT120 = "vat120"
+)
+
+var (
+	// ErrProviderNotConfigured is returned when provider is requested without credentials.
+	ErrProviderNotConfigured = errors.New("fiscal provider is not configured")
+
+	// ErrUnknownProvider is returned when provider name is not supported.
+	ErrUnknownProvider = errors.New("fiscal provider is not supported")
+
+	// ErrEmptyReceipt is returned when receipt has no items.
+	ErrEmptyReceipt = errors.New("fiscal receipt has no items")
+)
+
+// Provider is an interface of fiscal data provider which registers receipts at cash register.
+type Provider interface {
+	// Register will send receipt to provider, result contains uuid of registration.
+	// Error is returned when receipt may be sent again, rejected receipt is returned as result with fail status.
+	Register(receipt *Receipt) (*Result, error)
+
+	// Report will return state of registered receipt.
+	Report(uuid string) (*Result, error)
+}
+
+// Company represent seller printed on receipt.
+type Company struct {
+	INN            string
+	Email          string
+	SNO            string
+	PaymentAddress string
+}
+
+// Client represent buyer who receives electronic receipt, email or phone is required.
+type Client struct {
+	Email string
+	Phone string
+}
+
+// Item represent line of receipt.
+type Item struct {
+	Name     string
+	Price    float64
+	Quantity float64
+	Sum      float64
+	VAT      string
+}
+
+// Receipt represent receipt of payment.
+type Receipt struct {
+	ExternalID string
+	Operation  string
+	Timestamp  time.Time
+	Client     Client
+	Items      []Item
+	Total      float64
+}
+
+// Result represent state of receipt at provider.
+type Result struct {
+	UUID           string
+	Status         string
+	DocumentNumber string
+	Sign           string
+	StorageNumber  string
+	RegisteredAt   time.Time
+	Error          string
+}
+
+// Fit will spread difference between total and sum of items over items,
+// so sum of items always equals total paid by client, rounding remainder is added to the last item.
+func (r *Receipt) Fit() {
+	var sum float64
+	for _, item := range r.Items {
+		sum += item.Sum
+	}
+	sum = Round(sum)
+	if len(r.Items) == 0 || sum == r.Total {
+		return
+	}
+	var fitted float64
+	for index := range r.Items {
+		item := &r.Items[index]
+		if sum > 0 {
+			item.Sum = Round(item.Sum * r.Total / sum)
+		}
+		if index == len(r.Items)-1 {
+			item.Sum = Round(r.Total - fitted)
+		}
+		fitted += item.Sum
+		if item.Quantity > 0 {
+			item.Price = Round(item.Sum / item.Quantity)
+		}
+	}
+}
+
+// VATSum return VAT included in sum of item.
+func VATSum(vat string, sum float64) float64 {
+	switch vat {
+	case VAT10, VAT110:
+		return Round(sum * 10 / 110)
+	case VAT20, VAT120:
+		return Round(sum * 20 / 120)
+	default:
+		return 0
+	}
+}
+
+// Round will round money to kopecks.
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
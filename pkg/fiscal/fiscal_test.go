@@ -0,0 +1,71 @@
+package fiscal_test
+
+import (
+	"cargo-rest-api/pkg/fiscal"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiptFit(t *testing.T) {
+	receipt := fiscal.Receipt{
+		Total: 1000,
+		Items: []fiscal.Item{
+			{Name: "adult", Price: 500, Quantity: 1, Sum: 500},
+			{Name: "child", Price: 350, Quantity: 2, Sum: 700},
+		},
+	}
+	receipt.Fit()
+
+	assert.Equal(t, 416.67, receipt.Items[0].Sum)
+	assert.Equal(t, 583.33, receipt.Items[1].Sum)
+	assert.Equal(t, 291.67, receipt.Items[1].Price)
+	assert.Equal(t, receipt.Total, fiscal.Round(receipt.Items[0].Sum+receipt.Items[1].Sum))
+}
+
+func TestReceiptFit_Equal(t *testing.T) {
+	receipt := fiscal.Receipt{Total: 700, Items: []fiscal.Item{{Price: 350, Quantity: 2, Sum: 700}}}
+	receipt.Fit()
+
+	assert.Equal(t, 350.0, receipt.Items[0].Price)
+	assert.Equal(t, 700.0, receipt.Items[0].Sum)
+}
+
+func TestVATSum(t *testing.T) {
+	assert.Equal(t, 20.0, fiscal.VATSum(fiscal.VAT20, 120))
+	assert.Equal(t, 20.0, fiscal.VATSum(fiscal.VAT120, 120))
+	assert.Equal(t, 10.0, fiscal.VATSum(fiscal.VAT10, 110))
+	assert.Equal(t, 0.0, fiscal.VATSum(fiscal.VAT0, 110))
+	assert.Equal(t, 0.0, fiscal.VATSum(fiscal.VATNone, 110))
+}
+
+func TestStub(t *testing.T) {
+	stub := fiscal.NewStub()
+	receipt := &fiscal.Receipt{
+		ExternalID: "payment-1",
+		Operation:  fiscal.OperationSell,
+		Items:      []fiscal.Item{{Name: "ticket", Price: 100, Quantity: 1, Sum: 100}},
+		Total:      100,
+	}
+
+	registered, err := stub.Register(receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, fiscal.StatusDone, registered.Status)
+	assert.Equal(t, "1", registered.DocumentNumber)
+	assert.NotEmpty(t, registered.Sign)
+
+	again, err := stub.Register(receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, registered.UUID, again.UUID)
+
+	report, err := stub.Report(registered.UUID)
+	assert.NoError(t, err)
+	assert.Equal(t, registered.Sign, report.Sign)
+
+	missing, err := stub.Report("unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, fiscal.StatusFail, missing.Status)
+
+	_, err = stub.Register(&fiscal.Receipt{ExternalID: "payment-2"})
+	assert.ErrorIs(t, err, fiscal.ErrEmptyReceipt)
+}
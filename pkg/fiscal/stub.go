@@ -0,0 +1,69 @@
+package fiscal
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StubStorageNumber is a number of fiscal storage printed on receipts of stub provider.
+const StubStorageNumber = "9999078900000000"
+
+// Stub is a local provider which prints every receipt at once without cash register.
+// It is used in development and tests, fiscal sign is derived from receipt so it never matches real one.
+type Stub struct {
+	mu       sync.Mutex
+	number   int
+	receipts map[string]Result
+	external map[string]string
+}
+
+// Stub implements the Provider interface.
+var _ Provider = &Stub{}
+
+// NewStub will initialize stub provider.
+func NewStub() *Stub {
+	return &Stub{receipts: map[string]Result{}, external: map[string]string{}}
+}
+
+// Register will print receipt, receipt with the same external id is printed once.
+func (s *Stub) Register(receipt *Receipt) (*Result, error) {
+	if len(receipt.Items) == 0 {
+		return nil, ErrEmptyReceipt
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if printed, ok := s.external[receipt.ExternalID]; ok {
+		result := s.receipts[printed]
+		return &result, nil
+	}
+
+	s.number++
+	sum := sha1.Sum([]byte(receipt.ExternalID + receipt.Operation + strconv.Itoa(s.number)))
+	result := Result{
+		UUID:           uuid.New().String(),
+		Status:         StatusDone,
+		DocumentNumber: strconv.Itoa(s.number),
+		Sign:           strconv.FormatUint(uint64(binary.BigEndian.Uint32(sum[:4])), 10),
+		StorageNumber:  StubStorageNumber,
+		RegisteredAt:   time.Now().Truncate(time.Second),
+	}
+	s.receipts[result.UUID] = result
+	s.external[receipt.ExternalID] = result.UUID
+	return &result, nil
+}
+
+// Report will return printed receipt.
+func (s *Stub) Report(uuid string) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result, ok := s.receipts[uuid]
+	if !ok {
+		return &Result{UUID: uuid, Status: StatusFail, Error: "receipt is not found"}, nil
+	}
+	return &result, nil
+}
@@ -0,0 +1,271 @@
+// Package gtfs writes static timetable feed in GTFS format consumed by trip planners and map applications.
+// Feed is validated structurally before it is written: required files and fields, unique ids, references
+// between files and order of stop times are checked.
+package gtfs
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	// DateLayout is a layout of service dates.
+	DateLayout = "20060102"
+
+	// RouteTypeBus is a type of route served by bus.
+	RouteTypeBus = 3
+
+	// ExceptionAdded is a type of calendar date when service is added.
+	ExceptionAdded = 1
+
+	// ExceptionRemoved is a type of calendar date when service is removed.
+	ExceptionRemoved = 2
+
+	// TimepointExact is a timepoint of stop time which is exact.
+	TimepointExact = 1
+)
+
+// Agency represent row of agency.txt.
+type Agency struct {
+	ID       string
+	Name     string
+	URL      string
+	Timezone string
+	Lang     string
+	Phone    string
+}
+
+// Stop represent row of stops.txt, stop of read feed is not located when it has no coordinates,
+// e.g. platform of parent station. Parent station is not written.
+type Stop struct {
+	ID            string
+	Name          string
+	Description   string
+	Latitude      float64
+	Longitude     float64
+	Located       bool
+	ParentStation string
+}
+
+// Route represent row of routes.txt.
+type Route struct {
+	ID        string
+	AgencyID  string
+	ShortName string
+	LongName  string
+	Type      int
+}
+
+// Trip represent row of trips.txt.
+type Trip struct {
+	ID        string
+	RouteID   string
+	ServiceID string
+	Headsign  string
+}
+
+// StopTime represent row of stop_times.txt, times are seconds since start of service date,
+// so time of trip running past midnight is greater than 24 hours.
+type StopTime struct {
+	TripID        string
+	StopID        string
+	Sequence      int
+	ArrivalTime   int
+	DepartureTime int
+	Timepoint     int
+}
+
+// Calendar represent row of calendar.txt, days are indexed by time.Weekday.
+type Calendar struct {
+	ServiceID string
+	Days      [7]bool
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// CalendarDate represent row of calendar_dates.txt.
+type CalendarDate struct {
+	ServiceID     string
+	Date          time.Time
+	ExceptionType int
+}
+
+// FeedInfo represent row of feed_info.txt.
+type FeedInfo struct {
+	PublisherName string
+	PublisherURL  string
+	Lang          string
+	StartDate     time.Time
+	EndDate       time.Time
+	Version       string
+}
+
+// Feed represent static GTFS feed.
+type Feed struct {
+	Agencies      []Agency
+	Stops         []Stop
+	Routes        []Route
+	Trips         []Trip
+	StopTimes     []StopTime
+	Calendars     []Calendar
+	CalendarDates []CalendarDate
+	FeedInfo      *FeedInfo
+}
+
+// Error is returned when feed is not valid, row is a number of data row in file starting from 1.
+type Error struct {
+	File    string
+	Row     int
+	Field   string
+	Message string
+}
+
+// Error return description of error.
+func (e *Error) Error() string {
+	if e.Row == 0 {
+		return fmt.Sprintf("%s: %s", e.File, e.Message)
+	}
+	return fmt.Sprintf("%s row %d field %s: %s", e.File, e.Row, e.Field, e.Message)
+}
+
+// FormatTime return seconds of service day as HH:MM:SS, hours may exceed 24.
+func FormatTime(seconds int) string {
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// Marshal will validate feed and return it as zip archive.
+func Marshal(feed *Feed) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := Write(&buffer, feed); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Write will validate feed and write it as zip archive, optional files are written when they have rows.
+func Write(w io.Writer, feed *Feed) error {
+	if err := feed.Validate(); err != nil {
+		return err
+	}
+	archive := zip.NewWriter(w)
+	for _, file := range feed.files() {
+		if file.optional && len(file.rows) == 0 {
+			continue
+		}
+		writer, err := archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		records := csv.NewWriter(writer)
+		if err := records.Write(file.header); err != nil {
+			return err
+		}
+		if err := records.WriteAll(file.rows); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// file represent CSV file of feed.
+type file struct {
+	name     string
+	header   []string
+	rows     [][]string
+	optional bool
+}
+
+// files return files of feed in order of GTFS reference.
+func (f *Feed) files() []file {
+	agency := file{name: "agency.txt", header: []string{
+		"agency_id", "agency_name", "agency_url", "agency_timezone", "agency_lang", "agency_phone",
+	}}
+	for _, v := range f.Agencies {
+		agency.rows = append(agency.rows, []string{v.ID, v.Name, v.URL, v.Timezone, v.Lang, v.Phone})
+	}
+	stops := file{name: "stops.txt", header: []string{"stop_id", "stop_name", "stop_desc", "stop_lat", "stop_lon"}}
+	for _, v := range f.Stops {
+		stops.rows = append(stops.rows, []string{
+			v.ID, v.Name, v.Description, formatCoordinate(v.Latitude), formatCoordinate(v.Longitude),
+		})
+	}
+	routes := file{name: "routes.txt", header: []string{
+		"route_id", "agency_id", "route_short_name", "route_long_name", "route_type",
+	}}
+	for _, v := range f.Routes {
+		routes.rows = append(routes.rows, []string{v.ID, v.AgencyID, v.ShortName, v.LongName, strconv.Itoa(v.Type)})
+	}
+	trips := file{name: "trips.txt", header: []string{"route_id", "service_id", "trip_id", "trip_headsign"}}
+	for _, v := range f.Trips {
+		trips.rows = append(trips.rows, []string{v.RouteID, v.ServiceID, v.ID, v.Headsign})
+	}
+	stopTimes := file{name: "stop_times.txt", header: []string{
+		"trip_id", "arrival_time", "departure_time", "stop_id", "stop_sequence", "timepoint",
+	}}
+	for _, v := range f.StopTimes {
+		stopTimes.rows = append(stopTimes.rows, []string{
+			v.TripID,
+			FormatTime(v.ArrivalTime),
+			FormatTime(v.DepartureTime),
+			v.StopID,
+			strconv.Itoa(v.Sequence),
+			strconv.Itoa(v.Timepoint),
+		})
+	}
+	calendar := file{name: "calendar.txt", optional: true, header: []string{
+		"service_id", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday",
+		"start_date", "end_date",
+	}}
+	for _, v := range f.Calendars {
+		row := []string{v.ServiceID}
+		for _, day := range []time.Weekday{
+			time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+		} {
+			row = append(row, formatBool(v.Days[day]))
+		}
+		calendar.rows = append(calendar.rows, append(row, v.StartDate.Format(DateLayout), v.EndDate.Format(DateLayout)))
+	}
+	calendarDates := file{name: "calendar_dates.txt", optional: true, header: []string{
+		"service_id", "date", "exception_type",
+	}}
+	for _, v := range f.CalendarDates {
+		calendarDates.rows = append(calendarDates.rows, []string{
+			v.ServiceID, v.Date.Format(DateLayout), strconv.Itoa(v.ExceptionType),
+		})
+	}
+	feedInfo := file{name: "feed_info.txt", optional: true, header: []string{
+		"feed_publisher_name", "feed_publisher_url", "feed_lang", "feed_start_date", "feed_end_date", "feed_version",
+	}}
+	if v := f.FeedInfo; v != nil {
+		feedInfo.rows = append(feedInfo.rows, []string{
+			v.PublisherName, v.PublisherURL, v.Lang, formatDate(v.StartDate), formatDate(v.EndDate), v.Version,
+		})
+	}
+	return []file{agency, stops, routes, trips, stopTimes, calendar, calendarDates, feedInfo}
+}
+
+// formatCoordinate return coordinate in degrees with precision of about 10 cm.
+func formatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', 6, 64)
+}
+
+// formatBool return 1 for true and 0 for false.
+func formatBool(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+// formatDate return date of feed, zero date is empty.
+func formatDate(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format(DateLayout)
+}
@@ -0,0 +1,148 @@
+package gtfs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"cargo-rest-api/pkg/gtfs"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sampleFeed return valid feed of one trip running every day except one date.
+func sampleFeed() *gtfs.Feed {
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	return &gtfs.Feed{
+		Agencies: []gtfs.Agency{
+			{ID: "agency", Name: "Перевозчик", URL: "https://example.com", Timezone: "Europe/Moscow", Lang: "ru"},
+		},
+		Stops: []gtfs.Stop{
+			{ID: "s1", Name: "Тверь", Latitude: 56.858721, Longitude: 35.917597},
+			{ID: "s2", Name: "Москва", Latitude: 55.755826, Longitude: 37.6173},
+		},
+		Routes: []gtfs.Route{{ID: "r1", AgencyID: "agency", LongName: "Тверь - Москва", Type: gtfs.RouteTypeBus}},
+		Trips:  []gtfs.Trip{{ID: "t1", RouteID: "r1", ServiceID: "daily", Headsign: "Москва"}},
+		StopTimes: []gtfs.StopTime{
+			{TripID: "t1", StopID: "s1", Sequence: 1, ArrivalTime: 82800, DepartureTime: 82800},
+			{TripID: "t1", StopID: "s2", Sequence: 2, ArrivalTime: 95400, DepartureTime: 95400},
+		},
+		Calendars: []gtfs.Calendar{
+			{ServiceID: "daily", Days: [7]bool{true, true, true, true, true, true, true}, StartDate: start,
+				EndDate: start.AddDate(0, 0, 6)},
+		},
+		CalendarDates: []gtfs.CalendarDate{
+			{ServiceID: "daily", Date: start.AddDate(0, 0, 2), ExceptionType: gtfs.ExceptionRemoved},
+		},
+		FeedInfo: &gtfs.FeedInfo{PublisherName: "Перевозчик", PublisherURL: "https://example.com", Lang: "ru"},
+	}
+}
+
+// readFile return records of file of zip archive.
+func readFile(t *testing.T, archive *zip.Reader, name string) [][]string {
+	for _, file := range archive.File {
+		if file.Name != name {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("this is the error: %v\n", err)
+		}
+		defer reader.Close()
+		records, err := csv.NewReader(reader).ReadAll()
+		if err != nil {
+			t.Fatalf("this is the error: %v\n", err)
+		}
+		return records
+	}
+	return nil
+}
+
+func TestMarshal(t *testing.T) {
+	content, err := gtfs.Marshal(sampleFeed())
+	assert.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	assert.NoError(t, err)
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.Equal(t, []string{
+		"agency.txt", "stops.txt", "routes.txt", "trips.txt", "stop_times.txt", "calendar.txt",
+		"calendar_dates.txt", "feed_info.txt",
+	}, names)
+
+	stopTimes := readFile(t, archive, "stop_times.txt")
+	assert.Equal(t, []string{"trip_id", "arrival_time", "departure_time", "stop_id", "stop_sequence", "timepoint"},
+		stopTimes[0])
+	assert.Equal(t, "23:00:00", stopTimes[1][2])
+	assert.Equal(t, "26:30:00", stopTimes[2][1])
+
+	calendar := readFile(t, archive, "calendar.txt")
+	assert.Equal(t, []string{"daily", "1", "1", "1", "1", "1", "1", "1", "20210301", "20210307"}, calendar[1])
+	assert.Equal(t, []string{"daily", "20210303", "2"}, readFile(t, archive, "calendar_dates.txt")[1])
+	assert.Equal(t, "56.858721", readFile(t, archive, "stops.txt")[1][3])
+}
+
+func TestMarshal_OptionalFiles(t *testing.T) {
+	feed := sampleFeed()
+	feed.Calendars = nil
+	feed.CalendarDates[0].ExceptionType = gtfs.ExceptionAdded
+	feed.FeedInfo = nil
+
+	content, err := gtfs.Marshal(feed)
+	assert.NoError(t, err)
+
+	archive, _ := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	assert.Nil(t, readFile(t, archive, "calendar.txt"))
+	assert.Nil(t, readFile(t, archive, "feed_info.txt"))
+	assert.Len(t, readFile(t, archive, "calendar_dates.txt"), 2)
+}
+
+func TestValidate(t *testing.T) {
+	samples := []struct {
+		modify func(feed *gtfs.Feed)
+		file   string
+		field  string
+	}{
+		{func(feed *gtfs.Feed) { feed.Agencies = nil }, "agency.txt", ""},
+		{func(feed *gtfs.Feed) { feed.Agencies[0].URL = "example.com" }, "agency.txt", "agency_url"},
+		{func(feed *gtfs.Feed) { feed.Agencies[0].Timezone = "Moscow" }, "agency.txt", "agency_timezone"},
+		{func(feed *gtfs.Feed) { feed.Stops[1].ID = "s1" }, "stops.txt", "stop_id"},
+		{func(feed *gtfs.Feed) { feed.Stops[0].Latitude = 91 }, "stops.txt", "stop_lat"},
+		{func(feed *gtfs.Feed) { feed.Routes[0].AgencyID = "other" }, "routes.txt", "agency_id"},
+		{func(feed *gtfs.Feed) { feed.Routes[0].LongName = "" }, "routes.txt", "route_long_name"},
+		{func(feed *gtfs.Feed) { feed.Calendars, feed.CalendarDates = nil, nil }, "calendar.txt", ""},
+		{func(feed *gtfs.Feed) { feed.Calendars[0].EndDate = time.Time{} }, "calendar.txt", "end_date"},
+		{func(feed *gtfs.Feed) { feed.CalendarDates[0].ExceptionType = 3 }, "calendar_dates.txt", "exception_type"},
+		{func(feed *gtfs.Feed) { feed.Trips[0].ServiceID = "weekend" }, "trips.txt", "service_id"},
+		{func(feed *gtfs.Feed) { feed.StopTimes[1].StopID = "s3" }, "stop_times.txt", "stop_id"},
+		{func(feed *gtfs.Feed) { feed.StopTimes[1].Sequence = 1 }, "stop_times.txt", "stop_sequence"},
+		{func(feed *gtfs.Feed) { feed.StopTimes[1].ArrivalTime = 3600 }, "stop_times.txt", "arrival_time"},
+		{func(feed *gtfs.Feed) { feed.StopTimes = feed.StopTimes[:1] }, "trips.txt", "trip_id"},
+		{func(feed *gtfs.Feed) { feed.FeedInfo.Lang = "" }, "feed_info.txt", "feed_lang"},
+	}
+
+	for _, v := range samples {
+		feed := sampleFeed()
+		v.modify(feed)
+
+		err := feed.Validate()
+
+		var feedErr *gtfs.Error
+		if assert.ErrorAs(t, err, &feedErr) {
+			assert.Equal(t, v.file, feedErr.File)
+			assert.Equal(t, v.field, feedErr.Field)
+		}
+		_, err = gtfs.Marshal(feed)
+		assert.Error(t, err)
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	assert.Equal(t, "00:00:00", gtfs.FormatTime(0))
+	assert.Equal(t, "08:05:09", gtfs.FormatTime(8*3600+5*60+9))
+	assert.Equal(t, "25:30:00", gtfs.FormatTime(25*3600+30*60))
+}
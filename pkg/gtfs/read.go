@@ -0,0 +1,412 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NoTime is a time of stop time which is not set, times of intermediate stops may be interpolated by consumer.
+const NoTime = -1
+
+// MaxTableSize is a max uncompressed size of file of feed in bytes, larger file is not read.
+var MaxTableSize int64 = 256 << 20
+
+// Read will read feed from zip archive, files may be placed in directory of archive.
+// Required files and columns are checked, other structural checks are left to Validate.
+func Read(content []byte) (*Feed, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, &Error{File: "gtfs.zip", Message: "is not zip archive"}
+	}
+	files := map[string]*zip.File{}
+	for _, file := range archive.File {
+		files[path.Base(file.Name)] = file
+	}
+
+	feed := &Feed{}
+	readers := []struct {
+		name     string
+		optional bool
+		read     func(*table) error
+	}{
+		{"agency.txt", false, feed.readAgencies},
+		{"stops.txt", false, feed.readStops},
+		{"routes.txt", false, feed.readRoutes},
+		{"trips.txt", false, feed.readTrips},
+		{"stop_times.txt", false, feed.readStopTimes},
+		{"calendar.txt", true, feed.readCalendars},
+		{"calendar_dates.txt", true, feed.readCalendarDates},
+	}
+	for _, reader := range readers {
+		file, ok := files[reader.name]
+		if !ok {
+			if reader.optional {
+				continue
+			}
+			return nil, &Error{File: reader.name, Message: "file is missing"}
+		}
+		t, err := openTable(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := reader.read(t); err != nil {
+			return nil, err
+		}
+	}
+	if len(feed.Calendars) == 0 && len(feed.CalendarDates) == 0 {
+		return nil, &Error{File: "calendar.txt", Message: "calendar.txt or calendar_dates.txt must have rows"}
+	}
+	sort.SliceStable(feed.StopTimes, func(i, j int) bool {
+		if feed.StopTimes[i].TripID != feed.StopTimes[j].TripID {
+			return feed.StopTimes[i].TripID < feed.StopTimes[j].TripID
+		}
+		return feed.StopTimes[i].Sequence < feed.StopTimes[j].Sequence
+	})
+	return feed, nil
+}
+
+// ServiceDates return dates of every service between start and end dates inclusive, dates are in UTC.
+// Weekdays of calendar are applied first, dates added and removed by calendar dates are applied afterwards.
+func (f *Feed) ServiceDates(start time.Time, end time.Time) map[string][]time.Time {
+	start = toDate(start)
+	end = toDate(end)
+	active := map[string]map[time.Time]bool{}
+	set := func(serviceID string, date time.Time, value bool) {
+		if active[serviceID] == nil {
+			active[serviceID] = map[time.Time]bool{}
+		}
+		active[serviceID][date] = value
+	}
+	for _, calendar := range f.Calendars {
+		from, to := toDate(calendar.StartDate), toDate(calendar.EndDate)
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+			if calendar.Days[date.Weekday()] {
+				set(calendar.ServiceID, date, true)
+			}
+		}
+	}
+	for _, calendarDate := range f.CalendarDates {
+		date := toDate(calendarDate.Date)
+		if date.Before(start) || date.After(end) {
+			continue
+		}
+		set(calendarDate.ServiceID, date, calendarDate.ExceptionType == ExceptionAdded)
+	}
+
+	result := map[string][]time.Time{}
+	for serviceID, dates := range active {
+		for date, ok := range dates {
+			if ok {
+				result[serviceID] = append(result[serviceID], date)
+			}
+		}
+		sort.Slice(result[serviceID], func(i, j int) bool {
+			return result[serviceID][i].Before(result[serviceID][j])
+		})
+	}
+	return result
+}
+
+// ParseTime return seconds of service day of time HH:MM:SS, hours may exceed 24.
+func ParseTime(value string) (int, bool) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	var seconds int
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 || (i > 0 && (number > 59 || len(part) != 2)) {
+			return 0, false
+		}
+		seconds = seconds*60 + number
+	}
+	return seconds, true
+}
+
+// table represent CSV file of feed read by column names.
+type table struct {
+	name    string
+	columns map[string]int
+	records [][]string
+}
+
+// openTable will read all records of CSV file, header is trimmed and BOM is removed.
+// Size of file is checked while reading as well, size in header of archive may be forged.
+func openTable(file *zip.File) (*table, error) {
+	name := path.Base(file.Name)
+	if file.UncompressedSize64 > uint64(MaxTableSize) {
+		return nil, &Error{File: name, Message: "is too large"}
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, &Error{File: name, Message: "can not be opened"}
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(io.LimitReader(reader, MaxTableSize+1))
+	if err != nil {
+		return nil, &Error{File: name, Message: "can not be read"}
+	}
+	if int64(len(content)) > MaxTableSize {
+		return nil, &Error{File: name, Message: "is too large"}
+	}
+	records := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	records.FieldsPerRecord = -1
+	header, err := records.Read()
+	if err == io.EOF {
+		return &table{name: name, columns: map[string]int{}}, nil
+	}
+	if err != nil {
+		return nil, &Error{File: name, Message: "is not valid CSV"}
+	}
+	t := &table{name: name, columns: map[string]int{}}
+	for i, column := range header {
+		t.columns[strings.TrimSpace(column)] = i
+	}
+	for {
+		record, err := records.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, &Error{File: name, Row: len(t.records) + 1, Message: "is not valid CSV"}
+		}
+		t.records = append(t.records, record)
+	}
+	return t, nil
+}
+
+// require return error when any of columns is missing.
+func (t *table) require(columns ...string) error {
+	for _, column := range columns {
+		if _, ok := t.columns[column]; !ok {
+			return &Error{File: t.name, Field: column, Message: "column is missing"}
+		}
+	}
+	return nil
+}
+
+// value return trimmed value of column in record, missing value is empty.
+func (t *table) value(record []string, column string) string {
+	i, ok := t.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// each will call read for every record with number of row starting from 1.
+func (t *table) each(read func(row int, value func(string) string) error) error {
+	for i, record := range t.records {
+		record := record
+		if err := read(i+1, func(column string) string { return t.value(record, column) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invalid return error of invalid value of field in row.
+func (t *table) invalid(row int, field string) error {
+	return &Error{File: t.name, Row: row, Field: field, Message: "is invalid"}
+}
+
+// readAgencies will read agency.txt.
+func (f *Feed) readAgencies(t *table) error {
+	if err := t.require("agency_name", "agency_timezone"); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		f.Agencies = append(f.Agencies, Agency{
+			ID:       value("agency_id"),
+			Name:     value("agency_name"),
+			URL:      value("agency_url"),
+			Timezone: value("agency_timezone"),
+			Lang:     value("agency_lang"),
+			Phone:    value("agency_phone"),
+		})
+		return nil
+	})
+}
+
+// readStops will read stops.txt, coordinates are optional.
+func (f *Feed) readStops(t *table) error {
+	if err := t.require("stop_id"); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		stop := Stop{
+			ID:            value("stop_id"),
+			Name:          value("stop_name"),
+			Description:   value("stop_desc"),
+			ParentStation: value("parent_station"),
+		}
+		latitude, longitude := value("stop_lat"), value("stop_lon")
+		if latitude != "" || longitude != "" {
+			var err error
+			if stop.Latitude, err = strconv.ParseFloat(latitude, 64); err != nil {
+				return t.invalid(row, "stop_lat")
+			}
+			if stop.Longitude, err = strconv.ParseFloat(longitude, 64); err != nil {
+				return t.invalid(row, "stop_lon")
+			}
+			stop.Located = true
+		}
+		f.Stops = append(f.Stops, stop)
+		return nil
+	})
+}
+
+// readRoutes will read routes.txt.
+func (f *Feed) readRoutes(t *table) error {
+	if err := t.require("route_id", "route_type"); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		routeType, err := strconv.Atoi(value("route_type"))
+		if err != nil {
+			return t.invalid(row, "route_type")
+		}
+		f.Routes = append(f.Routes, Route{
+			ID:        value("route_id"),
+			AgencyID:  value("agency_id"),
+			ShortName: value("route_short_name"),
+			LongName:  value("route_long_name"),
+			Type:      routeType,
+		})
+		return nil
+	})
+}
+
+// readTrips will read trips.txt.
+func (f *Feed) readTrips(t *table) error {
+	if err := t.require("route_id", "service_id", "trip_id"); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		f.Trips = append(f.Trips, Trip{
+			ID:        value("trip_id"),
+			RouteID:   value("route_id"),
+			ServiceID: value("service_id"),
+			Headsign:  value("trip_headsign"),
+		})
+		return nil
+	})
+}
+
+// readStopTimes will read stop_times.txt, empty times are NoTime.
+func (f *Feed) readStopTimes(t *table) error {
+	if err := t.require("trip_id", "stop_id", "stop_sequence"); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		sequence, err := strconv.Atoi(value("stop_sequence"))
+		if err != nil {
+			return t.invalid(row, "stop_sequence")
+		}
+		stopTime := StopTime{
+			TripID:        value("trip_id"),
+			StopID:        value("stop_id"),
+			Sequence:      sequence,
+			ArrivalTime:   NoTime,
+			DepartureTime: NoTime,
+		}
+		for field, target := range map[string]*int{
+			"arrival_time":   &stopTime.ArrivalTime,
+			"departure_time": &stopTime.DepartureTime,
+		} {
+			if text := value(field); text != "" {
+				seconds, ok := ParseTime(text)
+				if !ok {
+					return t.invalid(row, field)
+				}
+				*target = seconds
+			}
+		}
+		if timepoint := value("timepoint"); timepoint != "" {
+			if stopTime.Timepoint, err = strconv.Atoi(timepoint); err != nil {
+				return t.invalid(row, "timepoint")
+			}
+		}
+		f.StopTimes = append(f.StopTimes, stopTime)
+		return nil
+	})
+}
+
+// readCalendars will read calendar.txt.
+func (f *Feed) readCalendars(t *table) error {
+	weekdays := map[string]time.Weekday{
+		"monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday, "thursday": time.Thursday,
+		"friday": time.Friday, "saturday": time.Saturday, "sunday": time.Sunday,
+	}
+	columns := []string{"service_id", "start_date", "end_date"}
+	for column := range weekdays {
+		columns = append(columns, column)
+	}
+	if err := t.require(columns...); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		calendar := Calendar{ServiceID: value("service_id")}
+		for column, weekday := range weekdays {
+			switch value(column) {
+			case "0":
+			case "1":
+				calendar.Days[weekday] = true
+			default:
+				return t.invalid(row, column)
+			}
+		}
+		var err error
+		if calendar.StartDate, err = time.Parse(DateLayout, value("start_date")); err != nil {
+			return t.invalid(row, "start_date")
+		}
+		if calendar.EndDate, err = time.Parse(DateLayout, value("end_date")); err != nil {
+			return t.invalid(row, "end_date")
+		}
+		f.Calendars = append(f.Calendars, calendar)
+		return nil
+	})
+}
+
+// readCalendarDates will read calendar_dates.txt.
+func (f *Feed) readCalendarDates(t *table) error {
+	if err := t.require("service_id", "date", "exception_type"); err != nil {
+		return err
+	}
+	return t.each(func(row int, value func(string) string) error {
+		date, err := time.Parse(DateLayout, value("date"))
+		if err != nil {
+			return t.invalid(row, "date")
+		}
+		exceptionType, err := strconv.Atoi(value("exception_type"))
+		if err != nil || (exceptionType != ExceptionAdded && exceptionType != ExceptionRemoved) {
+			return t.invalid(row, "exception_type")
+		}
+		f.CalendarDates = append(f.CalendarDates, CalendarDate{
+			ServiceID:     value("service_id"),
+			Date:          date,
+			ExceptionType: exceptionType,
+		})
+		return nil
+	})
+}
+
+// toDate return date of time in UTC.
+func toDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,165 @@
+package gtfs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"cargo-rest-api/pkg/gtfs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// archive return zip archive of files.
+func archive(t *testing.T, files map[string]string) []byte {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("this is the error: %v\n", err)
+		}
+		_, _ = file.Write([]byte(content))
+	}
+	_ = writer.Close()
+	return buffer.Bytes()
+}
+
+// partnerFeed return files of feed with stations, platforms and interpolated stop times.
+func partnerFeed() map[string]string {
+	return map[string]string{
+		"feed/agency.txt": "\xef\xbb\xbfagency_id,agency_name,agency_url,agency_timezone\n" +
+			"a,Партнер,https://partner.example,Europe/Moscow\n",
+		"feed/stops.txt": "stop_id,stop_name,stop_lat,stop_lon,location_type,parent_station\n" +
+			"tver,Тверь автовокзал,56.8587,35.9176,1,\n" +
+			"tver-1,Платформа 1,,,0,tver\n" +
+			"klin,Клин,56.3318,36.7286,0,\n" +
+			"msk,Москва,55.7558,37.6173,0,\n",
+		"feed/routes.txt": "route_id,agency_id,route_short_name,route_type\nr1,a,101,3\n",
+		"feed/trips.txt":  "route_id,service_id,trip_id\nr1,weekdays,t1\n",
+		"feed/stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\n" +
+			"t1,26:30:00,26:30:00,msk,3\n" +
+			"t1,,,klin,2\n" +
+			"t1,23:00:00,23:00:00,tver-1,1\n",
+		"feed/calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"weekdays,1,1,1,1,1,0,0,20210301,20210331\n",
+		"feed/calendar_dates.txt": "service_id,date,exception_type\n" +
+			"weekdays,20210308,2\n" +
+			"weekdays,20210306,1\n",
+	}
+}
+
+func TestRead(t *testing.T) {
+	feed, err := gtfs.Read(archive(t, partnerFeed()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Moscow", feed.Agencies[0].Timezone)
+	assert.Len(t, feed.Stops, 4)
+	assert.True(t, feed.Stops[0].Located)
+	assert.False(t, feed.Stops[1].Located)
+	assert.Equal(t, "tver", feed.Stops[1].ParentStation)
+	assert.Equal(t, "101", feed.Routes[0].ShortName)
+	assert.Equal(t, []string{"tver-1", "klin", "msk"}, []string{
+		feed.StopTimes[0].StopID, feed.StopTimes[1].StopID, feed.StopTimes[2].StopID,
+	})
+	assert.Equal(t, 82800, feed.StopTimes[0].DepartureTime)
+	assert.Equal(t, gtfs.NoTime, feed.StopTimes[1].ArrivalTime)
+	assert.Equal(t, 95400, feed.StopTimes[2].ArrivalTime)
+	assert.True(t, feed.Calendars[0].Days[time.Friday])
+	assert.False(t, feed.Calendars[0].Days[time.Sunday])
+}
+
+func TestRead_Written(t *testing.T) {
+	content, _ := gtfs.Marshal(sampleFeed())
+
+	feed, err := gtfs.Read(content)
+
+	assert.NoError(t, err)
+	assert.Len(t, feed.Trips, 1)
+	assert.Equal(t, sampleFeed().StopTimes[1].ArrivalTime, feed.StopTimes[1].ArrivalTime)
+	assert.Equal(t, sampleFeed().Calendars[0].EndDate, feed.Calendars[0].EndDate)
+	assert.NoError(t, feed.Validate())
+}
+
+func TestRead_Errors(t *testing.T) {
+	samples := []struct {
+		modify func(files map[string]string)
+		file   string
+		field  string
+	}{
+		{func(files map[string]string) { delete(files, "feed/stop_times.txt") }, "stop_times.txt", ""},
+		{func(files map[string]string) {
+			delete(files, "feed/calendar.txt")
+			delete(files, "feed/calendar_dates.txt")
+		}, "calendar.txt", ""},
+		{func(files map[string]string) { files["feed/trips.txt"] = "route_id,trip_id\nr1,t1\n" }, "trips.txt", "service_id"},
+		{func(files map[string]string) {
+			files["feed/stop_times.txt"] = "trip_id,arrival_time,departure_time,stop_id,stop_sequence\nt1,7:5:00,,msk,1\n"
+		}, "stop_times.txt", "arrival_time"},
+		{func(files map[string]string) {
+			files["feed/stops.txt"] = "stop_id,stop_name,stop_lat,stop_lon\nmsk,Москва,north,37.6\n"
+		}, "stops.txt", "stop_lat"},
+		{func(files map[string]string) {
+			files["feed/calendar_dates.txt"] = "service_id,date,exception_type\nweekdays,2021-03-08,2\n"
+		}, "calendar_dates.txt", "date"},
+	}
+
+	for _, v := range samples {
+		files := partnerFeed()
+		v.modify(files)
+
+		_, err := gtfs.Read(archive(t, files))
+
+		var feedErr *gtfs.Error
+		if assert.ErrorAs(t, err, &feedErr) {
+			assert.Equal(t, v.file, feedErr.File)
+			assert.Equal(t, v.field, feedErr.Field)
+		}
+	}
+
+	_, err := gtfs.Read([]byte("agency_id,agency_name"))
+	assert.Error(t, err)
+}
+
+func TestRead_TooLarge(t *testing.T) {
+	files := partnerFeed()
+	maxTableSize := gtfs.MaxTableSize
+	gtfs.MaxTableSize = int64(len(files["feed/stops.txt"]) - 1)
+	defer func() { gtfs.MaxTableSize = maxTableSize }()
+
+	_, err := gtfs.Read(archive(t, files))
+
+	var feedErr *gtfs.Error
+	if assert.ErrorAs(t, err, &feedErr) {
+		assert.Equal(t, "stops.txt", feedErr.File)
+	}
+}
+
+func TestServiceDates(t *testing.T) {
+	feed, _ := gtfs.Read(archive(t, partnerFeed()))
+
+	dates := feed.ServiceDates(time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 9, 0, 0, 0, 0, time.UTC))
+
+	var days []int
+	for _, date := range dates["weekdays"] {
+		days = append(days, date.Day())
+	}
+	assert.Equal(t, []int{4, 5, 6, 9}, days)
+}
+
+func TestParseTime(t *testing.T) {
+	seconds, ok := gtfs.ParseTime("25:30:05")
+	assert.True(t, ok)
+	assert.Equal(t, 25*3600+30*60+5, seconds)
+
+	seconds, ok = gtfs.ParseTime("7:05:00")
+	assert.True(t, ok)
+	assert.Equal(t, 7*3600+5*60, seconds)
+
+	_, ok = gtfs.ParseTime("07:5:00")
+	assert.False(t, ok)
+	_, ok = gtfs.ParseTime("07:60:00")
+	assert.False(t, ok)
+	_, ok = gtfs.ParseTime("07:00")
+	assert.False(t, ok)
+}
@@ -0,0 +1,244 @@
+package gtfs
+
+import (
+	"net/url"
+	"time"
+)
+
+// Validate return the first structural error of feed: missing required files and fields, duplicated ids,
+// references to missing records, invalid coordinates and stop times out of order.
+func (f *Feed) Validate() error {
+	if err := f.validateAgencies(); err != nil {
+		return err
+	}
+	stops, err := f.validateStops()
+	if err != nil {
+		return err
+	}
+	routes, err := f.validateRoutes()
+	if err != nil {
+		return err
+	}
+	services, err := f.validateServices()
+	if err != nil {
+		return err
+	}
+	trips, err := f.validateTrips(routes, services)
+	if err != nil {
+		return err
+	}
+	if err := f.validateStopTimes(trips, stops); err != nil {
+		return err
+	}
+	return f.validateFeedInfo()
+}
+
+// validateAgencies will check agencies, agency id is required when feed has more than one agency.
+func (f *Feed) validateAgencies() error {
+	if len(f.Agencies) == 0 {
+		return &Error{File: "agency.txt", Message: "file has no rows"}
+	}
+	ids := map[string]bool{}
+	for i, v := range f.Agencies {
+		row := i + 1
+		switch {
+		case v.ID == "" && len(f.Agencies) > 1:
+			return &Error{File: "agency.txt", Row: row, Field: "agency_id", Message: "is required"}
+		case ids[v.ID]:
+			return &Error{File: "agency.txt", Row: row, Field: "agency_id", Message: "is duplicated"}
+		case v.Name == "":
+			return &Error{File: "agency.txt", Row: row, Field: "agency_name", Message: "is required"}
+		case !validURL(v.URL):
+			return &Error{File: "agency.txt", Row: row, Field: "agency_url", Message: "must be absolute URL"}
+		case !validTimezone(v.Timezone):
+			return &Error{File: "agency.txt", Row: row, Field: "agency_timezone", Message: "must be IANA timezone"}
+		case v.Timezone != f.Agencies[0].Timezone:
+			return &Error{File: "agency.txt", Row: row, Field: "agency_timezone", Message: "must be the same for all agencies"}
+		}
+		ids[v.ID] = true
+	}
+	return nil
+}
+
+// validateStops will check stops and return them by id.
+func (f *Feed) validateStops() (map[string]bool, error) {
+	if len(f.Stops) == 0 {
+		return nil, &Error{File: "stops.txt", Message: "file has no rows"}
+	}
+	ids := map[string]bool{}
+	for i, v := range f.Stops {
+		row := i + 1
+		switch {
+		case v.ID == "":
+			return nil, &Error{File: "stops.txt", Row: row, Field: "stop_id", Message: "is required"}
+		case ids[v.ID]:
+			return nil, &Error{File: "stops.txt", Row: row, Field: "stop_id", Message: "is duplicated"}
+		case v.Name == "":
+			return nil, &Error{File: "stops.txt", Row: row, Field: "stop_name", Message: "is required"}
+		case v.Latitude < -90 || v.Latitude > 90:
+			return nil, &Error{File: "stops.txt", Row: row, Field: "stop_lat", Message: "must be between -90 and 90"}
+		case v.Longitude < -180 || v.Longitude > 180:
+			return nil, &Error{File: "stops.txt", Row: row, Field: "stop_lon", Message: "must be between -180 and 180"}
+		}
+		ids[v.ID] = true
+	}
+	return ids, nil
+}
+
+// validateRoutes will check routes and return them by id.
+func (f *Feed) validateRoutes() (map[string]bool, error) {
+	if len(f.Routes) == 0 {
+		return nil, &Error{File: "routes.txt", Message: "file has no rows"}
+	}
+	agencies := map[string]bool{}
+	for _, v := range f.Agencies {
+		agencies[v.ID] = true
+	}
+	ids := map[string]bool{}
+	for i, v := range f.Routes {
+		row := i + 1
+		switch {
+		case v.ID == "":
+			return nil, &Error{File: "routes.txt", Row: row, Field: "route_id", Message: "is required"}
+		case ids[v.ID]:
+			return nil, &Error{File: "routes.txt", Row: row, Field: "route_id", Message: "is duplicated"}
+		case !agencies[v.AgencyID]:
+			return nil, &Error{File: "routes.txt", Row: row, Field: "agency_id", Message: "references missing agency"}
+		case v.ShortName == "" && v.LongName == "":
+			return nil, &Error{File: "routes.txt", Row: row, Field: "route_long_name", Message: "is required"}
+		case v.Type < 0:
+			return nil, &Error{File: "routes.txt", Row: row, Field: "route_type", Message: "is invalid"}
+		}
+		ids[v.ID] = true
+	}
+	return ids, nil
+}
+
+// validateServices will check calendar and calendar dates and return services defined by them.
+func (f *Feed) validateServices() (map[string]bool, error) {
+	if len(f.Calendars) == 0 && len(f.CalendarDates) == 0 {
+		return nil, &Error{File: "calendar.txt", Message: "calendar.txt or calendar_dates.txt must have rows"}
+	}
+	services := map[string]bool{}
+	for i, v := range f.Calendars {
+		row := i + 1
+		switch {
+		case v.ServiceID == "":
+			return nil, &Error{File: "calendar.txt", Row: row, Field: "service_id", Message: "is required"}
+		case services[v.ServiceID]:
+			return nil, &Error{File: "calendar.txt", Row: row, Field: "service_id", Message: "is duplicated"}
+		case v.StartDate.IsZero():
+			return nil, &Error{File: "calendar.txt", Row: row, Field: "start_date", Message: "is required"}
+		case v.EndDate.Before(v.StartDate):
+			return nil, &Error{File: "calendar.txt", Row: row, Field: "end_date", Message: "is before start_date"}
+		}
+		services[v.ServiceID] = true
+	}
+	dates := map[string]bool{}
+	for i, v := range f.CalendarDates {
+		row := i + 1
+		key := v.ServiceID + " " + v.Date.Format(DateLayout)
+		switch {
+		case v.ServiceID == "":
+			return nil, &Error{File: "calendar_dates.txt", Row: row, Field: "service_id", Message: "is required"}
+		case v.Date.IsZero():
+			return nil, &Error{File: "calendar_dates.txt", Row: row, Field: "date", Message: "is required"}
+		case dates[key]:
+			return nil, &Error{File: "calendar_dates.txt", Row: row, Field: "date", Message: "is duplicated"}
+		case v.ExceptionType != ExceptionAdded && v.ExceptionType != ExceptionRemoved:
+			return nil, &Error{File: "calendar_dates.txt", Row: row, Field: "exception_type", Message: "is invalid"}
+		}
+		dates[key] = true
+		services[v.ServiceID] = true
+	}
+	return services, nil
+}
+
+// validateTrips will check trips and return them by id.
+func (f *Feed) validateTrips(routes map[string]bool, services map[string]bool) (map[string]bool, error) {
+	if len(f.Trips) == 0 {
+		return nil, &Error{File: "trips.txt", Message: "file has no rows"}
+	}
+	ids := map[string]bool{}
+	for i, v := range f.Trips {
+		row := i + 1
+		switch {
+		case v.ID == "":
+			return nil, &Error{File: "trips.txt", Row: row, Field: "trip_id", Message: "is required"}
+		case ids[v.ID]:
+			return nil, &Error{File: "trips.txt", Row: row, Field: "trip_id", Message: "is duplicated"}
+		case !routes[v.RouteID]:
+			return nil, &Error{File: "trips.txt", Row: row, Field: "route_id", Message: "references missing route"}
+		case !services[v.ServiceID]:
+			return nil, &Error{File: "trips.txt", Row: row, Field: "service_id", Message: "references missing service"}
+		}
+		ids[v.ID] = true
+	}
+	return ids, nil
+}
+
+// validateStopTimes will check that every trip has at least two stop times in order of sequence and time.
+func (f *Feed) validateStopTimes(trips map[string]bool, stops map[string]bool) error {
+	last := map[string]*StopTime{}
+	count := map[string]int{}
+	for i := range f.StopTimes {
+		v := &f.StopTimes[i]
+		row := i + 1
+		previous := last[v.TripID]
+		switch {
+		case !trips[v.TripID]:
+			return &Error{File: "stop_times.txt", Row: row, Field: "trip_id", Message: "references missing trip"}
+		case !stops[v.StopID]:
+			return &Error{File: "stop_times.txt", Row: row, Field: "stop_id", Message: "references missing stop"}
+		case v.Sequence < 0:
+			return &Error{File: "stop_times.txt", Row: row, Field: "stop_sequence", Message: "is invalid"}
+		case v.ArrivalTime < 0 || v.DepartureTime < v.ArrivalTime:
+			return &Error{File: "stop_times.txt", Row: row, Field: "departure_time", Message: "is before arrival_time"}
+		case previous != nil && v.Sequence <= previous.Sequence:
+			return &Error{File: "stop_times.txt", Row: row, Field: "stop_sequence", Message: "must increase"}
+		case previous != nil && v.ArrivalTime < previous.DepartureTime:
+			return &Error{File: "stop_times.txt", Row: row, Field: "arrival_time", Message: "is before previous stop"}
+		}
+		last[v.TripID] = v
+		count[v.TripID]++
+	}
+	for i, v := range f.Trips {
+		if count[v.ID] < 2 {
+			return &Error{File: "trips.txt", Row: i + 1, Field: "trip_id", Message: "must have at least two stop times"}
+		}
+	}
+	return nil
+}
+
+// validateFeedInfo will check feed info when it is written.
+func (f *Feed) validateFeedInfo() error {
+	v := f.FeedInfo
+	switch {
+	case v == nil:
+		return nil
+	case v.PublisherName == "":
+		return &Error{File: "feed_info.txt", Row: 1, Field: "feed_publisher_name", Message: "is required"}
+	case !validURL(v.PublisherURL):
+		return &Error{File: "feed_info.txt", Row: 1, Field: "feed_publisher_url", Message: "must be absolute URL"}
+	case v.Lang == "":
+		return &Error{File: "feed_info.txt", Row: 1, Field: "feed_lang", Message: "is required"}
+	case !v.StartDate.IsZero() && v.EndDate.Before(v.StartDate):
+		return &Error{File: "feed_info.txt", Row: 1, Field: "feed_end_date", Message: "is before feed_start_date"}
+	}
+	return nil
+}
+
+// validURL return true when value is absolute http or https URL.
+func validURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validTimezone return true when value is name of IANA timezone.
+func validTimezone(value string) bool {
+	if value == "" || value == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(value)
+	return err == nil
+}
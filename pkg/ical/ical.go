@@ -0,0 +1,148 @@
+// Package ical write iCalendar (RFC 5545) feeds of events for calendar subscriptions.
+package ical
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ContentType is a media type of iCalendar feed.
+	ContentType = "text/calendar; charset=utf-8"
+
+	// StatusConfirmed is a status of event which takes place.
+	StatusConfirmed = "CONFIRMED"
+
+	// StatusCancelled is a status of cancelled event, subscribed calendars remove it.
+	StatusCancelled = "CANCELLED"
+
+	// dateTimeLayout is a layout of date with time in UTC.
+	dateTimeLayout = "20060102T150405Z"
+
+	// lineLength is a max length of content line in octets without line break.
+	lineLength = 75
+)
+
+// Calendar represent VCALENDAR with events, Timezone is a hint for clients which display times of events
+// in their own timezone.
+type Calendar struct {
+	ProductID string
+	Name      string
+	Timezone  string
+
+	// RefreshInterval is a suggested interval of polling of subscribed calendar.
+	RefreshInterval time.Duration
+	Events          []*Event
+}
+
+// Event represent VEVENT, UID must be the same in every version of feed to update event in calendar.
+// Sequence must grow when event is changed.
+type Event struct {
+	UID          string
+	Sequence     int
+	Status       string
+	Summary      string
+	Description  string
+	Location     string
+	Start        time.Time
+	End          time.Time
+	Created      time.Time
+	LastModified time.Time
+}
+
+// Marshal return calendar as iCalendar text, times are written in UTC.
+func Marshal(calendar *Calendar, now time.Time) []byte {
+	w := &writer{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.text("PRODID", calendar.ProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if calendar.Name != "" {
+		w.text("X-WR-CALNAME", calendar.Name)
+	}
+	if calendar.Timezone != "" {
+		w.text("X-WR-TIMEZONE", calendar.Timezone)
+	}
+	if calendar.RefreshInterval > 0 {
+		duration := "PT" + strconv.Itoa(int(calendar.RefreshInterval.Minutes())) + "M"
+		w.line("REFRESH-INTERVAL;VALUE=DURATION", duration)
+		w.line("X-PUBLISHED-TTL", duration)
+	}
+	for _, event := range calendar.Events {
+		w.line("BEGIN", "VEVENT")
+		w.text("UID", event.UID)
+		w.time("DTSTAMP", now)
+		w.time("DTSTART", event.Start)
+		w.time("DTEND", event.End)
+		w.line("SEQUENCE", strconv.Itoa(event.Sequence))
+		if event.Status != "" {
+			w.line("STATUS", event.Status)
+		}
+		w.text("SUMMARY", event.Summary)
+		if event.Location != "" {
+			w.text("LOCATION", event.Location)
+		}
+		if event.Description != "" {
+			w.text("DESCRIPTION", event.Description)
+		}
+		if !event.Created.IsZero() {
+			w.time("CREATED", event.Created)
+		}
+		if !event.LastModified.IsZero() {
+			w.time("LAST-MODIFIED", event.LastModified)
+		}
+		w.line("END", "VEVENT")
+	}
+	w.line("END", "VCALENDAR")
+	return w.Bytes()
+}
+
+// Escape return text value with escaped backslashes, separators and line breaks.
+func Escape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// writer write content lines of calendar.
+type writer struct {
+	bytes.Buffer
+}
+
+// text will write property with text value.
+func (w *writer) text(name string, value string) {
+	w.line(name, Escape(value))
+}
+
+// time will write property with time value in UTC.
+func (w *writer) time(name string, value time.Time) {
+	w.line(name, value.UTC().Format(dateTimeLayout))
+}
+
+// line will write content line, line longer than 75 octets is folded
+// and continued on the next line starting with space. Characters are not split.
+func (w *writer) line(name string, value string) {
+	line := name + ":" + value
+	limit := lineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = lineLength - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,76 @@
+package ical_test
+
+import (
+	"cargo-rest-api/pkg/ical"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshal(t *testing.T) {
+	location, _ := time.LoadLocation("Europe/Moscow")
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	calendar := &ical.Calendar{
+		ProductID:       "-//cargo-rest-api//trips//RU",
+		Name:            "Рейсы",
+		Timezone:        "Europe/Moscow",
+		RefreshInterval: time.Hour,
+		Events: []*ical.Event{
+			{
+				UID:          "trip-1@cargo-rest-api",
+				Sequence:     3,
+				Status:       ical.StatusConfirmed,
+				Summary:      "Тверь — Москва",
+				Location:     "Тверь, Тверская область",
+				Description:  "Автобус: А123ВС69\nМесто: 12",
+				Start:        time.Date(2021, 3, 1, 23, 0, 0, 0, location),
+				End:          time.Date(2021, 3, 2, 2, 30, 0, 0, location),
+				LastModified: now,
+			},
+		},
+	}
+
+	content := string(ical.Marshal(calendar, now))
+
+	assert.True(t, strings.HasPrefix(content, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(content, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Contains(t, content, "X-WR-TIMEZONE:Europe/Moscow\r\n")
+	assert.Contains(t, content, "REFRESH-INTERVAL;VALUE=DURATION:PT60M\r\n")
+	assert.Contains(t, content, "UID:trip-1@cargo-rest-api\r\n")
+	assert.Contains(t, content, "DTSTAMP:20210301T120000Z\r\n")
+	assert.Contains(t, content, "DTSTART:20210301T200000Z\r\n")
+	assert.Contains(t, content, "DTEND:20210301T233000Z\r\n")
+	assert.Contains(t, content, "SEQUENCE:3\r\n")
+	assert.Contains(t, content, "LOCATION:Тверь\\, Тверская область\r\n")
+	assert.Contains(t, content, "DESCRIPTION:Автобус: А123ВС69\\nМесто: 12\r\n")
+	assert.NotContains(t, content, "CREATED:")
+}
+
+func TestMarshal_Folding(t *testing.T) {
+	calendar := &ical.Calendar{
+		ProductID: "-//cargo-rest-api//trips//RU",
+		Events: []*ical.Event{
+			{UID: "trip-1", Summary: strings.Repeat("Москва", 20)},
+		},
+	}
+
+	content := string(ical.Marshal(calendar, time.Now()))
+
+	var summary string
+	for _, line := range strings.Split(strings.TrimSuffix(content, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		switch {
+		case strings.HasPrefix(line, "SUMMARY:"):
+			summary = strings.TrimPrefix(line, "SUMMARY:")
+		case strings.HasPrefix(line, " ") && summary != "":
+			summary += line[1:]
+		}
+	}
+	assert.Equal(t, strings.Repeat("Москва", 20), summary)
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne\nf`, ical.Escape("a\\b;c,d\r\ne\nf"))
+}
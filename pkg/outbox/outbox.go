@@ -0,0 +1,99 @@
+// Package outbox publishes domain events stored in outbox table to sinks.
+//
+// Event is stored in the same transaction as the change and is published by relay after commit, so event is
+// never lost when change is stored and never published when change is rolled back. Relay publishes event again
+// until every sink accepts it, so sinks receive event at least once and consumers skip repeated events by ID.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AllEvents is a name of handler of every event.
+const AllEvents = "*"
+
+// Message represent event published to sinks, ID is the same in every publication of event
+// so consumers use it as idempotency key.
+type Message struct {
+	ID          string
+	Event       string
+	Aggregate   string
+	AggregateID string
+	Payload     []byte
+	CreatedAt   time.Time
+}
+
+// Sink is an interface of destination of published events.
+type Sink interface {
+	// Name return name of sink used in errors.
+	Name() string
+
+	// Publish will deliver message, message is published again when error is returned.
+	Publish(ctx context.Context, message *Message) error
+}
+
+// HandlerFunc is an in-process consumer of event.
+type HandlerFunc func(ctx context.Context, message *Message) error
+
+// Handlers is a sink calling in-process handlers of event.
+type Handlers struct {
+	handlers map[string][]HandlerFunc
+}
+
+// PublishError is returned when sinks did not accept message.
+type PublishError struct {
+	Errors map[string]error
+}
+
+// NewHandlers will construct sink of in-process handlers.
+func NewHandlers() *Handlers {
+	return &Handlers{handlers: map[string][]HandlerFunc{}}
+}
+
+// Handle will register handler of event, handler of AllEvents is called for every event.
+func (h *Handlers) Handle(event string, handler HandlerFunc) {
+	h.handlers[event] = append(h.handlers[event], handler)
+}
+
+// Name return name of sink.
+func (h *Handlers) Name() string {
+	return "handlers"
+}
+
+// Publish will call every handler of event, the first error stops publication.
+func (h *Handlers) Publish(ctx context.Context, message *Message) error {
+	for _, event := range []string{message.Event, AllEvents} {
+		for _, handler := range h.handlers[event] {
+			if err := handler(ctx, message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Publish will deliver message to every sink, message is delivered to all sinks even when some of them fail.
+func Publish(ctx context.Context, sinks []Sink, message *Message) error {
+	errs := map[string]error{}
+	for _, sink := range sinks {
+		if err := sink.Publish(ctx, message); err != nil {
+			errs[sink.Name()] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &PublishError{Errors: errs}
+	}
+	return nil
+}
+
+// Error return errors of sinks.
+func (e *PublishError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for name, err := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %v", name, err))
+	}
+	return strings.Join(messages, "; ")
+}
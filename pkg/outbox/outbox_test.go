@@ -0,0 +1,113 @@
+package outbox_test
+
+import (
+	"cargo-rest-api/pkg/outbox"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+type streamAdder struct {
+	args *redis.XAddArgs
+	err  error
+}
+
+func (s *streamAdder) XAdd(ctx context.Context, a *redis.XAddArgs) *redis.StringCmd {
+	s.args = a
+	return redis.NewStringResult("1-0", s.err)
+}
+
+func message() *outbox.Message {
+	return &outbox.Message{
+		ID:          "event-1",
+		Event:       "order.created",
+		Aggregate:   "order",
+		AggregateID: "order-1",
+		Payload:     []byte(`{"uuid":"order-1"}`),
+		CreatedAt:   time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestHandlers_Publish(t *testing.T) {
+	var called []string
+	handlers := outbox.NewHandlers()
+	handlers.Handle("order.created", func(ctx context.Context, m *outbox.Message) error {
+		called = append(called, "created:"+m.ID)
+		return nil
+	})
+	handlers.Handle("payment.succeeded", func(ctx context.Context, m *outbox.Message) error {
+		called = append(called, "payment:"+m.ID)
+		return nil
+	})
+	handlers.Handle(outbox.AllEvents, func(ctx context.Context, m *outbox.Message) error {
+		called = append(called, "all:"+m.ID)
+		return nil
+	})
+
+	err := handlers.Publish(context.Background(), message())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"created:event-1", "all:event-1"}, called)
+}
+
+func TestHandlers_Publish_Error(t *testing.T) {
+	called := 0
+	failed := errors.New("handler failed")
+	handlers := outbox.NewHandlers()
+	handlers.Handle("order.created", func(ctx context.Context, m *outbox.Message) error {
+		return failed
+	})
+	handlers.Handle(outbox.AllEvents, func(ctx context.Context, m *outbox.Message) error {
+		called++
+		return nil
+	})
+
+	err := handlers.Publish(context.Background(), message())
+
+	assert.Equal(t, failed, err)
+	assert.Equal(t, 0, called)
+}
+
+func TestRedisStream_Publish(t *testing.T) {
+	client := &streamAdder{}
+	sink := outbox.NewRedisStream(client, "cargo:events", 1000)
+
+	err := sink.Publish(context.Background(), message())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "redis:cargo:events", sink.Name())
+	assert.Equal(t, "cargo:events", client.args.Stream)
+	assert.Equal(t, int64(1000), client.args.MaxLen)
+	assert.True(t, client.args.Approx)
+	values := client.args.Values.(map[string]interface{})
+	assert.Equal(t, "event-1", values["id"])
+	assert.Equal(t, "order.created", values["event"])
+	assert.Equal(t, "order-1", values["aggregate_id"])
+	assert.Equal(t, `{"uuid":"order-1"}`, values["payload"])
+	assert.Equal(t, "2021-03-01T12:00:00Z", values["created_at"])
+}
+
+func TestPublish(t *testing.T) {
+	handlers := outbox.NewHandlers()
+	var published int
+	handlers.Handle(outbox.AllEvents, func(ctx context.Context, m *outbox.Message) error {
+		published++
+		return nil
+	})
+	failing := outbox.NewRedisStream(&streamAdder{err: errors.New("connection refused")}, "events", 0)
+
+	err := outbox.Publish(context.Background(), []outbox.Sink{failing, handlers}, message())
+
+	var publishErr *outbox.PublishError
+	assert.True(t, errors.As(err, &publishErr))
+	assert.Len(t, publishErr.Errors, 1)
+	assert.EqualError(t, err, "redis:events: connection refused")
+	assert.Equal(t, 1, published)
+
+	assert.NoError(t, outbox.Publish(context.Background(), []outbox.Sink{handlers}, message()))
+	assert.Equal(t, 2, published)
+}
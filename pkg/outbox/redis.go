@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// StreamAdder is an interface of redis client appending entries to stream.
+type StreamAdder interface {
+	XAdd(ctx context.Context, a *redis.XAddArgs) *redis.StringCmd
+}
+
+// RedisStream is a sink appending events to Redis Stream. Consumer groups read stream and skip repeated events
+// by "id" field of entry.
+type RedisStream struct {
+	client StreamAdder
+	stream string
+	maxLen int64
+}
+
+// NewRedisStream will construct sink of stream, stream is trimmed approximately to maxLen entries,
+// zero maxLen keeps all entries.
+func NewRedisStream(client StreamAdder, stream string, maxLen int64) *RedisStream {
+	return &RedisStream{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+// Name return name of sink.
+func (s *RedisStream) Name() string {
+	return "redis:" + s.stream
+}
+
+// Publish will append message to stream.
+func (s *RedisStream) Publish(ctx context.Context, message *Message) error {
+	return s.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		MaxLen: s.maxLen,
+		Approx: s.maxLen > 0,
+		Values: map[string]interface{}{
+			"id":           message.ID,
+			"event":        message.Event,
+			"aggregate":    message.Aggregate,
+			"aggregate_id": message.AggregateID,
+			"payload":      string(message.Payload),
+			"created_at":   message.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Err()
+}
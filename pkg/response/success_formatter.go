@@ -1,7 +1,9 @@
 package response
 
 import (
+	"cargo-rest-api/pkg/etag"
 	"cargo-rest-api/pkg/translation"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,6 +43,13 @@ func (so *successOutput) WithMeta(meta interface{}) *successOutput {
 	return so
 }
 
+// WithETag is a function uses to attach tag of record updated at updatedAt into response's header.
+func (so *successOutput) WithETag(updatedAt time.Time) *successOutput {
+	so.c.Header(etag.HeaderETag, etag.New(updatedAt))
+
+	return so
+}
+
 // JSON is a function uses to format response then return as json.
 func (so *successOutput) JSON() {
 	so.c.Header("Accept-Language", so.language)
@@ -0,0 +1,119 @@
+// Package routing orders drop-off stops of a delivery run so the driver travels the shortest distance.
+// Stops are visited by nearest neighbour heuristic first and the path is improved by 2-opt afterwards.
+// Distances are great-circle distances, which is accurate enough to order stops within a city.
+package routing
+
+import "math"
+
+const (
+	// earthRadius is a mean radius of Earth in kilometers.
+	earthRadius = 6371.0
+
+	// improvementThreshold is a minimal gain in kilometers for 2-opt move to be applied.
+	improvementThreshold = 1e-9
+)
+
+// Point represent geographic coordinates in degrees.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Distance return great-circle distance between two points in kilometers.
+func Distance(a Point, b Point) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Optimize return order of stops (indexes of stops) for a run starting at depot.
+// The run ends at the last stop, the way back to depot is not counted.
+func Optimize(depot Point, stops []Point) []int {
+	if len(stops) == 0 {
+		return []int{}
+	}
+	matrix := distanceMatrix(depot, stops)
+	path := nearestNeighbour(matrix)
+	twoOpt(matrix, path)
+
+	order := make([]int, len(stops))
+	for i, node := range path[1:] {
+		order[i] = node - 1
+	}
+	return order
+}
+
+// Length return distance in kilometers of the run visiting stops in given order starting at depot.
+func Length(depot Point, stops []Point, order []int) float64 {
+	var length float64
+	previous := depot
+	for _, index := range order {
+		length += Distance(previous, stops[index])
+		previous = stops[index]
+	}
+	return length
+}
+
+// distanceMatrix return distances between all points, depot is a node with index 0.
+func distanceMatrix(depot Point, stops []Point) [][]float64 {
+	points := append([]Point{depot}, stops...)
+	matrix := make([][]float64, len(points))
+	for i := range points {
+		matrix[i] = make([]float64, len(points))
+		for j := range points {
+			if i != j {
+				matrix[i][j] = Distance(points[i], points[j])
+			}
+		}
+	}
+	return matrix
+}
+
+// nearestNeighbour return path starting at depot which always goes to the closest not visited node.
+func nearestNeighbour(matrix [][]float64) []int {
+	visited := make([]bool, len(matrix))
+	visited[0] = true
+	path := make([]int, 1, len(matrix))
+	for len(path) < len(matrix) {
+		current := path[len(path)-1]
+		next := -1
+		for node := range matrix {
+			if !visited[node] && (next == -1 || matrix[current][node] < matrix[current][next]) {
+				next = node
+			}
+		}
+		visited[next] = true
+		path = append(path, next)
+	}
+	return path
+}
+
+// twoOpt will reverse segments of open path while it makes the path shorter, depot stays first.
+func twoOpt(matrix [][]float64, path []int) {
+	last := len(path) - 1
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < last; i++ {
+			for k := i + 1; k <= last; k++ {
+				gain := matrix[path[i-1]][path[i]] - matrix[path[i-1]][path[k]]
+				if k < last {
+					gain += matrix[path[k]][path[k+1]] - matrix[path[i]][path[k+1]]
+				}
+				if gain > improvementThreshold {
+					reverse(path[i : k+1])
+					improved = true
+				}
+			}
+		}
+	}
+}
+
+// reverse will reverse order of nodes in place.
+func reverse(nodes []int) {
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+}
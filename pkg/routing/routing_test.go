@@ -0,0 +1,44 @@
+package routing_test
+
+import (
+	"cargo-rest-api/pkg/routing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	moscow := routing.Point{Latitude: 55.7558, Longitude: 37.6173}
+	petersburg := routing.Point{Latitude: 59.9343, Longitude: 30.3351}
+	assert.InDelta(t, 634, routing.Distance(moscow, petersburg), 5)
+	assert.Zero(t, routing.Distance(moscow, moscow))
+}
+
+func TestOptimize(t *testing.T) {
+	depot := routing.Point{Latitude: 55.75, Longitude: 37.60}
+	stops := []routing.Point{
+		{Latitude: 55.75, Longitude: 37.64},
+		{Latitude: 55.75, Longitude: 37.61},
+		{Latitude: 55.75, Longitude: 37.63},
+		{Latitude: 55.75, Longitude: 37.62},
+	}
+	order := routing.Optimize(depot, stops)
+	assert.Equal(t, []int{1, 3, 2, 0}, order)
+	assert.Empty(t, routing.Optimize(depot, nil))
+}
+
+func TestOptimize_TwoOptImprovesNearestNeighbour(t *testing.T) {
+	depot := routing.Point{Latitude: 55.70, Longitude: 37.60}
+	stops := []routing.Point{
+		{Latitude: 55.71, Longitude: 37.60},
+		{Latitude: 55.69, Longitude: 37.60},
+		{Latitude: 55.74, Longitude: 37.60},
+		{Latitude: 55.66, Longitude: 37.60},
+		{Latitude: 55.79, Longitude: 37.60},
+	}
+	nearestNeighbour := []int{0, 1, 3, 2, 4}
+	order := routing.Optimize(depot, stops)
+	assert.ElementsMatch(t, nearestNeighbour, order)
+	assert.Equal(t, []int{3, 1, 0, 2, 4}, order)
+	assert.Less(t, routing.Length(depot, stops, order), routing.Length(depot, stops, nearestNeighbour))
+}
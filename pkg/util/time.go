@@ -0,0 +1,17 @@
+package util
+
+import "time"
+
+// FullYears returns number of complete years passed between from and to.
+func FullYears(from time.Time, to time.Time) int {
+	if from.IsZero() || to.Before(from) {
+		return 0
+	}
+
+	years := to.Year() - from.Year()
+	if to.Month() < from.Month() || (to.Month() == from.Month() && to.Day() < from.Day()) {
+		years--
+	}
+
+	return years
+}
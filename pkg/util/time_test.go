@@ -0,0 +1,17 @@
+package util_test
+
+import (
+	"cargo-rest-api/pkg/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFullYears(t *testing.T) {
+	birthday := time.Date(2010, time.June, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 11, util.FullYears(birthday, time.Date(2022, time.June, 14, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 12, util.FullYears(birthday, time.Date(2022, time.June, 15, 8, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0, util.FullYears(birthday, time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0, util.FullYears(time.Time{}, time.Now()))
+}
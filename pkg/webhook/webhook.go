@@ -0,0 +1,185 @@
+// Package webhook send signed webhook requests to subscribers.
+//
+// Body of request is signed by HMAC-SHA256 with secret of subscription, signature header contains
+// timestamp and signature of "timestamp.body": "t=1614600000,v1=5257a869...". Subscriber verifies signature
+// and rejects old timestamps to prevent replay of intercepted requests.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderEvent is a header of name of event.
+	HeaderEvent = "X-Webhook-Event"
+
+	// HeaderID is a header of ID of event, it is the same in every delivery and replay of event
+	// so subscriber uses it as idempotency key.
+	HeaderID = "X-Webhook-Id"
+
+	// HeaderDelivery is a header of ID of delivery attempt.
+	HeaderDelivery = "X-Webhook-Delivery"
+
+	// HeaderSignature is a header of signature of request.
+	HeaderSignature = "X-Webhook-Signature"
+
+	// DefaultTimeout is a default timeout of request to subscriber.
+	DefaultTimeout = 10 * time.Second
+
+	// responseLimit is a max length of kept response body.
+	responseLimit = 1000
+
+	userAgent = "cargo-rest-api-webhook/1.0"
+)
+
+var (
+	// ErrInvalidSignature is returned when signature header is malformed or does not match body.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	// ErrExpiredSignature is returned when timestamp of signature is out of tolerance.
+	ErrExpiredSignature = errors.New("expired webhook signature")
+)
+
+// Request represent webhook request to subscriber.
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	EventID    string
+	DeliveryID string
+	Body       []byte
+	Timestamp  time.Time
+}
+
+// Response represent response of subscriber, body is truncated.
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+// StatusError is returned when subscriber does not respond with 2xx status.
+type StatusError struct {
+	StatusCode int
+}
+
+// Error return description of status.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("subscriber responded with status %d", e.StatusCode)
+}
+
+// Client send webhook requests.
+type Client struct {
+	client *http.Client
+}
+
+// NewClient will construct client with timeout of request, zero timeout is DefaultTimeout.
+func NewClient(timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{client: &http.Client{Timeout: timeout}}
+}
+
+// Send will post signed body to subscriber. Response is returned with StatusError when status is not 2xx
+// and is nil when request is not sent.
+func (c *Client) Send(request *Request) (*Response, error) {
+	req, err := http.NewRequest(http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderEvent, request.Event)
+	req.Header.Set(HeaderID, request.EventID)
+	req.Header.Set(HeaderDelivery, request.DeliveryID)
+	req.Header.Set(HeaderSignature, Sign(request.Secret, request.Timestamp, request.Body))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, responseLimit))
+	response := &Response{StatusCode: resp.StatusCode, Body: string(body)}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return response, &StatusError{StatusCode: resp.StatusCode}
+	}
+	return response, nil
+}
+
+// Sign return signature header of body signed at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + unix + ",v1=" + signature(secret, unix, body)
+}
+
+// Verify will check signature header of body, signature older than tolerance is expired.
+func Verify(secret string, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var unix, signed string
+	for _, part := range strings.Split(header, ",") {
+		pair := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(pair) != 2 {
+			return ErrInvalidSignature
+		}
+		switch pair[0] {
+		case "t":
+			unix = pair[1]
+		case "v1":
+			signed = pair[1]
+		}
+	}
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || signed == "" {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signed), []byte(signature(secret, unix, body))) {
+		return ErrInvalidSignature
+	}
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrExpiredSignature
+	}
+	return nil
+}
+
+// NewSecret return random secret of subscription.
+func NewSecret() (string, error) {
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+// Backoff return delay before attempt after failed attempts, delay doubles from base up to max.
+func Backoff(attempts int, base time.Duration, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
+
+// signature return hex HMAC-SHA256 of "timestamp.body".
+func signature(secret string, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
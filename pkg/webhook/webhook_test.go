@@ -0,0 +1,96 @@
+package webhook_test
+
+import (
+	"cargo-rest-api/pkg/webhook"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	now := time.Now()
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	response, err := webhook.NewClient(time.Second).Send(&webhook.Request{
+		URL:        server.URL,
+		Secret:     "secret",
+		Event:      "order.created",
+		EventID:    "event-1",
+		DeliveryID: "delivery-1",
+		Body:       []byte(`{"id":"event-1"}`),
+		Timestamp:  now,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "ok", response.Body)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "order.created", received.Header.Get(webhook.HeaderEvent))
+	assert.Equal(t, "event-1", received.Header.Get(webhook.HeaderID))
+	assert.Equal(t, "delivery-1", received.Header.Get(webhook.HeaderDelivery))
+	assert.NoError(t, webhook.Verify("secret", received.Header.Get(webhook.HeaderSignature), body, time.Minute, now))
+}
+
+func TestSend_Status(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strings.Repeat("x", 2000)))
+	}))
+	defer server.Close()
+
+	response, err := webhook.NewClient(time.Second).Send(&webhook.Request{URL: server.URL, Timestamp: time.Now()})
+
+	var statusErr *webhook.StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Len(t, response.Body, 1000)
+
+	response, err = webhook.NewClient(time.Second).Send(&webhook.Request{URL: "http://127.0.0.1:1", Timestamp: time.Now()})
+	assert.Error(t, err)
+	assert.Nil(t, response)
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	body := []byte(`{"id":"event-1"}`)
+	header := webhook.Sign("secret", now, body)
+
+	assert.True(t, strings.HasPrefix(header, "t=1614600000,v1="))
+	assert.NoError(t, webhook.Verify("secret", header, body, 5*time.Minute, now.Add(time.Minute)))
+	assert.Equal(t, webhook.ErrInvalidSignature, webhook.Verify("other", header, body, 5*time.Minute, now))
+	assert.Equal(t, webhook.ErrInvalidSignature, webhook.Verify("secret", header, []byte("{}"), 5*time.Minute, now))
+	assert.Equal(t, webhook.ErrInvalidSignature, webhook.Verify("secret", "v1=abc", body, 5*time.Minute, now))
+	assert.Equal(t, webhook.ErrExpiredSignature, webhook.Verify("secret", header, body, 5*time.Minute, now.Add(time.Hour)))
+}
+
+func TestNewSecret(t *testing.T) {
+	first, err := webhook.NewSecret()
+	assert.NoError(t, err)
+	second, _ := webhook.NewSecret()
+
+	assert.True(t, strings.HasPrefix(first, "whsec_"))
+	assert.Len(t, first, 54)
+	assert.NotEqual(t, first, second)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, webhook.Backoff(1, time.Minute, time.Hour))
+	assert.Equal(t, 2*time.Minute, webhook.Backoff(2, time.Minute, time.Hour))
+	assert.Equal(t, 32*time.Minute, webhook.Backoff(6, time.Minute, time.Hour))
+	assert.Equal(t, time.Hour, webhook.Backoff(7, time.Minute, time.Hour))
+	assert.Equal(t, time.Hour, webhook.Backoff(100, time.Minute, time.Hour))
+}
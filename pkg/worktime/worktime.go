@@ -0,0 +1,360 @@
+// Package worktime evaluates working time of driver against driving and rest limits.
+// Every trip is a shift of driving, trip is expected to include breaks en route it needs itself,
+// so continuous driving is only checked for trips following each other without a break.
+// Days and weeks are calendar days and weeks (from Monday) in given location.
+package worktime
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// RuleContinuousDriving is a limit of driving without a break.
+	RuleContinuousDriving = "continuous_driving"
+
+	// RuleDailyDriving is a limit of driving within a day.
+	RuleDailyDriving = "daily_driving"
+
+	// RuleDailyRest is a minimal rest within 24 hours since start of work.
+	RuleDailyRest = "daily_rest"
+
+	// RuleWeeklyDriving is a limit of driving within a week.
+	RuleWeeklyDriving = "weekly_driving"
+
+	// RuleWeeklyRest is a minimal continuous rest within a week.
+	RuleWeeklyRest = "weekly_rest"
+
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// Rules represent driving and rest limits, zero value of limit disables the rule.
+// Up to ExtendedDaysPerWeek days in a week driving may be extended to ExtendedDailyDriving.
+type Rules struct {
+	MaxContinuousDriving time.Duration
+	MinBreak             time.Duration
+	MaxDailyDriving      time.Duration
+	ExtendedDailyDriving time.Duration
+	ExtendedDaysPerWeek  int
+	MinDailyRest         time.Duration
+	MaxWeeklyDriving     time.Duration
+	MinWeeklyRest        time.Duration
+}
+
+// Shift represent a period of driving.
+type Shift struct {
+	ID    string
+	Start time.Time
+	End   time.Time
+}
+
+// Violation represent breach of rule, Actual and Limit are driving or rest durations depending on rule.
+type Violation struct {
+	Rule   string
+	Start  time.Time
+	End    time.Time
+	Actual time.Duration
+	Limit  time.Duration
+	Shifts []string
+}
+
+// Involves will check whether shift takes part in violation.
+func (v Violation) Involves(id string) bool {
+	for _, shift := range v.Shifts {
+		if shift == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Day represent driving within a calendar day.
+type Day struct {
+	Date    time.Time
+	Driving time.Duration
+	Shifts  []string
+}
+
+// Check return violations of rules by shifts, shifts may be given in any order.
+func Check(rules Rules, shifts []Shift, location *time.Location) []Violation {
+	shifts = sorted(shifts)
+	var violations []Violation
+	violations = append(violations, continuousDriving(rules, shifts)...)
+	violations = append(violations, dailyDriving(rules, shifts, location)...)
+	violations = append(violations, dailyRest(rules, shifts)...)
+	violations = append(violations, weeklyDriving(rules, shifts, location)...)
+	violations = append(violations, weeklyRest(rules, shifts, location)...)
+	return violations
+}
+
+// Days return driving of shifts split by calendar days, days without driving are left out.
+func Days(shifts []Shift, location *time.Location) []Day {
+	shifts = sorted(shifts)
+	var days []Day
+	index := map[time.Time]int{}
+	for _, shift := range shifts {
+		for start := startOfDay(shift.Start, location); start.Before(shift.End); start = start.AddDate(0, 0, 1) {
+			driving := overlap(shift, start, start.AddDate(0, 0, 1))
+			if driving <= 0 {
+				continue
+			}
+			i, ok := index[start]
+			if !ok {
+				i = len(days)
+				index[start] = i
+				days = append(days, Day{Date: start})
+			}
+			days[i].Driving += driving
+			days[i].Shifts = append(days[i].Shifts, shift.ID)
+		}
+	}
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+	return days
+}
+
+// StartOfWeek return midnight of Monday of the week.
+func StartOfWeek(t time.Time, location *time.Location) time.Time {
+	start := startOfDay(t, location)
+	return start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+}
+
+// continuousDriving will find shifts following each other without a break which are too long together.
+func continuousDriving(rules Rules, shifts []Shift) []Violation {
+	if rules.MaxContinuousDriving == 0 {
+		return nil
+	}
+	var violations []Violation
+	for _, block := range split(shifts, rules.MinBreak) {
+		if len(block) < 2 {
+			continue
+		}
+		driving := total(block)
+		if driving > rules.MaxContinuousDriving {
+			violations = append(violations, violation(RuleContinuousDriving, block, driving, rules.MaxContinuousDriving))
+		}
+	}
+	return violations
+}
+
+// dailyDriving will find days with driving over the limit, extended days over weekly allowance are violations too.
+func dailyDriving(rules Rules, shifts []Shift, location *time.Location) []Violation {
+	if rules.MaxDailyDriving == 0 {
+		return nil
+	}
+	var violations []Violation
+	extended := map[time.Time]int{}
+	for _, d := range Days(shifts, location) {
+		if d.Driving <= rules.MaxDailyDriving {
+			continue
+		}
+		limit := rules.MaxDailyDriving
+		weekStart := StartOfWeek(d.Date, location)
+		if d.Driving <= rules.ExtendedDailyDriving && extended[weekStart] < rules.ExtendedDaysPerWeek {
+			extended[weekStart]++
+			continue
+		}
+		if d.Driving > rules.ExtendedDailyDriving && rules.ExtendedDailyDriving > limit {
+			limit = rules.ExtendedDailyDriving
+		}
+		violations = append(violations, Violation{
+			Rule:   RuleDailyDriving,
+			Start:  d.Date,
+			End:    d.Date.AddDate(0, 0, 1),
+			Actual: d.Driving,
+			Limit:  limit,
+			Shifts: d.Shifts,
+		})
+	}
+	return violations
+}
+
+// dailyRest will find working periods which leave less than daily rest within 24 hours since their start.
+// Working period is a sequence of shifts separated by rest shorter than daily rest.
+func dailyRest(rules Rules, shifts []Shift) []Violation {
+	if rules.MinDailyRest == 0 {
+		return nil
+	}
+	var violations []Violation
+	for _, period := range split(shifts, rules.MinDailyRest) {
+		first, last := period[0], period[len(period)-1]
+		rest := day - last.End.Sub(first.Start)
+		if rest < rules.MinDailyRest {
+			if rest < 0 {
+				rest = 0
+			}
+			violations = append(violations, Violation{
+				Rule:   RuleDailyRest,
+				Start:  first.Start,
+				End:    last.End,
+				Actual: rest,
+				Limit:  rules.MinDailyRest,
+				Shifts: ids(period),
+			})
+		}
+	}
+	return violations
+}
+
+// weeklyDriving will find weeks with driving over the limit.
+func weeklyDriving(rules Rules, shifts []Shift, location *time.Location) []Violation {
+	if rules.MaxWeeklyDriving == 0 {
+		return nil
+	}
+	var violations []Violation
+	for _, weekStart := range weeks(shifts, location) {
+		weekEnd := weekStart.AddDate(0, 0, 7)
+		var driving time.Duration
+		var involved []string
+		for _, shift := range shifts {
+			if d := overlap(shift, weekStart, weekEnd); d > 0 {
+				driving += d
+				involved = append(involved, shift.ID)
+			}
+		}
+		if driving > rules.MaxWeeklyDriving {
+			violations = append(violations, Violation{
+				Rule:   RuleWeeklyDriving,
+				Start:  weekStart,
+				End:    weekEnd,
+				Actual: driving,
+				Limit:  rules.MaxWeeklyDriving,
+				Shifts: involved,
+			})
+		}
+	}
+	return violations
+}
+
+// weeklyRest will find weeks without continuous rest of required length.
+func weeklyRest(rules Rules, shifts []Shift, location *time.Location) []Violation {
+	if rules.MinWeeklyRest == 0 || rules.MinWeeklyRest > week {
+		return nil
+	}
+	var violations []Violation
+	for _, weekStart := range weeks(shifts, location) {
+		weekEnd := weekStart.AddDate(0, 0, 7)
+		var involved []string
+		longest := time.Duration(0)
+		free := weekStart
+		for _, shift := range shifts {
+			if overlap(shift, weekStart, weekEnd) <= 0 {
+				continue
+			}
+			involved = append(involved, shift.ID)
+			if rest := shift.Start.Sub(free); rest > longest {
+				longest = rest
+			}
+			if shift.End.After(free) {
+				free = shift.End
+			}
+		}
+		if rest := weekEnd.Sub(free); rest > longest {
+			longest = rest
+		}
+		if longest < rules.MinWeeklyRest {
+			violations = append(violations, Violation{
+				Rule:   RuleWeeklyRest,
+				Start:  weekStart,
+				End:    weekEnd,
+				Actual: longest,
+				Limit:  rules.MinWeeklyRest,
+				Shifts: involved,
+			})
+		}
+	}
+	return violations
+}
+
+// split will group sorted shifts separated by gaps shorter than given gap.
+func split(shifts []Shift, gap time.Duration) [][]Shift {
+	var groups [][]Shift
+	var end time.Time
+	for i, shift := range shifts {
+		if i == 0 || shift.Start.Sub(end) >= gap {
+			groups = append(groups, []Shift{})
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], shift)
+		if i == 0 || shift.End.After(end) {
+			end = shift.End
+		}
+	}
+	return groups
+}
+
+// weeks return start of every week with driving.
+func weeks(shifts []Shift, location *time.Location) []time.Time {
+	var result []time.Time
+	for _, shift := range shifts {
+		for start := StartOfWeek(shift.Start, location); start.Before(shift.End); start = start.AddDate(0, 0, 7) {
+			if len(result) == 0 || result[len(result)-1].Before(start) {
+				result = append(result, start)
+			}
+		}
+	}
+	return result
+}
+
+// violation will build violation covering all shifts.
+func violation(rule string, shifts []Shift, actual time.Duration, limit time.Duration) Violation {
+	return Violation{
+		Rule:   rule,
+		Start:  shifts[0].Start,
+		End:    shifts[len(shifts)-1].End,
+		Actual: actual,
+		Limit:  limit,
+		Shifts: ids(shifts),
+	}
+}
+
+// total return driving of shifts.
+func total(shifts []Shift) time.Duration {
+	var driving time.Duration
+	for _, shift := range shifts {
+		driving += shift.End.Sub(shift.Start)
+	}
+	return driving
+}
+
+// overlap return part of shift within the period.
+func overlap(shift Shift, from time.Time, to time.Time) time.Duration {
+	start, end := shift.Start, shift.End
+	if from.After(start) {
+		start = from
+	}
+	if to.Before(end) {
+		end = to
+	}
+	return end.Sub(start)
+}
+
+// ids return identifiers of shifts.
+func ids(shifts []Shift) []string {
+	result := make([]string, len(shifts))
+	for i, shift := range shifts {
+		result[i] = shift.ID
+	}
+	return result
+}
+
+// sorted return copy of shifts ordered by start, shifts ending before they start are left out.
+func sorted(shifts []Shift) []Shift {
+	result := make([]Shift, 0, len(shifts))
+	for _, shift := range shifts {
+		if shift.End.After(shift.Start) {
+			result = append(result, shift)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+// startOfDay return midnight of the day in location.
+func startOfDay(t time.Time, location *time.Location) time.Time {
+	year, month, date := t.In(location).Date()
+	return time.Date(year, month, date, 0, 0, 0, 0, location)
+}
@@ -0,0 +1,121 @@
+package worktime_test
+
+import (
+	"cargo-rest-api/pkg/worktime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rules return limits of driving and rest for drivers.
+func rules() worktime.Rules {
+	return worktime.Rules{
+		MaxContinuousDriving: 270 * time.Minute,
+		MinBreak:             45 * time.Minute,
+		MaxDailyDriving:      9 * time.Hour,
+		ExtendedDailyDriving: 10 * time.Hour,
+		ExtendedDaysPerWeek:  2,
+		MinDailyRest:         11 * time.Hour,
+		MaxWeeklyDriving:     56 * time.Hour,
+		MinWeeklyRest:        45 * time.Hour,
+	}
+}
+
+// shift return shift starting at given day (2026-10-19 is Monday) and hour.
+func shift(id string, date int, hour int, duration time.Duration) worktime.Shift {
+	start := time.Date(2026, time.October, date, hour, 0, 0, 0, time.UTC)
+	return worktime.Shift{ID: id, Start: start, End: start.Add(duration)}
+}
+
+// rulesOf return rules of violations.
+func rulesOf(violations []worktime.Violation) []string {
+	result := make([]string, len(violations))
+	for i, v := range violations {
+		result[i] = v.Rule
+	}
+	return result
+}
+
+func TestCheck_NoViolations(t *testing.T) {
+	shifts := []worktime.Shift{
+		shift("mon", 19, 8, 8*time.Hour),
+		shift("tue", 20, 8, 8*time.Hour),
+		shift("wed", 21, 8, 8*time.Hour),
+	}
+	assert.Empty(t, worktime.Check(rules(), shifts, time.UTC))
+}
+
+func TestCheck_BackToBackTrips(t *testing.T) {
+	shifts := []worktime.Shift{
+		shift("first", 19, 6, 10*time.Hour),
+		shift("second", 19, 18, 10*time.Hour),
+	}
+	violations := worktime.Check(rules(), shifts, time.UTC)
+	assert.ElementsMatch(t, []string{worktime.RuleDailyDriving, worktime.RuleDailyRest}, rulesOf(violations))
+	for _, v := range violations {
+		if v.Rule == worktime.RuleDailyRest {
+			assert.Equal(t, []string{"first", "second"}, v.Shifts)
+			assert.Equal(t, 2*time.Hour, v.Actual)
+		}
+	}
+}
+
+func TestCheck_ContinuousDriving(t *testing.T) {
+	shifts := []worktime.Shift{
+		shift("first", 19, 8, 3*time.Hour),
+		shift("second", 19, 11, 2*time.Hour),
+	}
+	shifts[1].Start = shifts[1].Start.Add(30 * time.Minute)
+	shifts[1].End = shifts[1].End.Add(30 * time.Minute)
+	violations := worktime.Check(rules(), shifts, time.UTC)
+	assert.Equal(t, []string{worktime.RuleContinuousDriving}, rulesOf(violations))
+	assert.True(t, violations[0].Involves("second"))
+	assert.Equal(t, 5*time.Hour, violations[0].Actual)
+
+	shifts[1].Start = shifts[1].Start.Add(15 * time.Minute)
+	assert.Empty(t, worktime.Check(rules(), shifts, time.UTC))
+}
+
+func TestCheck_ExtendedDays(t *testing.T) {
+	shifts := []worktime.Shift{
+		shift("mon", 19, 8, 10*time.Hour),
+		shift("tue", 20, 8, 10*time.Hour),
+		shift("wed", 21, 8, 10*time.Hour),
+	}
+	violations := worktime.Check(rules(), shifts, time.UTC)
+	assert.Equal(t, []string{worktime.RuleDailyDriving}, rulesOf(violations))
+	assert.Equal(t, []string{"wed"}, violations[0].Shifts)
+	assert.Equal(t, 9*time.Hour, violations[0].Limit)
+}
+
+func TestCheck_Weekly(t *testing.T) {
+	var shifts []worktime.Shift
+	for date := 19; date <= 25; date++ {
+		weekday := time.Date(2026, time.October, date, 0, 0, 0, 0, time.UTC).Weekday()
+		shifts = append(shifts, shift(weekday.String(), date, 8, 9*time.Hour))
+	}
+	violations := worktime.Check(rules(), shifts, time.UTC)
+	assert.ElementsMatch(t, []string{worktime.RuleWeeklyDriving, worktime.RuleWeeklyRest}, rulesOf(violations))
+	for _, v := range violations {
+		assert.Len(t, v.Shifts, 7)
+		assert.Equal(t, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), v.Start)
+	}
+}
+
+func TestDays(t *testing.T) {
+	shifts := []worktime.Shift{
+		shift("night", 19, 20, 8*time.Hour),
+		shift("day", 20, 14, 2*time.Hour),
+	}
+	days := worktime.Days(shifts, time.UTC)
+	assert.Len(t, days, 2)
+	assert.Equal(t, 4*time.Hour, days[0].Driving)
+	assert.Equal(t, 6*time.Hour, days[1].Driving)
+	assert.Equal(t, []string{"night", "day"}, days[1].Shifts)
+}
+
+func TestStartOfWeek(t *testing.T) {
+	sunday := time.Date(2026, time.October, 25, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), worktime.StartOfWeek(sunday, time.UTC))
+}
@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// AccountingExportAppInterface is a mock of application.AccountingExportAppInterface.
+type AccountingExportAppInterface struct {
+	SaveCommerceMLExportFn func(*entity.AccountingExportRequest, string, string) (*entity.AccountingExport, error)
+	GetAccountingExportsFn func(params *repository.Parameters) ([]*entity.AccountingExport, *repository.Meta, error)
+}
+
+// SaveCommerceMLExport calls the SaveCommerceMLExportFn.
+func (u *AccountingExportAppInterface) SaveCommerceMLExport(
+	request *entity.AccountingExportRequest,
+	source string,
+	createdBy string,
+) (*entity.AccountingExport, error) {
+	return u.SaveCommerceMLExportFn(request, source, createdBy)
+}
+
+// GetAccountingExports calls the GetAccountingExportsFn.
+func (u *AccountingExportAppInterface) GetAccountingExports(
+	params *repository.Parameters,
+) ([]*entity.AccountingExport, *repository.Meta, error) {
+	return u.GetAccountingExportsFn(params)
+}
@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// AuditLogAppInterface is a mock of application.AuditLogAppInterface.
+type AuditLogAppInterface struct {
+	GetAuditLogsFn       func(params *repository.Parameters) ([]*entity.AuditLog, *repository.Meta, error)
+	GetEntityAuditLogsFn func(entityType string, entityUUID string, params *repository.Parameters) (
+		[]*entity.AuditLog,
+		*repository.Meta,
+		error,
+	)
+	GetUserAuditLogsFn func(userUUID string, params *repository.Parameters) (
+		[]*entity.AuditLog,
+		*repository.Meta,
+		error,
+	)
+}
+
+// GetAuditLogs calls the GetAuditLogsFn.
+func (u *AuditLogAppInterface) GetAuditLogs(
+	params *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return u.GetAuditLogsFn(params)
+}
+
+// GetEntityAuditLogs calls the GetEntityAuditLogsFn.
+func (u *AuditLogAppInterface) GetEntityAuditLogs(
+	entityType string,
+	entityUUID string,
+	params *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return u.GetEntityAuditLogsFn(entityType, entityUUID, params)
+}
+
+// GetUserAuditLogs calls the GetUserAuditLogsFn.
+func (u *AuditLogAppInterface) GetUserAuditLogs(
+	userUUID string,
+	params *repository.Parameters,
+) ([]*entity.AuditLog, *repository.Meta, error) {
+	return u.GetUserAuditLogsFn(userUUID, params)
+}
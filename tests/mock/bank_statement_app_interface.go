@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// BankStatementAppInterface is a mock of application.BankStatementAppInterface.
+type BankStatementAppInterface struct {
+	SaveBankStatementFn        func(*entity.BankStatement) (*entity.BankStatement, error)
+	GetBankStatementsFn        func(params *repository.Parameters) ([]*entity.BankStatement, *repository.Meta, error)
+	GetBankStatementFn         func(UUID string) (*entity.BankStatement, error)
+	ResolveBankStatementLineFn func(
+		UUID string,
+		request *entity.BankStatementLineResolveRequest,
+		resolvedBy string,
+	) (*entity.BankStatementLine, map[string]string, error)
+}
+
+// SaveBankStatement calls the SaveBankStatementFn.
+func (u *BankStatementAppInterface) SaveBankStatement(statement *entity.BankStatement) (*entity.BankStatement, error) {
+	return u.SaveBankStatementFn(statement)
+}
+
+// GetBankStatements calls the GetBankStatementsFn.
+func (u *BankStatementAppInterface) GetBankStatements(
+	params *repository.Parameters,
+) ([]*entity.BankStatement, *repository.Meta, error) {
+	return u.GetBankStatementsFn(params)
+}
+
+// GetBankStatement calls the GetBankStatementFn.
+func (u *BankStatementAppInterface) GetBankStatement(uuid string) (*entity.BankStatement, error) {
+	return u.GetBankStatementFn(uuid)
+}
+
+// ResolveBankStatementLine calls the ResolveBankStatementLineFn.
+func (u *BankStatementAppInterface) ResolveBankStatementLine(
+	uuid string,
+	request *entity.BankStatementLineResolveRequest,
+	resolvedBy string,
+) (*entity.BankStatementLine, map[string]string, error) {
+	return u.ResolveBankStatementLineFn(uuid, request, resolvedBy)
+}
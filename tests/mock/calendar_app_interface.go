@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// CalendarAppInterface is a mock of application.CalendarAppInterface.
+type CalendarAppInterface struct {
+	GetCalendarTokensFn   func(string) ([]*entity.CalendarToken, error)
+	ResetCalendarTokensFn func(string) ([]*entity.CalendarToken, error)
+	GetCalendarFeedFn     func(string) (*entity.CalendarFeed, error)
+}
+
+// GetCalendarTokens calls the GetCalendarTokensFn.
+func (u *CalendarAppInterface) GetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error) {
+	return u.GetCalendarTokensFn(userUUID)
+}
+
+// ResetCalendarTokens calls the ResetCalendarTokensFn.
+func (u *CalendarAppInterface) ResetCalendarTokens(userUUID string) ([]*entity.CalendarToken, error) {
+	return u.ResetCalendarTokensFn(userUUID)
+}
+
+// GetCalendarFeed calls the GetCalendarFeedFn.
+func (u *CalendarAppInterface) GetCalendarFeed(token string) (*entity.CalendarFeed, error) {
+	return u.GetCalendarFeedFn(token)
+}
@@ -0,0 +1,55 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// CargoTariffAppInterface is a mock of application.CargoTariffAppInterface.
+type CargoTariffAppInterface struct {
+	SaveCargoTariffFn   func(*entity.CargoTariff) (*entity.CargoTariff, map[string]string, error)
+	UpdateCargoTariffFn func(string, *entity.CargoTariff) (*entity.CargoTariff, map[string]string, error)
+	DeleteCargoTariffFn func(UUID string) error
+	GetCargoTariffsFn   func(params *repository.Parameters) ([]*entity.CargoTariff, *repository.Meta, error)
+	GetCargoTariffFn    func(UUID string) (*entity.CargoTariff, error)
+	QuoteShipmentFn     func(*entity.Shipment) (*entity.CargoQuote, map[string]string, error)
+}
+
+// SaveCargoTariff calls the SaveCargoTariffFn.
+func (u *CargoTariffAppInterface) SaveCargoTariff(
+	tariff *entity.CargoTariff,
+) (*entity.CargoTariff, map[string]string, error) {
+	return u.SaveCargoTariffFn(tariff)
+}
+
+// UpdateCargoTariff calls the UpdateCargoTariffFn.
+func (u *CargoTariffAppInterface) UpdateCargoTariff(
+	uuid string,
+	tariff *entity.CargoTariff,
+) (*entity.CargoTariff, map[string]string, error) {
+	return u.UpdateCargoTariffFn(uuid, tariff)
+}
+
+// DeleteCargoTariff calls the DeleteCargoTariffFn.
+func (u *CargoTariffAppInterface) DeleteCargoTariff(uuid string) error {
+	return u.DeleteCargoTariffFn(uuid)
+}
+
+// GetCargoTariffs calls the GetCargoTariffsFn.
+func (u *CargoTariffAppInterface) GetCargoTariffs(
+	params *repository.Parameters,
+) ([]*entity.CargoTariff, *repository.Meta, error) {
+	return u.GetCargoTariffsFn(params)
+}
+
+// GetCargoTariff calls the GetCargoTariffFn.
+func (u *CargoTariffAppInterface) GetCargoTariff(uuid string) (*entity.CargoTariff, error) {
+	return u.GetCargoTariffFn(uuid)
+}
+
+// QuoteShipment calls the QuoteShipmentFn.
+func (u *CargoTariffAppInterface) QuoteShipment(
+	shipment *entity.Shipment,
+) (*entity.CargoQuote, map[string]string, error) {
+	return u.QuoteShipmentFn(shipment)
+}
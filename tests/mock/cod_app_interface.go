@@ -0,0 +1,37 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// CODAppInterface is a mock of application.CODAppInterface.
+type CODAppInterface struct {
+	GetCODSettlementsFn func(*entity.CODSettlementRequest) ([]*entity.CODSettlement, error)
+	SaveCODPayoutsFn    func(*entity.CODSettlementRequest, string) ([]*entity.CODPayout, error)
+	GetCODPayoutsFn     func(params *repository.Parameters) ([]*entity.CODPayout, *repository.Meta, error)
+	GetCODPayoutFn      func(UUID string) (*entity.CODPayout, error)
+}
+
+// GetCODSettlements calls the GetCODSettlementsFn.
+func (u *CODAppInterface) GetCODSettlements(request *entity.CODSettlementRequest) ([]*entity.CODSettlement, error) {
+	return u.GetCODSettlementsFn(request)
+}
+
+// SaveCODPayouts calls the SaveCODPayoutsFn.
+func (u *CODAppInterface) SaveCODPayouts(
+	request *entity.CODSettlementRequest,
+	createdBy string,
+) ([]*entity.CODPayout, error) {
+	return u.SaveCODPayoutsFn(request, createdBy)
+}
+
+// GetCODPayouts calls the GetCODPayoutsFn.
+func (u *CODAppInterface) GetCODPayouts(params *repository.Parameters) ([]*entity.CODPayout, *repository.Meta, error) {
+	return u.GetCODPayoutsFn(params)
+}
+
+// GetCODPayout calls the GetCODPayoutFn.
+func (u *CODAppInterface) GetCODPayout(uuid string) (*entity.CODPayout, error) {
+	return u.GetCODPayoutFn(uuid)
+}
@@ -0,0 +1,15 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// DataImportAppInterface is a mock of application.DataImportAppInterface.
+type DataImportAppInterface struct {
+	SaveDataImportFn func(*entity.DataImport) (*entity.DataImport, error)
+}
+
+// SaveDataImport calls the SaveDataImportFn.
+func (u *DataImportAppInterface) SaveDataImport(data *entity.DataImport) (*entity.DataImport, error) {
+	return u.SaveDataImportFn(data)
+}
@@ -15,6 +15,10 @@ type DriverAppInterface struct {
 
 	AddDriverVehicleFn    func(*entity.Driver) (*entity.Driver, map[string]string, error)
 	DeleteDriverVehicleFn func(*entity.Driver) (*entity.Driver, map[string]string, error)
+
+	GetDriverWorkRuleFn    func() (*entity.DriverWorkRule, error)
+	UpdateDriverWorkRuleFn func(*entity.DriverWorkRule) (*entity.DriverWorkRule, error)
+	GetDriverTimesheetFn   func(string, *entity.DriverTimesheetRequest) (*entity.DriverTimesheet, map[string]string, error)
 }
 
 // SaveDriver calls the SaveDriverFn.
@@ -53,3 +57,21 @@ func (u *DriverAppInterface) AddDriverVehicle(driver *entity.Driver) (*entity.Dr
 func (u *DriverAppInterface) DeleteDriverVehicle(driver *entity.Driver) (*entity.Driver, map[string]string, error) {
 	return u.DeleteDriverVehicleFn(driver)
 }
+
+// GetDriverWorkRule calls the GetDriverWorkRuleFn.
+func (u *DriverAppInterface) GetDriverWorkRule() (*entity.DriverWorkRule, error) {
+	return u.GetDriverWorkRuleFn()
+}
+
+// UpdateDriverWorkRule calls the UpdateDriverWorkRuleFn.
+func (u *DriverAppInterface) UpdateDriverWorkRule(rule *entity.DriverWorkRule) (*entity.DriverWorkRule, error) {
+	return u.UpdateDriverWorkRuleFn(rule)
+}
+
+// GetDriverTimesheet calls the GetDriverTimesheetFn.
+func (u *DriverAppInterface) GetDriverTimesheet(
+	uuid string,
+	request *entity.DriverTimesheetRequest,
+) (*entity.DriverTimesheet, map[string]string, error) {
+	return u.GetDriverTimesheetFn(uuid, request)
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// GTFSAppInterface is a mock of application.GTFSAppInterface.
+type GTFSAppInterface struct {
+	GetGTFSExportFn  func(*entity.GTFSExport) (*entity.GTFSExport, error)
+	SaveGTFSImportFn func(*entity.GTFSImport) (*entity.GTFSImport, error)
+}
+
+// GetGTFSExport calls the GetGTFSExportFn.
+func (u *GTFSAppInterface) GetGTFSExport(export *entity.GTFSExport) (*entity.GTFSExport, error) {
+	return u.GetGTFSExportFn(export)
+}
+
+// SaveGTFSImport calls the SaveGTFSImportFn.
+func (u *GTFSAppInterface) SaveGTFSImport(data *entity.GTFSImport) (*entity.GTFSImport, error) {
+	return u.SaveGTFSImportFn(data)
+}
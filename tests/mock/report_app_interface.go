@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+)
+
+// ReportAppInterface is a mock of application.ReportAppInterface.
+type ReportAppInterface struct {
+	GetRevenueReportFn      func(*entity.ReportRequest) (entity.ReportRevenues, error)
+	GetLoadFactorReportFn   func(*entity.ReportRequest) (entity.ReportLoadFactors, error)
+	GetAverageFareReportFn  func(*entity.ReportRequest) (entity.ReportAverageFares, error)
+	GetCancellationReportFn func(*entity.ReportRequest) (entity.ReportCancellations, error)
+}
+
+// GetRevenueReport calls the GetRevenueReportFn.
+func (u *ReportAppInterface) GetRevenueReport(request *entity.ReportRequest) (entity.ReportRevenues, error) {
+	return u.GetRevenueReportFn(request)
+}
+
+// GetLoadFactorReport calls the GetLoadFactorReportFn.
+func (u *ReportAppInterface) GetLoadFactorReport(request *entity.ReportRequest) (entity.ReportLoadFactors, error) {
+	return u.GetLoadFactorReportFn(request)
+}
+
+// GetAverageFareReport calls the GetAverageFareReportFn.
+func (u *ReportAppInterface) GetAverageFareReport(request *entity.ReportRequest) (entity.ReportAverageFares, error) {
+	return u.GetAverageFareReportFn(request)
+}
+
+// GetCancellationReport calls the GetCancellationReportFn.
+func (u *ReportAppInterface) GetCancellationReport(
+	request *entity.ReportRequest,
+) (entity.ReportCancellations, error) {
+	return u.GetCancellationReportFn(request)
+}
@@ -0,0 +1,101 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// ShipmentAppInterface is a mock of application.ShipmentAppInterface.
+type ShipmentAppInterface struct {
+	SaveShipmentFn   func(*entity.Shipment) (*entity.Shipment, map[string]string, error)
+	UpdateShipmentFn func(string, *entity.Shipment) (*entity.Shipment, map[string]string, error)
+	DeleteShipmentFn func(UUID string) error
+	GetShipmentsFn   func(params *repository.Parameters) ([]*entity.Shipment, *repository.Meta, error)
+	GetShipmentFn    func(UUID string) (*entity.Shipment, error)
+
+	GetShipmentByTrackingNumberFn func(trackingNumber string) (*entity.Shipment, error)
+	GetTripShipmentsFn            func(tripUUID string) ([]*entity.Shipment, error)
+	SaveShipmentEventFn           func(string, *entity.ShipmentEvent) (*entity.ShipmentEvent, map[string]string, error)
+	GetShipmentEventsFn           func(UUID string) ([]*entity.ShipmentEvent, error)
+
+	IssueShipmentDeliveryCodeFn func(UUID string) (*entity.Shipment, map[string]string, error)
+	VerifyShipmentDeliveryFn    func(UUID string, delivery *entity.ShipmentDelivery) (map[string]string, error)
+	SaveShipmentDeliveryFn      func(string, *entity.ShipmentDelivery) (*entity.ShipmentDelivery, map[string]string, error)
+	GetShipmentDeliveryFn       func(UUID string) (*entity.ShipmentDelivery, error)
+}
+
+// SaveShipment calls the SaveShipmentFn.
+func (u *ShipmentAppInterface) SaveShipment(shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+	return u.SaveShipmentFn(shipment)
+}
+
+// UpdateShipment calls the UpdateShipmentFn.
+func (u *ShipmentAppInterface) UpdateShipment(uuid string, shipment *entity.Shipment) (*entity.Shipment, map[string]string, error) {
+	return u.UpdateShipmentFn(uuid, shipment)
+}
+
+// DeleteShipment calls the DeleteShipmentFn.
+func (u *ShipmentAppInterface) DeleteShipment(uuid string) error {
+	return u.DeleteShipmentFn(uuid)
+}
+
+// GetShipments calls the GetShipmentsFn.
+func (u *ShipmentAppInterface) GetShipments(
+	params *repository.Parameters,
+) ([]*entity.Shipment, *repository.Meta, error) {
+	return u.GetShipmentsFn(params)
+}
+
+// GetShipment calls the GetShipmentFn.
+func (u *ShipmentAppInterface) GetShipment(uuid string) (*entity.Shipment, error) {
+	return u.GetShipmentFn(uuid)
+}
+
+// GetShipmentByTrackingNumber calls the GetShipmentByTrackingNumberFn.
+func (u *ShipmentAppInterface) GetShipmentByTrackingNumber(trackingNumber string) (*entity.Shipment, error) {
+	return u.GetShipmentByTrackingNumberFn(trackingNumber)
+}
+
+// GetTripShipments calls the GetTripShipmentsFn.
+func (u *ShipmentAppInterface) GetTripShipments(tripUUID string) ([]*entity.Shipment, error) {
+	return u.GetTripShipmentsFn(tripUUID)
+}
+
+// SaveShipmentEvent calls the SaveShipmentEventFn.
+func (u *ShipmentAppInterface) SaveShipmentEvent(
+	uuid string,
+	event *entity.ShipmentEvent,
+) (*entity.ShipmentEvent, map[string]string, error) {
+	return u.SaveShipmentEventFn(uuid, event)
+}
+
+// GetShipmentEvents calls the GetShipmentEventsFn.
+func (u *ShipmentAppInterface) GetShipmentEvents(uuid string) ([]*entity.ShipmentEvent, error) {
+	return u.GetShipmentEventsFn(uuid)
+}
+
+// IssueShipmentDeliveryCode calls the IssueShipmentDeliveryCodeFn.
+func (u *ShipmentAppInterface) IssueShipmentDeliveryCode(uuid string) (*entity.Shipment, map[string]string, error) {
+	return u.IssueShipmentDeliveryCodeFn(uuid)
+}
+
+// VerifyShipmentDelivery calls the VerifyShipmentDeliveryFn.
+func (u *ShipmentAppInterface) VerifyShipmentDelivery(
+	uuid string,
+	delivery *entity.ShipmentDelivery,
+) (map[string]string, error) {
+	return u.VerifyShipmentDeliveryFn(uuid, delivery)
+}
+
+// SaveShipmentDelivery calls the SaveShipmentDeliveryFn.
+func (u *ShipmentAppInterface) SaveShipmentDelivery(
+	uuid string,
+	delivery *entity.ShipmentDelivery,
+) (*entity.ShipmentDelivery, map[string]string, error) {
+	return u.SaveShipmentDeliveryFn(uuid, delivery)
+}
+
+// GetShipmentDelivery calls the GetShipmentDeliveryFn.
+func (u *ShipmentAppInterface) GetShipmentDelivery(uuid string) (*entity.ShipmentDelivery, error) {
+	return u.GetShipmentDeliveryFn(uuid)
+}
@@ -0,0 +1,11 @@
+package mock
+
+// SMSGatewayInterface is a mock of notify.SMSGatewayInterface.
+type SMSGatewayInterface struct {
+	SendFn func(receiver []string, message string) error
+}
+
+// Send calls the SendFn.
+func (u *SMSGatewayInterface) Send(receiver []string, message string) error {
+	return u.SendFn(receiver, message)
+}
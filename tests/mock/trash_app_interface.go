@@ -0,0 +1,38 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// TrashAppInterface is a mock of application.TrashAppInterface.
+type TrashAppInterface struct {
+	GetTrashFn func(resource *entity.TrashResource, params *repository.Parameters) (
+		[]interface{},
+		*repository.Meta,
+		error,
+	)
+	RestoreTrashFn func(resource *entity.TrashResource, UUID string) (interface{}, map[string]string, error)
+	PurgeTrashFn   func(resource *entity.TrashResource, UUID string) error
+}
+
+// GetTrash calls the GetTrashFn.
+func (u *TrashAppInterface) GetTrash(
+	resource *entity.TrashResource,
+	params *repository.Parameters,
+) ([]interface{}, *repository.Meta, error) {
+	return u.GetTrashFn(resource, params)
+}
+
+// RestoreTrash calls the RestoreTrashFn.
+func (u *TrashAppInterface) RestoreTrash(
+	resource *entity.TrashResource,
+	UUID string,
+) (interface{}, map[string]string, error) {
+	return u.RestoreTrashFn(resource, UUID)
+}
+
+// PurgeTrash calls the PurgeTrashFn.
+func (u *TrashAppInterface) PurgeTrash(resource *entity.TrashResource, UUID string) error {
+	return u.PurgeTrashFn(resource, UUID)
+}
@@ -12,6 +12,11 @@ type TripAppInterface struct {
 	DeleteTripFn func(UUID string) error
 	GetTripsFn   func(params *repository.Parameters) ([]*entity.Trip, *repository.Meta, error)
 	GetTripFn    func(UUID string) (*entity.Trip, error)
+
+	GetTripLoadPlanFn     func(UUID string, weight float64, volume float64) (*entity.TripLoadPlan, error)
+	SaveTripRouteFn       func(UUID string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error)
+	GetTripRouteFn        func(UUID string) (*entity.TripRoute, error)
+	GetDriverTripRoutesFn func(userUUID string) ([]*entity.TripRoute, error)
 }
 
 // SaveTrip calls the SaveTripFn.
@@ -40,3 +45,23 @@ func (u *TripAppInterface) GetTrips(
 func (u *TripAppInterface) GetTrip(uuid string) (*entity.Trip, error) {
 	return u.GetTripFn(uuid)
 }
+
+// GetTripLoadPlan calls the GetTripLoadPlanFn.
+func (u *TripAppInterface) GetTripLoadPlan(uuid string, weight float64, volume float64) (*entity.TripLoadPlan, error) {
+	return u.GetTripLoadPlanFn(uuid, weight, volume)
+}
+
+// SaveTripRoute calls the SaveTripRouteFn.
+func (u *TripAppInterface) SaveTripRoute(uuid string, route *entity.TripRoute) (*entity.TripRoute, map[string]string, error) {
+	return u.SaveTripRouteFn(uuid, route)
+}
+
+// GetTripRoute calls the GetTripRouteFn.
+func (u *TripAppInterface) GetTripRoute(uuid string) (*entity.TripRoute, error) {
+	return u.GetTripRouteFn(uuid)
+}
+
+// GetDriverTripRoutes calls the GetDriverTripRoutesFn.
+func (u *TripAppInterface) GetDriverTripRoutes(userUUID string) ([]*entity.TripRoute, error) {
+	return u.GetDriverTripRoutesFn(userUUID)
+}
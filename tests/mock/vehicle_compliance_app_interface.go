@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// VehicleComplianceAppInterface is a mock of application.VehicleComplianceAppInterface.
+type VehicleComplianceAppInterface struct {
+	SaveVehicleComplianceFn   func(*entity.VehicleCompliance) (*entity.VehicleCompliance, map[string]string, error)
+	UpdateVehicleComplianceFn func(
+		string,
+		*entity.VehicleCompliance,
+	) (*entity.VehicleCompliance, map[string]string, error)
+	DeleteVehicleComplianceFn func(UUID string) error
+	GetVehicleCompliancesFn   func(
+		params *repository.Parameters,
+	) ([]*entity.VehicleCompliance, *repository.Meta, error)
+	GetVehicleComplianceFn          func(UUID string) (*entity.VehicleCompliance, error)
+	GetExpiringVehicleCompliancesFn func(days int) ([]*entity.VehicleCompliance, error)
+}
+
+// SaveVehicleCompliance calls the SaveVehicleComplianceFn.
+func (u *VehicleComplianceAppInterface) SaveVehicleCompliance(
+	record *entity.VehicleCompliance,
+) (*entity.VehicleCompliance, map[string]string, error) {
+	return u.SaveVehicleComplianceFn(record)
+}
+
+// UpdateVehicleCompliance calls the UpdateVehicleComplianceFn.
+func (u *VehicleComplianceAppInterface) UpdateVehicleCompliance(
+	uuid string,
+	record *entity.VehicleCompliance,
+) (*entity.VehicleCompliance, map[string]string, error) {
+	return u.UpdateVehicleComplianceFn(uuid, record)
+}
+
+// DeleteVehicleCompliance calls the DeleteVehicleComplianceFn.
+func (u *VehicleComplianceAppInterface) DeleteVehicleCompliance(uuid string) error {
+	return u.DeleteVehicleComplianceFn(uuid)
+}
+
+// GetVehicleCompliances calls the GetVehicleCompliancesFn.
+func (u *VehicleComplianceAppInterface) GetVehicleCompliances(
+	params *repository.Parameters,
+) ([]*entity.VehicleCompliance, *repository.Meta, error) {
+	return u.GetVehicleCompliancesFn(params)
+}
+
+// GetVehicleCompliance calls the GetVehicleComplianceFn.
+func (u *VehicleComplianceAppInterface) GetVehicleCompliance(uuid string) (*entity.VehicleCompliance, error) {
+	return u.GetVehicleComplianceFn(uuid)
+}
+
+// GetExpiringVehicleCompliances calls the GetExpiringVehicleCompliancesFn.
+func (u *VehicleComplianceAppInterface) GetExpiringVehicleCompliances(days int) ([]*entity.VehicleCompliance, error) {
+	return u.GetExpiringVehicleCompliancesFn(days)
+}
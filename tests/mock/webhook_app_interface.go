@@ -0,0 +1,78 @@
+package mock
+
+import (
+	"cargo-rest-api/domain/entity"
+	"cargo-rest-api/domain/repository"
+)
+
+// WebhookAppInterface is a mock of application.WebhookAppInterface.
+type WebhookAppInterface struct {
+	SaveWebhookSubscriptionFn func(*entity.WebhookSubscription) (
+		*entity.WebhookSubscription,
+		map[string]string,
+		error,
+	)
+	UpdateWebhookSubscriptionFn func(string, *entity.WebhookSubscription) (
+		*entity.WebhookSubscription,
+		map[string]string,
+		error,
+	)
+	DeleteWebhookSubscriptionFn func(UUID string) error
+	GetWebhookSubscriptionFn    func(UUID string) (*entity.WebhookSubscription, error)
+	GetWebhookSubscriptionsFn   func(params *repository.Parameters) (
+		[]*entity.WebhookSubscription,
+		*repository.Meta,
+		error,
+	)
+	GetWebhookDeliveriesFn func(subscriptionUUID string, params *repository.Parameters) (
+		[]*entity.WebhookDelivery,
+		*repository.Meta,
+		error,
+	)
+	ReplayWebhookDeliveryFn func(UUID string) (*entity.WebhookDelivery, error)
+}
+
+// SaveWebhookSubscription calls the SaveWebhookSubscriptionFn.
+func (u *WebhookAppInterface) SaveWebhookSubscription(
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, map[string]string, error) {
+	return u.SaveWebhookSubscriptionFn(subscription)
+}
+
+// UpdateWebhookSubscription calls the UpdateWebhookSubscriptionFn.
+func (u *WebhookAppInterface) UpdateWebhookSubscription(
+	uuid string,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, map[string]string, error) {
+	return u.UpdateWebhookSubscriptionFn(uuid, subscription)
+}
+
+// DeleteWebhookSubscription calls the DeleteWebhookSubscriptionFn.
+func (u *WebhookAppInterface) DeleteWebhookSubscription(uuid string) error {
+	return u.DeleteWebhookSubscriptionFn(uuid)
+}
+
+// GetWebhookSubscription calls the GetWebhookSubscriptionFn.
+func (u *WebhookAppInterface) GetWebhookSubscription(uuid string) (*entity.WebhookSubscription, error) {
+	return u.GetWebhookSubscriptionFn(uuid)
+}
+
+// GetWebhookSubscriptions calls the GetWebhookSubscriptionsFn.
+func (u *WebhookAppInterface) GetWebhookSubscriptions(
+	params *repository.Parameters,
+) ([]*entity.WebhookSubscription, *repository.Meta, error) {
+	return u.GetWebhookSubscriptionsFn(params)
+}
+
+// GetWebhookDeliveries calls the GetWebhookDeliveriesFn.
+func (u *WebhookAppInterface) GetWebhookDeliveries(
+	subscriptionUUID string,
+	params *repository.Parameters,
+) ([]*entity.WebhookDelivery, *repository.Meta, error) {
+	return u.GetWebhookDeliveriesFn(subscriptionUUID, params)
+}
+
+// ReplayWebhookDelivery calls the ReplayWebhookDeliveryFn.
+func (u *WebhookAppInterface) ReplayWebhookDelivery(uuid string) (*entity.WebhookDelivery, error) {
+	return u.ReplayWebhookDeliveryFn(uuid)
+}